@@ -27,5 +27,5 @@ func init() {
 	app.RootCmd.AddCommand(historyCmd)
 	historyCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
 	historyCmd.PersistentFlags().StringP("output", "o", app.Table,
-		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+		fmt.Sprintf("Output format (one of %s)", strings.Join(append(app.SupportedOutputFormats(), app.SARIF), ",")))
 }
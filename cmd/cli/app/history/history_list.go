@@ -5,6 +5,7 @@ package history
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -19,7 +20,9 @@ import (
 
 	"github.com/mindersec/minder/cmd/cli/app"
 	"github.com/mindersec/minder/cmd/cli/app/common"
+	"github.com/mindersec/minder/internal/constants"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/sarif"
 	"github.com/mindersec/minder/internal/util"
 	"github.com/mindersec/minder/internal/util/cli"
 	"github.com/mindersec/minder/internal/util/cli/table"
@@ -62,7 +65,7 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 	format := viper.GetString("output")
 
 	// Ensure the output format is supported
-	if !app.IsOutputFormatSupported(format) {
+	if !app.IsOutputFormatSupported(format) && format != app.SARIF {
 		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
 	}
 
@@ -126,6 +129,14 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 			return cli.MessageAndError("Error getting yaml from proto", err)
 		}
 		cmd.Println(out)
+	case app.SARIF:
+		builder := sarif.NewBuilder(constants.CLIVersion)
+		builder.AddEvaluationHistory(resp.Data)
+		out, err := json.MarshalIndent(builder.Log(), "", "  ")
+		if err != nil {
+			return cli.MessageAndError("Error getting sarif from evaluation history", err)
+		}
+		cmd.Println(string(out))
 	case app.Table:
 		printTable(cmd.OutOrStderr(), resp)
 	}
@@ -5,6 +5,7 @@
 package status
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -12,6 +13,10 @@ import (
 
 	"github.com/mindersec/minder/cmd/cli/app"
 	"github.com/mindersec/minder/cmd/cli/app/profile"
+	"github.com/mindersec/minder/internal/constants"
+	"github.com/mindersec/minder/internal/sarif"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
 // profileStatusCmd is the root command for the profile_status subcommands
@@ -28,5 +33,22 @@ func init() {
 	profile.ProfileCmd.AddCommand(profileStatusCmd)
 	// Flags
 	profileStatusCmd.PersistentFlags().StringP("output", "o", app.Table,
-		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+		fmt.Sprintf("Output format (one of %s)", strings.Join(append(app.SupportedOutputFormats(), app.SARIF), ",")))
+}
+
+// printSarif prints the failed rule evaluations of a profile as a
+// SARIF log.
+func printSarif(
+	cmd *cobra.Command,
+	profileStatus *minderv1.ProfileStatus,
+	statuses []*minderv1.RuleEvaluationStatus,
+) error {
+	builder := sarif.NewBuilder(constants.CLIVersion)
+	builder.AddRuleEvaluationStatuses(profileStatus.GetProfileName(), statuses)
+	out, err := json.MarshalIndent(builder.Log(), "", "  ")
+	if err != nil {
+		return cli.MessageAndError("Error getting sarif from profile status", err)
+	}
+	cmd.Println(string(out))
+	return nil
 }
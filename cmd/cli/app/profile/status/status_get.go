@@ -39,7 +39,7 @@ func getCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.
 	format := viper.GetString("output")
 
 	// Ensure the output format is supported
-	if !app.IsOutputFormatSupported(format) {
+	if !app.IsOutputFormatSupported(format) && format != app.SARIF {
 		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
 	}
 
@@ -128,6 +128,8 @@ func formatAndDisplayOutputById(cmd *cobra.Command, format string, resp *minderv
 			return cli.MessageAndError("Error getting yaml from proto", err)
 		}
 		cmd.Println(out)
+	case app.SARIF:
+		return printSarif(cmd, resp.GetProfileStatus(), resp.GetRuleEvaluationStatus())
 	case app.Table:
 		table := profile.NewProfileStatusTable()
 		profile.RenderProfileStatusTable(resp.ProfileStatus, table)
@@ -150,6 +152,8 @@ func formatAndDisplayOutputByName(cmd *cobra.Command, format string, resp *minde
 			return cli.MessageAndError("Error getting yaml from proto", err)
 		}
 		cmd.Println(out)
+	case app.SARIF:
+		return printSarif(cmd, resp.GetProfileStatus(), resp.GetRuleEvaluationStatus())
 	case app.Table:
 		table := profile.NewProfileStatusTable()
 		profile.RenderProfileStatusTable(resp.ProfileStatus, table)
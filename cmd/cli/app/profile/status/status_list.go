@@ -38,14 +38,16 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 	ruleName := viper.GetString("ruleName")
 
 	// Ensure the output format is supported
-	if !app.IsOutputFormatSupported(format) {
+	if !app.IsOutputFormatSupported(format) && format != app.SARIF {
 		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
 	}
 
+	// SARIF output is built from the rule evaluations, which are
+	// only returned when listing all of them
 	resp, err := client.GetProfileStatusByName(ctx, &minderv1.GetProfileStatusByNameRequest{
 		Context:  &minderv1.Context{Project: &project},
 		Name:     profileName,
-		All:      detailed,
+		All:      detailed || format == app.SARIF,
 		RuleType: ruleType,
 		RuleName: ruleName,
 	})
@@ -66,6 +68,8 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 			return cli.MessageAndError("Error getting yaml from proto", err)
 		}
 		cmd.Println(out)
+	case app.SARIF:
+		return printSarif(cmd, resp.GetProfileStatus(), resp.GetRuleEvaluationStatus())
 	case app.Table:
 		table := profile.NewProfileStatusTable()
 		profile.RenderProfileStatusTable(resp.ProfileStatus, table)
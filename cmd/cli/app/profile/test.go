@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/cmd/cli/app/common"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// testCmd represents the profile test command
+var testCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Test a profile against registered entities",
+	Long: `The profile test subcommand evaluates a profile against a set of registered entities
+without creating or updating the profile. Rule types which are not yet in the project, or
+modified versions of existing ones, can be passed with --rule-type.
+
+Nothing is recorded in the evaluation history, and no alerts or remediations are performed,
+so this can be used to review the effect of a profile before creating or updating it.`,
+	RunE: cli.GRPCClientWrapRunE(testCommand),
+}
+
+// testCommand is the profile test subcommand
+func testCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProfileServiceClient(conn)
+
+	project := viper.GetString("project")
+	f := viper.GetString("file")
+	format := viper.GetString("output")
+	entityIDs := viper.GetStringSlice("entity")
+	ruleTypeFiles := viper.GetStringSlice("rule-type")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	reader, closer, err := util.OpenFileArg(f, cmd.InOrStdin())
+	if err != nil {
+		return cli.MessageAndError("Error opening profile file", err)
+	}
+	defer closer()
+
+	profile, err := parseProfile(reader, project)
+	if err != nil {
+		return cli.MessageAndError(fmt.Sprintf("Error parsing profile from %s", f), err)
+	}
+
+	ruleTypes, err := readRuleTypes(ruleTypeFiles)
+	if err != nil {
+		return cli.MessageAndError("Error reading rule types", err)
+	}
+
+	entities := make([]*minderv1.EntityTypedId, 0, len(entityIDs))
+	for _, id := range entityIDs {
+		entities = append(entities, &minderv1.EntityTypedId{Id: id})
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	stream, err := client.TestProfile(ctx, &minderv1.TestProfileRequest{
+		Profile:   profile,
+		RuleTypes: ruleTypes,
+		Entities:  entities,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error testing profile", err)
+	}
+
+	t := table.New(table.Simple, layouts.Default, []string{"Entity", "Rule", "Status", "Details"})
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return cli.MessageAndError("Error testing profile", err)
+		}
+
+		switch format {
+		case app.JSON:
+			out, err := util.GetJsonFromProto(res)
+			if err != nil {
+				return cli.MessageAndError("Error getting json from proto", err)
+			}
+			cmd.Println(out)
+		case app.YAML:
+			out, err := util.GetYamlFromProto(res)
+			if err != nil {
+				return cli.MessageAndError("Error getting yaml from proto", err)
+			}
+			cmd.Println("---")
+			cmd.Println(out)
+		case app.Table:
+			t.AddRowWithColor(
+				layouts.NoColor(fmt.Sprintf("%s\n[%s]", res.GetEntityName(), res.GetEntity().GetId())),
+				layouts.NoColor(fmt.Sprintf("%s\n[%s]", res.GetRuleName(), res.GetRuleTypeName())),
+				common.GetEvalStatusColor(res.GetStatus()),
+				layouts.NoColor(cli.ConcatenateAndWrap(res.GetDetails(), 60)),
+			)
+		}
+	}
+
+	if format == app.Table {
+		t.Render()
+	}
+	return nil
+}
+
+// readRuleTypes reads the rule types in the given files or directories
+func readRuleTypes(fileArgs []string) ([]*minderv1.RuleType, error) {
+	files, err := util.ExpandFileArgs(fileArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding file args: %w", err)
+	}
+
+	ruleTypes := make([]*minderv1.RuleType, 0, len(files))
+	for _, f := range files {
+		rt, err := readRuleType(f.Path)
+		if err != nil {
+			// Directories may contain other files, such as rule type tests
+			if f.Expanded && minderv1.YouMayHaveTheWrongResource(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error reading rule type from %s: %w", f.Path, err)
+		}
+		ruleTypes = append(ruleTypes, rt)
+	}
+
+	return ruleTypes, nil
+}
+
+func readRuleType(path string) (*minderv1.RuleType, error) {
+	reader, closer, err := util.OpenFileArg(path, os.Stdin)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	rt := &minderv1.RuleType{}
+	if err := minderv1.ParseResource(reader, rt); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+func init() {
+	ProfileCmd.AddCommand(testCmd)
+	// Flags
+	testCmd.Flags().StringP("file", "f", "", "Path to the YAML defining the profile (or - for stdin)")
+	testCmd.Flags().StringSliceP("entity", "e", []string{},
+		"ID of an entity to test the profile against. Can be specified multiple times.")
+	testCmd.Flags().StringSliceP("rule-type", "r", []string{},
+		"Path to the YAML defining a rule type to use instead of the one in the project. "+
+			"Can be specified multiple times. Can be a directory.")
+	testCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	// Required
+	if err := testCmd.MarkFlagRequired("file"); err != nil {
+		testCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+	if err := testCmd.MarkFlagRequired("entity"); err != nil {
+		testCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alertwebhook
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var setCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Create or replace an alert webhook of a project",
+	Long: `The minder project alert-webhook set command creates an alert webhook,
+or replaces the URL and secret of an existing one. Rule types with a webhook
+alert post to the webhook they name, or to the one called "default".
+
+The URL and secret are stored encrypted and cannot be read back. The secret
+is used to sign payloads with an HMAC-SHA256 X-Minder-Signature header. It can
+be read from a file with --secret-file to keep it out of the shell history.`,
+	RunE: cli.GRPCClientWrapRunE(setCommand),
+}
+
+// setCommand is the command for setting an alert webhook
+func setCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+	url := viper.GetString("url")
+	secret := viper.GetString("secret")
+	secretFile := viper.GetString("secret-file")
+
+	if secretFile != "" {
+		contents, err := os.ReadFile(secretFile) // #nosec G304 -- the file is chosen by the user
+		if err != nil {
+			return cli.MessageAndError("Error reading secret file", err)
+		}
+		secret = strings.TrimSpace(string(contents))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.SetAlertWebhook(ctx, &minderv1.SetAlertWebhookRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name:   name,
+		Url:    url,
+		Secret: secret,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting alert webhook", err)
+	}
+
+	cmd.Println("Successfully set alert webhook:", resp.GetWebhook().GetName())
+	return nil
+}
+
+func init() {
+	AlertWebhookCmd.AddCommand(setCmd)
+
+	setCmd.Flags().StringP("name", "n", "default", "Name of the alert webhook")
+	setCmd.Flags().StringP("url", "u", "", "URL alerts are posted to")
+	setCmd.Flags().StringP("secret", "s", "", "Secret used to sign the alert payloads")
+	setCmd.Flags().String("secret-file", "", "File containing the secret used to sign the alert payloads")
+	setCmd.MarkFlagsMutuallyExclusive("secret", "secret-file")
+	if err := setCmd.MarkFlagRequired("url"); err != nil {
+		panic(err)
+	}
+}
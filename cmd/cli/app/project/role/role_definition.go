@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package role
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var definitionCmd = &cobra.Command{
+	Use:   "definition",
+	Short: "Manage custom role definitions on a project within the minder control plane",
+	Long: `The minder project role definition commands manage the custom roles of a
+project. Custom roles are named sets of project permissions, such as repo_get or
+profile_create, which can then be granted to users like the built-in roles.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	RoleCmd.AddCommand(definitionCmd)
+}
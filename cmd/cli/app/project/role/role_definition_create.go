@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package role
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var definitionCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Define a custom role on a project within the minder control plane",
+	Long: `The minder project role definition create command defines a custom role
+on a particular project, granting the given permissions to the users the role is
+assigned to.`,
+	RunE: cli.GRPCClientWrapRunE(DefinitionCreateCommand),
+}
+
+// DefinitionCreateCommand is the command for defining a custom role on a project
+func DefinitionCreateCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewPermissionsServiceClient(conn)
+
+	name := viper.GetString("name")
+	permissions := viper.GetStringSlice("permission")
+	project := viper.GetString("project")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.CreateRoleDefinition(ctx, &minderv1.CreateRoleDefinitionRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Role: &minderv1.RoleDefinition{
+			Name:        name,
+			Permissions: permissions,
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error defining role", err)
+	}
+
+	cmd.Printf("Defined role %s granting %s.\n",
+		resp.GetRole().GetName(), strings.Join(resp.GetRole().GetPermissions(), ", "))
+	return nil
+}
+
+func init() {
+	definitionCmd.AddCommand(definitionCreateCmd)
+
+	definitionCreateCmd.Flags().StringP("name", "n", "", "the name of the role")
+	definitionCreateCmd.Flags().StringSliceP("permission", "p", nil, "a permission granted by the role, e.g. repo_get")
+	if err := definitionCreateCmd.MarkFlagRequired("name"); err != nil {
+		definitionCreateCmd.Print("Error marking `name` flag as required.")
+		os.Exit(1)
+	}
+	if err := definitionCreateCmd.MarkFlagRequired("permission"); err != nil {
+		definitionCreateCmd.Print("Error marking `permission` flag as required.")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package role
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var definitionDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a custom role definition from a project within the minder control plane",
+	Long: `The minder project role definition delete command deletes a custom role
+from a particular project. The role is revoked from all the users it was granted to.`,
+	RunE: cli.GRPCClientWrapRunE(DefinitionDeleteCommand),
+}
+
+// DefinitionDeleteCommand is the command for deleting a custom role from a project
+func DefinitionDeleteCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewPermissionsServiceClient(conn)
+
+	name := viper.GetString("name")
+	project := viper.GetString("project")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err := client.DeleteRoleDefinition(ctx, &minderv1.DeleteRoleDefinitionRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name: name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting role definition", err)
+	}
+
+	cmd.Printf("Deleted role %s.\n", name)
+	return nil
+}
+
+func init() {
+	definitionCmd.AddCommand(definitionDeleteCmd)
+
+	definitionDeleteCmd.Flags().StringP("name", "n", "", "the name of the role")
+	if err := definitionDeleteCmd.MarkFlagRequired("name"); err != nil {
+		definitionDeleteCmd.Print("Error marking `name` flag as required.")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a secret of a project",
+	Long: `The minder project secret delete command deletes a secret. REST data
+sources of the project which refer to it fail.`,
+	RunE: cli.GRPCClientWrapRunE(deleteCommand),
+}
+
+// deleteCommand is the command for deleting a secret
+func deleteCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err := client.DeleteProjectSecret(ctx, &minderv1.DeleteProjectSecretRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name: name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting secret", err)
+	}
+
+	cmd.Println("Successfully deleted secret:", name)
+	return nil
+}
+
+func init() {
+	SecretCmd.AddCommand(deleteCmd)
+	deleteCmd.Flags().StringP("name", "n", "", "Name of the secret")
+	if err := deleteCmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var setCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Create or replace a secret of a project",
+	Long: `The minder project secret set command creates a secret, or replaces the
+value of an existing one. REST data sources defined in the project can refer
+to the secret by name.
+
+The value is stored encrypted and cannot be read back. It can be read from a
+file with --value-file, e.g. for PEM encoded certificates and keys, or to keep
+it out of the shell history.`,
+	RunE: cli.GRPCClientWrapRunE(setCommand),
+}
+
+// setCommand is the command for setting a secret
+func setCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+	value := viper.GetString("value")
+	valueFile := viper.GetString("value-file")
+
+	if valueFile != "" {
+		contents, err := os.ReadFile(valueFile) // #nosec G304 -- the file is chosen by the user
+		if err != nil {
+			return cli.MessageAndError("Error reading value file", err)
+		}
+		value = string(contents)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.SetProjectSecret(ctx, &minderv1.SetProjectSecretRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name:  name,
+		Value: value,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting secret", err)
+	}
+
+	cmd.Println("Successfully set secret:", resp.GetSecret().GetName())
+	return nil
+}
+
+func init() {
+	SecretCmd.AddCommand(setCmd)
+
+	setCmd.Flags().StringP("name", "n", "", "Name of the secret")
+	setCmd.Flags().String("value", "", "Value of the secret")
+	setCmd.Flags().String("value-file", "", "File containing the value of the secret")
+	setCmd.MarkFlagsMutuallyExclusive("value", "value-file")
+	setCmd.MarkFlagsOneRequired("value", "value-file")
+	if err := setCmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+}
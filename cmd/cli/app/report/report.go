@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package report provides the CLI command for compliance posture reports
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// CSV is the csv format for output
+	CSV = "csv"
+
+	defaultDays = 30
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Report the compliance posture of a project",
+	Long: `The report command aggregates the evaluation history of a project into
+daily posture snapshots, one per profile, rule and entity type.
+
+Each snapshot counts the entities whose latest evaluation at the end of
+the day passed, failed, errored or was skipped, and the mean time it took
+to remediate the failures resolved during the day.`,
+	RunE: cli.GRPCClientWrapRunE(reportCommand),
+}
+
+// reportCommand is the "report" command
+func reportCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	profileName := viper.GetStringSlice("profile-name")
+	entityName := viper.GetStringSlice("entity-name")
+	entityType := viper.GetStringSlice("entity-type")
+	labels := viper.GetStringSlice("label")
+	days := viper.GetInt("days")
+	format := viper.GetString("output")
+
+	if !isOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+	if days <= 0 {
+		return cli.MessageAndError("Number of days must be positive", fmt.Errorf("invalid argument"))
+	}
+
+	// Viper returns time.Time rather than a pointer to it, so we
+	// have to check whether from and/or to were specified by
+	// other means.
+	to := time.Now()
+	if cmd.Flags().Lookup("to").Changed {
+		to = viper.GetTime("to")
+	}
+	from := to.AddDate(0, 0, -days)
+	if cmd.Flags().Lookup("from").Changed {
+		from = viper.GetTime("from")
+	}
+
+	resp, err := client.GetPostureReport(ctx, &minderv1.GetPostureReportRequest{
+		Context:     &minderv1.Context{Project: &project},
+		EntityType:  entityType,
+		EntityName:  entityName,
+		ProfileName: profileName,
+		LabelFilter: labels,
+		From:        timestamppb.New(from),
+		To:          timestamppb.New(to),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error getting posture report", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case CSV:
+		if err := printCSV(cmd.OutOrStdout(), resp.GetSnapshots()); err != nil {
+			return cli.MessageAndError("Error writing csv", err)
+		}
+	case app.Table:
+		printTable(resp.GetSnapshots())
+	}
+
+	return nil
+}
+
+var reportHeader = []string{
+	"Day", "Profile", "Rule", "Rule Type", "Entity Type",
+	"Passing", "Failing", "Errored", "Skipped", "Remediated", "MTTR",
+}
+
+func reportRow(snapshot *minderv1.PostureSnapshot) []string {
+	mttr := ""
+	if snapshot.GetMeanTimeToRemediation() != nil {
+		mttr = snapshot.GetMeanTimeToRemediation().AsDuration().Round(time.Second).String()
+	}
+	return []string{
+		snapshot.GetDay().AsTime().Format(time.DateOnly),
+		snapshot.GetProfile(),
+		snapshot.GetRuleName(),
+		snapshot.GetRuleType(),
+		snapshot.GetEntityType().ToString(),
+		strconv.Itoa(int(snapshot.GetPassing())),
+		strconv.Itoa(int(snapshot.GetFailing())),
+		strconv.Itoa(int(snapshot.GetErrored())),
+		strconv.Itoa(int(snapshot.GetSkipped())),
+		strconv.Itoa(int(snapshot.GetRemediated())),
+		mttr,
+	}
+}
+
+func printCSV(w io.Writer, snapshots []*minderv1.PostureSnapshot) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(reportHeader); err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		if err := writer.Write(reportRow(snapshot)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func printTable(snapshots []*minderv1.PostureSnapshot) {
+	t := table.New(table.Simple, layouts.Default, reportHeader)
+	for _, snapshot := range snapshots {
+		t.AddRow(reportRow(snapshot)...)
+	}
+	t.Render()
+}
+
+func supportedOutputFormats() []string {
+	return append(app.SupportedOutputFormats(), CSV)
+}
+
+func isOutputFormatSupported(format string) bool {
+	return format == CSV || app.IsOutputFormatSupported(format)
+}
+
+func init() {
+	app.RootCmd.AddCommand(reportCmd)
+
+	reportCmd.Flags().StringP("project", "j", "", "ID of the project")
+	reportCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(supportedOutputFormats(), ",")))
+	reportCmd.Flags().StringSlice("profile-name", nil, "Filter the report by profile name")
+	reportCmd.Flags().StringSlice("entity-name", nil, "Filter the report by entity name")
+	reportCmd.Flags().StringSlice("entity-type", nil, "Filter the report by entity type")
+	reportCmd.Flags().StringSliceP("label", "l", nil, "Filter the report by profile label")
+	reportCmd.Flags().String("from", "", "Start of the report, defaults to --days before --to")
+	reportCmd.Flags().String("to", "", "End of the report, defaults to now")
+	reportCmd.Flags().Int("days", defaultDays, "Number of days covered by the report when --from is not set")
+}
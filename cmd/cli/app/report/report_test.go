@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestPrintCSV(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := []*minderv1.PostureSnapshot{
+		{
+			Day:                   timestamppb.New(day),
+			Profile:               "profile",
+			RuleName:              "rule",
+			RuleType:              "rule_type",
+			EntityType:            minderv1.Entity_ENTITY_REPOSITORIES,
+			Passing:               3,
+			Failing:               1,
+			Remediated:            2,
+			MeanTimeToRemediation: durationpb.New(90 * time.Minute),
+		},
+		{
+			Day:        timestamppb.New(day.Add(24 * time.Hour)),
+			Profile:    "profile",
+			RuleName:   "rule, with comma",
+			RuleType:   "rule_type",
+			EntityType: minderv1.Entity_ENTITY_ARTIFACTS,
+			Errored:    1,
+			Skipped:    2,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, printCSV(&buf, snapshots))
+	require.Equal(t,
+		"Day,Profile,Rule,Rule Type,Entity Type,Passing,Failing,Errored,Skipped,Remediated,MTTR\n"+
+			"2025-03-01,profile,rule,rule_type,repository,3,1,0,0,2,1h30m0s\n"+
+			"2025-03-02,profile,\"rule, with comma\",rule_type,artifact,0,0,1,2,0,\n",
+		buf.String())
+}
@@ -70,6 +70,9 @@ const (
 	YAML = "yaml"
 	// Table is the table format for output
 	Table = "table"
+	// SARIF is the sarif format for output, only supported by the
+	// commands listing evaluation results
+	SARIF = "sarif"
 )
 
 // Execute adds all child commands to the root command and sets flags appropriately.
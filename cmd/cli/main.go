@@ -16,10 +16,13 @@ import (
 	_ "github.com/mindersec/minder/cmd/cli/app/profile"
 	_ "github.com/mindersec/minder/cmd/cli/app/profile/status"
 	_ "github.com/mindersec/minder/cmd/cli/app/project"
+	_ "github.com/mindersec/minder/cmd/cli/app/project/alertwebhook"
 	_ "github.com/mindersec/minder/cmd/cli/app/project/role"
+	_ "github.com/mindersec/minder/cmd/cli/app/project/secret"
 	_ "github.com/mindersec/minder/cmd/cli/app/provider"
 	_ "github.com/mindersec/minder/cmd/cli/app/quickstart"
 	_ "github.com/mindersec/minder/cmd/cli/app/repo"
+	_ "github.com/mindersec/minder/cmd/cli/app/report"
 	_ "github.com/mindersec/minder/cmd/cli/app/ruletype"
 	_ "github.com/mindersec/minder/cmd/cli/app/set_project"
 	_ "github.com/mindersec/minder/cmd/cli/app/version"
@@ -5,6 +5,8 @@ package bundles
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,18 +29,37 @@ Arguments:
 build_id: an identifier of the form 'namespace/name@version'
 input: Directory containing bundle profiles and rule types
 output: Path to tar where bundle will be written
+
+The bundle manifest can optionally be signed, either with an ECDSA private key
+(--signing-key, e.g. a key generated by cosign, whose password is read from the
+COSIGN_PASSWORD environment variable) or keyless with a short-lived certificate
+issued by sigstore to the identity of an OIDC token (--identity-token, which
+defaults to the SIGSTORE_ID_TOKEN environment variable).
 `,
 		RunE:         buildCmdRun,
 		SilenceUsage: true,
 	}
+	buildCmd.Flags().String("signing-key", "", "Path to a PEM encoded private key to sign the bundle manifest with")
+	buildCmd.Flags().Bool("keyless", false, "Sign the bundle manifest keyless with a sigstore certificate")
+	buildCmd.Flags().String("identity-token", "", "OIDC token used to sign keyless, defaults to $SIGSTORE_ID_TOKEN")
+	buildCmd.Flags().String("fulcio-url", build.DefaultFulcioURL, "URL of the sigstore certificate authority used to sign keyless")
+	buildCmd.Flags().String("rekor-url", build.DefaultRekorURL, "URL of the sigstore transparency log used to sign keyless")
+	buildCmd.MarkFlagsMutuallyExclusive("signing-key", "keyless")
 	return buildCmd
 }
 
-func buildCmdRun(_ *cobra.Command, args []string) error {
+func buildCmdRun(cmd *cobra.Command, args []string) error {
 	metadata, err := parseVersion(args[0])
 	if err != nil {
 		return err
 	}
+
+	// Read the signing options before touching the bundle directory
+	signOpts, err := signOptionsFromFlags(cmd, args[1])
+	if err != nil {
+		return err
+	}
+
 	packer := build.NewPacker()
 	options := build.InitOptions{
 		Metadata: metadata,
@@ -50,6 +71,12 @@ func buildCmdRun(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	if signOpts != nil {
+		if err := packer.SignBundle(cmd.Context(), signOpts); err != nil {
+			return err
+		}
+	}
+
 	err = packer.WriteToFile(bundle, args[2])
 	if err != nil {
 		return err
@@ -58,6 +85,59 @@ func buildCmdRun(_ *cobra.Command, args []string) error {
 	return nil
 }
 
+// signOptionsFromFlags returns the options to sign the bundle with, or nil
+// if the bundle should not be signed
+func signOptionsFromFlags(cmd *cobra.Command, path string) (*build.SignOptions, error) {
+	keyPath, err := cmd.Flags().GetString("signing-key")
+	if err != nil {
+		return nil, err
+	}
+	keyless, err := cmd.Flags().GetBool("keyless")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case keyPath != "":
+		key, err := os.ReadFile(filepath.Clean(keyPath))
+		if err != nil {
+			return nil, fmt.Errorf("reading signing key: %w", err)
+		}
+		return &build.SignOptions{
+			Path:     path,
+			Key:      key,
+			Password: []byte(os.Getenv("COSIGN_PASSWORD")),
+		}, nil
+	case keyless:
+		token, err := cmd.Flags().GetString("identity-token")
+		if err != nil {
+			return nil, err
+		}
+		if token == "" {
+			token = os.Getenv("SIGSTORE_ID_TOKEN")
+		}
+		if token == "" {
+			return nil, fmt.Errorf("an identity token is required to sign keyless")
+		}
+		fulcioURL, err := cmd.Flags().GetString("fulcio-url")
+		if err != nil {
+			return nil, err
+		}
+		rekorURL, err := cmd.Flags().GetString("rekor-url")
+		if err != nil {
+			return nil, err
+		}
+		return &build.SignOptions{
+			Path:          path,
+			IdentityToken: token,
+			FulcioURL:     fulcioURL,
+			RekorURL:      rekorURL,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
 func parseVersion(id string) (*mindpak.Metadata, error) {
 	firstSplit := strings.Split(id, "/")
 	if len(firstSplit) != 2 {
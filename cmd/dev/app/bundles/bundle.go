@@ -14,6 +14,7 @@ func CmdBundle() *cobra.Command {
 	}
 
 	rtCmd.AddCommand(CmdBuild())
+	rtCmd.AddCommand(CmdPush())
 
 	return rtCmd
 }
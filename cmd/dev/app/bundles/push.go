@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundles
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/sources"
+)
+
+// CmdPush is the push command
+func CmdPush() *cobra.Command {
+	var pushCmd = &cobra.Command{
+		Use:   "push bundle repository",
+		Short: "push a mindpak bundle to an OCI registry",
+		Args:  cobra.ExactArgs(2),
+		Long: `
+The 'bundle push' subcommand allows you to push a mindpak bundle to an OCI registry,
+tagged with the bundle version. The registry credentials are read from the docker config.
+
+Arguments:
+
+bundle: Path to a tar built by 'bundle build', or a directory with a bundle manifest
+repository: Repository below which the bundles are stored, e.g. registry.example.com/mindpaks
+`,
+		RunE:         pushCmdRun,
+		SilenceUsage: true,
+	}
+	pushCmd.Flags().Bool("insecure", false, "Connect to the registry over plain HTTP")
+	return pushCmd
+}
+
+func pushCmdRun(cmd *cobra.Command, args []string) error {
+	insecure, err := cmd.Flags().GetBool("insecure")
+	if err != nil {
+		return err
+	}
+
+	bundle, err := loadBundle(args[0])
+	if err != nil {
+		return err
+	}
+
+	var opts []sources.OCIOption
+	if insecure {
+		opts = append(opts, sources.WithInsecureRegistry())
+	}
+	source, err := sources.NewOCISource(args[1], opts...)
+	if err != nil {
+		return err
+	}
+
+	digest, err := source.PushBundle(cmd.Context(), bundle)
+	if err != nil {
+		return err
+	}
+
+	metadata := bundle.Manifest.Metadata
+	cmd.Printf("pushed %s/%s@%s to %s (%s)\n", metadata.Namespace, metadata.Name, metadata.Version, args[1], digest)
+	return nil
+}
+
+func loadBundle(path string) (*mindpak.Bundle, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading bundle: %w", err)
+	}
+	if info.IsDir() {
+		return mindpak.NewBundleFromDirectory(path)
+	}
+	return mindpak.NewBundleFromTarGZ(path)
+}
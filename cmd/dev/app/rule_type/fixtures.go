@@ -0,0 +1,581 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions"
+	"github.com/mindersec/minder/internal/engine/errors"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/engine/options"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/rtengine"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	tkv1 "github.com/mindersec/minder/pkg/testkit/v1"
+)
+
+const (
+	// testSuiteSuffix is the suffix of the files holding the tests of a rule
+	// type, e.g. the tests of `secret_scanning.yaml` are in
+	// `secret_scanning.test.yaml`.
+	testSuiteSuffix = ".test"
+	// testDataSuffix is the suffix of the directory holding the files the
+	// tests of a rule type refer to, e.g. `secret_scanning.testdata`.
+	testDataSuffix = ".testdata"
+)
+
+// Outcomes of a rule type test
+const (
+	testOutcomePass  = "pass"
+	testOutcomeFail  = "fail"
+	testOutcomeError = "error"
+)
+
+// ruleTypeTestSuite is the contents of a rule type test file
+type ruleTypeTestSuite struct {
+	Tests []ruleTypeTestCase `yaml:"tests"`
+}
+
+// ruleTypeTestCase is a single test of a rule type
+type ruleTypeTestCase struct {
+	// Name is the name of the test
+	Name string `yaml:"name"`
+	// Entity holds the properties of the entity to evaluate, in the same
+	// format as the entity file of a single test
+	Entity map[string]any `yaml:"entity"`
+	// Def and Params are the rule definition and parameters, as they would
+	// appear in a profile
+	Def    map[string]any `yaml:"def"`
+	Params map[string]any `yaml:"params"`
+	// Expect is the expected evaluation status, e.g. success or failure
+	Expect string `yaml:"expect"`
+	// HTTP is the response returned for REST requests which do not match
+	// any of HTTPResponses
+	HTTP          *testHTTPResponse  `yaml:"http"`
+	HTTPResponses []testHTTPResponse `yaml:"http_responses"`
+	// Git is the file tree returned by the git ingester
+	Git *testGitTree `yaml:"git"`
+	// Remediation is the expected outcome of the remediation, if the rule
+	// should be remediated
+	Remediation *testRemediation `yaml:"remediation"`
+}
+
+// testHTTPResponse is a mocked response to REST requests
+type testHTTPResponse struct {
+	Method string `yaml:"method"`
+	Path   string `yaml:"path"`
+	Status int    `yaml:"status"`
+	Body   string `yaml:"body"`
+	// BodyFile is a file in the test data directory to read the body from
+	BodyFile string            `yaml:"body_file"`
+	Headers  map[string]string `yaml:"headers"`
+}
+
+// testGitTree is the file tree of a mocked git repository
+type testGitTree struct {
+	// RepoBase is a directory in the test data directory
+	RepoBase string `yaml:"repo_base"`
+	// Files maps file paths to their contents
+	Files map[string]string `yaml:"files"`
+}
+
+// testRemediation is the expected outcome of a remediation
+type testRemediation struct {
+	// Status is the expected remediation status, e.g. success or skipped
+	Status string `yaml:"status"`
+	// Requests are the REST requests the remediation is expected to make
+	Requests []testExpectedRequest `yaml:"requests"`
+}
+
+// testExpectedRequest is a REST request a remediation is expected to make
+type testExpectedRequest struct {
+	Method string `yaml:"method"`
+	Path   string `yaml:"path"`
+	// Body is compared to the request body as JSON
+	Body any `yaml:"body"`
+}
+
+// ruleTypeTestResult is the result of running a single rule type test
+type ruleTypeTestResult struct {
+	RuleType string
+	Name     string
+	Outcome  string
+	Details  string
+	Duration time.Duration
+}
+
+// ruleTypeTestFile is a rule type together with its test file
+type ruleTypeTestFile struct {
+	ruleTypePath string
+	testPath     string
+}
+
+// dataDir returns the directory holding the files the tests refer to
+func (f ruleTypeTestFile) dataDir() string {
+	return strings.TrimSuffix(f.ruleTypePath, filepath.Ext(f.ruleTypePath)) + testDataSuffix
+}
+
+// discoverRuleTypeTests finds the rule types with a test file in the given
+// path. If the path is a rule type file, its test file must exist.
+func discoverRuleTypeTests(path string) ([]ruleTypeTestFile, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		tf, ok := testFileForRuleType(path)
+		if !ok {
+			return nil, fmt.Errorf("no test file found for rule type %s", path)
+		}
+		return []ruleTypeTestFile{tf}, nil
+	}
+
+	var tests []ruleTypeTestFile
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if strings.HasSuffix(p, testDataSuffix) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if isTestFile(p) {
+			return nil
+		}
+		if tf, ok := testFileForRuleType(p); ok {
+			tests = append(tests, tf)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking %s: %w", path, err)
+	}
+
+	return tests, nil
+}
+
+func isTestFile(path string) bool {
+	ext := filepath.Ext(path)
+	return strings.HasSuffix(strings.TrimSuffix(path, ext), testSuiteSuffix)
+}
+
+func testFileForRuleType(path string) (ruleTypeTestFile, bool) {
+	ext := filepath.Ext(path)
+	if ext != ".yaml" && ext != ".yml" {
+		return ruleTypeTestFile{}, false
+	}
+
+	base := strings.TrimSuffix(path, ext)
+	for _, testExt := range []string{".yaml", ".yml"} {
+		testPath := base + testSuiteSuffix + testExt
+		if _, err := os.Stat(testPath); err == nil {
+			return ruleTypeTestFile{ruleTypePath: path, testPath: testPath}, true
+		}
+	}
+	return ruleTypeTestFile{}, false
+}
+
+func readRuleTypeTestSuite(path string) (*ruleTypeTestSuite, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	suite := &ruleTypeTestSuite{}
+	if err := yaml.Unmarshal(data, suite); err != nil {
+		return nil, fmt.Errorf("error parsing rule type tests: %w", err)
+	}
+	return suite, nil
+}
+
+// ruleTypeTestRunner runs the tests of rule types against the fakes of the
+// testkit.
+type ruleTypeTestRunner struct {
+	// converter is the provider used to convert the entity properties to
+	// the protobuf messages the rules are evaluated against
+	converter  provifv1.Provider
+	dsRegistry *v1datasources.DataSourceRegistry
+}
+
+// runFile runs all the tests of a rule type
+func (r *ruleTypeTestRunner) runFile(ctx context.Context, tf ruleTypeTestFile) []ruleTypeTestResult {
+	ruletype, err := readRuleTypeFromFile(tf.ruleTypePath)
+	if err != nil {
+		return []ruleTypeTestResult{{
+			RuleType: tf.ruleTypePath,
+			Outcome:  testOutcomeError,
+			Details:  err.Error(),
+		}}
+	}
+
+	setTestContext(ruletype)
+
+	suite, err := readRuleTypeTestSuite(tf.testPath)
+	if err != nil {
+		return []ruleTypeTestResult{{
+			RuleType: ruletype.GetName(),
+			Outcome:  testOutcomeError,
+			Details:  fmt.Sprintf("%s: %s", tf.testPath, err),
+		}}
+	}
+
+	results := make([]ruleTypeTestResult, 0, len(suite.Tests))
+	for i := range suite.Tests {
+		tc := &suite.Tests[i]
+		start := time.Now()
+		outcome, details := r.runCase(ctx, ruletype, tc, tf.dataDir())
+		results = append(results, ruleTypeTestResult{
+			RuleType: ruletype.GetName(),
+			Name:     tc.Name,
+			Outcome:  outcome,
+			Details:  details,
+			Duration: time.Since(start),
+		})
+	}
+
+	return results
+}
+
+// runCase runs a single test, and returns its outcome and the details of
+// why it didn't pass.
+func (r *ruleTypeTestRunner) runCase(
+	ctx context.Context,
+	ruletype *minderv1.RuleType,
+	tc *ruleTypeTestCase,
+	dataDir string,
+) (string, string) {
+	tk, err := testKitForCase(tc, dataDir)
+	if err != nil {
+		return testOutcomeError, err.Error()
+	}
+
+	eng, err := rtengine.NewRuleTypeEngine(ctx, ruletype, tk, nil /*experiments*/, options.WithDataSources(r.dsRegistry))
+	if err != nil {
+		return testOutcomeError, fmt.Sprintf("cannot create rule type engine: %s", err)
+	}
+	if tk.ShouldOverrideIngest() {
+		eng.WithCustomIngester(tk)
+	}
+
+	def, err := structpb.NewStruct(tc.Def)
+	if err != nil {
+		return testOutcomeError, fmt.Sprintf("invalid def: %s", err)
+	}
+	params, err := structpb.NewStruct(tc.Params)
+	if err != nil {
+		return testOutcomeError, fmt.Sprintf("invalid params: %s", err)
+	}
+
+	val := eng.GetRuleInstanceValidator()
+	if err := val.ValidateRuleDefAgainstSchema(def.AsMap()); err != nil {
+		return testOutcomeError, fmt.Sprintf("error validating rule against schema: %s", err)
+	}
+	if err := val.ValidateParamsAgainstSchema(params.AsMap()); err != nil {
+		return testOutcomeError, fmt.Sprintf("error validating params against schema: %s", err)
+	}
+
+	// The properties go through structpb so that they have the same types
+	// as when they're read from an entity file.
+	entityProps, err := structpb.NewStruct(tc.Entity)
+	if err != nil {
+		return testOutcomeError, fmt.Sprintf("invalid entity: %s", err)
+	}
+	ewp := entityWithPropertiesFromMap(
+		entityProps.AsMap(), uuid.MustParse(testProjectID),
+		minderv1.EntityFromString(ruletype.GetDef().GetInEntity()))
+	inf, err := entityWithPropertiesToEntityInfoWrapper(ewp, r.converter)
+	if err != nil {
+		return testOutcomeError, fmt.Sprintf("error converting entity: %s", err)
+	}
+
+	rule := models.RuleFromPB(uuid.New(), &minderv1.Profile_Rule{
+		Type:   ruletype.GetName(),
+		Name:   tc.Name,
+		Def:    def,
+		Params: params,
+	})
+	evalStatus := &engif.EvalStatusParams{
+		Rule: &rule,
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{
+			RemStatus:   db.RemediationStatusTypesSkipped,
+			RemMetadata: []byte("{}"),
+		},
+	}
+
+	_, evalErr := eng.Eval(ctx, inf.Entity, rule.Def, rule.Params, evalStatus)
+	evalStatus.SetEvalErr(evalErr)
+
+	if status := errors.ErrorAsEvalStatus(evalErr); string(status) != tc.Expect {
+		details := fmt.Sprintf("expected %s, got %s", tc.Expect, status)
+		if evalErr != nil {
+			details = fmt.Sprintf("%s: %s", details, errors.ErrorAsEvalDetails(evalErr))
+		}
+		return testOutcomeFail, details
+	}
+
+	if tc.Remediation != nil {
+		return checkRemediation(ctx, ruletype, tk, inf.Entity, evalStatus, tc.Remediation)
+	}
+
+	return testOutcomePass, ""
+}
+
+// checkRemediation remediates the entity and compares the outcome with the
+// expected one. Only the requests made through the REST fake of the testkit
+// can be checked.
+func checkRemediation(
+	ctx context.Context,
+	ruletype *minderv1.RuleType,
+	tk *tkv1.TestKit,
+	entity protoreflect.ProtoMessage,
+	evalStatus *engif.EvalStatusParams,
+	expected *testRemediation,
+) (string, string) {
+	actionEngine, err := actions.NewRuleActions(ctx, ruletype, tk, &models.ActionConfiguration{
+		Remediate: models.ActionOptOn,
+		Alert:     models.ActionOptOff,
+	}, nil /*webhooks*/, nil /*repos*/)
+	if err != nil {
+		return testOutcomeError, fmt.Sprintf("cannot create rule actions engine: %s", err)
+	}
+
+	before := len(tk.Requests())
+	actionsErr := actionEngine.DoActions(ctx, entity, evalStatus)
+
+	if expected.Status != "" {
+		if status := errors.ErrorAsRemediationStatus(actionsErr.RemediateErr); string(status) != expected.Status {
+			details := fmt.Sprintf("expected remediation %s, got %s", expected.Status, status)
+			if actionsErr.RemediateErr != nil {
+				details = fmt.Sprintf("%s: %s", details, actionsErr.RemediateErr)
+			}
+			return testOutcomeFail, details
+		}
+	}
+
+	if expected.Requests != nil {
+		if err := compareRequests(expected.Requests, tk.Requests()[before:]); err != nil {
+			return testOutcomeFail, err.Error()
+		}
+	}
+
+	return testOutcomePass, ""
+}
+
+func compareRequests(expected []testExpectedRequest, actual []tkv1.HTTPRequest) error {
+	if len(expected) != len(actual) {
+		return fmt.Errorf("expected %d remediation requests, got %d", len(expected), len(actual))
+	}
+
+	for i, exp := range expected {
+		act := actual[i]
+		if !strings.EqualFold(exp.Method, act.Method) {
+			return fmt.Errorf("remediation request %d: expected method %s, got %s", i, exp.Method, act.Method)
+		}
+		if exp.Path != act.URL {
+			return fmt.Errorf("remediation request %d: expected path %s, got %s", i, exp.Path, act.URL)
+		}
+		if exp.Body == nil {
+			continue
+		}
+		if err := compareJSONBody(exp.Body, act.Body); err != nil {
+			return fmt.Errorf("remediation request %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func compareJSONBody(expected any, actual []byte) error {
+	expJSON, err := json.Marshal(expected)
+	if err != nil {
+		return fmt.Errorf("invalid expected body: %w", err)
+	}
+
+	var exp, act any
+	if err := json.Unmarshal(expJSON, &exp); err != nil {
+		return fmt.Errorf("invalid expected body: %w", err)
+	}
+	if err := json.Unmarshal(actual, &act); err != nil {
+		return fmt.Errorf("body is not JSON: %s", actual)
+	}
+
+	if !reflect.DeepEqual(exp, act) {
+		return fmt.Errorf("expected body %s, got %s", expJSON, actual)
+	}
+	return nil
+}
+
+// testKitForCase sets up the testkit fakes for a test
+func testKitForCase(tc *ruleTypeTestCase, dataDir string) (*tkv1.TestKit, error) {
+	var opts []tkv1.Option
+
+	if tc.HTTP != nil {
+		resp, err := tc.HTTP.toTestKit(dataDir)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, tkv1.WithHTTP(resp.Status, resp.Body, resp.Headers))
+	}
+
+	for i := range tc.HTTPResponses {
+		resp, err := tc.HTTPResponses[i].toTestKit(dataDir)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, tkv1.WithHTTPResponse(resp))
+	}
+
+	if tc.Git != nil {
+		gitFS, err := tc.Git.toFilesystem(dataDir)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, tkv1.WithGitFS(gitFS))
+	}
+
+	return tkv1.NewTestKit(opts...), nil
+}
+
+func (h *testHTTPResponse) toTestKit(dataDir string) (tkv1.HTTPResponse, error) {
+	body := []byte(h.Body)
+	if h.BodyFile != "" {
+		var err error
+		body, err = os.ReadFile(filepath.Join(dataDir, filepath.Clean(h.BodyFile)))
+		if err != nil {
+			return tkv1.HTTPResponse{}, fmt.Errorf("error reading body file: %w", err)
+		}
+	}
+
+	status := h.Status
+	if status == 0 {
+		status = 200
+	}
+
+	return tkv1.HTTPResponse{
+		Method:  h.Method,
+		Path:    h.Path,
+		Status:  status,
+		Body:    body,
+		Headers: h.Headers,
+	}, nil
+}
+
+func (g *testGitTree) toFilesystem(dataDir string) (billy.Filesystem, error) {
+	if g.RepoBase != "" {
+		dir := filepath.Join(dataDir, filepath.Clean(g.RepoBase))
+		if _, err := os.Stat(dir); err != nil {
+			return nil, fmt.Errorf("error reading repo_base: %w", err)
+		}
+		return osfs.New(dir), nil
+	}
+
+	gitFS := memfs.New()
+	// Sort the paths so that errors are reported consistently
+	paths := make([]string, 0, len(g.Files))
+	for p := range g.Files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		f, err := gitFS.Create(p)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s: %w", p, err)
+		}
+		_, err = f.Write([]byte(g.Files[p]))
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error writing %s: %w", p, err)
+		}
+	}
+
+	return gitFS, nil
+}
+
+// runRuleTypeTests runs the tests of the rule types found in path, prints
+// the results and optionally writes them as a JUnit XML report.
+func runRuleTypeTests(
+	ctx context.Context,
+	cmd *cobra.Command,
+	path string,
+	runner *ruleTypeTestRunner,
+	junitPath string,
+) error {
+	testFiles, err := discoverRuleTypeTests(path)
+	if err != nil {
+		return err
+	}
+	if len(testFiles) == 0 {
+		return fmt.Errorf("no rule type tests found in %s", path)
+	}
+
+	var results []ruleTypeTestResult
+	for _, tf := range testFiles {
+		results = append(results, runner.runFile(ctx, tf)...)
+	}
+
+	t := table.New(table.Simple, layouts.Default, []string{"Rule Type", "Test", "Result", "Details"})
+	failed := 0
+	for _, res := range results {
+		outcome := layouts.GreenColumn(res.Outcome)
+		if res.Outcome != testOutcomePass {
+			outcome = layouts.RedColumn(res.Outcome)
+			failed++
+		}
+		t.AddRowWithColor(
+			layouts.NoColor(res.RuleType),
+			layouts.NoColor(res.Name),
+			outcome,
+			layouts.NoColor(cli.ConcatenateAndWrap(res.Details, 60)),
+		)
+	}
+	t.Render()
+
+	if junitPath != "" {
+		f, err := os.Create(filepath.Clean(junitPath))
+		if err != nil {
+			return fmt.Errorf("error creating JUnit report: %w", err)
+		}
+		defer f.Close()
+		if err := writeJUnitReport(f, results); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d rule type tests did not pass", failed, len(results))
+	}
+	cmd.Printf("All %d rule type tests passed\n", len(results))
+	return nil
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+)
+
+func newTestRunner(t *testing.T) *ruleTypeTestRunner {
+	t.Helper()
+
+	prov, err := getProvider("github", "", "")
+	require.NoError(t, err)
+
+	return &ruleTypeTestRunner{
+		converter:  prov,
+		dsRegistry: v1datasources.NewDataSourceRegistry(),
+	}
+}
+
+func TestRuleTypeTestRunner(t *testing.T) {
+	t.Parallel()
+
+	testFiles, err := discoverRuleTypeTests("testdata")
+	require.NoError(t, err)
+	require.Len(t, testFiles, 2)
+
+	runner := newTestRunner(t)
+	for _, tf := range testFiles {
+		for _, res := range runner.runFile(context.Background(), tf) {
+			require.Equal(t, testOutcomePass, res.Outcome, "%s/%s: %s", res.RuleType, res.Name, res.Details)
+		}
+	}
+}
+
+func TestRuleTypeTestRunnerFailures(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rtData, err := os.ReadFile(filepath.Join("testdata", "secret_scanning.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret_scanning.yaml"), rtData, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret_scanning.test.yaml"), []byte(`
+tests:
+  - name: wrong status
+    entity: &repo
+      github/repo_owner: mindersec
+      github/repo_name: minder
+      github/repo_id: 1234
+      is_private: false
+      is_fork: false
+    def: {}
+    expect: success
+    http:
+      body: '{"security_and_analysis": {"secret_scanning": {"status": "disabled"}}}'
+  - name: wrong remediation
+    entity: *repo
+    def: {}
+    expect: failure
+    http:
+      body: '{}'
+    remediation:
+      requests:
+        - method: PATCH
+          path: /repos/mindersec/minder
+          body:
+            security_and_analysis: {}
+  - name: missing body file
+    entity: *repo
+    def: {}
+    expect: success
+    http:
+      body_file: missing.json
+`), 0600))
+
+	testFiles, err := discoverRuleTypeTests(filepath.Join(dir, "secret_scanning.yaml"))
+	require.NoError(t, err)
+	require.Len(t, testFiles, 1)
+
+	results := newTestRunner(t).runFile(context.Background(), testFiles[0])
+	require.Len(t, results, 3)
+
+	require.Equal(t, testOutcomeFail, results[0].Outcome)
+	require.Contains(t, results[0].Details, "expected success, got failure")
+
+	require.Equal(t, testOutcomeFail, results[1].Outcome)
+	require.Contains(t, results[1].Details, "expected body")
+
+	require.Equal(t, testOutcomeError, results[2].Outcome)
+	require.Contains(t, results[2].Details, "error reading body file")
+}
+
+func TestDiscoverRuleTypeTestsMissing(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "no_tests.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("name: no_tests\n"), 0600))
+
+	_, err := discoverRuleTypeTests(path)
+	require.ErrorContains(t, err, "no test file found")
+
+	testFiles, err := discoverRuleTypeTests(dir)
+	require.NoError(t, err)
+	require.Empty(t, testFiles)
+}
+
+func TestWriteJUnitReport(t *testing.T) {
+	t.Parallel()
+
+	results := []ruleTypeTestResult{
+		{RuleType: "rule_a", Name: "passes", Outcome: testOutcomePass, Duration: time.Second},
+		{RuleType: "rule_a", Name: "fails", Outcome: testOutcomeFail, Details: "expected success, got failure"},
+		{RuleType: "rule_b", Name: "errors", Outcome: testOutcomeError, Details: "invalid def"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeJUnitReport(&buf, results))
+
+	var report junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &report))
+
+	require.Equal(t, 3, report.Tests)
+	require.Equal(t, 1, report.Failures)
+	require.Equal(t, 1, report.Errors)
+	require.Len(t, report.Suites, 2)
+
+	require.Equal(t, "rule_a", report.Suites[0].Name)
+	require.Equal(t, 2, report.Suites[0].Tests)
+	require.Equal(t, "1.000", report.Suites[0].Time)
+	require.Nil(t, report.Suites[0].Cases[0].Failure)
+	require.Equal(t, "expected success, got failure", report.Suites[0].Cases[1].Failure.Message)
+
+	require.Equal(t, "rule_b", report.Suites[1].Name)
+	require.Equal(t, "invalid def", report.Suites[1].Cases[0].Error.Message)
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// junitTestSuites is the root element of a JUnit XML report
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite holds the tests of a single rule type
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnitReport writes the results of the rule type tests as a JUnit XML
+// report, with a test suite per rule type.
+func writeJUnitReport(w io.Writer, results []ruleTypeTestResult) error {
+	report := junitTestSuites{}
+	suiteIdx := make(map[string]int)
+	durations := make(map[string]time.Duration)
+
+	for _, res := range results {
+		idx, ok := suiteIdx[res.RuleType]
+		if !ok {
+			idx = len(report.Suites)
+			suiteIdx[res.RuleType] = idx
+			report.Suites = append(report.Suites, junitTestSuite{Name: res.RuleType})
+		}
+		suite := &report.Suites[idx]
+
+		tc := junitTestCase{
+			Name:      res.Name,
+			Classname: res.RuleType,
+			Time:      formatJUnitDuration(res.Duration),
+		}
+		switch res.Outcome {
+		case testOutcomeFail:
+			tc.Failure = &junitMessage{Message: res.Details, Text: res.Details}
+			suite.Failures++
+			report.Failures++
+		case testOutcomeError:
+			tc.Error = &junitMessage{Message: res.Details, Text: res.Details}
+			suite.Errors++
+			report.Errors++
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+		suite.Tests++
+		report.Tests++
+		durations[res.RuleType] += res.Duration
+	}
+
+	for i := range report.Suites {
+		report.Suites[i].Time = formatJUnitDuration(durations[report.Suites[i].Name])
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("error writing report: %w", err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("error encoding report: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func formatJUnitDuration(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
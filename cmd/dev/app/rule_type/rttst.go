@@ -46,19 +46,34 @@ import (
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
+const (
+	// testProviderName and testProjectID are the provider and project the
+	// rule types are tested in
+	testProviderName = "test"
+	testProjectID    = "00000000-0000-0000-0000-000000000002"
+)
+
 // CmdTest is the root command for the rule subcommands
 func CmdTest() *cobra.Command {
 	var testCmd = &cobra.Command{
-		Use:          "test",
-		Short:        "test a rule type definition",
-		Long:         `The 'rule type test' subcommand allows you test a rule type definition`,
+		Use:   "test",
+		Short: "test a rule type definition",
+		Long: `The 'rule type test' subcommand allows you test a rule type definition.
+
+When an entity is given, the rule type is evaluated against it using the
+given provider. Otherwise, the tests in the '<rule type>.test.yaml' file
+next to the rule type are run against mocked REST responses and git
+repositories. If --rule-type is a directory, the tests of all the rule
+types in it are run.`,
 		RunE:         testCmdRun,
 		SilenceUsage: true,
 	}
 
 	testCmd.Flags().String("log-level", "error", "Log Level")
-	testCmd.Flags().StringP("rule-type", "r", "", "file to read rule type definition from")
-	testCmd.Flags().StringP("entity", "e", "", "YAML file containing the entity to test the rule against")
+	testCmd.Flags().StringP("rule-type", "r", "",
+		"file to read rule type definition from, or directory of rule types to run the tests of")
+	testCmd.Flags().StringP("entity", "e", "",
+		"YAML file containing the entity to test the rule against. If not set, the tests of the rule type are run")
 	testCmd.Flags().StringP("profile", "p", "", "YAML file containing a profile to test the rule against")
 	testCmd.Flags().StringP("provider", "P", "github", "The provider class to test the rule against")
 	testCmd.Flags().StringP("provider-config", "c", "", "YAML file containing the provider configuration (optional)")
@@ -67,17 +82,13 @@ func CmdTest() *cobra.Command {
 	testCmd.Flags().StringP("token", "t", "", "token to authenticate to the provider."+
 		"Can also be set via the TEST_AUTH_TOKEN environment variable.")
 	testCmd.Flags().StringArrayP("data-source", "d", []string{}, "YAML file containing the data source to test the rule with")
+	testCmd.Flags().String("junit-xml", "", "File to write the results of the rule type tests to, in JUnit XML format")
 
 	if err := testCmd.MarkFlagRequired("rule-type"); err != nil {
 		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
 		os.Exit(1)
 	}
 
-	if err := testCmd.MarkFlagRequired("entity"); err != nil {
-		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
-		os.Exit(1)
-	}
-
 	if err := viper.BindPFlag("test.auth.token", testCmd.Flags().Lookup("token")); err != nil {
 		fmt.Fprintf(os.Stderr, "Error binding flag: %s\n", err)
 		os.Exit(1)
@@ -123,20 +134,20 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 		cmd.Println("If the rule you're testing is rego-based, you will not be able to use `print` statements for debugging.")
 	}
 
+	if epath.Value.String() == "" {
+		return testFixturesRun(cmd, rtpath.Value.String(), providerclass.Value.String(),
+			providerconfig.Value.String(), dataSourcefiles)
+	}
+
 	ruletype, err := readRuleTypeFromFile(rtpath.Value.String())
 	if err != nil {
 		return fmt.Errorf("error reading rule type from file: %w", err)
 	}
 
-	provider := "test"
-	rootProject := "00000000-0000-0000-0000-000000000002"
-	ruletype.Context = &minderv1.Context{
-		Provider: &provider,
-		Project:  &rootProject,
-	}
+	setTestContext(ruletype)
 
 	ewp, err := readEntityWithPropertiesFromFile(
-		epath.Value.String(), uuid.MustParse(rootProject), minderv1.EntityFromString(ruletype.Def.InEntity))
+		epath.Value.String(), uuid.MustParse(testProjectID), minderv1.EntityFromString(ruletype.Def.InEntity))
 	if err != nil {
 		return fmt.Errorf("error reading entity from file: %w", err)
 	}
@@ -201,7 +212,7 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return fmt.Errorf("cannot create rule type engine: %w", err)
 	}
-	actionEngine, err := actions.NewRuleActions(ctx, ruletype, prov, &actionConfig)
+	actionEngine, err := actions.NewRuleActions(ctx, ruletype, prov, &actionConfig, nil /*webhooks*/, nil /*repos*/)
 	if err != nil {
 		return fmt.Errorf("cannot create rule actions engine: %w", err)
 	}
@@ -214,6 +225,31 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 	return runEvaluationForRules(cmd, eng, ewp, profSel, remediateStatus, remMetadata, rules, actionEngine, prov)
 }
 
+func testFixturesRun(
+	cmd *cobra.Command, rtpath string, providerclass string, providerconfig string, dataSourcefiles []*os.File,
+) error {
+	// The provider is only used to convert the entity properties, so it
+	// doesn't need a token.
+	prov, err := getProvider(providerclass, "", providerconfig)
+	if err != nil {
+		return err
+	}
+
+	dsRegistry, err := getDataSources(dataSourcefiles)
+	if err != nil {
+		return fmt.Errorf("error getting data sources: %w", err)
+	}
+
+	logConfig := serverconfig.LoggingConfig{Level: cmd.Flag("log-level").Value.String()}
+	ctx := serverconfig.LoggerFromConfigFlags(logConfig).WithContext(cmd.Context())
+
+	runner := &ruleTypeTestRunner{
+		converter:  prov,
+		dsRegistry: dsRegistry,
+	}
+	return runRuleTypeTests(ctx, cmd, rtpath, runner, cmd.Flag("junit-xml").Value.String())
+}
+
 func getProfileSelectors(entType minderv1.Entity, profile *minderv1.Profile) (selectors.Selection, error) {
 	selectorEnv := selectors.NewEnv()
 
@@ -335,6 +371,17 @@ func selectAndEval(
 	return evalErr
 }
 
+// setTestContext sets the context of the rule type to the provider and
+// project it is tested in
+func setTestContext(ruletype *minderv1.RuleType) {
+	provider := testProviderName
+	project := testProjectID
+	ruletype.Context = &minderv1.Context{
+		Provider: &provider,
+		Project:  &project,
+	}
+}
+
 func readRuleTypeFromFile(fpath string) (*minderv1.RuleType, error) {
 	f, err := os.Open(filepath.Clean(fpath))
 	if err != nil {
@@ -371,6 +418,12 @@ func readEntityWithPropertiesFromFile(
 		return nil, fmt.Errorf("error decoding json: %w", err)
 	}
 
+	return entityWithPropertiesFromMap(propertiesMap, projectID, entType), nil
+}
+
+func entityWithPropertiesFromMap(
+	propertiesMap map[string]any, projectID uuid.UUID, entType minderv1.Entity,
+) *entModels.EntityWithProperties {
 	props := entProps.NewProperties(propertiesMap)
 
 	return &entModels.EntityWithProperties{
@@ -382,7 +435,7 @@ func readEntityWithPropertiesFromFile(
 			ProjectID:  projectID,
 		},
 		Properties: props,
-	}, nil
+	}
 }
 
 func entityWithPropertiesToEntityInfoWrapper(
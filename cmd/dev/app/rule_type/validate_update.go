@@ -9,7 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/mindersec/minder/internal/util/schemaupdate"
+	"github.com/mindersec/minder/pkg/ruletypes"
 )
 
 // CmdValidateUpdate is the command for validating an update of a rule type definition
@@ -53,23 +53,8 @@ func vuCmdRun(cmd *cobra.Command, _ []string) error {
 	}
 
 	// We only validate the after rule type because the before rule type is assumed to be valid
-	if err := afterRt.Validate(); err != nil {
-		return fmt.Errorf("error validating rule type %s: %w", afterPath, err)
-	}
-
-	if beforeRt.GetName() != afterRt.GetName() {
-		return fmt.Errorf("rule type name cannot be changed")
-	}
-
-	beforeDef := beforeRt.GetDef()
-	afterDef := afterRt.GetDef()
-
-	if err := schemaupdate.ValidateSchemaUpdate(beforeDef.GetRuleSchema(), afterDef.GetRuleSchema()); err != nil {
-		return fmt.Errorf("error validating rule schema update: %w", err)
-	}
-
-	if err := schemaupdate.ValidateSchemaUpdate(beforeDef.GetParamSchema(), afterDef.GetParamSchema()); err != nil {
-		return fmt.Errorf("error validating param schema update: %w", err)
+	if err := ruletypes.ValidateRuleTypeUpdate(beforeRt, afterRt); err != nil {
+		return fmt.Errorf("error validating update of rule type %s: %w", afterPath, err)
 	}
 
 	return nil
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/open-feature/go-sdk/openfeature"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	datasourcessvc "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/marketplaces"
+	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/eventer"
+	"github.com/mindersec/minder/pkg/flags"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// bundleUpgradeCmd represents the `bundle upgrade` command
+var bundleUpgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade the bundle a project is subscribed to",
+	Long: `Upgrades the subscription of a project to the version of the bundle in the marketplace.
+
+The rule types, data sources and profiles installed from the bundle are compared
+against the new version of the bundle, and the changes are printed before
+applying them. Rule type changes are validated in the same way as
+"mindev ruletype validate-update", and the upgrade is refused if any change is
+invalid. All changes are applied in a single transaction.`,
+	RunE: bundleUpgradeCommand,
+}
+
+func bundleUpgradeCommand(cmd *cobra.Command, _ []string) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %s", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s", err)
+	}
+
+	projectID, err := uuid.Parse(viper.GetString("project"))
+	if err != nil {
+		cliErrorf(cmd, "invalid project ID: %s", err)
+	}
+	bundleID, err := parseBundleID(viper.GetString("bundle"))
+	if err != nil {
+		cliErrorf(cmd, "%s", err)
+	}
+	dryRun := viper.GetBool("dry-run")
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+	store, closer, err := wireUpDB(ctx, cfg)
+	if err != nil {
+		cliErrorf(cmd, "unable to connect to database: %s", err)
+	}
+	defer closer()
+
+	marketplace, err := wireUpMarketplace(ctx, cfg, store)
+	if err != nil {
+		cliErrorf(cmd, "unable to create marketplace: %s", err)
+	}
+
+	diff, err := marketplace.DiffUpgrade(ctx, projectID, bundleID, store)
+	if err != nil {
+		cliErrorf(cmd, "unable to compute bundle upgrade: %s", err)
+	}
+	printBundleDiff(cmd.OutOrStdout(), diff)
+
+	if err := diff.Err(); err != nil {
+		cliErrorf(cmd, "%s\n", err)
+	}
+	if dryRun {
+		return nil
+	}
+	if diff.IsEmpty() && diff.FromVersion == diff.ToVersion {
+		cmd.Printf("Bundle %s is up to date\n", bundleID)
+		return nil
+	}
+	if !confirm(cmd, fmt.Sprintf("This will upgrade bundle %s in project %s", bundleID, projectID)) {
+		return nil
+	}
+
+	_, err = db.WithTransaction(store, func(qtx db.ExtendQuerier) (*sub.BundleDiff, error) {
+		return marketplace.Upgrade(ctx, projectID, bundleID, qtx)
+	})
+	if err != nil {
+		cliErrorf(cmd, "unable to upgrade bundle: %s\n", err)
+	}
+
+	cmd.Printf("Upgraded bundle %s to version %s\n", bundleID, diff.ToVersion)
+	return nil
+}
+
+func wireUpMarketplace(
+	ctx context.Context,
+	cfg *serverconfig.Config,
+	store db.Store,
+) (marketplaces.Marketplace, error) {
+	flags.OpenFeatureProviderFromFlags(ctx, cfg.Flags)
+	featureFlagClient := openfeature.NewClient(cfg.Flags.AppName)
+
+	// profile updates publish events so the updated profiles get evaluated
+	evt, err := eventer.New(ctx, featureFlagClient, &cfg.Events)
+	if err != nil {
+		return nil, fmt.Errorf("unable to setup eventer: %w", err)
+	}
+
+	profileSvc := profiles.NewProfileService(evt, selectors.NewEnv())
+	ruleSvc := ruletypes.NewRuleTypeService()
+	dataSourcesSvc := datasourcessvc.NewDataSourceService(store)
+	return marketplaces.NewMarketplaceFromServiceConfig(cfg.Marketplace, profileSvc, ruleSvc, dataSourcesSvc)
+}
+
+func parseBundleID(id string) (mindpak.BundleID, error) {
+	namespace, name, ok := strings.Cut(id, "/")
+	if !ok || namespace == "" || name == "" {
+		return mindpak.BundleID{}, fmt.Errorf("invalid bundle %q, expected namespace/name", id)
+	}
+	return mindpak.ID(namespace, name), nil
+}
+
+func printBundleDiff(out io.Writer, diff *sub.BundleDiff) {
+	fmt.Fprintf(out, "Bundle %s/%s: %s -> %s\n", diff.Namespace, diff.Name, diff.FromVersion, diff.ToVersion)
+	if diff.IsEmpty() {
+		fmt.Fprintln(out, "No changes")
+		return
+	}
+
+	for _, group := range []struct {
+		title   string
+		changes []sub.Change
+	}{
+		{"Data sources", diff.DataSources},
+		{"Rule types", diff.RuleTypes},
+		{"Profiles", diff.Profiles},
+	} {
+		if len(group.changes) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "%s:\n", group.title)
+		for _, change := range group.changes {
+			fmt.Fprintf(out, "  %-8s %s\n", change.Kind, change.Name)
+			if change.Error != nil {
+				fmt.Fprintf(out, "           error: %s\n", change.Error)
+			}
+		}
+	}
+}
+
+func init() {
+	bundleCmd.AddCommand(bundleUpgradeCmd)
+	bundleUpgradeCmd.Flags().String("project", "", "ID of the project subscribed to the bundle")
+	bundleUpgradeCmd.Flags().String("bundle", "", "Bundle to upgrade, as namespace/name")
+	bundleUpgradeCmd.Flags().Bool("dry-run", false, "Print the changes of the upgrade without applying them")
+	bundleUpgradeCmd.Flags().BoolP("yes", "y", false, "Answer yes to all questions")
+	for _, flag := range []string{"project", "bundle"} {
+		if err := bundleUpgradeCmd.MarkFlagRequired(flag); err != nil {
+			fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+func TestParseBundleID(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		input    string
+		expected mindpak.BundleID
+		mustErr  bool
+	}{
+		{input: "stacklok/healthcheck", expected: mindpak.ID("stacklok", "healthcheck")},
+		{input: "healthcheck", mustErr: true},
+		{input: "/healthcheck", mustErr: true},
+		{input: "stacklok/", mustErr: true},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			t.Parallel()
+			id, err := parseBundleID(tc.input)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, id)
+		})
+	}
+}
+
+func TestPrintBundleDiff(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	printBundleDiff(&out, &sub.BundleDiff{
+		Namespace:   "stacklok",
+		Name:        "healthcheck",
+		FromVersion: "1.0.0",
+		ToVersion:   "2.0.0",
+		RuleTypes: []sub.Change{
+			{Kind: sub.ChangeAdded, Name: "stacklok/new_rule"},
+			{Kind: sub.ChangeModified, Name: "stacklok/rule", Error: errors.New("rule schema update is invalid")},
+		},
+	})
+	require.Equal(t, `Bundle stacklok/healthcheck: 1.0.0 -> 2.0.0
+Rule types:
+  added    stacklok/new_rule
+  modified stacklok/rule
+           error: rule schema update is invalid
+`, out.String())
+
+	out.Reset()
+	printBundleDiff(&out, &sub.BundleDiff{Namespace: "stacklok", Name: "healthcheck", FromVersion: "1.0.0", ToVersion: "1.0.0"})
+	require.Equal(t, "Bundle stacklok/healthcheck: 1.0.0 -> 1.0.0\nNo changes\n", out.String())
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/events/common"
+	"github.com/mindersec/minder/internal/events/dlq"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// dlqCmd groups together the dead letter queue commands
+var dlqCmd = &cobra.Command{
+	Use:   "dlq",
+	Short: "Dead letter queue",
+	Long: `Inspect, replay and discard the events which could not be handled and
+were moved to the dead letter queue. Only the sql and cloudevents-nats event
+drivers keep a dead letter queue.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+// wireUpDeadLetterQueue reads the server configuration and connects to
+// the dead letter queue of the configured event driver
+func wireUpDeadLetterQueue(cmd *cobra.Command) (context.Context, dlq.Queue, common.DriverCloser) {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		cliErrorf(cmd, "error binding flags: %s", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s", err)
+	}
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+	queue, closer, err := dlq.NewQueue(ctx, &cfg.Events)
+	if err != nil {
+		cliErrorf(cmd, "unable to connect to dead letter queue: %s", err)
+	}
+	return ctx, queue, closer
+}
+
+func init() {
+	RootCmd.AddCommand(dlqCmd)
+	dlqCmd.PersistentFlags().BoolP("yes", "y", false, "Answer yes to all questions")
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// dlqDiscardCmd represents the `dlq discard` command
+var dlqDiscardCmd = &cobra.Command{
+	Use:   "discard ID...",
+	Short: "Discard events from the dead letter queue",
+	Long:  `Removes the given events from the dead letter queue, without handling them`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, queue, closer := wireUpDeadLetterQueue(cmd)
+		defer closer()
+
+		if !confirm(cmd, "Running this command will permanently delete the events") {
+			return nil
+		}
+
+		if err := queue.Discard(ctx, args); err != nil {
+			cliErrorf(cmd, "unable to discard events: %s", err)
+		}
+		cmd.Printf("Discarded %d events\n", len(args))
+		return nil
+	},
+}
+
+func init() {
+	dlqCmd.AddCommand(dlqDiscardCmd)
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/events/dlq"
+)
+
+// dlqListCmd represents the `dlq list` command
+var dlqListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the events in the dead letter queue",
+	Long: `Lists the events in the dead letter queue, oldest first, with the topic
+they were published to, the handler which failed and its error`,
+	RunE: dlqListCommand,
+}
+
+func dlqListCommand(cmd *cobra.Command, _ []string) error {
+	ctx, queue, closer := wireUpDeadLetterQueue(cmd)
+	defer closer()
+
+	msgs, err := queue.List(ctx, viper.GetInt("limit"))
+	if err != nil {
+		cliErrorf(cmd, "unable to list dead letter queue: %s", err)
+	}
+
+	switch format := viper.GetString("output"); format {
+	case "json":
+		return printDeadLettersJSON(cmd.OutOrStdout(), msgs)
+	case "table":
+		return printDeadLettersTable(cmd.OutOrStdout(), msgs)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+type deadLetterJSON struct {
+	ID           string            `json:"id"`
+	UUID         string            `json:"uuid"`
+	Topic        string            `json:"topic"`
+	Handler      string            `json:"handler"`
+	Error        string            `json:"error"`
+	FailureCount int               `json:"failure_count"`
+	Metadata     map[string]string `json:"metadata"`
+	Payload      json.RawMessage   `json:"payload"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+func printDeadLettersJSON(w io.Writer, msgs []*dlq.Message) error {
+	out := make([]deadLetterJSON, 0, len(msgs))
+	for _, msg := range msgs {
+		payload := json.RawMessage(msg.Payload)
+		if !json.Valid(payload) {
+			// Keep the output valid JSON, encoding the payload as a string
+			payload, _ = json.Marshal(string(msg.Payload))
+		}
+		out = append(out, deadLetterJSON{
+			ID:           msg.ID,
+			UUID:         msg.UUID,
+			Topic:        msg.Topic,
+			Handler:      msg.Handler,
+			Error:        msg.Error,
+			FailureCount: msg.FailureCount,
+			Metadata:     msg.Metadata,
+			Payload:      payload,
+			CreatedAt:    msg.CreatedAt,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func printDeadLettersTable(w io.Writer, msgs []*dlq.Message) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCREATED\tTOPIC\tHANDLER\tFAILURES\tERROR")
+	for _, msg := range msgs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\n",
+			msg.ID, msg.CreatedAt.UTC().Format(time.RFC3339), msg.Topic, msg.Handler, msg.FailureCount, msg.Error)
+	}
+	return tw.Flush()
+}
+
+func init() {
+	dlqCmd.AddCommand(dlqListCmd)
+	dlqListCmd.Flags().Int("limit", 100, "Maximum number of events to list")
+	dlqListCmd.Flags().StringP("output", "o", "table", "Output format (table or json)")
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/events/dlq"
+)
+
+func TestPrintDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	msgs := []*dlq.Message{
+		{
+			ID:           "1",
+			UUID:         "uuid-1",
+			Topic:        "topic.a",
+			Handler:      "handler",
+			Error:        "boom",
+			FailureCount: 2,
+			Metadata:     map[string]string{"k": "v"},
+			Payload:      []byte(`{"a":1}`),
+			CreatedAt:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			ID:           "2",
+			Topic:        "topic.b",
+			FailureCount: 1,
+			Payload:      []byte("not json"),
+			CreatedAt:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+
+	var table bytes.Buffer
+	require.NoError(t, printDeadLettersTable(&table, msgs))
+	lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, []string{"ID", "CREATED", "TOPIC", "HANDLER", "FAILURES", "ERROR"}, strings.Fields(lines[0]))
+	require.Equal(t, []string{"1", "2025-01-02T03:04:05Z", "topic.a", "handler", "2", "boom"}, strings.Fields(lines[1]))
+
+	var out bytes.Buffer
+	require.NoError(t, printDeadLettersJSON(&out, msgs))
+	var decoded []map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	require.Len(t, decoded, 2)
+	require.Equal(t, map[string]any{"a": float64(1)}, decoded[0]["payload"])
+	require.Equal(t, "not json", decoded[1]["payload"])
+	require.Equal(t, float64(2), decoded[0]["failure_count"])
+}
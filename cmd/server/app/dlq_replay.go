@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// dlqReplayCmd represents the `dlq replay` command
+var dlqReplayCmd = &cobra.Command{
+	Use:   "replay ID...",
+	Short: "Replay events from the dead letter queue",
+	Long: `Publishes the given events from the dead letter queue back onto the topic
+they were originally published to, and removes them from the dead letter queue`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, queue, closer := wireUpDeadLetterQueue(cmd)
+		defer closer()
+
+		if err := queue.Replay(ctx, args); err != nil {
+			cliErrorf(cmd, "unable to replay events: %s", err)
+		}
+		cmd.Printf("Replayed %d events\n", len(args))
+		return nil
+	},
+}
+
+func init() {
+	dlqCmd.AddCommand(dlqReplayCmd)
+}
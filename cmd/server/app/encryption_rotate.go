@@ -6,6 +6,7 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -30,8 +31,9 @@ var errCancelRotation = errors.New("cancelling rotation process")
 // rotateCmd represents the `encryption rotate` command
 var rotateCmd = &cobra.Command{
 	Use:   "rotate-provider-tokens",
-	Short: "Rotate keys and encryption algorithms for provider tokens",
-	Long:  `re-encrypt all provider access tokens with the default key version and algorithm`,
+	Short: "Rotate keys and encryption algorithms for provider tokens and project secrets",
+	Long: `re-encrypt all provider access tokens, project secrets and alert webhooks
+with the default key version and algorithm`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
 		if err != nil {
@@ -57,12 +59,13 @@ var rotateCmd = &cobra.Command{
 		}
 		defer closer()
 
-		yes := confirm(cmd, "Running this command will re-encrypt provider access tokens")
+		yes := confirm(cmd, "Running this command will re-encrypt provider access tokens, "+
+			"project secrets and alert webhooks")
 		if !yes {
 			return nil
 		}
 
-		// rotate the provider access tokens
+		// rotate the provider access tokens, project secrets and alert webhooks
 		totalRotated, err := rotateSecrets(ctx, cmd, store, cfg)
 		if err != nil {
 			// if we cancel or have nothing to migrate...
@@ -78,6 +81,23 @@ var rotateCmd = &cobra.Command{
 	},
 }
 
+// rotationBatchFunc re-encrypts a batch of the secrets stored in a table,
+// and returns the number of secrets re-encrypted
+type rotationBatchFunc func(
+	ctx context.Context,
+	offset int64,
+	store db.ExtendQuerier,
+	engine crypto.Engine,
+	cfg *serverconfig.CryptoConfig,
+) (int64, error)
+
+// rotationBatches re-encrypt each of the tables storing encrypted secrets
+var rotationBatches = []rotationBatchFunc{
+	runRotationBatch,
+	runProjectSecretsRotationBatch,
+	runAlertWebhooksRotationBatch,
+}
+
 func rotateSecrets(
 	ctx context.Context,
 	cmd *cobra.Command,
@@ -93,16 +113,20 @@ func rotateSecrets(
 	return db.WithTransaction[int64](store, func(qtx db.ExtendQuerier) (int64, error) {
 		var rotated int64 = 0
 
-		for {
-			updated, err := runRotationBatch(ctx, rotated, qtx, cryptoEngine, &cfg.Crypto)
-			if err != nil {
-				return rotated, err
+		for _, runBatch := range rotationBatches {
+			var tableRotated int64 = 0
+			for {
+				updated, err := runBatch(ctx, tableRotated, qtx, cryptoEngine, &cfg.Crypto)
+				if err != nil {
+					return rotated, err
+				}
+				// nothing more to do - exit loop
+				if updated == 0 {
+					break
+				}
+				tableRotated += updated
 			}
-			// nothing more to do - exit loop
-			if updated == 0 {
-				break
-			}
-			rotated += updated
+			rotated += tableRotated
 		}
 
 		// print useful status for the case where there is nothing to rotate
@@ -194,6 +218,96 @@ func runRotationBatch(
 	return int64(len(batch)), nil
 }
 
+// runProjectSecretsRotationBatch re-encrypts a batch of project secrets.
+// Re-encrypted secrets are no longer listed, so the offset is not used.
+func runProjectSecretsRotationBatch(
+	ctx context.Context,
+	_ int64,
+	store db.ExtendQuerier,
+	engine crypto.Engine,
+	cfg *serverconfig.CryptoConfig,
+) (int64, error) {
+	batch, err := store.ListProjectSecretsToMigrate(ctx, db.ListProjectSecretsToMigrateParams{
+		DefaultAlgorithm:  string(crypto.DefaultAlgorithm),
+		DefaultKeyVersion: cfg.Default.KeyID,
+		BatchSize:         batchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	zerolog.Ctx(ctx).
+		Debug().
+		Msgf("processing batch of %d project secrets", len(batch))
+
+	for _, secret := range batch {
+		reencrypted, err := reencrypt(engine, secret.EncryptedValue)
+		if err != nil {
+			return 0, fmt.Errorf("unable to re-encrypt project secret %s: %w", secret.ID, err)
+		}
+
+		err = store.UpdateProjectSecretEncryptedValue(ctx, db.UpdateProjectSecretEncryptedValueParams{
+			ID:             secret.ID,
+			EncryptedValue: reencrypted,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("unable to update project secret %s in database: %w", secret.ID, err)
+		}
+	}
+
+	return int64(len(batch)), nil
+}
+
+// runAlertWebhooksRotationBatch re-encrypts a batch of alert webhooks.
+// Re-encrypted webhooks are no longer listed, so the offset is not used.
+func runAlertWebhooksRotationBatch(
+	ctx context.Context,
+	_ int64,
+	store db.ExtendQuerier,
+	engine crypto.Engine,
+	cfg *serverconfig.CryptoConfig,
+) (int64, error) {
+	batch, err := store.ListAlertWebhooksToMigrate(ctx, db.ListAlertWebhooksToMigrateParams{
+		DefaultAlgorithm:  string(crypto.DefaultAlgorithm),
+		DefaultKeyVersion: cfg.Default.KeyID,
+		BatchSize:         batchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	zerolog.Ctx(ctx).
+		Debug().
+		Msgf("processing batch of %d alert webhooks", len(batch))
+
+	for _, wh := range batch {
+		reencrypted, err := reencrypt(engine, wh.EncryptedDestination)
+		if err != nil {
+			return 0, fmt.Errorf("unable to re-encrypt alert webhook %s: %w", wh.ID, err)
+		}
+
+		err = store.UpdateAlertWebhookEncryptedDestination(ctx, db.UpdateAlertWebhookEncryptedDestinationParams{
+			ID:                   wh.ID,
+			EncryptedDestination: reencrypted,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("unable to update alert webhook %s in database: %w", wh.ID, err)
+		}
+	}
+
+	return int64(len(batch)), nil
+}
+
+// reencrypt decrypts a value serialized by crypto.EncryptAndSerialize, and
+// encrypts it again with the default key version and algorithm
+func reencrypt(engine crypto.Engine, serialized json.RawMessage) (json.RawMessage, error) {
+	decrypted, err := crypto.DeserializeAndDecrypt(engine, serialized)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.EncryptAndSerialize(engine, decrypted)
+}
+
 func tokenError(tokenID int32, action string, err error) error {
 	return fmt.Errorf("unable to re-encrypt provider token %d during %s: %s", tokenID, action, err)
 }
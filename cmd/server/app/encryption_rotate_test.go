@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// newRotationEngines returns an engine using the old key, and an engine
+// using a new default key with the old key as a fallback
+func newRotationEngines(t *testing.T) (crypto.Engine, crypto.Engine, *serverconfig.Config) {
+	t.Helper()
+
+	keyDir := t.TempDir()
+	for _, name := range []string{"old_key", "new_key"} {
+		key := make([]byte, 32)
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+		encoded := base64.StdEncoding.EncodeToString(key)
+		require.NoError(t, os.WriteFile(filepath.Join(keyDir, name), []byte(encoded), 0600))
+	}
+
+	keyStore := serverconfig.KeyStoreConfig{
+		Type:  "local",
+		Local: serverconfig.LocalKeyStoreConfig{KeyDir: keyDir},
+	}
+	oldEngine, err := crypto.NewEngineFromConfig(&serverconfig.Config{
+		Crypto: serverconfig.CryptoConfig{
+			KeyStore: keyStore,
+			Default:  serverconfig.DefaultCrypto{KeyID: "old_key"},
+		},
+	})
+	require.NoError(t, err)
+
+	newCfg := &serverconfig.Config{
+		Crypto: serverconfig.CryptoConfig{
+			KeyStore: keyStore,
+			Default:  serverconfig.DefaultCrypto{KeyID: "new_key"},
+			Fallback: serverconfig.FallbackCrypto{KeyID: "old_key"},
+		},
+	}
+	newEngine, err := crypto.NewEngineFromConfig(newCfg)
+	require.NoError(t, err)
+
+	return oldEngine, newEngine, newCfg
+}
+
+// requireRotated checks that a value is encrypted with the new key
+func requireRotated(t *testing.T, engine crypto.Engine, serialized json.RawMessage, expected string) {
+	t.Helper()
+
+	data, err := crypto.DeserializeEncryptedData(serialized)
+	require.NoError(t, err)
+	require.Equal(t, "new_key", data.KeyVersion)
+
+	decrypted, err := crypto.DeserializeAndDecrypt(engine, serialized)
+	require.NoError(t, err)
+	require.Equal(t, expected, decrypted)
+}
+
+func TestRunProjectSecretsRotationBatch(t *testing.T) {
+	t.Parallel()
+
+	oldEngine, newEngine, cfg := newRotationEngines(t)
+	encrypted, err := crypto.EncryptAndSerialize(oldEngine, "s3cr3t")
+	require.NoError(t, err)
+
+	secretID := uuid.New()
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListProjectSecretsToMigrate(gomock.Any(), db.ListProjectSecretsToMigrateParams{
+		DefaultAlgorithm:  string(crypto.DefaultAlgorithm),
+		DefaultKeyVersion: "new_key",
+		BatchSize:         batchSize,
+	}).Return([]db.ProjectSecret{{ID: secretID, EncryptedValue: encrypted}}, nil)
+	store.EXPECT().UpdateProjectSecretEncryptedValue(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.UpdateProjectSecretEncryptedValueParams) error {
+			require.Equal(t, secretID, arg.ID)
+			requireRotated(t, newEngine, arg.EncryptedValue, "s3cr3t")
+			return nil
+		})
+
+	rotated, err := runProjectSecretsRotationBatch(context.Background(), 0, store, newEngine, &cfg.Crypto)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rotated)
+}
+
+func TestRunAlertWebhooksRotationBatch(t *testing.T) {
+	t.Parallel()
+
+	oldEngine, newEngine, cfg := newRotationEngines(t)
+	const destination = `{"url":"https://hooks.example.com/alerts"}`
+	encrypted, err := crypto.EncryptAndSerialize(oldEngine, destination)
+	require.NoError(t, err)
+
+	webhookID := uuid.New()
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListAlertWebhooksToMigrate(gomock.Any(), db.ListAlertWebhooksToMigrateParams{
+		DefaultAlgorithm:  string(crypto.DefaultAlgorithm),
+		DefaultKeyVersion: "new_key",
+		BatchSize:         batchSize,
+	}).Return([]db.AlertWebhook{{ID: webhookID, EncryptedDestination: encrypted}}, nil)
+	store.EXPECT().UpdateAlertWebhookEncryptedDestination(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.UpdateAlertWebhookEncryptedDestinationParams) error {
+			require.Equal(t, webhookID, arg.ID)
+			requireRotated(t, newEngine, arg.EncryptedDestination, destination)
+			return nil
+		})
+
+	rotated, err := runAlertWebhooksRotationBatch(context.Background(), 0, store, newEngine, &cfg.Crypto)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rotated)
+}
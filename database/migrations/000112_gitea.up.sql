@@ -0,0 +1,5 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Add `gitea` provider class, used for Gitea and Forgejo instances
+ALTER TYPE provider_class ADD VALUE 'gitea';
@@ -0,0 +1,8 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP TABLE alert_webhooks;
+
+COMMIT;
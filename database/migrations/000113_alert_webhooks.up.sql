@@ -0,0 +1,21 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Alert webhooks are the destinations of webhook alerts in a project.
+-- encrypted_destination is a JSON document holding the URL and signing
+-- secret, encrypted as a whole so that neither can be read back.
+CREATE TABLE alert_webhooks(
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    encrypted_destination JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX alert_webhooks_name_idx ON alert_webhooks (project_id, name);
+
+COMMIT;
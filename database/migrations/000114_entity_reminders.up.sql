@@ -0,0 +1,20 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- entity_reminders tracks when the reminder service last asked for an
+-- entity to be re-evaluated.  This replaces repositories.reminder_last_sent,
+-- as reminders are sent for all the entity types.
+CREATE TABLE entity_reminders(
+    entity_instance_id UUID NOT NULL PRIMARY KEY REFERENCES entity_instances(id) ON DELETE CASCADE,
+    last_sent TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO entity_reminders (entity_instance_id, last_sent)
+SELECT ei.id, r.reminder_last_sent
+FROM repositories AS r
+    INNER JOIN entity_instances AS ei ON ei.id = r.id
+WHERE r.reminder_last_sent IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,11 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- evaluation_schedule is the cron spec at which the entities selected by the
+-- profile are due for re-evaluation.  Intervals are stored as "@every <duration>".
+-- NULL means the reminder service defaults apply.
+ALTER TABLE profiles ADD COLUMN evaluation_schedule TEXT;
+
+COMMIT;
@@ -0,0 +1,22 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Project secrets are credentials which REST data sources refer to by
+-- name. encrypted_value holds the serialized EncryptedData of the secret
+-- value, and records the key version it was encrypted with so that it is
+-- re-encrypted on key rotation.
+CREATE TABLE project_secrets(
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    encrypted_value JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX project_secrets_name_idx ON project_secrets (project_id, name);
+
+COMMIT;
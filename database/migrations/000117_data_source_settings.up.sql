@@ -0,0 +1,11 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- settings holds the driver settings which apply to all the functions of a
+-- data source, e.g. the cache policy and request limits of REST data sources.
+-- It is serialized as the driver message without its function definitions.
+ALTER TABLE data_sources ADD COLUMN settings JSONB NOT NULL DEFAULT '{}';
+
+COMMIT;
@@ -132,6 +132,21 @@ func (mr *MockStoreMockRecorder) Commit(tx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockStore)(nil).Commit), tx)
 }
 
+// CountEvaluationHistoryToPurge mocks base method.
+func (m *MockStore) CountEvaluationHistoryToPurge(ctx context.Context, arg db.CountEvaluationHistoryToPurgeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEvaluationHistoryToPurge", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEvaluationHistoryToPurge indicates an expected call of CountEvaluationHistoryToPurge.
+func (mr *MockStoreMockRecorder) CountEvaluationHistoryToPurge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEvaluationHistoryToPurge", reflect.TypeOf((*MockStore)(nil).CountEvaluationHistoryToPurge), ctx, arg)
+}
+
 // CountProfilesByEntityType mocks base method.
 func (m *MockStore) CountProfilesByEntityType(ctx context.Context) ([]db.CountProfilesByEntityTypeRow, error) {
 	m.ctrl.T.Helper()
@@ -476,6 +491,21 @@ func (mr *MockStoreMockRecorder) CreateUser(ctx, identitySubject any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, identitySubject)
 }
 
+// DeleteAlertWebhook mocks base method.
+func (m *MockStore) DeleteAlertWebhook(ctx context.Context, arg db.DeleteAlertWebhookParams) (db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlertWebhook indicates an expected call of DeleteAlertWebhook.
+func (mr *MockStoreMockRecorder) DeleteAlertWebhook(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertWebhook", reflect.TypeOf((*MockStore)(nil).DeleteAlertWebhook), ctx, arg)
+}
+
 // DeleteAllPropertiesForEntity mocks base method.
 func (m *MockStore) DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -679,6 +709,21 @@ func (mr *MockStoreMockRecorder) DeleteProject(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockStore)(nil).DeleteProject), ctx, id)
 }
 
+// DeleteProjectSecret mocks base method.
+func (m *MockStore) DeleteProjectSecret(ctx context.Context, arg db.DeleteProjectSecretParams) (db.ProjectSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectSecret", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectSecret indicates an expected call of DeleteProjectSecret.
+func (mr *MockStoreMockRecorder) DeleteProjectSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectSecret", reflect.TypeOf((*MockStore)(nil).DeleteProjectSecret), ctx, arg)
+}
+
 // DeleteProperty mocks base method.
 func (m *MockStore) DeleteProperty(ctx context.Context, arg db.DeletePropertyParams) error {
 	m.ctrl.T.Helper()
@@ -834,6 +879,21 @@ func (mr *MockStoreMockRecorder) EnqueueFlush(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueFlush", reflect.TypeOf((*MockStore)(nil).EnqueueFlush), ctx, arg)
 }
 
+// EntityExistsAfterID mocks base method.
+func (m *MockStore) EntityExistsAfterID(ctx context.Context, arg db.EntityExistsAfterIDParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EntityExistsAfterID", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EntityExistsAfterID indicates an expected call of EntityExistsAfterID.
+func (mr *MockStoreMockRecorder) EntityExistsAfterID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntityExistsAfterID", reflect.TypeOf((*MockStore)(nil).EntityExistsAfterID), ctx, arg)
+}
+
 // FindProviders mocks base method.
 func (m *MockStore) FindProviders(ctx context.Context, arg db.FindProvidersParams) ([]db.Provider, error) {
 	m.ctrl.T.Helper()
@@ -924,6 +984,21 @@ func (mr *MockStoreMockRecorder) GetAccessTokenSinceDate(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenSinceDate", reflect.TypeOf((*MockStore)(nil).GetAccessTokenSinceDate), ctx, arg)
 }
 
+// GetAlertWebhookByName mocks base method.
+func (m *MockStore) GetAlertWebhookByName(ctx context.Context, arg db.GetAlertWebhookByNameParams) (db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertWebhookByName", ctx, arg)
+	ret0, _ := ret[0].(db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertWebhookByName indicates an expected call of GetAlertWebhookByName.
+func (mr *MockStoreMockRecorder) GetAlertWebhookByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertWebhookByName", reflect.TypeOf((*MockStore)(nil).GetAlertWebhookByName), ctx, arg)
+}
+
 // GetAllPropertiesForEntity mocks base method.
 func (m *MockStore) GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]db.Property, error) {
 	m.ctrl.T.Helper()
@@ -1479,6 +1554,21 @@ func (mr *MockStoreMockRecorder) GetProjectIDBySessionState(ctx, sessionState an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectIDBySessionState", reflect.TypeOf((*MockStore)(nil).GetProjectIDBySessionState), ctx, sessionState)
 }
 
+// GetProjectSecretByName mocks base method.
+func (m *MockStore) GetProjectSecretByName(ctx context.Context, arg db.GetProjectSecretByNameParams) (db.ProjectSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectSecretByName", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectSecretByName indicates an expected call of GetProjectSecretByName.
+func (mr *MockStoreMockRecorder) GetProjectSecretByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectSecretByName", reflect.TypeOf((*MockStore)(nil).GetProjectSecretByName), ctx, arg)
+}
+
 // GetProperty mocks base method.
 func (m *MockStore) GetProperty(ctx context.Context, arg db.GetPropertyParams) (db.Property, error) {
 	m.ctrl.T.Helper()
@@ -1971,6 +2061,51 @@ func (mr *MockStoreMockRecorder) InsertRemediationEvent(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRemediationEvent", reflect.TypeOf((*MockStore)(nil).InsertRemediationEvent), ctx, arg)
 }
 
+// ListAlertWebhooksByProject mocks base method.
+func (m *MockStore) ListAlertWebhooksByProject(ctx context.Context, projectID uuid.UUID) ([]db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertWebhooksByProject", ctx, projectID)
+	ret0, _ := ret[0].([]db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertWebhooksByProject indicates an expected call of ListAlertWebhooksByProject.
+func (mr *MockStoreMockRecorder) ListAlertWebhooksByProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertWebhooksByProject", reflect.TypeOf((*MockStore)(nil).ListAlertWebhooksByProject), ctx, projectID)
+}
+
+// ListAlertWebhooksToMigrate mocks base method.
+func (m *MockStore) ListAlertWebhooksToMigrate(ctx context.Context, arg db.ListAlertWebhooksToMigrateParams) ([]db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertWebhooksToMigrate", ctx, arg)
+	ret0, _ := ret[0].([]db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertWebhooksToMigrate indicates an expected call of ListAlertWebhooksToMigrate.
+func (mr *MockStoreMockRecorder) ListAlertWebhooksToMigrate(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertWebhooksToMigrate", reflect.TypeOf((*MockStore)(nil).ListAlertWebhooksToMigrate), ctx, arg)
+}
+
+// ListAllProjects mocks base method.
+func (m *MockStore) ListAllProjects(ctx context.Context) ([]db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllProjects", ctx)
+	ret0, _ := ret[0].([]db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllProjects indicates an expected call of ListAllProjects.
+func (mr *MockStoreMockRecorder) ListAllProjects(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllProjects", reflect.TypeOf((*MockStore)(nil).ListAllProjects), ctx)
+}
+
 // ListAllRootProjects mocks base method.
 func (m *MockStore) ListAllRootProjects(ctx context.Context) ([]db.Project, error) {
 	m.ctrl.T.Helper()
@@ -2031,6 +2166,21 @@ func (mr *MockStoreMockRecorder) ListDataSources(ctx, projects any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDataSources", reflect.TypeOf((*MockStore)(nil).ListDataSources), ctx, projects)
 }
 
+// ListEntitiesAfterID mocks base method.
+func (m *MockStore) ListEntitiesAfterID(ctx context.Context, arg db.ListEntitiesAfterIDParams) ([]db.ListEntitiesAfterIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntitiesAfterID", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEntitiesAfterIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntitiesAfterID indicates an expected call of ListEntitiesAfterID.
+func (mr *MockStoreMockRecorder) ListEntitiesAfterID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntitiesAfterID", reflect.TypeOf((*MockStore)(nil).ListEntitiesAfterID), ctx, arg)
+}
+
 // ListEvaluationHistory mocks base method.
 func (m *MockStore) ListEvaluationHistory(ctx context.Context, arg db.ListEvaluationHistoryParams) ([]db.ListEvaluationHistoryRow, error) {
 	m.ctrl.T.Helper()
@@ -2046,19 +2196,34 @@ func (mr *MockStoreMockRecorder) ListEvaluationHistory(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationHistory", reflect.TypeOf((*MockStore)(nil).ListEvaluationHistory), ctx, arg)
 }
 
-// ListEvaluationHistoryStaleRecords mocks base method.
-func (m *MockStore) ListEvaluationHistoryStaleRecords(ctx context.Context, arg db.ListEvaluationHistoryStaleRecordsParams) ([]db.ListEvaluationHistoryStaleRecordsRow, error) {
+// ListEvaluationHistoryForReport mocks base method.
+func (m *MockStore) ListEvaluationHistoryForReport(ctx context.Context, arg db.ListEvaluationHistoryForReportParams) ([]db.ListEvaluationHistoryForReportRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEvaluationHistoryStaleRecords", ctx, arg)
-	ret0, _ := ret[0].([]db.ListEvaluationHistoryStaleRecordsRow)
+	ret := m.ctrl.Call(m, "ListEvaluationHistoryForReport", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEvaluationHistoryForReportRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEvaluationHistoryStaleRecords indicates an expected call of ListEvaluationHistoryStaleRecords.
-func (mr *MockStoreMockRecorder) ListEvaluationHistoryStaleRecords(ctx, arg any) *gomock.Call {
+// ListEvaluationHistoryForReport indicates an expected call of ListEvaluationHistoryForReport.
+func (mr *MockStoreMockRecorder) ListEvaluationHistoryForReport(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationHistoryStaleRecords", reflect.TypeOf((*MockStore)(nil).ListEvaluationHistoryStaleRecords), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationHistoryForReport", reflect.TypeOf((*MockStore)(nil).ListEvaluationHistoryForReport), ctx, arg)
+}
+
+// ListEvaluationHistoryToPurge mocks base method.
+func (m *MockStore) ListEvaluationHistoryToPurge(ctx context.Context, arg db.ListEvaluationHistoryToPurgeParams) ([]db.ListEvaluationHistoryToPurgeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvaluationHistoryToPurge", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEvaluationHistoryToPurgeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvaluationHistoryToPurge indicates an expected call of ListEvaluationHistoryToPurge.
+func (mr *MockStoreMockRecorder) ListEvaluationHistoryToPurge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationHistoryToPurge", reflect.TypeOf((*MockStore)(nil).ListEvaluationHistoryToPurge), ctx, arg)
 }
 
 // ListFlushCache mocks base method.
@@ -2091,19 +2256,19 @@ func (mr *MockStoreMockRecorder) ListInvitationsForProject(ctx, project any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitationsForProject", reflect.TypeOf((*MockStore)(nil).ListInvitationsForProject), ctx, project)
 }
 
-// ListOldestRuleEvaluationsByRepositoryId mocks base method.
-func (m *MockStore) ListOldestRuleEvaluationsByRepositoryId(ctx context.Context, repositoryIds []uuid.UUID) ([]db.ListOldestRuleEvaluationsByRepositoryIdRow, error) {
+// ListOldestRuleEvaluationsByEntityId mocks base method.
+func (m *MockStore) ListOldestRuleEvaluationsByEntityId(ctx context.Context, entityIds []uuid.UUID) ([]db.ListOldestRuleEvaluationsByEntityIdRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOldestRuleEvaluationsByRepositoryId", ctx, repositoryIds)
-	ret0, _ := ret[0].([]db.ListOldestRuleEvaluationsByRepositoryIdRow)
+	ret := m.ctrl.Call(m, "ListOldestRuleEvaluationsByEntityId", ctx, entityIds)
+	ret0, _ := ret[0].([]db.ListOldestRuleEvaluationsByEntityIdRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListOldestRuleEvaluationsByRepositoryId indicates an expected call of ListOldestRuleEvaluationsByRepositoryId.
-func (mr *MockStoreMockRecorder) ListOldestRuleEvaluationsByRepositoryId(ctx, repositoryIds any) *gomock.Call {
+// ListOldestRuleEvaluationsByEntityId indicates an expected call of ListOldestRuleEvaluationsByEntityId.
+func (mr *MockStoreMockRecorder) ListOldestRuleEvaluationsByEntityId(ctx, entityIds any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOldestRuleEvaluationsByRepositoryId", reflect.TypeOf((*MockStore)(nil).ListOldestRuleEvaluationsByRepositoryId), ctx, repositoryIds)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOldestRuleEvaluationsByEntityId", reflect.TypeOf((*MockStore)(nil).ListOldestRuleEvaluationsByEntityId), ctx, entityIds)
 }
 
 // ListProfilesByProjectIDAndLabel mocks base method.
@@ -2136,6 +2301,36 @@ func (mr *MockStoreMockRecorder) ListProfilesInstantiatingRuleType(ctx, ruleType
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfilesInstantiatingRuleType", reflect.TypeOf((*MockStore)(nil).ListProfilesInstantiatingRuleType), ctx, ruleTypeID)
 }
 
+// ListProjectSecretsByProject mocks base method.
+func (m *MockStore) ListProjectSecretsByProject(ctx context.Context, projectID uuid.UUID) ([]db.ProjectSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectSecretsByProject", ctx, projectID)
+	ret0, _ := ret[0].([]db.ProjectSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectSecretsByProject indicates an expected call of ListProjectSecretsByProject.
+func (mr *MockStoreMockRecorder) ListProjectSecretsByProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectSecretsByProject", reflect.TypeOf((*MockStore)(nil).ListProjectSecretsByProject), ctx, projectID)
+}
+
+// ListProjectSecretsToMigrate mocks base method.
+func (m *MockStore) ListProjectSecretsToMigrate(ctx context.Context, arg db.ListProjectSecretsToMigrateParams) ([]db.ProjectSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectSecretsToMigrate", ctx, arg)
+	ret0, _ := ret[0].([]db.ProjectSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectSecretsToMigrate indicates an expected call of ListProjectSecretsToMigrate.
+func (mr *MockStoreMockRecorder) ListProjectSecretsToMigrate(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectSecretsToMigrate", reflect.TypeOf((*MockStore)(nil).ListProjectSecretsToMigrate), ctx, arg)
+}
+
 // ListProvidersByProjectID mocks base method.
 func (m *MockStore) ListProvidersByProjectID(ctx context.Context, projects []uuid.UUID) ([]db.Provider, error) {
 	m.ctrl.T.Helper()
@@ -2181,21 +2376,6 @@ func (mr *MockStoreMockRecorder) ListRegisteredRepositoriesByProjectIDAndProvide
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredRepositoriesByProjectIDAndProvider", reflect.TypeOf((*MockStore)(nil).ListRegisteredRepositoriesByProjectIDAndProvider), ctx, arg)
 }
 
-// ListRepositoriesAfterID mocks base method.
-func (m *MockStore) ListRepositoriesAfterID(ctx context.Context, arg db.ListRepositoriesAfterIDParams) ([]db.Repository, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRepositoriesAfterID", ctx, arg)
-	ret0, _ := ret[0].([]db.Repository)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// ListRepositoriesAfterID indicates an expected call of ListRepositoriesAfterID.
-func (mr *MockStoreMockRecorder) ListRepositoriesAfterID(ctx, arg any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesAfterID", reflect.TypeOf((*MockStore)(nil).ListRepositoriesAfterID), ctx, arg)
-}
-
 // ListRepositoriesByProjectID mocks base method.
 func (m *MockStore) ListRepositoriesByProjectID(ctx context.Context, arg db.ListRepositoriesByProjectIDParams) ([]db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -2330,21 +2510,6 @@ func (mr *MockStoreMockRecorder) ReleaseLock(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLock", reflect.TypeOf((*MockStore)(nil).ReleaseLock), ctx, arg)
 }
 
-// RepositoryExistsAfterID mocks base method.
-func (m *MockStore) RepositoryExistsAfterID(ctx context.Context, id uuid.UUID) (bool, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RepositoryExistsAfterID", ctx, id)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// RepositoryExistsAfterID indicates an expected call of RepositoryExistsAfterID.
-func (mr *MockStoreMockRecorder) RepositoryExistsAfterID(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryExistsAfterID", reflect.TypeOf((*MockStore)(nil).RepositoryExistsAfterID), ctx, id)
-}
-
 // Rollback mocks base method.
 func (m *MockStore) Rollback(tx *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -2373,6 +2538,20 @@ func (mr *MockStoreMockRecorder) SetSubscriptionBundleVersion(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubscriptionBundleVersion", reflect.TypeOf((*MockStore)(nil).SetSubscriptionBundleVersion), ctx, arg)
 }
 
+// UpdateAlertWebhookEncryptedDestination mocks base method.
+func (m *MockStore) UpdateAlertWebhookEncryptedDestination(ctx context.Context, arg db.UpdateAlertWebhookEncryptedDestinationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertWebhookEncryptedDestination", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlertWebhookEncryptedDestination indicates an expected call of UpdateAlertWebhookEncryptedDestination.
+func (mr *MockStoreMockRecorder) UpdateAlertWebhookEncryptedDestination(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertWebhookEncryptedDestination", reflect.TypeOf((*MockStore)(nil).UpdateAlertWebhookEncryptedDestination), ctx, arg)
+}
+
 // UpdateDataSource mocks base method.
 func (m *MockStore) UpdateDataSource(ctx context.Context, arg db.UpdateDataSourceParams) (db.DataSource, error) {
 	m.ctrl.T.Helper()
@@ -2476,6 +2655,20 @@ func (mr *MockStoreMockRecorder) UpdateProjectMeta(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectMeta", reflect.TypeOf((*MockStore)(nil).UpdateProjectMeta), ctx, arg)
 }
 
+// UpdateProjectSecretEncryptedValue mocks base method.
+func (m *MockStore) UpdateProjectSecretEncryptedValue(ctx context.Context, arg db.UpdateProjectSecretEncryptedValueParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectSecretEncryptedValue", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectSecretEncryptedValue indicates an expected call of UpdateProjectSecretEncryptedValue.
+func (mr *MockStoreMockRecorder) UpdateProjectSecretEncryptedValue(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectSecretEncryptedValue", reflect.TypeOf((*MockStore)(nil).UpdateProjectSecretEncryptedValue), ctx, arg)
+}
+
 // UpdateProvider mocks base method.
 func (m *MockStore) UpdateProvider(ctx context.Context, arg db.UpdateProviderParams) error {
 	m.ctrl.T.Helper()
@@ -2490,18 +2683,18 @@ func (mr *MockStoreMockRecorder) UpdateProvider(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProvider", reflect.TypeOf((*MockStore)(nil).UpdateProvider), ctx, arg)
 }
 
-// UpdateReminderLastSentForRepositories mocks base method.
-func (m *MockStore) UpdateReminderLastSentForRepositories(ctx context.Context, repositoryIds []uuid.UUID) error {
+// UpdateReminderLastSentForEntities mocks base method.
+func (m *MockStore) UpdateReminderLastSentForEntities(ctx context.Context, entityIds []uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateReminderLastSentForRepositories", ctx, repositoryIds)
+	ret := m.ctrl.Call(m, "UpdateReminderLastSentForEntities", ctx, entityIds)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateReminderLastSentForRepositories indicates an expected call of UpdateReminderLastSentForRepositories.
-func (mr *MockStoreMockRecorder) UpdateReminderLastSentForRepositories(ctx, repositoryIds any) *gomock.Call {
+// UpdateReminderLastSentForEntities indicates an expected call of UpdateReminderLastSentForEntities.
+func (mr *MockStoreMockRecorder) UpdateReminderLastSentForEntities(ctx, entityIds any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReminderLastSentForRepositories", reflect.TypeOf((*MockStore)(nil).UpdateReminderLastSentForRepositories), ctx, repositoryIds)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReminderLastSentForEntities", reflect.TypeOf((*MockStore)(nil).UpdateReminderLastSentForEntities), ctx, entityIds)
 }
 
 // UpdateRuleType mocks base method.
@@ -2534,6 +2727,20 @@ func (mr *MockStoreMockRecorder) UpdateSelector(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSelector", reflect.TypeOf((*MockStore)(nil).UpdateSelector), ctx, arg)
 }
 
+// UpdateSubscriptionVersion mocks base method.
+func (m *MockStore) UpdateSubscriptionVersion(ctx context.Context, arg db.UpdateSubscriptionVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscriptionVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscriptionVersion indicates an expected call of UpdateSubscriptionVersion.
+func (mr *MockStoreMockRecorder) UpdateSubscriptionVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscriptionVersion", reflect.TypeOf((*MockStore)(nil).UpdateSubscriptionVersion), ctx, arg)
+}
+
 // UpsertAccessToken mocks base method.
 func (m *MockStore) UpsertAccessToken(ctx context.Context, arg db.UpsertAccessTokenParams) (db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -2549,6 +2756,21 @@ func (mr *MockStoreMockRecorder) UpsertAccessToken(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAccessToken", reflect.TypeOf((*MockStore)(nil).UpsertAccessToken), ctx, arg)
 }
 
+// UpsertAlertWebhook mocks base method.
+func (m *MockStore) UpsertAlertWebhook(ctx context.Context, arg db.UpsertAlertWebhookParams) (db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlertWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAlertWebhook indicates an expected call of UpsertAlertWebhook.
+func (mr *MockStoreMockRecorder) UpsertAlertWebhook(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlertWebhook", reflect.TypeOf((*MockStore)(nil).UpsertAlertWebhook), ctx, arg)
+}
+
 // UpsertArtifact mocks base method.
 func (m *MockStore) UpsertArtifact(ctx context.Context, arg db.UpsertArtifactParams) (db.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -2622,6 +2844,21 @@ func (mr *MockStoreMockRecorder) UpsertProfileForEntity(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProfileForEntity", reflect.TypeOf((*MockStore)(nil).UpsertProfileForEntity), ctx, arg)
 }
 
+// UpsertProjectSecret mocks base method.
+func (m *MockStore) UpsertProjectSecret(ctx context.Context, arg db.UpsertProjectSecretParams) (db.ProjectSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProjectSecret", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProjectSecret indicates an expected call of UpsertProjectSecret.
+func (mr *MockStoreMockRecorder) UpsertProjectSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProjectSecret", reflect.TypeOf((*MockStore)(nil).UpsertProjectSecret), ctx, arg)
+}
+
 // UpsertProperty mocks base method.
 func (m *MockStore) UpsertProperty(ctx context.Context, arg db.UpsertPropertyParams) (db.Property, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,41 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- UpsertAlertWebhook creates an alert webhook in a project, or replaces
+-- the destination of the webhook with the same name.
+
+-- name: UpsertAlertWebhook :one
+INSERT INTO alert_webhooks (project_id, name, encrypted_destination)
+VALUES ($1, $2, $3)
+ON CONFLICT (project_id, name)
+DO UPDATE SET encrypted_destination = $3, updated_at = NOW()
+RETURNING *;
+
+-- name: GetAlertWebhookByName :one
+SELECT * FROM alert_webhooks
+WHERE project_id = $1 AND name = $2;
+
+-- name: ListAlertWebhooksByProject :many
+SELECT * FROM alert_webhooks
+WHERE project_id = $1
+ORDER BY name;
+
+-- name: DeleteAlertWebhook :one
+DELETE FROM alert_webhooks
+WHERE project_id = $1 AND name = $2
+RETURNING *;
+
+-- ListAlertWebhooksToMigrate lists the webhooks which are not encrypted with
+-- the default key version and algorithm, for key rotation. Webhooks no longer
+-- match once they are re-encrypted, so batches are not offset.
+-- name: ListAlertWebhooksToMigrate :many
+SELECT * FROM alert_webhooks WHERE
+    encrypted_destination->>'Algorithm'  <> sqlc.arg(default_algorithm)::TEXT OR
+    encrypted_destination->>'KeyVersion' <> sqlc.arg(default_key_version)::TEXT
+ORDER BY id
+LIMIT sqlc.arg(batch_size)::bigint;
+
+-- name: UpdateAlertWebhookEncryptedDestination :exec
+UPDATE alert_webhooks
+SET encrypted_destination = sqlc.arg(encrypted_destination)::JSONB
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,23 @@
+-- ListEntitiesAfterID lists a batch of entities of the given type, ordered by
+-- ID and starting after the given ID, along with the last time a reminder was
+-- sent for each of them.
+
+-- name: ListEntitiesAfterID :many
+SELECT ei.id, ei.entity_type, ei.project_id, ei.provider_id, er.last_sent AS reminder_last_sent
+FROM entity_instances AS ei
+    LEFT JOIN entity_reminders AS er ON er.entity_instance_id = ei.id
+WHERE ei.entity_type = sqlc.arg(entity_type) AND ei.id > sqlc.arg(id)
+ORDER BY ei.id
+LIMIT sqlc.arg('limit')::bigint;
+
+-- name: EntityExistsAfterID :one
+SELECT EXISTS (
+  SELECT 1
+  FROM entity_instances
+  WHERE entity_type = sqlc.arg(entity_type) AND id > sqlc.arg(id))
+AS exists;
+
+-- name: UpdateReminderLastSentForEntities :exec
+INSERT INTO entity_reminders (entity_instance_id, last_sent)
+SELECT unnest(sqlc.arg('entity_ids')::uuid[]), NOW()
+ON CONFLICT (entity_instance_id) DO UPDATE SET last_sent = NOW();
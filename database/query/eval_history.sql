@@ -171,25 +171,209 @@ SELECT s.id::uuid AS evaluation_id,
  CASE WHEN sqlc.narg(prev)::timestamp without time zone IS NULL THEN s.evaluation_time END DESC
  LIMIT sqlc.arg(size)::bigint;
 
--- name: ListEvaluationHistoryStaleRecords :many
-SELECT s.evaluation_time,
-       s.id,
-       ere.rule_id,
-       -- entity type
-       ere.entity_type,
-       -- entity id
-       ere.entity_instance_id as entity_id
-  FROM evaluation_statuses s
-       JOIN evaluation_rule_entities ere ON s.rule_entity_id = ere.id
+-- ListEvaluationHistoryForReport aggregates the evaluations of a project
+-- into daily snapshots covering the [fromts, tots) time range, per profile,
+-- rule and entity type.  Days are aligned to midnight UTC and the posture of
+-- a day is the status of the latest evaluation of each rule and entity at
+-- its end, or at tots for the last, possibly partial, day.  The last
+-- evaluation preceding the range is included, so that the posture at the
+-- beginning of the range is known.
+--
+-- Time to remediation is measured from the first failing evaluation of a
+-- streak, as far as the history above goes, to the next successful one.
+-- Errored and skipped evaluations do not interrupt a streak.
+
+-- name: ListEvaluationHistoryForReport :many
+WITH history AS (
+    SELECT s.rule_entity_id,
+           s.evaluation_time,
+           s.status,
+           ere.entity_type,
+           rt.name AS rule_type,
+           ri.name AS rule_name,
+           p.name AS profile_name,
+           -- failure streaks are delimited by successful evaluations
+           COUNT(*) FILTER (WHERE s.status = 'success') OVER (
+               PARTITION BY s.rule_entity_id
+               ORDER BY s.evaluation_time
+               ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+           ) AS streak
+      FROM evaluation_statuses s
+      JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+      JOIN rule_instances ri ON ere.rule_id = ri.id
+      JOIN rule_type rt ON ri.rule_type_id = rt.id
+      JOIN profiles p ON ri.profile_id = p.id
+      JOIN entity_instances ei ON ere.entity_instance_id = ei.id
+     WHERE ei.project_id = sqlc.arg(projectId)
+       AND s.evaluation_time < sqlc.arg(tots)::timestamp without time zone
+       AND (s.evaluation_time >= sqlc.arg(fromts)::timestamp without time zone
+            OR s.id = (SELECT prev.id
+                         FROM evaluation_statuses prev
+                        WHERE prev.rule_entity_id = s.rule_entity_id
+                          AND prev.evaluation_time < sqlc.arg(fromts)::timestamp without time zone
+                        ORDER BY prev.evaluation_time DESC
+                        LIMIT 1))
+       -- inclusion filters
+       AND (sqlc.slice(entityTypes)::entities[] IS NULL OR ere.entity_type = ANY(sqlc.slice(entityTypes)::entities[]))
+       AND (sqlc.slice(entityNames)::text[] IS NULL OR ei.name = ANY(sqlc.slice(entityNames)::text[]))
+       AND (sqlc.slice(profileNames)::text[] IS NULL OR p.name = ANY(sqlc.slice(profileNames)::text[]))
+       -- exclusion filters
+       AND (sqlc.slice(notEntityTypes)::entities[] IS NULL OR ere.entity_type != ALL(sqlc.slice(notEntityTypes)::entities[]))
+       AND (sqlc.slice(notEntityNames)::text[] IS NULL OR ei.name != ALL(sqlc.slice(notEntityNames)::text[]))
+       AND (sqlc.slice(notProfileNames)::text[] IS NULL OR p.name != ALL(sqlc.slice(notProfileNames)::text[]))
+       -- implicit filter by profile labels
+       AND ((sqlc.slice(labels)::text[] IS NULL AND p.labels = array[]::text[]) -- include only unlabelled records
+	    OR ((sqlc.slice(labels)::text[] IS NOT NULL AND sqlc.slice(labels)::text[] = array['*']::text[]) -- include all labels
+	        OR (sqlc.slice(labels)::text[] IS NOT NULL AND p.labels && sqlc.slice(labels)::text[]) -- include only specified labels
+	    )
+       )
+       AND (sqlc.slice(notLabels)::text[] IS NULL OR NOT p.labels && sqlc.slice(notLabels)::text[]) -- exclude only specified labels
+), streaks AS (
+    SELECT h.*,
+           MIN(h.evaluation_time) FILTER (WHERE h.status = 'failure') OVER (
+               PARTITION BY h.rule_entity_id, h.streak
+           ) AS failing_since
+      FROM history h
+), remediations AS (
+    SELECT date_trunc('day', st.evaluation_time) AS day,
+           st.profile_name,
+           st.rule_name,
+           st.rule_type,
+           st.entity_type,
+           COUNT(*) AS remediated,
+           AVG(EXTRACT(EPOCH FROM st.evaluation_time - st.failing_since)) AS mean_seconds
+      FROM streaks st
+     WHERE st.status = 'success'
+       AND st.failing_since IS NOT NULL
+       AND st.evaluation_time >= sqlc.arg(fromts)::timestamp without time zone
+     GROUP BY 1, 2, 3, 4, 5
+), posture AS (
+    -- the status of each rule and entity at the end of the days it was
+    -- evaluated, which holds until the next day it was evaluated
+    SELECT d.*,
+           LEAD(d.day) OVER (PARTITION BY d.rule_entity_id ORDER BY d.day) AS next_day
+      FROM (SELECT DISTINCT ON (h.rule_entity_id, date_trunc('day', h.evaluation_time))
+                   h.rule_entity_id,
+                   date_trunc('day', h.evaluation_time) AS day,
+                   h.status,
+                   h.profile_name,
+                   h.rule_name,
+                   h.rule_type,
+                   h.entity_type
+              FROM history h
+             ORDER BY h.rule_entity_id, date_trunc('day', h.evaluation_time), h.evaluation_time DESC) d
+), days AS (
+    SELECT g.day::timestamp without time zone AS day
+      FROM generate_series(
+               date_trunc('day', sqlc.arg(fromts)::timestamp without time zone),
+               sqlc.arg(tots)::timestamp without time zone,
+               interval '1 day'
+           ) AS g(day)
+     WHERE g.day < sqlc.arg(tots)::timestamp without time zone
+)
+SELECT days.day,
+       p.profile_name,
+       p.rule_name,
+       p.rule_type,
+       p.entity_type,
+       COUNT(*) FILTER (WHERE p.status = 'success') AS passing,
+       COUNT(*) FILTER (WHERE p.status = 'failure') AS failing,
+       COUNT(*) FILTER (WHERE p.status = 'error') AS errored,
+       COUNT(*) FILTER (WHERE p.status = 'skipped') AS skipped,
+       COALESCE(MAX(r.remediated), 0)::bigint AS remediated,
+       COALESCE(MAX(r.mean_seconds), 0)::float8 AS mean_remediation_seconds
+  FROM days
+  JOIN posture p ON p.day <= days.day AND (p.next_day IS NULL OR days.day < p.next_day)
+  LEFT JOIN remediations r
+    ON r.day = days.day
+   AND r.profile_name = p.profile_name
+   AND r.rule_name = p.rule_name
+   AND r.rule_type = p.rule_type
+   AND r.entity_type = p.entity_type
+ GROUP BY days.day, p.profile_name, p.rule_name, p.rule_type, p.entity_type
+ ORDER BY days.day, p.profile_name, p.rule_name, p.entity_type;
+
+-- ListEvaluationHistoryToPurge lists a batch of evaluation history records
+-- of a project, from oldest to newest, following the record identified by
+-- after_time and after_id, and whether each of them is outside of its
+-- retention policy, i.e. older than the threshold or, when transitions_only
+-- is set, with the same status as the previous record for the same rule and
+-- entity.  The latest record for any rule/entity pair is never purged.
+-- Only the records of the batch and the last record preceding the batch for
+-- each rule and entity are looked at, so that the cost of a batch does not
+-- depend on the size of the history.
+
+-- name: ListEvaluationHistoryToPurge :many
+WITH batch AS (
+    SELECT s.id,
+           s.rule_entity_id,
+           s.evaluation_time,
+           s.status
+      FROM evaluation_statuses s
+           JOIN evaluation_rule_entities ere ON s.rule_entity_id = ere.id
+           JOIN rule_instances ri ON ere.rule_id = ri.id
+     WHERE ri.project_id = sqlc.arg(project_id)
+       AND (sqlc.arg(transitions_only)::boolean OR s.evaluation_time < sqlc.arg(threshold))
+       AND (sqlc.narg(after_time)::timestamp without time zone IS NULL
+            OR (s.evaluation_time, s.id) > (sqlc.narg(after_time)::timestamp without time zone, sqlc.narg(after_id)::uuid))
+     ORDER BY s.evaluation_time, s.id
+     LIMIT sqlc.arg(size)::integer
+), predecessors AS (
+    SELECT prev.*
+      FROM (SELECT DISTINCT rule_entity_id FROM batch) b
+           CROSS JOIN LATERAL (
+               SELECT p.id,
+                      p.rule_entity_id,
+                      p.evaluation_time,
+                      p.status
+                 FROM evaluation_statuses p
+                WHERE p.rule_entity_id = b.rule_entity_id
+                  AND (p.evaluation_time, p.id) <= (sqlc.narg(after_time)::timestamp without time zone, sqlc.narg(after_id)::uuid)
+                ORDER BY p.evaluation_time DESC, p.id DESC
+                LIMIT 1
+           ) prev
+), history AS (
+    SELECT h.*,
+           LAG(h.status) OVER (PARTITION BY h.rule_entity_id ORDER BY h.evaluation_time, h.id) AS previous_status
+      FROM (SELECT batch.*, true AS in_batch FROM batch
+            UNION ALL
+            SELECT predecessors.*, false AS in_batch FROM predecessors) h
+)
+SELECT h.id,
+       h.evaluation_time,
+       (l.evaluation_history_id IS NULL
+        AND (h.evaluation_time < sqlc.arg(threshold)
+             OR (sqlc.arg(transitions_only)::boolean AND COALESCE(h.status = h.previous_status, false))))::boolean AS purge
+  FROM history h
        LEFT JOIN latest_evaluation_statuses l
-	   ON l.rule_entity_id = s.rule_entity_id
-	   AND l.evaluation_history_id = s.id
- WHERE s.evaluation_time < sqlc.arg(threshold)
-  -- the following predicate ensures we get only "stale" records
-   AND l.evaluation_history_id IS NULL
- -- listing from oldest to newest
- ORDER BY s.evaluation_time ASC, rule_id ASC, entity_id ASC
- LIMIT sqlc.arg(size)::integer;
+	   ON l.rule_entity_id = h.rule_entity_id
+	   AND l.evaluation_history_id = h.id
+ WHERE h.in_batch
+ ORDER BY h.evaluation_time, h.id;
+
+-- CountEvaluationHistoryToPurge counts the records ListEvaluationHistoryToPurge
+-- would mark to be purged, over the whole history in one go.
+
+-- name: CountEvaluationHistoryToPurge :one
+WITH history AS (
+    SELECT s.id,
+           s.evaluation_time,
+           s.status,
+           LAG(s.status) OVER (PARTITION BY s.rule_entity_id ORDER BY s.evaluation_time) AS previous_status,
+           l.evaluation_history_id IS NOT NULL AS is_latest
+      FROM evaluation_statuses s
+           JOIN evaluation_rule_entities ere ON s.rule_entity_id = ere.id
+           JOIN rule_instances ri ON ere.rule_id = ri.id
+           LEFT JOIN latest_evaluation_statuses l
+	       ON l.rule_entity_id = s.rule_entity_id
+	       AND l.evaluation_history_id = s.id
+     WHERE ri.project_id = sqlc.arg(project_id)
+)
+SELECT COUNT(*)
+  FROM history
+ WHERE NOT is_latest
+   AND (evaluation_time < sqlc.arg(threshold)
+        OR (sqlc.arg(transitions_only)::boolean AND status = previous_status));
 
 -- name: DeleteEvaluationHistoryByIDs :execrows
 DELETE FROM evaluation_statuses s
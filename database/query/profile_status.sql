@@ -13,17 +13,22 @@ SELECT p.id, p.name, ps.profile_status, ps.last_updated FROM profile_status ps
 INNER JOIN profiles p ON p.id = ps.profile_id
 WHERE p.project_id = $1;
 
--- ListOldestRuleEvaluationsByRepositoryId has casts in select statement as sqlc generates incorrect types.
+-- ListOldestRuleEvaluationsByEntityId has casts in select statement as sqlc generates incorrect types.
 -- cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
+-- One row is returned for each entity and profile pair, along with the profile's evaluation schedule.
 
--- name: ListOldestRuleEvaluationsByRepositoryId :many
-SELECT ere.entity_instance_id::uuid AS repository_id, MIN(es.evaluation_time)::timestamp AS oldest_last_updated
+-- name: ListOldestRuleEvaluationsByEntityId :many
+SELECT ere.entity_instance_id::uuid AS entity_id,
+       p.id AS profile_id,
+       p.evaluation_schedule,
+       MIN(es.evaluation_time)::timestamp AS oldest_last_updated
 FROM evaluation_rule_entities AS ere
     INNER JOIN latest_evaluation_statuses AS les ON ere.id = les.rule_entity_id
     INNER JOIN evaluation_statuses AS es ON les.evaluation_history_id = es.id
-WHERE ere.entity_instance_id = ANY (sqlc.arg('repository_ids')::uuid[])
-AND ere.entity_type = 'repository'
-GROUP BY ere.entity_instance_id;
+    INNER JOIN rule_instances AS ri ON ere.rule_id = ri.id
+    INNER JOIN profiles AS p ON ri.profile_id = p.id
+WHERE ere.entity_instance_id = ANY (sqlc.arg('entity_ids')::uuid[])
+GROUP BY ere.entity_instance_id, p.id;
 
 -- name: ListRuleEvaluationsByProfileId :many
 WITH
@@ -6,8 +6,12 @@ INSERT INTO profiles (
     name,
     subscription_id,
     display_name,
-    labels
-) VALUES ($1, $2, $3, $4, sqlc.narg(subscription_id), sqlc.arg(display_name), COALESCE(sqlc.arg(labels)::text[], '{}'::text[])) RETURNING *;
+    labels,
+    evaluation_schedule
+) VALUES (
+    $1, $2, $3, $4, sqlc.narg(subscription_id), sqlc.arg(display_name),
+    COALESCE(sqlc.arg(labels)::text[], '{}'::text[]), sqlc.narg(evaluation_schedule)
+) RETURNING *;
 
 -- name: UpdateProfile :one
 UPDATE profiles SET
@@ -15,7 +19,8 @@ UPDATE profiles SET
     alert = $4,
     updated_at = NOW(),
     display_name = sqlc.arg(display_name),
-    labels = COALESCE(sqlc.arg(labels)::TEXT[], '{}'::TEXT[])
+    labels = COALESCE(sqlc.arg(labels)::TEXT[], '{}'::TEXT[]),
+    evaluation_schedule = sqlc.narg(evaluation_schedule)
 WHERE id = $1 AND project_id = $2 RETURNING *;
 
 -- name: CreateProfileForEntity :one
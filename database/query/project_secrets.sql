@@ -0,0 +1,41 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- UpsertProjectSecret creates a secret in a project, or replaces the
+-- value of the secret with the same name.
+
+-- name: UpsertProjectSecret :one
+INSERT INTO project_secrets (project_id, name, encrypted_value)
+VALUES ($1, $2, $3)
+ON CONFLICT (project_id, name)
+DO UPDATE SET encrypted_value = $3, updated_at = NOW()
+RETURNING *;
+
+-- name: GetProjectSecretByName :one
+SELECT * FROM project_secrets
+WHERE project_id = $1 AND name = $2;
+
+-- name: ListProjectSecretsByProject :many
+SELECT * FROM project_secrets
+WHERE project_id = $1
+ORDER BY name;
+
+-- name: DeleteProjectSecret :one
+DELETE FROM project_secrets
+WHERE project_id = $1 AND name = $2
+RETURNING *;
+
+-- ListProjectSecretsToMigrate lists the secrets which are not encrypted with
+-- the default key version and algorithm, for key rotation. Secrets no longer
+-- match once they are re-encrypted, so batches are not offset.
+-- name: ListProjectSecretsToMigrate :many
+SELECT * FROM project_secrets WHERE
+    encrypted_value->>'Algorithm'  <> sqlc.arg(default_algorithm)::TEXT OR
+    encrypted_value->>'KeyVersion' <> sqlc.arg(default_key_version)::TEXT
+ORDER BY id
+LIMIT sqlc.arg(batch_size)::bigint;
+
+-- name: UpdateProjectSecretEncryptedValue :exec
+UPDATE project_secrets
+SET encrypted_value = sqlc.arg(encrypted_value)::JSONB
+WHERE id = sqlc.arg(id);
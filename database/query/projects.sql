@@ -103,6 +103,10 @@ UPDATE projects
 SET metadata = $2
 WHERE id = $1 RETURNING *;
 
+-- name: ListAllProjects :many
+SELECT * FROM projects
+ORDER BY id;
+
 -- name: ListAllRootProjects :many
 SELECT * FROM projects
 WHERE parent_id IS NULL AND is_organization = FALSE;
@@ -24,3 +24,6 @@ WHERE bu.namespace = $1 AND bu.name = $2 AND su.project_id = $3;
 
 -- name: SetSubscriptionBundleVersion :exec
 UPDATE subscriptions SET current_version = $2 WHERE project_id = $1;
+
+-- name: UpdateSubscriptionVersion :exec
+UPDATE subscriptions SET current_version = $2 WHERE id = $1;
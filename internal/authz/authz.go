@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/google/uuid"
 	fgasdk "github.com/openfga/go-sdk"
@@ -32,6 +34,38 @@ var (
 
 	//go:embed model/minder.generated.json
 	authzModel string
+
+	// AllPermissions lists the project relations of the authz model which
+	// are permissions rather than roles, i.e. the relations which may be
+	// granted by custom roles.
+	AllPermissions = sync.OnceValue(func() []string {
+		var m fgasdk.WriteAuthorizationModelRequest
+		if err := json.Unmarshal([]byte(authzModel), &m); err != nil {
+			panic(fmt.Sprintf("failed to unmarshal authz model: %v", err))
+		}
+
+		perms := []string{}
+		for _, td := range m.TypeDefinitions {
+			if td.Type != projectType {
+				continue
+			}
+			for rel := range td.GetRelations() {
+				if rel == parentRelation || !Role(rel).IsCustom() {
+					continue
+				}
+				perms = append(perms, rel)
+			}
+		}
+		slices.Sort(perms)
+		return perms
+	})
+)
+
+const (
+	projectType      = "project"
+	roleType         = "role"
+	parentRelation   = "parent"
+	assigneeRelation = "assignee"
 )
 
 // ClientWrapper is a wrapper for the OpenFgaClient.
@@ -260,6 +294,13 @@ func (a *ClientWrapper) Check(ctx context.Context, action string, project uuid.U
 
 // Write persists the given role for the given user and project
 func (a *ClientWrapper) Write(ctx context.Context, user string, role Role, project uuid.UUID) error {
+	if role.IsCustom() {
+		return a.write(ctx, fgasdk.TupleKey{
+			User:     getUserForTuple(user),
+			Relation: assigneeRelation,
+			Object:   getRoleForTuple(project, role),
+		})
+	}
 	return a.write(ctx, fgasdk.TupleKey{
 		User:     getUserForTuple(user),
 		Relation: role.String(),
@@ -276,9 +317,9 @@ func (a *ClientWrapper) Adopt(ctx context.Context, parent, child uuid.UUID) erro
 	})
 }
 
-func (a *ClientWrapper) write(ctx context.Context, t fgasdk.TupleKey) error {
+func (a *ClientWrapper) write(ctx context.Context, t ...fgasdk.TupleKey) error {
 	resp, err := a.cli.WriteTuples(ctx).Options(fgaclient.ClientWriteOptions{}).
-		Body([]fgasdk.TupleKey{t}).Execute()
+		Body(t).Execute()
 	if err != nil && strings.Contains(err.Error(), "already exists") {
 		return nil
 	} else if err != nil {
@@ -296,9 +337,109 @@ func (a *ClientWrapper) write(ctx context.Context, t fgasdk.TupleKey) error {
 
 // Delete removes the given role for the given user and project
 func (a *ClientWrapper) Delete(ctx context.Context, user string, role Role, project uuid.UUID) error {
+	if role.IsCustom() {
+		return a.doDelete(ctx, getUserForTuple(user), assigneeRelation, getRoleForTuple(project, role))
+	}
 	return a.doDelete(ctx, getUserForTuple(user), role.String(), getProjectForTuple(project))
 }
 
+// CreateCustomRole defines a custom role on the project, by granting each of
+// the role permissions on the project to the role assignees.
+func (a *ClientWrapper) CreateCustomRole(ctx context.Context, project uuid.UUID, role *CustomRole) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	existing, err := a.ListCustomRoles(ctx, project)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(existing, func(r *CustomRole) bool { return r.Name == role.Name }) {
+		return ErrCustomRoleExists
+	}
+
+	assignees := getRoleAssigneesForTuple(project, role.Name)
+	tuples := make([]fgasdk.TupleKey, 0, len(role.Permissions))
+	for _, perm := range role.Permissions {
+		tuples = append(tuples, fgasdk.TupleKey{
+			User:     assignees,
+			Relation: perm,
+			Object:   getProjectForTuple(project),
+		})
+	}
+
+	return a.write(ctx, tuples...)
+}
+
+// ListCustomRoles lists the custom roles defined on the project
+func (a *ClientWrapper) ListCustomRoles(ctx context.Context, project uuid.UUID) ([]*CustomRole, error) {
+	o := getProjectForTuple(project)
+	tuples, err := a.readTuples(ctx, fgaclient.ClientReadRequest{
+		Object: &o,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []*CustomRole{}
+	for _, t := range tuples {
+		k := t.GetKey()
+		name, ok := getRoleFromAssigneesTuple(project, k.GetUser())
+		if !ok {
+			continue
+		}
+		idx := slices.IndexFunc(roles, func(r *CustomRole) bool { return r.Name == name })
+		if idx == -1 {
+			idx = len(roles)
+			roles = append(roles, &CustomRole{Name: name})
+		}
+		roles[idx].Permissions = append(roles[idx].Permissions, k.GetRelation())
+	}
+
+	for _, r := range roles {
+		slices.Sort(r.Permissions)
+	}
+	slices.SortFunc(roles, func(a, b *CustomRole) int { return strings.Compare(a.Name.String(), b.Name.String()) })
+
+	return roles, nil
+}
+
+// DeleteCustomRole removes the permissions granted by a custom role on the
+// project, and then all of its assignments.
+func (a *ClientWrapper) DeleteCustomRole(ctx context.Context, project uuid.UUID, role Role) error {
+	roles, err := a.ListCustomRoles(ctx, project)
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(roles, func(r *CustomRole) bool { return r.Name == role })
+	if idx == -1 {
+		return ErrCustomRoleNotFound
+	}
+
+	assignees := getRoleAssigneesForTuple(project, role)
+	for _, perm := range roles[idx].Permissions {
+		if err := a.doDelete(ctx, assignees, perm, getProjectForTuple(project)); err != nil {
+			return err
+		}
+	}
+
+	o := getRoleForTuple(project, role)
+	assignments, err := a.readTuples(ctx, fgaclient.ClientReadRequest{
+		Object: &o,
+	})
+	if err != nil {
+		return err
+	}
+	for _, t := range assignments {
+		k := t.GetKey()
+		if err := a.doDelete(ctx, k.GetUser(), k.GetRelation(), k.GetObject()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Orphan removes the relationship between the parent and child projects
 func (a *ClientWrapper) Orphan(ctx context.Context, parent, child uuid.UUID) error {
 	return a.doDelete(ctx, getProjectForTuple(parent), "parent", getProjectForTuple(child))
@@ -348,81 +489,102 @@ func (a *ClientWrapper) DeleteUser(ctx context.Context, user string) error {
 		}
 	}
 
+	// Remove the custom role assignments as well
+	u := getUserForTuple(user)
+	roleObj := roleType + ":"
+	tuples, err := a.readTuples(ctx, fgaclient.ClientReadRequest{
+		User:   &u,
+		Object: &roleObj,
+	})
+	if err != nil {
+		return err
+	}
+	for _, t := range tuples {
+		k := t.GetKey()
+		if err := a.doDelete(ctx, k.GetUser(), k.GetRelation(), k.GetObject()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// AssignmentsToProject lists the current role assignments that are scoped to a project
+// AssignmentsToProject lists the current role assignments that are scoped to a project,
+// including the assignments of the custom roles defined on the project
 func (a *ClientWrapper) AssignmentsToProject(ctx context.Context, project uuid.UUID) ([]*minderv1.RoleAssignment, error) {
 	o := getProjectForTuple(project)
 	prjStr := project.String()
 
-	var pagesize int32 = 50
-	var contTok *string = nil
+	tuples, err := a.readTuples(ctx, fgaclient.ClientReadRequest{
+		Object: &o,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	assignments := []*minderv1.RoleAssignment{}
-
-	for {
-		resp, err := a.cli.Read(ctx).Options(fgaclient.ClientReadOptions{
-			PageSize:          &pagesize,
-			ContinuationToken: contTok,
-		}).Body(fgaclient.ClientReadRequest{
-			Object: &o,
-		}).Execute()
+	customRoles := []Role{}
+
+	for _, t := range tuples {
+		k := t.GetKey()
+		// Permissions granted by custom roles are not assignments themselves
+		if name, ok := getRoleFromAssigneesTuple(project, k.GetUser()); ok {
+			if !slices.Contains(customRoles, name) {
+				customRoles = append(customRoles, name)
+			}
+			continue
+		}
+		r, err := ParseRole(k.GetRelation())
 		if err != nil {
-			return nil, fmt.Errorf("unable to read authorization tuples: %w", err)
+			a.l.Err(err).Msg("Found invalid role in authz store")
+			continue
 		}
-
-		for _, t := range resp.GetTuples() {
-			k := t.GetKey()
-			r, err := ParseRole(k.GetRelation())
-			if err != nil {
-				a.l.Err(err).Msg("Found invalid role in authz store")
-				continue
-			}
+		assignments = append(assignments, &minderv1.RoleAssignment{
+			Subject: getUserFromTuple(k.GetUser()),
+			Role:    r.String(),
+			Project: &prjStr,
+		})
+	}
+
+	for _, r := range customRoles {
+		ro := getRoleForTuple(project, r)
+		tuples, err := a.readTuples(ctx, fgaclient.ClientReadRequest{
+			Relation: fgasdk.PtrString(assigneeRelation),
+			Object:   &ro,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tuples {
 			assignments = append(assignments, &minderv1.RoleAssignment{
-				Subject: getUserFromTuple(k.GetUser()),
+				Subject: getUserFromTuple(t.Key.GetUser()),
 				Role:    r.String(),
 				Project: &prjStr,
 			})
 		}
-
-		if resp.GetContinuationToken() == "" {
-			break
-		}
-
-		contTok = &resp.ContinuationToken
 	}
 
 	return assignments, nil
 }
 
-// ProjectsForUser lists the projects that the given user has access to
-func (a *ClientWrapper) ProjectsForUser(ctx context.Context, sub string) ([]uuid.UUID, error) {
-	u := getUserForTuple(sub)
-
+// readTuples reads all the tuples matching the request, following the
+// continuation tokens of the paginated results
+func (a *ClientWrapper) readTuples(ctx context.Context, body fgaclient.ClientReadRequest) ([]fgasdk.Tuple, error) {
 	var pagesize int32 = 50
 	var contTok *string = nil
 
-	projs := map[string]any{}
-	projectObj := "project:"
+	tuples := []fgasdk.Tuple{}
 
 	for {
 		resp, err := a.cli.Read(ctx).Options(fgaclient.ClientReadOptions{
 			PageSize:          &pagesize,
 			ContinuationToken: contTok,
-		}).Body(fgaclient.ClientReadRequest{
-			User:   &u,
-			Object: &projectObj,
-		}).Execute()
+		}).Body(body).Execute()
 		if err != nil {
 			return nil, fmt.Errorf("unable to read authorization tuples: %w", err)
 		}
 
-		for _, t := range resp.GetTuples() {
-			k := t.GetKey()
-
-			projs[k.GetObject()] = struct{}{}
-		}
+		tuples = append(tuples, resp.GetTuples()...)
 
 		if resp.GetContinuationToken() == "" {
 			break
@@ -431,6 +593,30 @@ func (a *ClientWrapper) ProjectsForUser(ctx context.Context, sub string) ([]uuid
 		contTok = &resp.ContinuationToken
 	}
 
+	return tuples, nil
+}
+
+// ProjectsForUser lists the projects that the given user has access to
+func (a *ClientWrapper) ProjectsForUser(ctx context.Context, sub string) ([]uuid.UUID, error) {
+	u := getUserForTuple(sub)
+
+	projs := map[string]any{}
+	projectObj := "project:"
+
+	tuples, err := a.readTuples(ctx, fgaclient.ClientReadRequest{
+		User:   &u,
+		Object: &projectObj,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tuples {
+		k := t.GetKey()
+
+		projs[k.GetObject()] = struct{}{}
+	}
+
 	out := []uuid.UUID{}
 	for proj := range projs {
 		u, err := uuid.Parse(getProjectFromTuple(proj))
@@ -448,6 +634,22 @@ func (a *ClientWrapper) ProjectsForUser(ctx context.Context, sub string) ([]uuid
 		out = append(out, children...)
 	}
 
+	// Custom roles are not inherited, so users assigned a custom role only
+	// have access to the project defining it
+	roleObj := roleType + ":"
+	roleTuples, err := a.readTuples(ctx, fgaclient.ClientReadRequest{
+		User:   &u,
+		Object: &roleObj,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range roleTuples {
+		if p, ok := getProjectFromRoleTuple(t.Key.GetObject()); ok && !slices.Contains(out, p) {
+			out = append(out, p)
+		}
+	}
+
 	return out, nil
 }
 
@@ -500,3 +702,44 @@ func getUserFromTuple(user string) string {
 func getProjectFromTuple(project string) string {
 	return strings.TrimPrefix(project, "project:")
 }
+
+// getRoleForTuple returns the object of a custom role, which is scoped to
+// the project defining it, e.g. "role:<project>/repo_registrar".
+func getRoleForTuple(project uuid.UUID, role Role) string {
+	return roleType + ":" + project.String() + "/" + role.String()
+}
+
+func getRoleAssigneesForTuple(project uuid.UUID, role Role) string {
+	return getRoleForTuple(project, role) + "#" + assigneeRelation
+}
+
+// getRoleFromAssigneesTuple returns the name of the custom role of the
+// project whose assignees are the given tuple user, if any.
+func getRoleFromAssigneesTuple(project uuid.UUID, user string) (Role, bool) {
+	rest, ok := strings.CutPrefix(user, roleType+":"+project.String()+"/")
+	if !ok {
+		return "", false
+	}
+	name, ok := strings.CutSuffix(rest, "#"+assigneeRelation)
+	if !ok {
+		return "", false
+	}
+	return Role(name), true
+}
+
+// getProjectFromRoleTuple returns the project defining the given custom role object
+func getProjectFromRoleTuple(role string) (uuid.UUID, bool) {
+	rest, ok := strings.CutPrefix(role, roleType+":")
+	if !ok {
+		return uuid.Nil, false
+	}
+	prj, _, ok := strings.Cut(rest, "/")
+	if !ok {
+		return uuid.Nil, false
+	}
+	u, err := uuid.Parse(prj)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return u, true
+}
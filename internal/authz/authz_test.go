@@ -205,6 +205,129 @@ func TestVerifyMultipleProjects(t *testing.T) {
 	assert.Len(t, assignments, 0, "expected 0 assignments to project")
 }
 
+func TestCustomRoles(t *testing.T) {
+	t.Parallel()
+
+	c, stopFunc := newOpenFGAServerAndClient(t)
+	defer stopFunc()
+	assert.NotNil(t, c)
+
+	ctx := context.Background()
+
+	require.NoError(t, c.MigrateUp(ctx), "failed to migrate up")
+	require.NoError(t, c.PrepareForRun(ctx), "failed to prepare for run")
+
+	prj := uuid.New()
+	otherPrj := uuid.New()
+	registrar := authz.Role("repo_registrar")
+
+	// define a custom role
+	require.NoError(t, c.CreateCustomRole(ctx, prj, &authz.CustomRole{
+		Name:        registrar,
+		Permissions: []string{"repo_get", "repo_create"},
+	}), "failed to create custom role")
+	assert.ErrorIs(t, c.CreateCustomRole(ctx, prj, &authz.CustomRole{
+		Name:        registrar,
+		Permissions: []string{"repo_get"},
+	}), authz.ErrCustomRoleExists)
+	assert.Error(t, c.CreateCustomRole(ctx, prj, &authz.CustomRole{
+		Name:        "bad_role",
+		Permissions: []string{"admin"},
+	}), "expected roles not to be granted by custom roles")
+
+	roles, err := c.ListCustomRoles(ctx, prj)
+	require.NoError(t, err, "failed to list custom roles")
+	require.Len(t, roles, 1, "expected 1 custom role")
+	assert.Equal(t, registrar, roles[0].Name)
+	assert.Equal(t, []string{"repo_create", "repo_get"}, roles[0].Permissions)
+
+	roles, err = c.ListCustomRoles(ctx, otherPrj)
+	require.NoError(t, err, "failed to list custom roles")
+	assert.Empty(t, roles, "expected custom roles to be scoped to a project")
+
+	// assign it alongside a built-in role
+	require.NoError(t, c.Write(ctx, "user-1", registrar, prj), "failed to assign custom role")
+	require.NoError(t, c.Write(ctx, "user-2", authz.RoleAdmin, prj), "failed to assign admin role")
+
+	userctx := auth.WithIdentityContext(ctx, &auth.Identity{
+		UserID: "user-1",
+	})
+
+	assert.NoError(t, c.Check(userctx, "repo_get", prj), "expected custom role to grant repo_get")
+	assert.NoError(t, c.Check(userctx, "repo_create", prj), "expected custom role to grant repo_create")
+	assert.Error(t, c.Check(userctx, "repo_delete", prj), "expected custom role not to grant repo_delete")
+	assert.Error(t, c.Check(userctx, "get", prj), "expected custom role not to grant get")
+	assert.Error(t, c.Check(userctx, "repo_get", otherPrj), "expected custom role not to apply to other projects")
+
+	projects, err := c.ProjectsForUser(userctx, "user-1")
+	require.NoError(t, err, "failed to get projects for user")
+	assert.Equal(t, []uuid.UUID{prj}, projects, "expected custom role project to be returned")
+
+	assignments, err := c.AssignmentsToProject(ctx, prj)
+	require.NoError(t, err, "failed to get assignments to project")
+	assert.Len(t, assignments, 2, "expected 2 assignments to project")
+	for _, a := range assignments {
+		switch a.Subject {
+		case "user-1":
+			assert.Equal(t, registrar.String(), a.Role)
+		case "user-2":
+			assert.Equal(t, authz.RoleAdmin.String(), a.Role)
+		default:
+			t.Errorf("unexpected assignment for %s", a.Subject)
+		}
+	}
+
+	// deleting the role also removes its assignments
+	require.NoError(t, c.DeleteCustomRole(ctx, prj, registrar), "failed to delete custom role")
+	assert.ErrorIs(t, c.DeleteCustomRole(ctx, prj, registrar), authz.ErrCustomRoleNotFound)
+	assert.Error(t, c.Check(userctx, "repo_get", prj), "expected custom role to be revoked")
+
+	roles, err = c.ListCustomRoles(ctx, prj)
+	require.NoError(t, err, "failed to list custom roles")
+	assert.Empty(t, roles, "expected no custom roles")
+
+	assignments, err = c.AssignmentsToProject(ctx, prj)
+	require.NoError(t, err, "failed to get assignments to project")
+	assert.Len(t, assignments, 1, "expected 1 assignment to project")
+
+	projects, err = c.ProjectsForUser(userctx, "user-1")
+	require.NoError(t, err, "failed to get projects for user")
+	assert.Empty(t, projects, "expected no projects for user")
+}
+
+func TestDeleteUserWithCustomRole(t *testing.T) {
+	t.Parallel()
+
+	c, stopFunc := newOpenFGAServerAndClient(t)
+	defer stopFunc()
+
+	ctx := context.Background()
+
+	require.NoError(t, c.MigrateUp(ctx), "failed to migrate up")
+	require.NoError(t, c.PrepareForRun(ctx), "failed to prepare for run")
+
+	prj := uuid.New()
+	reader := authz.Role("profile_reader")
+	require.NoError(t, c.CreateCustomRole(ctx, prj, &authz.CustomRole{
+		Name:        reader,
+		Permissions: []string{"profile_get"},
+	}), "failed to create custom role")
+	require.NoError(t, c.Write(ctx, "user-1", reader, prj), "failed to assign custom role")
+
+	userctx := auth.WithIdentityContext(ctx, &auth.Identity{
+		UserID: "user-1",
+	})
+	assert.NoError(t, c.Check(userctx, "profile_get", prj), "expected custom role to grant profile_get")
+
+	require.NoError(t, c.DeleteUser(ctx, "user-1"), "failed to delete user")
+	assert.Error(t, c.Check(userctx, "profile_get", prj), "expected custom role to be revoked")
+
+	// the role definition itself is kept
+	roles, err := c.ListCustomRoles(ctx, prj)
+	require.NoError(t, err, "failed to list custom roles")
+	assert.Len(t, roles, 1, "expected custom role to be kept")
+}
+
 func newOpenFGAServerAndClient(t *testing.T) (authz.Client, func()) {
 	t.Helper()
 
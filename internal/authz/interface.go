@@ -6,15 +6,24 @@ package authz
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 
 	"github.com/google/uuid"
 
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
-// ErrNotAuthorized is the error returned when a user is not authorized to perform an action
-var ErrNotAuthorized = fmt.Errorf("not authorized")
+var (
+	// ErrNotAuthorized is the error returned when a user is not authorized to perform an action
+	ErrNotAuthorized = fmt.Errorf("not authorized")
+	// ErrCustomRoleExists is the error returned when defining a custom role which already exists
+	ErrCustomRoleExists = errors.New("custom role already exists")
+	// ErrCustomRoleNotFound is the error returned when a custom role is not defined in the project
+	ErrCustomRoleNotFound = errors.New("custom role not found")
+)
 
 // Role is the role a user can have on a project
 type Role string
@@ -79,6 +88,69 @@ func ParseRole(r string) (Role, error) {
 	return rr, nil
 }
 
+// customRoleNameRegex matches the names allowed for custom roles, which
+// are the same as the names of the built-in roles.
+var customRoleNameRegex = regexp.MustCompile(`^[a-z]+(_[a-z]+)*$`)
+
+// maxCustomRoleNameLength is the maximum length of a custom role name
+const maxCustomRoleNameLength = 63
+
+// IsCustom returns true if the role is not one of the built-in roles
+func (r Role) IsCustom() bool {
+	_, ok := AllRolesDescriptions[r]
+	return !ok
+}
+
+// ParseRoleName parses a string into a Role, accepting both the built-in
+// roles and well-formed custom role names. It does not check whether a
+// custom role is defined in any project, so it is meant for roles which
+// have already been validated, e.g. read back from a role assignment.
+func ParseRoleName(r string) (Role, error) {
+	if r == "" {
+		return "", fmt.Errorf("role cannot be empty")
+	}
+	rr := Role(r)
+	if !rr.IsCustom() {
+		return rr, nil
+	}
+	if len(r) > maxCustomRoleNameLength || !customRoleNameRegex.MatchString(r) {
+		return "", fmt.Errorf("invalid role %s", r)
+	}
+
+	return rr, nil
+}
+
+// CustomRole is a role defined by a project as a named set of the
+// permissions of the project, e.g. "repo_registrar" granting
+// "repo_get" and "repo_create".
+type CustomRole struct {
+	// Name is the name of the role
+	Name Role
+	// Permissions are the project relations granted by the role
+	Permissions []string
+}
+
+// Validate checks that the custom role has a valid name, which does not
+// clash with the built-in roles, and only grants known permissions.
+func (r *CustomRole) Validate() error {
+	if !r.Name.IsCustom() {
+		return fmt.Errorf("role %s is a built-in role", r.Name)
+	}
+	if _, err := ParseRoleName(r.Name.String()); err != nil {
+		return err
+	}
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("role %s must grant at least one permission", r.Name)
+	}
+	for _, p := range r.Permissions {
+		if !slices.Contains(AllPermissions(), p) {
+			return fmt.Errorf("invalid permission %s", p)
+		}
+	}
+
+	return nil
+}
+
 // Client provides an abstract interface which simplifies interacting with
 // OpenFGA and supports no-op and fake implementations.
 type Client interface {
@@ -86,7 +158,8 @@ type Client interface {
 	Check(ctx context.Context, action string, project uuid.UUID) error
 
 	// Write stores an authorization tuple allowing user (an OAuth2 subject) to
-	// act in the specified role on the project.
+	// act in the specified role on the project. The role may be a custom role
+	// defined on the project.
 	//
 	// NOTE: this method _DOES NOT CHECK_ that the current user in the context
 	// has permissions to update the project.
@@ -98,6 +171,16 @@ type Client interface {
 	// has permissions to update the project.
 	Delete(ctx context.Context, user string, role Role, project uuid.UUID) error
 
+	// CreateCustomRole defines a custom role on the project, granting the
+	// role permissions to the users it is assigned to. Custom roles are
+	// assigned with Write and removed with Delete, like built-in roles.
+	CreateCustomRole(ctx context.Context, project uuid.UUID, role *CustomRole) error
+	// ListCustomRoles outputs the custom roles defined on the project.
+	ListCustomRoles(ctx context.Context, project uuid.UUID) ([]*CustomRole, error)
+	// DeleteCustomRole removes a custom role from the project, along with
+	// all of its assignments.
+	DeleteCustomRole(ctx context.Context, project uuid.UUID, role Role) error
+
 	// DeleteUser removes all authorizations for the given user.
 	DeleteUser(ctx context.Context, user string) error
 
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/authz"
+)
+
+func TestParseRoleName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		role    string
+		custom  bool
+		wantErr bool
+	}{
+		{name: "built-in role", role: "admin"},
+		{name: "custom role", role: "repo_registrar", custom: true},
+		{name: "empty", role: "", wantErr: true},
+		{name: "upper case", role: "Registrar", wantErr: true},
+		{name: "trailing underscore", role: "registrar_", wantErr: true},
+		{name: "separator", role: "project/registrar", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := authz.ParseRoleName(tt.role)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.custom, r.IsCustom())
+		})
+	}
+}
+
+func TestCustomRoleValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		role    authz.CustomRole
+		wantErr string
+	}{
+		{
+			name: "valid",
+			role: authz.CustomRole{Name: "repo_registrar", Permissions: []string{"repo_get", "repo_create"}},
+		},
+		{
+			name:    "built-in name",
+			role:    authz.CustomRole{Name: authz.RoleEditor, Permissions: []string{"repo_get"}},
+			wantErr: "built-in role",
+		},
+		{
+			name:    "no permissions",
+			role:    authz.CustomRole{Name: "empty"},
+			wantErr: "at least one permission",
+		},
+		{
+			name:    "role as permission",
+			role:    authz.CustomRole{Name: "sneaky", Permissions: []string{"admin"}},
+			wantErr: "invalid permission admin",
+		},
+		{
+			name:    "unknown permission",
+			role:    authz.CustomRole{Name: "unknown", Permissions: []string{"repo_get", "repo_destroy"}},
+			wantErr: "invalid permission repo_destroy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.role.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAllPermissions(t *testing.T) {
+	t.Parallel()
+
+	perms := authz.AllPermissions()
+	assert.Contains(t, perms, "repo_get")
+	assert.Contains(t, perms, "role_definition_create")
+	assert.NotContains(t, perms, "parent")
+	for r := range authz.AllRolesDescriptions {
+		assert.NotContains(t, perms, r.String())
+	}
+}
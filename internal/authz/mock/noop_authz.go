@@ -42,6 +42,21 @@ func (*NoopClient) Delete(_ context.Context, _ string, _ authz.Role, _ uuid.UUID
 	return nil
 }
 
+// CreateCustomRole implements authz.Client
+func (*NoopClient) CreateCustomRole(_ context.Context, _ uuid.UUID, _ *authz.CustomRole) error {
+	return nil
+}
+
+// ListCustomRoles implements authz.Client
+func (*NoopClient) ListCustomRoles(_ context.Context, _ uuid.UUID) ([]*authz.CustomRole, error) {
+	return nil, nil
+}
+
+// DeleteCustomRole implements authz.Client
+func (*NoopClient) DeleteCustomRole(_ context.Context, _ uuid.UUID, _ authz.Role) error {
+	return nil
+}
+
 // DeleteUser implements authz.Client
 func (*NoopClient) DeleteUser(_ context.Context, _ string) error {
 	return nil
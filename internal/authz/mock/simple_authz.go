@@ -20,6 +20,7 @@ import (
 type SimpleClient struct {
 	Allowed     []uuid.UUID
 	Assignments map[uuid.UUID][]*minderv1.RoleAssignment
+	CustomRoles map[uuid.UUID][]*authz.CustomRole
 
 	// Adoptions is a map of child project to parent project
 	Adoptions map[uuid.UUID]uuid.UUID
@@ -65,6 +66,50 @@ func (n *SimpleClient) Delete(_ context.Context, id string, role authz.Role, pro
 	return nil
 }
 
+// CreateCustomRole implements authz.Client
+func (n *SimpleClient) CreateCustomRole(_ context.Context, project uuid.UUID, role *authz.CustomRole) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+	if slices.ContainsFunc(n.CustomRoles[project], func(r *authz.CustomRole) bool { return r.Name == role.Name }) {
+		return authz.ErrCustomRoleExists
+	}
+	if n.CustomRoles == nil {
+		n.CustomRoles = make(map[uuid.UUID][]*authz.CustomRole)
+	}
+	n.CustomRoles[project] = append(n.CustomRoles[project], &authz.CustomRole{
+		Name:        role.Name,
+		Permissions: slices.Clone(role.Permissions),
+	})
+	return nil
+}
+
+// ListCustomRoles implements authz.Client
+func (n *SimpleClient) ListCustomRoles(_ context.Context, project uuid.UUID) ([]*authz.CustomRole, error) {
+	roles := make([]*authz.CustomRole, 0, len(n.CustomRoles[project]))
+	for _, r := range n.CustomRoles[project] {
+		roles = append(roles, &authz.CustomRole{
+			Name:        r.Name,
+			Permissions: slices.Clone(r.Permissions),
+		})
+	}
+	return roles, nil
+}
+
+// DeleteCustomRole implements authz.Client
+func (n *SimpleClient) DeleteCustomRole(_ context.Context, project uuid.UUID, role authz.Role) error {
+	if !slices.ContainsFunc(n.CustomRoles[project], func(r *authz.CustomRole) bool { return r.Name == role }) {
+		return authz.ErrCustomRoleNotFound
+	}
+	n.CustomRoles[project] = slices.DeleteFunc(n.CustomRoles[project], func(r *authz.CustomRole) bool {
+		return r.Name == role
+	})
+	n.Assignments[project] = slices.DeleteFunc(n.Assignments[project], func(a *minderv1.RoleAssignment) bool {
+		return a.Role == role.String()
+	})
+	return nil
+}
+
 // DeleteUser implements authz.Client
 func (n *SimpleClient) DeleteUser(_ context.Context, user string) error {
 	for p, as := range n.Assignments {
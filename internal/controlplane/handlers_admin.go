@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/events/dlq"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// defaultDeadLetterListLimit is the number of dead-lettered messages
+// returned when the request does not set a limit
+const defaultDeadLetterListLimit = 100
+
+// ListDeadLetterMessages lists the messages in the dead letter queue
+func (s *Server) ListDeadLetterMessages(
+	ctx context.Context,
+	in *pb.ListDeadLetterMessagesRequest,
+) (*pb.ListDeadLetterMessagesResponse, error) {
+	if err := s.checkDeadLetterQueueAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := int(in.GetLimit())
+	if limit == 0 {
+		limit = defaultDeadLetterListLimit
+	}
+
+	msgs, err := s.deadLetterQueue.List(ctx, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing dead letter queue: %v", err)
+	}
+
+	out := make([]*pb.DeadLetterMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		out = append(out, &pb.DeadLetterMessage{
+			Id:           msg.ID,
+			Uuid:         msg.UUID,
+			Topic:        msg.Topic,
+			Handler:      msg.Handler,
+			Error:        msg.Error,
+			FailureCount: int32(msg.FailureCount), //nolint:gosec // failure counts are small
+			Metadata:     msg.Metadata,
+			Payload:      msg.Payload,
+			CreatedAt:    timestamppb.New(msg.CreatedAt),
+		})
+	}
+
+	return &pb.ListDeadLetterMessagesResponse{Messages: out}, nil
+}
+
+// ReplayDeadLetterMessages publishes messages from the dead letter queue back
+// onto their original topic
+func (s *Server) ReplayDeadLetterMessages(
+	ctx context.Context,
+	in *pb.ReplayDeadLetterMessagesRequest,
+) (*pb.ReplayDeadLetterMessagesResponse, error) {
+	if err := s.checkDeadLetterQueueAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.deadLetterQueue.Replay(ctx, in.GetIds()); err != nil {
+		return nil, deadLetterQueueError(err, "error replaying messages")
+	}
+
+	zerolog.Ctx(ctx).Info().Strs("ids", in.GetIds()).Msg("replayed dead letter queue messages")
+	return &pb.ReplayDeadLetterMessagesResponse{}, nil
+}
+
+// DiscardDeadLetterMessages removes messages from the dead letter queue
+func (s *Server) DiscardDeadLetterMessages(
+	ctx context.Context,
+	in *pb.DiscardDeadLetterMessagesRequest,
+) (*pb.DiscardDeadLetterMessagesResponse, error) {
+	if err := s.checkDeadLetterQueueAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.deadLetterQueue.Discard(ctx, in.GetIds()); err != nil {
+		return nil, deadLetterQueueError(err, "error discarding messages")
+	}
+
+	zerolog.Ctx(ctx).Info().Strs("ids", in.GetIds()).Msg("discarded dead letter queue messages")
+	return &pb.DiscardDeadLetterMessagesResponse{}, nil
+}
+
+// checkDeadLetterQueueAccess checks that the caller is a server admin, and
+// that the dead letter queue is available with the configured event driver
+func (s *Server) checkDeadLetterQueueAccess(ctx context.Context) error {
+	if err := s.checkServerAdmin(ctx); err != nil {
+		return err
+	}
+	if s.deadLetterQueue == nil {
+		return util.UserVisibleError(codes.FailedPrecondition, "%s", dlq.ErrUnsupportedDriver)
+	}
+	return nil
+}
+
+// checkServerAdmin checks that the caller is one of the configured server admins
+func (s *Server) checkServerAdmin(ctx context.Context) error {
+	id := auth.IdentityFromContext(ctx)
+	if id != nil && slices.Contains(s.cfg.Auth.ServerAdmins, id.String()) {
+		return nil
+	}
+	return util.UserVisibleError(codes.PermissionDenied, "user %q is not a server admin", id.Human())
+}
+
+func deadLetterQueueError(err error, msg string) error {
+	if errors.Is(err, dlq.ErrMessageNotFound) {
+		return util.UserVisibleError(codes.NotFound, "%s: %s", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/events/dlq"
+	mockdlq "github.com/mindersec/minder/internal/events/dlq/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestListDeadLetterMessages(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		userID       string
+		noQueue      bool
+		limit        int32
+		setup        func(q *mockdlq.MockQueue)
+		expectedCode codes.Code
+	}{
+		{
+			name:   "lists messages with default limit",
+			userID: "admin",
+			setup: func(q *mockdlq.MockQueue) {
+				q.EXPECT().List(gomock.Any(), defaultDeadLetterListLimit).Return([]*dlq.Message{{
+					ID:           "7",
+					UUID:         "uuid",
+					Topic:        "internal.entity.evaluate.event",
+					Handler:      "handler",
+					Error:        "boom",
+					FailureCount: 2,
+					Metadata:     map[string]string{"k": "v"},
+					Payload:      []byte(`{}`),
+					CreatedAt:    createdAt,
+				}}, nil)
+			},
+		},
+		{
+			name:   "passes the limit",
+			userID: "admin",
+			limit:  5,
+			setup: func(q *mockdlq.MockQueue) {
+				q.EXPECT().List(gomock.Any(), 5).Return(nil, nil)
+			},
+		},
+		{
+			name:         "not an admin",
+			userID:       "someone",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "unsupported driver",
+			userID:       "admin",
+			noQueue:      true,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:   "queue error",
+			userID: "admin",
+			setup: func(q *mockdlq.MockQueue) {
+				q.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			s, q := newAdminTestServer(ctrl, tt.noQueue)
+			if tt.setup != nil {
+				tt.setup(q)
+			}
+
+			ctx := auth.WithIdentityContext(context.Background(), &auth.Identity{UserID: tt.userID})
+			resp, err := s.ListDeadLetterMessages(ctx, &pb.ListDeadLetterMessagesRequest{Limit: tt.limit})
+			if tt.expectedCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.limit != 0 {
+				require.Empty(t, resp.GetMessages())
+				return
+			}
+			require.Len(t, resp.GetMessages(), 1)
+			msg := resp.GetMessages()[0]
+			require.Equal(t, "7", msg.GetId())
+			require.Equal(t, "uuid", msg.GetUuid())
+			require.Equal(t, "internal.entity.evaluate.event", msg.GetTopic())
+			require.Equal(t, "handler", msg.GetHandler())
+			require.Equal(t, "boom", msg.GetError())
+			require.Equal(t, int32(2), msg.GetFailureCount())
+			require.Equal(t, map[string]string{"k": "v"}, msg.GetMetadata())
+			require.Equal(t, []byte(`{}`), msg.GetPayload())
+			require.Equal(t, createdAt, msg.GetCreatedAt().AsTime())
+		})
+	}
+}
+
+func TestReplayAndDiscardDeadLetterMessages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		userID       string
+		queueErr     error
+		expectCall   bool
+		expectedCode codes.Code
+	}{
+		{
+			name:       "success",
+			userID:     "admin",
+			expectCall: true,
+		},
+		{
+			name:         "unknown message",
+			userID:       "admin",
+			queueErr:     fmt.Errorf("error getting message 3: %w", dlq.ErrMessageNotFound),
+			expectCall:   true,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "queue error",
+			userID:       "admin",
+			queueErr:     errors.New("unavailable"),
+			expectCall:   true,
+			expectedCode: codes.Internal,
+		},
+		{
+			name:         "not an admin",
+			userID:       "someone",
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			s, q := newAdminTestServer(ctrl, false)
+			ids := []string{"3", "4"}
+			if tt.expectCall {
+				q.EXPECT().Replay(gomock.Any(), ids).Return(tt.queueErr)
+				q.EXPECT().Discard(gomock.Any(), ids).Return(tt.queueErr)
+			}
+
+			ctx := auth.WithIdentityContext(context.Background(), &auth.Identity{UserID: tt.userID})
+			_, replayErr := s.ReplayDeadLetterMessages(ctx, &pb.ReplayDeadLetterMessagesRequest{Ids: ids})
+			_, discardErr := s.DiscardDeadLetterMessages(ctx, &pb.DiscardDeadLetterMessagesRequest{Ids: ids})
+
+			for _, err := range []error{replayErr, discardErr} {
+				if tt.expectedCode == codes.OK {
+					require.NoError(t, err)
+				} else {
+					require.Equal(t, tt.expectedCode, status.Code(err))
+				}
+			}
+		})
+	}
+}
+
+func newAdminTestServer(ctrl *gomock.Controller, noQueue bool) (*Server, *mockdlq.MockQueue) {
+	q := mockdlq.NewMockQueue(ctrl)
+	s := &Server{
+		cfg: &serverconfig.Config{
+			Auth: serverconfig.AuthConfig{ServerAdmins: []string{"admin"}},
+		},
+	}
+	if !noQueue {
+		s.deadLetterQueue = q
+	}
+	return s, q
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/webhook"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// SetAlertWebhook creates or replaces a webhook which alerts of the project
+// are posted to. The URL and secret are stored encrypted.
+func (s *Server) SetAlertWebhook(
+	ctx context.Context,
+	req *minderv1.SetAlertWebhookRequest,
+) (*minderv1.SetAlertWebhookResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	whURL, err := url.Parse(req.GetUrl())
+	if err != nil || whURL.Scheme != "https" || whURL.Host == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "webhook URL must be an https URL")
+	}
+
+	encrypted, err := webhook.EncryptDestination(s.cryptoEngine, &webhook.Destination{
+		URL:    req.GetUrl(),
+		Secret: req.GetSecret(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error encrypting webhook: %v", err)
+	}
+
+	wh, err := s.store.UpsertAlertWebhook(ctx, db.UpsertAlertWebhookParams{
+		ProjectID:            projectID,
+		Name:                 req.GetName(),
+		EncryptedDestination: encrypted,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error storing webhook: %v", err)
+	}
+
+	return &minderv1.SetAlertWebhookResponse{
+		Webhook: alertWebhookToProto(wh),
+	}, nil
+}
+
+// ListAlertWebhooks lists the alert webhooks of the project
+func (s *Server) ListAlertWebhooks(
+	ctx context.Context,
+	_ *minderv1.ListAlertWebhooksRequest,
+) (*minderv1.ListAlertWebhooksResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	whs, err := s.store.ListAlertWebhooksByProject(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing webhooks: %v", err)
+	}
+
+	out := make([]*minderv1.AlertWebhook, 0, len(whs))
+	for _, wh := range whs {
+		out = append(out, alertWebhookToProto(wh))
+	}
+
+	return &minderv1.ListAlertWebhooksResponse{
+		Webhooks: out,
+	}, nil
+}
+
+// DeleteAlertWebhook deletes an alert webhook of the project
+func (s *Server) DeleteAlertWebhook(
+	ctx context.Context,
+	req *minderv1.DeleteAlertWebhookRequest,
+) (*minderv1.DeleteAlertWebhookResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	_, err := s.store.DeleteAlertWebhook(ctx, db.DeleteAlertWebhookParams{
+		ProjectID: projectID,
+		Name:      req.GetName(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "webhook %s not found", req.GetName())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error deleting webhook: %v", err)
+	}
+
+	return &minderv1.DeleteAlertWebhookResponse{}, nil
+}
+
+func alertWebhookToProto(wh db.AlertWebhook) *minderv1.AlertWebhook {
+	return &minderv1.AlertWebhook{
+		Name:      wh.Name,
+		CreatedAt: timestamppb.New(wh.CreatedAt),
+		UpdatedAt: timestamppb.New(wh.UpdatedAt),
+	}
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/webhook"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minder "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestSetAlertWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		req       *minder.SetAlertWebhookRequest
+		wantCode  codes.Code
+		wantStore bool
+	}{
+		{
+			name: "stores encrypted destination",
+			req: &minder.SetAlertWebhookRequest{
+				Name:   "default",
+				Url:    "https://hooks.example.com/alerts",
+				Secret: "s3cr3t",
+			},
+			wantStore: true,
+		},
+		{
+			name: "rejects plain http URL",
+			req: &minder.SetAlertWebhookRequest{
+				Name: "default",
+				Url:  "http://hooks.example.com/alerts",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "rejects non-http URL",
+			req: &minder.SetAlertWebhookRequest{
+				Name: "default",
+				Url:  "file:///etc/passwd",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			eng, err := crypto.NewEngineFromConfig(&serverconfig.Config{
+				Auth: serverconfig.AuthConfig{TokenKey: generateTokenKey(t)},
+			})
+			require.NoError(t, err)
+
+			projectID := uuid.New()
+			mockStore := mockdb.NewMockStore(ctrl)
+			if tt.wantStore {
+				mockStore.EXPECT().UpsertAlertWebhook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpsertAlertWebhookParams) (db.AlertWebhook, error) {
+						assert.Equal(t, projectID, arg.ProjectID)
+						assert.Equal(t, tt.req.GetName(), arg.Name)
+						assert.NotContains(t, string(arg.EncryptedDestination), tt.req.GetUrl())
+
+						dest, err := webhook.DecryptDestination(eng, arg.EncryptedDestination)
+						require.NoError(t, err)
+						assert.Equal(t, &webhook.Destination{URL: tt.req.GetUrl(), Secret: tt.req.GetSecret()}, dest)
+
+						return db.AlertWebhook{ProjectID: arg.ProjectID, Name: arg.Name}, nil
+					})
+			}
+
+			srv := &Server{store: mockStore, cryptoEngine: eng}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := srv.SetAlertWebhook(ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.req.GetName(), resp.GetWebhook().GetName())
+		})
+	}
+}
+
+func TestListAlertWebhooks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.New()
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().ListAlertWebhooksByProject(gomock.Any(), projectID).Return([]db.AlertWebhook{
+		{ProjectID: projectID, Name: "default"},
+		{ProjectID: projectID, Name: "slack"},
+	}, nil)
+
+	srv := &Server{store: mockStore}
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	})
+
+	resp, err := srv.ListAlertWebhooks(ctx, &minder.ListAlertWebhooksRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetWebhooks(), 2)
+	assert.Equal(t, "default", resp.GetWebhooks()[0].GetName())
+	assert.Equal(t, "slack", resp.GetWebhooks()[1].GetName())
+}
+
+func TestDeleteAlertWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		storeErr error
+		wantCode codes.Code
+	}{
+		{
+			name: "deletes webhook",
+		},
+		{
+			name:     "webhook not found",
+			storeErr: sql.ErrNoRows,
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectID := uuid.New()
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().DeleteAlertWebhook(gomock.Any(), db.DeleteAlertWebhookParams{
+				ProjectID: projectID,
+				Name:      "default",
+			}).Return(db.AlertWebhook{}, tt.storeErr)
+
+			srv := &Server{store: mockStore}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			_, err := srv.DeleteAlertWebhook(ctx, &minder.DeleteAlertWebhookRequest{Name: "default"})
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
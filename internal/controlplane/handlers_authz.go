@@ -7,6 +7,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -78,29 +81,38 @@ func ProjectAuthorizationInterceptor(ctx context.Context, req interface{}, info
 		return handler(ctx, req)
 	}
 
-	relation := opts.GetRelation()
+	entityCtx := engcontext.EntityFromContext(ctx)
+	server := info.Server.(*Server)
+
+	if err := server.authorizeRelation(ctx, opts.GetRelation(), entityCtx.Project.ID); err != nil {
+		return nil, err
+	}
 
+	return handler(ctx, req)
+}
+
+// authorizeRelation checks that the user has the relation on the project.
+// It is used by the authorization interceptor, and by handlers which need
+// more than the relation of the RPC for some requests.
+func (s *Server) authorizeRelation(ctx context.Context, relation minder.Relation, projectID uuid.UUID) error {
 	relationValue := relation.Descriptor().Values().ByNumber(relation.Number())
 	if relationValue == nil {
-		return nil, status.Errorf(codes.Internal, "error reading relation value %v", relation)
+		return status.Errorf(codes.Internal, "error reading relation value %v", relation)
 	}
 	extension := proto.GetExtension(relationValue.Options(), minder.E_Name)
 	relationName, ok := extension.(string)
 	if !ok {
-		return nil, status.Errorf(codes.Internal, "error getting name for requested relation %v", relation)
+		return status.Errorf(codes.Internal, "error getting name for requested relation %v", relation)
 	}
 
-	entityCtx := engcontext.EntityFromContext(ctx)
-	server := info.Server.(*Server)
-
-	if err := server.authzClient.Check(ctx, relationName, entityCtx.Project.ID); err != nil {
+	if err := s.authzClient.Check(ctx, relationName, projectID); err != nil {
 		zerolog.Ctx(ctx).Error().Err(err).Msg("authorization check failed")
-		return nil, util.UserVisibleError(
+		return util.UserVisibleError(
 			codes.PermissionDenied, "user %q is not authorized to perform this operation on project %q",
-			auth.IdentityFromContext(ctx).Human(), entityCtx.Project.ID)
+			auth.IdentityFromContext(ctx).Human(), projectID)
 	}
 
-	return handler(ctx, req)
+	return nil
 }
 
 // populateEntityContext populates the project in the entity context, by looking at the proto context or
@@ -203,8 +215,9 @@ func getDefaultProjectID(
 // ensure interface implementation
 var _ minder.PermissionsServiceServer = (*Server)(nil)
 
-// ListRoles returns the list of available roles for the minder instance
-func (*Server) ListRoles(_ context.Context, _ *minder.ListRolesRequest) (*minder.ListRolesResponse, error) {
+// ListRoles returns the list of available roles for the minder instance, followed by
+// the custom roles defined in the project
+func (s *Server) ListRoles(ctx context.Context, _ *minder.ListRolesRequest) (*minder.ListRolesResponse, error) {
 	resp := minder.ListRolesResponse{
 		Roles: make([]*minder.Role, 0, len(authz.AllRolesDescriptions)),
 	}
@@ -222,6 +235,19 @@ func (*Server) ListRoles(_ context.Context, _ *minder.ListRolesRequest) (*minder
 			Description: authz.AllRolesDescriptions[role],
 		})
 	}
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	customRoles, err := s.authzClient.ListCustomRoles(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing custom roles: %v", err)
+	}
+	for _, role := range customRoles {
+		resp.Roles = append(resp.Roles, &minder.Role{
+			Name:        role.Name.String(),
+			DisplayName: role.Name.String(),
+			Description: customRoleDescription(role),
+		})
+	}
 	return &resp, nil
 }
 
@@ -309,9 +335,9 @@ func (s *Server) AssignRole(ctx context.Context, req *minder.AssignRoleRequest)
 	}
 
 	// Parse role (this also validates)
-	authzRole, err := authz.ParseRole(role)
+	authzRole, err := s.parseProjectRole(ctx, targetProject, role)
 	if err != nil {
-		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err.Error())
+		return nil, err
 	}
 
 	// Ensure the target project exists
@@ -326,6 +352,9 @@ func (s *Server) AssignRole(ctx context.Context, req *minder.AssignRoleRequest)
 
 	// Decide if it's an invitation or a role assignment
 	if sub == "" && inviteeEmail != "" {
+		if authzRole.IsCustom() {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "custom roles cannot be used in invitations")
+		}
 		if flags.Bool(ctx, s.featureFlags, flags.UserManagement) {
 			invitation, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minder.Invitation, error) {
 				return s.invites.CreateInvite(ctx, qtx, s.evt, s.cfg.Email, targetProject, authzRole, inviteeEmail)
@@ -377,9 +406,9 @@ func (s *Server) RemoveRole(ctx context.Context, req *minder.RemoveRoleRequest)
 	targetProject := entityCtx.Project.ID
 
 	// Parse role (this also validates)
-	authzRole, err := authz.ParseRole(role)
+	authzRole, err := s.parseProjectRole(ctx, targetProject, role)
 	if err != nil {
-		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err.Error())
+		return nil, err
 	}
 
 	// Validate the subject and email - decide if it's about removing an invitation or a role assignment
@@ -433,13 +462,16 @@ func (s *Server) UpdateRole(ctx context.Context, req *minder.UpdateRoleRequest)
 	}
 
 	// Parse role (this also validates)
-	authzRole, err := authz.ParseRole(role)
+	authzRole, err := s.parseProjectRole(ctx, targetProject, role)
 	if err != nil {
-		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err.Error())
+		return nil, err
 	}
 
 	// Validate the subject and email - decide if it's about updating an invitation or a role assignment
 	if sub == "" && inviteeEmail != "" {
+		if authzRole.IsCustom() {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "custom roles cannot be used in invitations")
+		}
 		if flags.Bool(ctx, s.featureFlags, flags.UserManagement) {
 			updatedInvitation, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minder.Invitation, error) {
 				return s.invites.UpdateInvite(ctx, qtx, s.evt, s.cfg.Email, targetProject, authzRole, inviteeEmail)
@@ -473,6 +505,124 @@ func (s *Server) UpdateRole(ctx context.Context, req *minder.UpdateRoleRequest)
 	return nil, util.UserVisibleError(codes.InvalidArgument, "one of subject or email must be specified")
 }
 
+// CreateRoleDefinition defines a custom role in the project, granting a set of
+// the project permissions to the users the role is assigned to.
+// Note that this assumes that the request has already been authorized.
+func (s *Server) CreateRoleDefinition(
+	ctx context.Context,
+	req *minder.CreateRoleDefinitionRequest,
+) (*minder.CreateRoleDefinitionResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	targetProject := entityCtx.Project.ID
+
+	role := &authz.CustomRole{
+		Name:        authz.Role(req.GetRole().GetName()),
+		Permissions: req.GetRole().GetPermissions(),
+	}
+	if err := role.Validate(); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	if err := s.authzClient.CreateCustomRole(ctx, targetProject, role); err != nil {
+		if errors.Is(err, authz.ErrCustomRoleExists) {
+			return nil, util.UserVisibleError(codes.AlreadyExists, "role %s already exists", role.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "error creating custom role: %v", err)
+	}
+
+	return &minder.CreateRoleDefinitionResponse{
+		Role: roleDefinitionFromCustomRole(role),
+	}, nil
+}
+
+// ListRoleDefinitions returns the custom roles defined in the project
+func (s *Server) ListRoleDefinitions(
+	ctx context.Context,
+	_ *minder.ListRoleDefinitionsRequest,
+) (*minder.ListRoleDefinitionsResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	targetProject := entityCtx.Project.ID
+
+	customRoles, err := s.authzClient.ListCustomRoles(ctx, targetProject)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing custom roles: %v", err)
+	}
+
+	resp := &minder.ListRoleDefinitionsResponse{
+		Roles: make([]*minder.RoleDefinition, 0, len(customRoles)),
+	}
+	for _, role := range customRoles {
+		resp.Roles = append(resp.Roles, roleDefinitionFromCustomRole(role))
+	}
+	return resp, nil
+}
+
+// DeleteRoleDefinition removes a custom role from the project, revoking it from
+// all the users it was assigned to.
+// Note that this assumes that the request has already been authorized.
+func (s *Server) DeleteRoleDefinition(
+	ctx context.Context,
+	req *minder.DeleteRoleDefinitionRequest,
+) (*minder.DeleteRoleDefinitionResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	targetProject := entityCtx.Project.ID
+
+	customRoles, err := s.authzClient.ListCustomRoles(ctx, targetProject)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing custom roles: %v", err)
+	}
+	idx := slices.IndexFunc(customRoles, func(r *authz.CustomRole) bool {
+		return r.Name.String() == req.GetName()
+	})
+	if idx == -1 {
+		return nil, util.UserVisibleError(codes.NotFound, "role %s not found", req.GetName())
+	}
+	role := customRoles[idx]
+
+	if err := s.authzClient.DeleteCustomRole(ctx, targetProject, role.Name); err != nil {
+		if errors.Is(err, authz.ErrCustomRoleNotFound) {
+			return nil, util.UserVisibleError(codes.NotFound, "role %s not found", role.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "error deleting custom role: %v", err)
+	}
+
+	return &minder.DeleteRoleDefinitionResponse{
+		Role: roleDefinitionFromCustomRole(role),
+	}, nil
+}
+
+// parseProjectRole parses a role which is either one of the built-in roles or
+// a custom role defined in the project
+func (s *Server) parseProjectRole(ctx context.Context, project uuid.UUID, role string) (authz.Role, error) {
+	authzRole, err := authz.ParseRole(role)
+	if err == nil {
+		return authzRole, nil
+	}
+
+	customRoles, lerr := s.authzClient.ListCustomRoles(ctx, project)
+	if lerr != nil {
+		return "", status.Errorf(codes.Internal, "error listing custom roles: %v", lerr)
+	}
+	for _, r := range customRoles {
+		if r.Name.String() == role {
+			return r.Name, nil
+		}
+	}
+
+	return "", util.UserVisibleError(codes.InvalidArgument, "%s", err.Error())
+}
+
+func roleDefinitionFromCustomRole(role *authz.CustomRole) *minder.RoleDefinition {
+	return &minder.RoleDefinition{
+		Name:        role.Name.String(),
+		Permissions: role.Permissions,
+	}
+}
+
+func customRoleDescription(role *authz.CustomRole) string {
+	return fmt.Sprintf("Custom role granting %s.", strings.Join(role.Permissions, ", "))
+}
+
 // isUserSelfUpdating is used to prevent if the user is trying to update their own role
 func isUserSelfUpdating(ctx context.Context, subject, inviteeEmail string) error {
 	if subject != "" {
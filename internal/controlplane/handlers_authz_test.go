@@ -942,6 +942,100 @@ func InvitationsToJson(t *testing.T, invites []*minder.Invitation) []string {
 	return json
 }
 
+func TestRoleDefinitions(t *testing.T) {
+	t.Parallel()
+
+	project := uuid.New()
+	user1 := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().BeginTransaction().AnyTimes()
+	mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).AnyTimes()
+	mockStore.EXPECT().Rollback(gomock.Any()).AnyTimes()
+	mockStore.EXPECT().Commit(gomock.Any()).AnyTimes()
+	mockStore.EXPECT().GetProjectByID(gomock.Any(), project).Return(db.Project{ID: project}, nil).AnyTimes()
+	mockStore.EXPECT().GetUserBySubject(gomock.Any(), user1.String()).Return(db.User{ID: 1}, nil).AnyTimes()
+
+	authzClient := &mock.SimpleClient{}
+	server := Server{
+		store:        mockStore,
+		authzClient:  authzClient,
+		featureFlags: &flags.FakeClient{},
+		idClient: &SimpleResolver{
+			data: []auth.Identity{{
+				UserID:    user1.String(),
+				HumanName: "user1",
+				Provider:  &keycloak.KeyCloak{},
+			}},
+		},
+		roles: roles.NewRoleService(),
+	}
+
+	ctx := auth.WithIdentityContext(context.Background(), &auth.Identity{UserID: "testuser"})
+	ctx = engcontext.WithEntityContext(ctx, &engcontext.EntityContext{
+		Project: engcontext.Project{
+			ID: project,
+		},
+	})
+
+	registrar := &minder.RoleDefinition{
+		Name:        "repo_registrar",
+		Permissions: []string{"repo_get", "repo_create"},
+	}
+
+	created, err := server.CreateRoleDefinition(ctx, &minder.CreateRoleDefinitionRequest{Role: registrar})
+	require.NoError(t, err)
+	assert.Equal(t, registrar.Name, created.GetRole().GetName())
+
+	_, err = server.CreateRoleDefinition(ctx, &minder.CreateRoleDefinitionRequest{Role: registrar})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = server.CreateRoleDefinition(ctx, &minder.CreateRoleDefinitionRequest{
+		Role: &minder.RoleDefinition{Name: "viewer", Permissions: []string{"repo_get"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.CreateRoleDefinition(ctx, &minder.CreateRoleDefinitionRequest{
+		Role: &minder.RoleDefinition{Name: "superuser", Permissions: []string{"admin"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	defs, err := server.ListRoleDefinitions(ctx, &minder.ListRoleDefinitionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, defs.GetRoles(), 1)
+	assert.ElementsMatch(t, registrar.Permissions, defs.GetRoles()[0].GetPermissions())
+
+	roleList, err := server.ListRoles(ctx, &minder.ListRolesRequest{})
+	require.NoError(t, err)
+	require.Len(t, roleList.GetRoles(), len(authz.AllRolesSorted)+1)
+	assert.Equal(t, registrar.Name, roleList.GetRoles()[len(authz.AllRolesSorted)].GetName())
+
+	// custom roles are assigned like built-in ones
+	_, err = server.AssignRole(ctx, &minder.AssignRoleRequest{
+		RoleAssignment: &minder.RoleAssignment{Role: registrar.Name, Subject: user1.String()},
+	})
+	require.NoError(t, err)
+	require.Len(t, authzClient.Assignments[project], 1)
+	assert.Equal(t, registrar.Name, authzClient.Assignments[project][0].Role)
+
+	// but must be defined in the project
+	_, err = server.AssignRole(ctx, &minder.AssignRoleRequest{
+		RoleAssignment: &minder.RoleAssignment{Role: "profile_reader", Subject: user1.String()},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	deleted, err := server.DeleteRoleDefinition(ctx, &minder.DeleteRoleDefinitionRequest{Name: registrar.Name})
+	require.NoError(t, err)
+	assert.Equal(t, registrar.Name, deleted.GetRole().GetName())
+	assert.Empty(t, authzClient.Assignments[project], "expected assignments of the role to be removed")
+
+	_, err = server.DeleteRoleDefinition(ctx, &minder.DeleteRoleDefinitionRequest{Name: registrar.Name})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
 type SimpleResolver struct {
 	data []auth.Identity
 }
@@ -7,22 +7,29 @@ import (
 	"context"
 	"database/sql"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/mindersec/minder/internal/constants"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	entmodels "github.com/mindersec/minder/internal/entities/models"
 	propSvc "github.com/mindersec/minder/internal/entities/properties/service"
 	"github.com/mindersec/minder/internal/history"
 	ghprop "github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/sarif"
 	"github.com/mindersec/minder/internal/util"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/entities/properties"
@@ -205,6 +212,130 @@ func (s *Server) ListEvaluationHistory(
 	return resp, nil
 }
 
+// GetEvaluationResultsSarif returns the current failures of a project
+// as a SARIF log.
+func (s *Server) GetEvaluationResultsSarif(
+	ctx context.Context,
+	in *minderv1.GetEvaluationResultsSarifRequest,
+) (*minderv1.GetEvaluationResultsSarifResponse, error) {
+	projectID := GetProjectID(ctx)
+
+	profileList, err := buildProjectsProfileList(ctx, s.store, []uuid.UUID{projectID}, in.GetLabelFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	builder := sarif.NewBuilder(constants.CLIVersion)
+	found := in.GetProfileName() == ""
+	for _, p := range profileList {
+		if in.GetProfileName() != "" && p.Profile.Name != in.GetProfileName() {
+			continue
+		}
+		found = true
+
+		evals, err := s.store.ListRuleEvaluationsByProfileId(ctx, db.ListRuleEvaluationsByProfileIdParams{
+			ProfileID: p.Profile.ID,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error listing rule evaluations")
+			return nil, status.Error(codes.Internal, "error listing rule evaluations")
+		}
+
+		// only failures are reported, skip the others before
+		// fetching entity properties
+		failures := slices.DeleteFunc(evals, func(e db.ListRuleEvaluationsByProfileIdRow) bool {
+			return e.EvalStatus != db.EvalStatusTypesFailure
+		})
+		builder.AddRuleEvaluationStatuses(
+			p.Profile.Name,
+			s.getRuleEvaluationStatuses(ctx, failures, p.Profile.ID.String()),
+		)
+	}
+	if !found {
+		return nil, util.UserVisibleError(codes.NotFound, "profile %q not found", in.GetProfileName())
+	}
+
+	out, err := json.Marshal(builder.Log())
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error marshalling sarif log")
+		return nil, status.Error(codes.Internal, "error building sarif log")
+	}
+	sarifLog := &structpb.Struct{}
+	if err := protojson.Unmarshal(out, sarifLog); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error converting sarif log")
+		return nil, status.Error(codes.Internal, "error building sarif log")
+	}
+
+	return &minderv1.GetEvaluationResultsSarifResponse{Sarif: sarifLog}, nil
+}
+
+// GetPostureReport aggregates the evaluation history of a project
+// into daily posture snapshots.
+func (s *Server) GetPostureReport(
+	ctx context.Context,
+	in *minderv1.GetPostureReportRequest,
+) (*minderv1.GetPostureReportResponse, error) {
+	opts := []history.FilterOpt{}
+	opts = append(opts, FilterOptsFromStrings(in.GetEntityType(), history.WithEntityType)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetEntityName(), history.WithEntityName)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetProfileName(), history.WithProfileName)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetLabelFilter(), history.WithLabel)...)
+
+	if in.GetFrom() != nil {
+		opts = append(opts, history.WithFrom(in.GetFrom().AsTime()))
+	}
+	if in.GetTo() != nil {
+		opts = append(opts, history.WithTo(in.GetTo().AsTime()))
+	}
+
+	// we always filter by project id
+	opts = append(opts, history.WithProjectID(GetProjectID(ctx)))
+
+	filter, err := history.NewReportFilter(opts...)
+	if err != nil {
+		return nil, util.UserVisibleError(
+			codes.InvalidArgument,
+			"invalid filter: %s",
+			err,
+		)
+	}
+
+	snapshots, err := s.history.ReportEvaluationHistory(ctx, s.store, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error aggregating evaluations")
+		return nil, status.Error(codes.Internal, evalErrMsg)
+	}
+
+	return &minderv1.GetPostureReportResponse{
+		Snapshots: fromPostureSnapshots(snapshots),
+	}, nil
+}
+
+func fromPostureSnapshots(
+	snapshots []*history.PostureSnapshot,
+) []*minderv1.PostureSnapshot {
+	res := make([]*minderv1.PostureSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		res[i] = &minderv1.PostureSnapshot{
+			Day:        timestamppb.New(snapshot.Day),
+			Profile:    snapshot.ProfileName,
+			RuleName:   snapshot.RuleName,
+			RuleType:   snapshot.RuleType,
+			EntityType: dbEntityToEntity(snapshot.EntityType),
+			// counts are bounded by the number of entities
+			Passing:    int32(snapshot.Passing),    //nolint:gosec // see above
+			Failing:    int32(snapshot.Failing),    //nolint:gosec // see above
+			Errored:    int32(snapshot.Errored),    //nolint:gosec // see above
+			Skipped:    int32(snapshot.Skipped),    //nolint:gosec // see above
+			Remediated: int32(snapshot.Remediated), //nolint:gosec // see above
+		}
+		if snapshot.Remediated > 0 {
+			res[i].MeanTimeToRemediation = durationpb.New(snapshot.MeanTimeToRemediation)
+		}
+	}
+	return res
+}
+
 func fromEvaluationHistoryRows(
 	rows []*history.OneEvalHistoryAndEntity,
 ) ([]*minderv1.EvaluationHistory, error) {
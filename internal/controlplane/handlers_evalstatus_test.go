@@ -4,17 +4,29 @@
 package controlplane
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
 	entmodels "github.com/mindersec/minder/internal/entities/models"
+	mockprops "github.com/mindersec/minder/internal/entities/properties/service/mock"
 	"github.com/mindersec/minder/internal/history"
+	mockhistory "github.com/mindersec/minder/internal/history/mock"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
@@ -379,3 +391,249 @@ func nullRemediationStatusTypesSuccess() db.NullRemediationStatusTypes {
 		Valid:                  true,
 	}
 }
+
+func TestGetPostureReport(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	validRequest := &minderv1.GetPostureReportRequest{
+		ProfileName: []string{"profile"},
+		From:        timestamppb.New(day),
+		To:          timestamppb.New(day.Add(24 * time.Hour)),
+	}
+
+	tests := []struct {
+		name     string
+		req      *minderv1.GetPostureReportRequest
+		result   []*history.PostureSnapshot
+		err      error
+		wantCode codes.Code
+		expected []*minderv1.PostureSnapshot
+	}{
+		{
+			name: "snapshots are converted",
+			req:  validRequest,
+			result: []*history.PostureSnapshot{
+				{
+					Day:                   day,
+					ProfileName:           "profile",
+					RuleName:              "rule",
+					RuleType:              "rule_type",
+					EntityType:            db.EntitiesRepository,
+					Passing:               3,
+					Failing:               1,
+					Remediated:            2,
+					MeanTimeToRemediation: time.Hour,
+				},
+				{
+					Day:         day,
+					ProfileName: "profile",
+					RuleName:    "other",
+					RuleType:    "rule_type",
+					EntityType:  db.EntitiesArtifact,
+					Errored:     1,
+					Skipped:     2,
+				},
+			},
+			expected: []*minderv1.PostureSnapshot{
+				{
+					Day:                   timestamppb.New(day),
+					Profile:               "profile",
+					RuleName:              "rule",
+					RuleType:              "rule_type",
+					EntityType:            minderv1.Entity_ENTITY_REPOSITORIES,
+					Passing:               3,
+					Failing:               1,
+					Remediated:            2,
+					MeanTimeToRemediation: durationpb.New(time.Hour),
+				},
+				{
+					Day:        timestamppb.New(day),
+					Profile:    "profile",
+					RuleName:   "other",
+					RuleType:   "rule_type",
+					EntityType: minderv1.Entity_ENTITY_ARTIFACTS,
+					Errored:    1,
+					Skipped:    2,
+				},
+			},
+		},
+		{
+			name: "missing time range",
+			req: &minderv1.GetPostureReportRequest{
+				From: timestamppb.New(day),
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "history error",
+			req:      validRequest,
+			err:      errors.New("boom"),
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectID := uuid.New()
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockHistory := mockhistory.NewMockEvaluationHistoryService(ctrl)
+			if tt.result != nil || tt.err != nil {
+				mockHistory.EXPECT().
+					ReportEvaluationHistory(gomock.Any(), mockStore, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ db.ExtendQuerier, filter history.ReportFilter) ([]*history.PostureSnapshot, error) {
+						require.Equal(t, projectID, filter.GetProjectID())
+						require.Equal(t, []string{"profile"}, filter.IncludedProfileNames())
+						return tt.result, tt.err
+					})
+			}
+
+			srv := &Server{store: mockStore, history: mockHistory}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := srv.GetPostureReport(ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.GetSnapshots(), len(tt.expected))
+			for i, expected := range tt.expected {
+				require.True(t, proto.Equal(expected, resp.GetSnapshots()[i]))
+			}
+		})
+	}
+}
+
+func TestGetEvaluationResultsSarif(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	profileID := uuid.New()
+	entityID := uuid.New()
+	ruleTypeID := uuid.New()
+
+	profiles := []db.ListProfilesByProjectIDAndLabelRow{
+		{Profile: db.Profile{ID: profileID, Name: "profile"}},
+	}
+	evals := []db.ListRuleEvaluationsByProfileIdRow{
+		{
+			EvalStatus:            db.EvalStatusTypesFailure,
+			EvalDetails:           `[{"msg": "first"}, {"msg": "second"}]`,
+			EntityType:            db.EntitiesRepository,
+			EntityID:              entityID,
+			RuleName:              "rule",
+			RuleTypeName:          "rule_type",
+			RuleTypeID:            ruleTypeID,
+			RuleTypeSeverityValue: db.SeverityHigh,
+			RuleTypeReleasePhase:  db.ReleaseStatusGa,
+		},
+		{
+			EvalStatus:   db.EvalStatusTypesSuccess,
+			EntityType:   db.EntitiesRepository,
+			EntityID:     uuid.New(),
+			RuleName:     "other",
+			RuleTypeName: "other_type",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		req           *minderv1.GetEvaluationResultsSarifRequest
+		setup         func(*mockdb.MockStore, *mockprops.MockPropertiesService)
+		wantCode      codes.Code
+		expectResults []string
+	}{
+		{
+			name: "failures are reported",
+			req:  &minderv1.GetEvaluationResultsSarifRequest{},
+			setup: func(store *mockdb.MockStore, props *mockprops.MockPropertiesService) {
+				store.EXPECT().ListProfilesByProjectIDAndLabel(gomock.Any(), gomock.Any()).Return(profiles, nil)
+				store.EXPECT().ListRuleEvaluationsByProfileId(gomock.Any(), db.ListRuleEvaluationsByProfileIdParams{
+					ProfileID: profileID,
+				}).Return(slices.Clone(evals), nil)
+				store.EXPECT().GetRuleTypeByID(gomock.Any(), ruleTypeID).Return(db.RuleType{Guidance: "fix it"}, nil)
+				props.EXPECT().EntityWithPropertiesByID(gomock.Any(), entityID, gomock.Any()).
+					Return(entmodels.NewEntityWithPropertiesFromInstance(entmodels.EntityInstance{
+						ID:   entityID,
+						Type: minderv1.Entity_ENTITY_REPOSITORIES,
+						Name: "owner/repo",
+					}, nil), nil)
+				props.EXPECT().RetrieveAllPropertiesForEntity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			expectResults: []string{"first", "second"},
+		},
+		{
+			name: "unknown profile",
+			req:  &minderv1.GetEvaluationResultsSarifRequest{ProfileName: "unknown"},
+			setup: func(store *mockdb.MockStore, _ *mockprops.MockPropertiesService) {
+				store.EXPECT().ListProfilesByProjectIDAndLabel(gomock.Any(), gomock.Any()).Return(profiles, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "database error",
+			req:  &minderv1.GetEvaluationResultsSarifRequest{},
+			setup: func(store *mockdb.MockStore, _ *mockprops.MockPropertiesService) {
+				store.EXPECT().ListProfilesByProjectIDAndLabel(gomock.Any(), gomock.Any()).Return(profiles, nil)
+				store.EXPECT().ListRuleEvaluationsByProfileId(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			props := mockprops.NewMockPropertiesService(ctrl)
+			tt.setup(store, props)
+
+			srv := &Server{store: store, props: props}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := srv.GetEvaluationResultsSarif(ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			runs := resp.GetSarif().GetFields()["runs"].GetListValue().GetValues()
+			require.Len(t, runs, 1)
+			run := runs[0].GetStructValue().GetFields()
+
+			rules := run["tool"].GetStructValue().GetFields()["driver"].GetStructValue().
+				GetFields()["rules"].GetListValue().GetValues()
+			require.Len(t, rules, 1)
+			rule := rules[0].GetStructValue().GetFields()
+			require.Equal(t, "rule_type", rule["id"].GetStringValue())
+			require.Equal(t, "fix it", rule["help"].GetStructValue().GetFields()["text"].GetStringValue())
+
+			results := run["results"].GetListValue().GetValues()
+			require.Len(t, results, len(tt.expectResults))
+			for i, expected := range tt.expectResults {
+				res := results[i].GetStructValue().GetFields()
+				require.Equal(t, expected, res["message"].GetStructValue().GetFields()["text"].GetStringValue())
+				require.Equal(t, "error", res["level"].GetStringValue())
+			}
+		})
+	}
+}
@@ -13,13 +13,16 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/engine/entities"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
 	entmodels "github.com/mindersec/minder/internal/entities/models"
 	propSvc "github.com/mindersec/minder/internal/entities/properties/service"
 	"github.com/mindersec/minder/internal/logger"
@@ -401,6 +404,11 @@ func (s *Server) getRuleEvalStatus(
 		l.Err(err).Msg("error getting release phase")
 	}
 
+	severity, err := dbSeverityToSeverity(dbRuleEvalStat.RuleTypeSeverityValue)
+	if err != nil {
+		l.Err(err).Msg("error getting severity")
+	}
+
 	st := &minderv1.RuleEvaluationStatus{
 		ProfileId:           profileID,
 		RuleId:              dbRuleEvalStat.RuleTypeID.String(),
@@ -424,6 +432,7 @@ func (s *Server) getRuleEvalStatus(
 		},
 		RemediationLastUpdated: timestamppb.New(dbRuleEvalStat.RemLastUpdated),
 		ReleasePhase:           releasePhase,
+		Severity:               severity,
 	}
 
 	// If the alert is on and its metadata is valid, parse it and set the URL
@@ -565,6 +574,111 @@ func (s *Server) UpdateProfile(ctx context.Context,
 	}, nil
 }
 
+// TestProfile evaluates a profile against a set of registered entities
+// without storing the profile or the results of the evaluation, and without
+// performing any alerts or remediations. Passing rule types inline also
+// requires permission to create rule types in the project.
+func (s *Server) TestProfile(
+	in *minderv1.TestProfileRequest,
+	stream grpc.ServerStreamingServer[minderv1.TestProfileResponse],
+) error {
+	ctx := stream.Context()
+	entityCtx := engcontext.EntityFromContext(ctx)
+
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	profile := in.GetProfile()
+	if err := profile.Validate(); err != nil {
+		return util.UserVisibleError(codes.InvalidArgument, "invalid profile: %v", err)
+	}
+
+	for _, rt := range in.GetRuleTypes() {
+		if err := rt.Validate(); err != nil {
+			return util.UserVisibleError(codes.InvalidArgument, "invalid rule type %q: %v", rt.GetName(), err)
+		}
+	}
+
+	// Inline rule types run arbitrary ingesters and policies, so they need
+	// the same permission as creating them in the project.
+	if len(in.GetRuleTypes()) > 0 {
+		err := s.authorizeRelation(ctx, minderv1.Relation_RELATION_RULE_TYPE_CREATE, entityCtx.Project.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.BusinessRecord(ctx).Project = entityCtx.Project.ID
+
+	for _, ref := range in.GetEntities() {
+		inf, name, err := s.entityInfoForDryRun(ctx, entityCtx.Project.ID, ref)
+		if err != nil {
+			return err
+		}
+
+		err = s.executor.DryRunEntityEvent(ctx, inf, profile, in.GetRuleTypes(), func(res *engine.DryRunResult) error {
+			return stream.Send(&minderv1.TestProfileResponse{
+				Entity:       ref,
+				EntityName:   name,
+				RuleTypeName: res.RuleType,
+				RuleName:     res.RuleName,
+				Status:       string(evalerrors.ErrorAsEvalStatus(res.EvalErr)),
+				Details:      evalerrors.ErrorAsEvalDetails(res.EvalErr),
+			})
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "error evaluating entity %s: %v", ref.GetId(), err)
+		}
+	}
+
+	return nil
+}
+
+// entityInfoForDryRun builds the information needed to evaluate a
+// registered entity, and returns it along with the entity's name.
+func (s *Server) entityInfoForDryRun(
+	ctx context.Context,
+	projectID uuid.UUID,
+	ref *minderv1.EntityTypedId,
+) (*entities.EntityInfoWrapper, string, error) {
+	entityID, err := uuid.Parse(ref.GetId())
+	if err != nil {
+		return nil, "", util.UserVisibleError(codes.InvalidArgument, "invalid entity ID: %s", ref.GetId())
+	}
+
+	ewp, err := s.props.EntityWithPropertiesByID(ctx, entityID, nil)
+	if errors.Is(err, propSvc.ErrEntityNotFound) {
+		return nil, "", util.UserVisibleError(codes.NotFound, "entity %s not found", entityID)
+	} else if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "error fetching entity: %v", err)
+	}
+
+	// Don't reveal whether entities in other projects exist
+	if ewp.Entity.ProjectID != projectID {
+		return nil, "", util.UserVisibleError(codes.NotFound, "entity %s not found", entityID)
+	}
+
+	if ref.GetType() != minderv1.Entity_ENTITY_UNSPECIFIED && ref.GetType() != ewp.Entity.Type {
+		return nil, "", util.UserVisibleError(codes.InvalidArgument,
+			"entity %s is a %s, not a %s", entityID, ewp.Entity.Type.ToString(), ref.GetType().ToString())
+	}
+
+	pbEnt, err := s.props.EntityWithPropertiesAsProto(ctx, ewp, s.providerManager)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "error converting entity to protobuf: %v", err)
+	}
+
+	inf := entities.NewEntityInfoWrapper().
+		WithProjectID(ewp.Entity.ProjectID).
+		WithProviderID(ewp.Entity.ProviderID).
+		WithProtoMessage(ewp.Entity.Type, pbEnt).
+		WithID(ewp.Entity.ID)
+
+	return inf, ewp.Entity.Name, nil
+}
+
 // GetProfileStatusByName retrieves profile status by name
 func (s *Server) GetProfileStatusByName(
 	ctx context.Context,
@@ -14,13 +14,26 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	mockdb "github.com/mindersec/minder/database/mock"
+	authzmock "github.com/mindersec/minder/internal/authz/mock"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/db/embedded"
+	"github.com/mindersec/minder/internal/engine"
 	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/engine/entities"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
+	mockengine "github.com/mindersec/minder/internal/engine/mock"
+	entmodels "github.com/mindersec/minder/internal/entities/models"
+	propSvc "github.com/mindersec/minder/internal/entities/properties/service"
+	mockprops "github.com/mindersec/minder/internal/entities/properties/service/mock"
 	stubeventer "github.com/mindersec/minder/internal/events/stubs"
 	"github.com/mindersec/minder/internal/providers"
 	"github.com/mindersec/minder/internal/util"
@@ -1730,3 +1743,213 @@ func TestGetProfileByName(t *testing.T) {
 		})
 	}
 }
+
+type testProfileStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*minderv1.TestProfileResponse
+}
+
+func (s *testProfileStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testProfileStream) Send(res *minderv1.TestProfileResponse) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
+func TestTestProfile(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+	entityID := uuid.New()
+	otherProjectEntityID := uuid.New()
+
+	profile := &minderv1.Profile{
+		Name: "test-profile",
+		Repository: []*minderv1.Profile_Rule{
+			{Type: "rule_type_1", Def: &structpb.Struct{}},
+		},
+	}
+
+	ruleType := &minderv1.RuleType{
+		Name: "rule_type_1",
+		Def: &minderv1.RuleType_Definition{
+			InEntity:   minderv1.RepositoryEntity.String(),
+			RuleSchema: &structpb.Struct{},
+			Ingest: &minderv1.RuleType_Definition_Ingest{
+				Type:    "builtin",
+				Builtin: &minderv1.BuiltinType{Method: "Passthrough"},
+			},
+			Eval: &minderv1.RuleType_Definition_Eval{
+				Type: "rego",
+				Rego: &minderv1.RuleType_Definition_Eval_Rego{
+					Type: "deny-by-default",
+					Def:  "package minder\ndefault allow := true",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		profile   *minderv1.Profile
+		ruleTypes []*minderv1.RuleType
+		allowed   []uuid.UUID
+		entityID  uuid.UUID
+		setup     func(*mockprops.MockPropertiesService, *mockengine.MockExecutor)
+		wantCode  codes.Code
+		wantSent  []*minderv1.TestProfileResponse
+	}{
+		{
+			name:     "results are streamed",
+			profile:  profile,
+			entityID: entityID,
+			setup: func(props *mockprops.MockPropertiesService, exec *mockengine.MockExecutor) {
+				props.EXPECT().EntityWithPropertiesByID(gomock.Any(), entityID, gomock.Any()).
+					Return(entmodels.NewEntityWithPropertiesFromInstance(entmodels.EntityInstance{
+						ID:         entityID,
+						Type:       minderv1.Entity_ENTITY_REPOSITORIES,
+						Name:       "foo/bar",
+						ProjectID:  projectID,
+						ProviderID: providerID,
+					}, nil), nil)
+				props.EXPECT().EntityWithPropertiesAsProto(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&minderv1.Repository{Owner: "foo", Name: "bar"}, nil)
+				exec.EXPECT().DryRunEntityEvent(gomock.Any(), gomock.Any(), profile, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context, inf *entities.EntityInfoWrapper, _ *minderv1.Profile,
+						_ []*minderv1.RuleType, fn func(*engine.DryRunResult) error,
+					) error {
+						require.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, inf.Type)
+						require.Equal(t, projectID, inf.ProjectID)
+						require.NoError(t, fn(&engine.DryRunResult{RuleType: "rule_type_1", RuleName: "rule_type_1"}))
+						return fn(&engine.DryRunResult{
+							RuleType: "rule_type_1",
+							RuleName: "rule_type_1",
+							EvalErr:  evalerrors.NewErrEvaluationFailed("not good"),
+						})
+					})
+			},
+			wantSent: []*minderv1.TestProfileResponse{
+				{
+					Entity:       &minderv1.EntityTypedId{Id: entityID.String()},
+					EntityName:   "foo/bar",
+					RuleTypeName: "rule_type_1",
+					RuleName:     "rule_type_1",
+					Status:       "success",
+				},
+				{
+					Entity:       &minderv1.EntityTypedId{Id: entityID.String()},
+					EntityName:   "foo/bar",
+					RuleTypeName: "rule_type_1",
+					RuleName:     "rule_type_1",
+					Status:       "failure",
+					Details:      "not good",
+				},
+			},
+		},
+		{
+			name:      "inline rule types with permission to create rule types",
+			profile:   profile,
+			ruleTypes: []*minderv1.RuleType{ruleType},
+			allowed:   []uuid.UUID{projectID},
+			entityID:  entityID,
+			setup: func(props *mockprops.MockPropertiesService, exec *mockengine.MockExecutor) {
+				props.EXPECT().EntityWithPropertiesByID(gomock.Any(), entityID, gomock.Any()).
+					Return(entmodels.NewEntityWithPropertiesFromInstance(entmodels.EntityInstance{
+						ID:        entityID,
+						Type:      minderv1.Entity_ENTITY_REPOSITORIES,
+						Name:      "foo/bar",
+						ProjectID: projectID,
+					}, nil), nil)
+				props.EXPECT().EntityWithPropertiesAsProto(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&minderv1.Repository{Owner: "foo", Name: "bar"}, nil)
+				exec.EXPECT().DryRunEntityEvent(gomock.Any(), gomock.Any(), profile, []*minderv1.RuleType{ruleType}, gomock.Any()).
+					Return(nil)
+			},
+		},
+		{
+			name:      "inline rule types without permission to create rule types",
+			profile:   profile,
+			ruleTypes: []*minderv1.RuleType{ruleType},
+			entityID:  entityID,
+			wantCode:  codes.PermissionDenied,
+		},
+		{
+			name:     "invalid profile",
+			profile:  &minderv1.Profile{},
+			entityID: entityID,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "entity not found",
+			profile:  profile,
+			entityID: entityID,
+			setup: func(props *mockprops.MockPropertiesService, _ *mockengine.MockExecutor) {
+				props.EXPECT().EntityWithPropertiesByID(gomock.Any(), entityID, gomock.Any()).
+					Return(nil, propSvc.ErrEntityNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "entity in another project",
+			profile:  profile,
+			entityID: otherProjectEntityID,
+			setup: func(props *mockprops.MockPropertiesService, _ *mockengine.MockExecutor) {
+				props.EXPECT().EntityWithPropertiesByID(gomock.Any(), otherProjectEntityID, gomock.Any()).
+					Return(entmodels.NewEntityWithPropertiesFromInstance(entmodels.EntityInstance{
+						ID:        otherProjectEntityID,
+						Type:      minderv1.Entity_ENTITY_REPOSITORIES,
+						ProjectID: uuid.New(),
+					}, nil), nil)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetProjectByID(gomock.Any(), projectID).Return(db.Project{ID: projectID}, nil)
+			props := mockprops.NewMockPropertiesService(ctrl)
+			exec := mockengine.NewMockExecutor(ctrl)
+			if tt.setup != nil {
+				tt.setup(props, exec)
+			}
+
+			s := &Server{
+				store:       store,
+				props:       props,
+				executor:    exec,
+				authzClient: &authzmock.SimpleClient{Allowed: tt.allowed},
+			}
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			stream := &testProfileStream{ctx: ctx}
+			err := s.TestProfile(&minderv1.TestProfileRequest{
+				Profile:   tt.profile,
+				RuleTypes: tt.ruleTypes,
+				Entities:  []*minderv1.EntityTypedId{{Id: tt.entityID.String()}},
+			}, stream)
+
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, stream.sent, len(tt.wantSent))
+			for i := range tt.wantSent {
+				require.True(t, proto.Equal(tt.wantSent[i], stream.sent[i]),
+					"expected %v, got %v", tt.wantSent[i], stream.sent[i])
+			}
+		})
+	}
+}
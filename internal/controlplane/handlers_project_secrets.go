@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// SetProjectSecret creates or replaces a secret of the project, which REST
+// data sources may refer to by name. The value is stored encrypted.
+func (s *Server) SetProjectSecret(
+	ctx context.Context,
+	req *minderv1.SetProjectSecretRequest,
+) (*minderv1.SetProjectSecretResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	encrypted, err := crypto.EncryptAndSerialize(s.cryptoEngine, req.GetValue())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error encrypting secret: %v", err)
+	}
+
+	secret, err := s.store.UpsertProjectSecret(ctx, db.UpsertProjectSecretParams{
+		ProjectID:      projectID,
+		Name:           req.GetName(),
+		EncryptedValue: encrypted,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error storing secret: %v", err)
+	}
+
+	return &minderv1.SetProjectSecretResponse{
+		Secret: projectSecretToProto(secret),
+	}, nil
+}
+
+// ListProjectSecrets lists the secrets of the project, without their values
+func (s *Server) ListProjectSecrets(
+	ctx context.Context,
+	_ *minderv1.ListProjectSecretsRequest,
+) (*minderv1.ListProjectSecretsResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	secs, err := s.store.ListProjectSecretsByProject(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing secrets: %v", err)
+	}
+
+	out := make([]*minderv1.ProjectSecret, 0, len(secs))
+	for _, sec := range secs {
+		out = append(out, projectSecretToProto(sec))
+	}
+
+	return &minderv1.ListProjectSecretsResponse{
+		Secrets: out,
+	}, nil
+}
+
+// DeleteProjectSecret deletes a secret of the project
+func (s *Server) DeleteProjectSecret(
+	ctx context.Context,
+	req *minderv1.DeleteProjectSecretRequest,
+) (*minderv1.DeleteProjectSecretResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	_, err := s.store.DeleteProjectSecret(ctx, db.DeleteProjectSecretParams{
+		ProjectID: projectID,
+		Name:      req.GetName(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "secret %s not found", req.GetName())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error deleting secret: %v", err)
+	}
+
+	return &minderv1.DeleteProjectSecretResponse{}, nil
+}
+
+func projectSecretToProto(sec db.ProjectSecret) *minderv1.ProjectSecret {
+	return &minderv1.ProjectSecret{
+		Name:      sec.Name,
+		CreatedAt: timestamppb.New(sec.CreatedAt),
+		UpdatedAt: timestamppb.New(sec.UpdatedAt),
+	}
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minder "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestSetProjectSecret(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eng, err := crypto.NewEngineFromConfig(&serverconfig.Config{
+		Auth: serverconfig.AuthConfig{TokenKey: generateTokenKey(t)},
+	})
+	require.NoError(t, err)
+
+	projectID := uuid.New()
+	req := &minder.SetProjectSecretRequest{
+		Name:  "api-token",
+		Value: "s3cr3t-t0k3n",
+	}
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().UpsertProjectSecret(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.UpsertProjectSecretParams) (db.ProjectSecret, error) {
+			assert.Equal(t, projectID, arg.ProjectID)
+			assert.Equal(t, req.GetName(), arg.Name)
+			assert.NotContains(t, string(arg.EncryptedValue), req.GetValue())
+
+			value, err := crypto.DeserializeAndDecrypt(eng, arg.EncryptedValue)
+			require.NoError(t, err)
+			assert.Equal(t, req.GetValue(), value)
+
+			return db.ProjectSecret{ProjectID: arg.ProjectID, Name: arg.Name}, nil
+		})
+
+	srv := &Server{store: mockStore, cryptoEngine: eng}
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	})
+
+	resp, err := srv.SetProjectSecret(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, req.GetName(), resp.GetSecret().GetName())
+}
+
+func TestListProjectSecrets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.New()
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().ListProjectSecretsByProject(gomock.Any(), projectID).Return([]db.ProjectSecret{
+		{ProjectID: projectID, Name: "api-token"},
+		{ProjectID: projectID, Name: "client-cert"},
+	}, nil)
+
+	srv := &Server{store: mockStore}
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	})
+
+	resp, err := srv.ListProjectSecrets(ctx, &minder.ListProjectSecretsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetSecrets(), 2)
+	assert.Equal(t, "api-token", resp.GetSecrets()[0].GetName())
+	assert.Equal(t, "client-cert", resp.GetSecrets()[1].GetName())
+}
+
+func TestDeleteProjectSecret(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		storeErr error
+		wantCode codes.Code
+	}{
+		{
+			name: "deletes secret",
+		},
+		{
+			name:     "secret not found",
+			storeErr: sql.ErrNoRows,
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectID := uuid.New()
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().DeleteProjectSecret(gomock.Any(), db.DeleteProjectSecretParams{
+				ProjectID: projectID,
+				Name:      "api-token",
+			}).Return(db.ProjectSecret{}, tt.storeErr)
+
+			srv := &Server{store: mockStore}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			_, err := srv.DeleteProjectSecret(ctx, &minder.DeleteProjectSecretRequest{Name: "api-token"})
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -58,6 +58,7 @@ func (s *Server) ListProjects(
 		}
 
 		var description, displayName string
+		var historyRetention *minderv1.HistoryRetentionPolicy
 		meta, err := projects.ParseMetadata(&project)
 		// ignore error if we can't parse the metadata. This information is not critical... yet.
 		if err != nil {
@@ -67,15 +68,17 @@ func (s *Server) ListProjects(
 		} else {
 			description = meta.Public.Description
 			displayName = meta.Public.DisplayName
+			historyRetention = historyRetentionToPB(meta.HistoryRetention)
 		}
 
 		resp.Projects = append(resp.Projects, &minderv1.Project{
-			ProjectId:   project.ID.String(),
-			Name:        project.Name,
-			Description: description,
-			DisplayName: displayName,
-			CreatedAt:   timestamppb.New(project.CreatedAt),
-			UpdatedAt:   timestamppb.New(project.UpdatedAt),
+			ProjectId:        project.ID.String(),
+			Name:             project.Name,
+			Description:      description,
+			DisplayName:      displayName,
+			CreatedAt:        timestamppb.New(project.CreatedAt),
+			UpdatedAt:        timestamppb.New(project.UpdatedAt),
+			HistoryRetention: historyRetention,
 		})
 	}
 	return &resp, nil
@@ -342,12 +345,13 @@ func (s *Server) UpdateProject(
 
 	return &minderv1.UpdateProjectResponse{
 		Project: &minderv1.Project{
-			ProjectId:   outproj.ID.String(),
-			Name:        outproj.Name,
-			Description: meta.Public.Description,
-			DisplayName: meta.Public.DisplayName,
-			CreatedAt:   timestamppb.New(outproj.CreatedAt),
-			UpdatedAt:   timestamppb.New(outproj.UpdatedAt),
+			ProjectId:        outproj.ID.String(),
+			Name:             outproj.Name,
+			Description:      meta.Public.Description,
+			DisplayName:      meta.Public.DisplayName,
+			CreatedAt:        timestamppb.New(outproj.CreatedAt),
+			UpdatedAt:        timestamppb.New(outproj.UpdatedAt),
+			HistoryRetention: historyRetentionToPB(meta.HistoryRetention),
 		},
 	}, nil
 }
@@ -390,6 +394,8 @@ func (s *Server) PatchProject(
 			meta.Public.DisplayName = req.GetPatch().GetDisplayName()
 		case "description":
 			meta.Public.Description = req.GetPatch().GetDescription()
+		case "history_retention":
+			meta.HistoryRetention = historyRetentionFromPB(req.GetPatch().GetHistoryRetention())
 		}
 	}
 
@@ -413,12 +419,33 @@ func (s *Server) PatchProject(
 
 	return &minderv1.PatchProjectResponse{
 		Project: &minderv1.Project{
-			ProjectId:   outproj.ID.String(),
-			Name:        outproj.Name,
-			Description: meta.Public.Description,
-			DisplayName: meta.Public.DisplayName,
-			CreatedAt:   timestamppb.New(outproj.CreatedAt),
-			UpdatedAt:   timestamppb.New(outproj.UpdatedAt),
+			ProjectId:        outproj.ID.String(),
+			Name:             outproj.Name,
+			Description:      meta.Public.Description,
+			DisplayName:      meta.Public.DisplayName,
+			CreatedAt:        timestamppb.New(outproj.CreatedAt),
+			UpdatedAt:        timestamppb.New(outproj.UpdatedAt),
+			HistoryRetention: historyRetentionToPB(meta.HistoryRetention),
 		},
 	}, nil
 }
+
+func historyRetentionToPB(retention *projects.HistoryRetentionV1) *minderv1.HistoryRetentionPolicy {
+	if retention == nil {
+		return nil
+	}
+	return &minderv1.HistoryRetentionPolicy{
+		KeepDays:        int32(retention.KeepDays), //nolint:gosec // validated to be at most 3660
+		TransitionsOnly: retention.TransitionsOnly,
+	}
+}
+
+func historyRetentionFromPB(retention *minderv1.HistoryRetentionPolicy) *projects.HistoryRetentionV1 {
+	if retention.GetKeepDays() == 0 && !retention.GetTransitionsOnly() {
+		return nil
+	}
+	return &projects.HistoryRetentionV1{
+		KeepDays:        int(retention.GetKeepDays()),
+		TransitionsOnly: retention.GetTransitionsOnly(),
+	}
+}
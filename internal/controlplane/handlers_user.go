@@ -237,7 +237,7 @@ func (s *Server) getUserDependencies(ctx context.Context, user db.User) ([]*pb.P
 				}
 			}
 			// Parse role
-			authzRole, err := authz.ParseRole(roleString)
+			authzRole, err := authz.ParseRoleName(roleString)
 			if err != nil {
 				return nil, nil, status.Errorf(codes.Internal, "failed to parse role: %v", err)
 			}
@@ -246,6 +246,10 @@ func (s *Server) getUserDependencies(ctx context.Context, user db.User) ([]*pb.P
 				DisplayName: authz.AllRolesDisplayName[authzRole],
 				Description: authz.AllRolesDescriptions[authzRole],
 			}
+			if authzRole.IsCustom() {
+				projectRole.DisplayName = authzRole.String()
+				projectRole.Description = "Custom role defined by the project."
+			}
 		}
 
 		// Append the project role to the response
@@ -459,7 +463,7 @@ func (s *Server) acceptInvitation(ctx context.Context, userInvite db.GetInvitati
 				return util.UserVisibleError(codes.AlreadyExists, "user already has the same role in the project")
 			}
 			// Revoke the existing role assignments for the user in the project
-			existingRole, err := authz.ParseRole(existing.Role)
+			existingRole, err := authz.ParseRoleName(existing.Role)
 			if err != nil {
 				return status.Errorf(codes.Internal, "failed to parse existing role: %s", err)
 			}
@@ -47,8 +47,10 @@ import (
 	"github.com/mindersec/minder/internal/crypto"
 	datasourcessvc "github.com/mindersec/minder/internal/datasources/service"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine"
 	propSvc "github.com/mindersec/minder/internal/entities/properties/service"
 	entitySvc "github.com/mindersec/minder/internal/entities/service"
+	"github.com/mindersec/minder/internal/events/dlq"
 	"github.com/mindersec/minder/internal/history"
 	"github.com/mindersec/minder/internal/invites"
 	"github.com/mindersec/minder/internal/logger"
@@ -112,6 +114,8 @@ type Server struct {
 	providerAuthManager manager.AuthManager
 	projectCreator      projects.ProjectCreator
 	projectDeleter      projects.ProjectDeleter
+	executor            engine.Executor
+	deadLetterQueue     dlq.Queue
 
 	// Implementations for service registration
 	pb.UnimplementedHealthServiceServer
@@ -128,6 +132,7 @@ type Server struct {
 	pb.UnimplementedInviteServiceServer
 	pb.UnimplementedDataSourceServiceServer
 	pb.UnimplementedEntityInstanceServiceServer
+	pb.UnimplementedAdminServiceServer
 }
 
 // NewServer creates a new server instance
@@ -157,6 +162,8 @@ func NewServer(
 	projectCreator projects.ProjectCreator,
 	entityService entitySvc.EntityService,
 	featureFlagClient flags.Interface,
+	executor engine.Executor,
+	deadLetterQueue dlq.Queue,
 ) *Server {
 	return &Server{
 		store:               store,
@@ -185,6 +192,8 @@ func NewServer(
 		idClient:            idClient,
 		projectCreator:      projectCreator,
 		projectDeleter:      projectDeleter,
+		executor:            executor,
+		deadLetterQueue:     deadLetterQueue,
 	}
 }
 
@@ -268,6 +277,7 @@ func (s *Server) StartGRPCServer(ctx context.Context) error {
 	options := []grpc.ServerOption{
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(unaryToStreamInterceptor(interceptors...)),
 	}
 
 	otelGRPCOpts := s.getOTELGRPCInterceptorOpts()
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// unaryToStreamInterceptor applies a chain of unary interceptors to server
+// streaming RPCs, so that they get the same authentication, authorization
+// and logging as unary RPCs. The request is received before the
+// interceptors run, and handed to the RPC handler when it asks for it.
+//
+// Client streaming RPCs are not supported, since the interceptors need to
+// see the whole request.
+func unaryToStreamInterceptor(interceptors ...grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream {
+			return status.Errorf(codes.Unimplemented, "client streaming RPCs are not supported")
+		}
+
+		req, err := newRequestForMethod(info.FullMethod)
+		if err != nil {
+			return status.Errorf(codes.Internal, "error creating request: %v", err)
+		}
+		if err := ss.RecvMsg(req); err != nil {
+			return err
+		}
+
+		unaryInfo := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: info.FullMethod,
+		}
+
+		chained := func(ctx context.Context, req any) (any, error) {
+			return nil, handler(srv, &receivedServerStream{ServerStream: ss, ctx: ctx, req: req})
+		}
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, unaryInfo, next)
+			}
+		}
+
+		_, err = chained(ss.Context(), req)
+		return err
+	}
+}
+
+func newRequestForMethod(fullMethod string) (proto.Message, error) {
+	formattedName := strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", ".")
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(formattedName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to find descriptor for %q: %w", formattedName, err)
+	}
+
+	method, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a method", formattedName)
+	}
+
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, fmt.Errorf("unable to find request type for %q: %w", formattedName, err)
+	}
+
+	return msgType.New().Interface(), nil
+}
+
+// receivedServerStream is a server stream whose request has already been
+// received, and whose context has been set up by the interceptors.
+type receivedServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	req      any
+	received bool
+}
+
+// Context returns the context set up by the interceptors
+func (s *receivedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg returns the request which was received by the interceptor
+func (s *receivedServerStream) RecvMsg(m any) error {
+	if s.received {
+		return io.EOF
+	}
+
+	dst, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+	src, ok := s.req.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected request type %T", s.req)
+	}
+
+	proto.Reset(dst)
+	proto.Merge(dst, src)
+	s.received = true
+	return nil
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	minder "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+type testCtxKey struct{}
+
+// fakeServerStream is a server stream which returns a single request
+type fakeServerStream struct {
+	grpc.ServerStream
+	req  proto.Message
+	sent []any
+}
+
+func (*fakeServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeServerStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func (s *fakeServerStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestUnaryToStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	project := "my-project"
+	req := &minder.TestProfileRequest{
+		Profile: &minder.Profile{Name: "test", Context: &minder.Context{Project: &project}},
+	}
+	info := &grpc.StreamServerInfo{
+		FullMethod:     minder.ProfileService_TestProfile_FullMethodName,
+		IsServerStream: true,
+	}
+
+	var calls []string
+	first := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		calls = append(calls, "first")
+		require.Equal(t, minder.ProfileService_TestProfile_FullMethodName, info.FullMethod)
+		tpr, ok := req.(*minder.TestProfileRequest)
+		require.True(t, ok, "interceptors should get the typed request")
+		require.Equal(t, "my-project", tpr.GetContext().GetProject())
+		return handler(context.WithValue(ctx, testCtxKey{}, "value"), req)
+	}
+	second := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		calls = append(calls, "second")
+		return handler(ctx, req)
+	}
+
+	interceptor := unaryToStreamInterceptor(first, second)
+	ss := &fakeServerStream{req: req}
+	err := interceptor(nil, ss, info, func(_ any, stream grpc.ServerStream) error {
+		calls = append(calls, "handler")
+		require.Equal(t, "value", stream.Context().Value(testCtxKey{}),
+			"the handler should see the context set up by the interceptors")
+
+		got := &minder.TestProfileRequest{}
+		require.NoError(t, stream.RecvMsg(got))
+		require.True(t, proto.Equal(req, got))
+		require.ErrorIs(t, stream.RecvMsg(&minder.TestProfileRequest{}), io.EOF)
+
+		return stream.SendMsg(&minder.TestProfileResponse{Status: "success"})
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second", "handler"}, calls)
+	require.Len(t, ss.sent, 1)
+}
+
+func TestUnaryToStreamInterceptorRejects(t *testing.T) {
+	t.Parallel()
+
+	denied := status.Error(codes.PermissionDenied, "denied")
+	deny := func(_ context.Context, _ any, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (any, error) {
+		return nil, denied
+	}
+
+	interceptor := unaryToStreamInterceptor(deny)
+	err := interceptor(nil, &fakeServerStream{req: &minder.TestProfileRequest{}}, &grpc.StreamServerInfo{
+		FullMethod:     minder.ProfileService_TestProfile_FullMethodName,
+		IsServerStream: true,
+	}, func(_ any, _ grpc.ServerStream) error {
+		t.Fatal("handler should not be called")
+		return nil
+	})
+	require.ErrorIs(t, err, denied)
+
+	err = interceptor(nil, &fakeServerStream{}, &grpc.StreamServerInfo{
+		FullMethod:     minder.ProfileService_TestProfile_FullMethodName,
+		IsClientStream: true,
+	}, func(_ any, _ grpc.ServerStream) error {
+		t.Fatal("handler should not be called")
+		return nil
+	})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
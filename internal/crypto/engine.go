@@ -153,9 +153,20 @@ func (e *engine) encrypt(plaintext []byte) (EncryptedData, error) {
 		return EncryptedData{}, fmt.Errorf("unable to find preferred algorithm: %s", e.defaultAlgorithm)
 	}
 
-	key, err := e.keystore.GetKey(e.defaultKeyID)
-	if err != nil {
-		return EncryptedData{}, fmt.Errorf("unable to find preferred key with ID: %s", e.defaultKeyID)
+	var key, wrappedKey []byte
+	var err error
+	// Keys of remote keystores never leave them, so encrypt with a new data
+	// key which is stored wrapped by the key.
+	if envelope, ok := e.keystore.(keystores.EnvelopeKeyStore); ok {
+		key, wrappedKey, err = envelope.GenerateDataKey(e.defaultKeyID)
+		if err != nil {
+			return EncryptedData{}, errors.Join(ErrEncrypt, err)
+		}
+	} else {
+		key, err = e.keystore.GetKey(e.defaultKeyID)
+		if err != nil {
+			return EncryptedData{}, fmt.Errorf("unable to find preferred key with ID: %s", e.defaultKeyID)
+		}
 	}
 
 	encrypted, err := algorithm.Encrypt(plaintext, key)
@@ -165,11 +176,15 @@ func (e *engine) encrypt(plaintext []byte) (EncryptedData, error) {
 
 	encoded := base64.StdEncoding.EncodeToString(encrypted)
 	// TODO: Allow salt to be randomly generated per secret.
-	return EncryptedData{
+	result := EncryptedData{
 		Algorithm:   e.defaultAlgorithm,
 		EncodedData: encoded,
 		KeyVersion:  e.defaultKeyID,
-	}, nil
+	}
+	if wrappedKey != nil {
+		result.WrappedKey = base64.StdEncoding.EncodeToString(wrappedKey)
+	}
+	return result, nil
 }
 
 func (e *engine) decrypt(data EncryptedData) ([]byte, error) {
@@ -182,7 +197,7 @@ func (e *engine) decrypt(data EncryptedData) ([]byte, error) {
 		return nil, fmt.Errorf("%w: %s", algorithms.ErrUnknownAlgorithm, e.defaultAlgorithm)
 	}
 
-	key, err := e.keystore.GetKey(data.KeyVersion)
+	key, err := e.getDecryptionKey(data)
 	if err != nil {
 		// error from keystore is good enough - we do not need more context
 		return nil, err
@@ -202,6 +217,26 @@ func (e *engine) decrypt(data EncryptedData) ([]byte, error) {
 	return result, nil
 }
 
+// getDecryptionKey returns the key the data was encrypted with, unwrapping
+// its data key if it was envelope encrypted.
+func (e *engine) getDecryptionKey(data EncryptedData) ([]byte, error) {
+	if data.WrappedKey == "" {
+		return e.keystore.GetKey(data.KeyVersion)
+	}
+
+	envelope, ok := e.keystore.(keystores.EnvelopeKeyStore)
+	if !ok {
+		return nil, fmt.Errorf("%w: data key of key %s cannot be unwrapped by the keystore",
+			keystores.ErrUnknownKeyID, data.KeyVersion)
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(data.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding data key: %w", err)
+	}
+	return envelope.DecryptDataKey(data.KeyVersion, wrappedKey)
+}
+
 // This is for config transition purposes, and will eventually be removed.
 func convertToCryptoConfig(a *serverconfig.AuthConfig) (serverconfig.CryptoConfig, error) {
 	abspath, err := filepath.Abs(a.TokenKey)
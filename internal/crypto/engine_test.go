@@ -4,6 +4,8 @@
 package crypto
 
 import (
+	"bytes"
+	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,6 +13,7 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/mindersec/minder/internal/crypto/algorithms"
+	"github.com/mindersec/minder/internal/crypto/keystores"
 	"github.com/mindersec/minder/pkg/config/server"
 )
 
@@ -118,6 +121,21 @@ func TestFallbackDecrypt(t *testing.T) {
 	require.NotEqualf(t, newEncrypted, encrypted, "two encrypted values expected to be different but are not")
 }
 
+func TestEncryptAndSerialize(t *testing.T) {
+	t.Parallel()
+
+	const sampleData = "I'm a little teapot"
+	engine, err := NewEngineFromConfig(config)
+	require.NoError(t, err)
+	serialized, err := EncryptAndSerialize(engine, sampleData)
+	require.NoError(t, err)
+	assert.NotContains(t, string(serialized), sampleData)
+
+	decrypted, err := DeserializeAndDecrypt(engine, serialized)
+	require.NoError(t, err)
+	assert.Equal(t, sampleData, decrypted)
+}
+
 func TestEncryptDecryptOAuthToken(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +229,81 @@ func TestDecryptFailedDecryption(t *testing.T) {
 	require.ErrorIs(t, err, ErrDecrypt)
 }
 
+// fakeEnvelopeKeyStore wraps data keys by prefixing them with the key ID,
+// and serves the keys of the local keystore it embeds
+type fakeEnvelopeKeyStore struct {
+	keystores.KeyStore
+	keyID string
+}
+
+func (f *fakeEnvelopeKeyStore) GenerateDataKey(id string) ([]byte, []byte, error) {
+	if id != f.keyID {
+		return nil, nil, keystores.ErrUnknownKeyID
+	}
+	key := []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{42}, 32)))
+	return key, append([]byte(id+":"), key...), nil
+}
+
+func (f *fakeEnvelopeKeyStore) DecryptDataKey(id string, wrapped []byte) ([]byte, error) {
+	key, ok := bytes.CutPrefix(wrapped, []byte(id+":"))
+	if id != f.keyID || !ok {
+		return nil, keystores.ErrUnknownKeyID
+	}
+	return key, nil
+}
+
+func TestEnvelopeEncryption(t *testing.T) {
+	t.Parallel()
+
+	localEngine, err := NewEngineFromConfig(config)
+	require.NoError(t, err)
+	local := localEngine.(*engine)
+
+	// the remote key is the default key, the local key is the fallback
+	remote := &engine{
+		keystore: &fakeEnvelopeKeyStore{
+			KeyStore: local.keystore,
+			keyID:    "remote_key",
+		},
+		supportedAlgorithms: local.supportedAlgorithms,
+		defaultAlgorithm:    DefaultAlgorithm,
+		defaultKeyID:        "remote_key",
+	}
+
+	const sampleData = "Hello world!"
+	encrypted, err := remote.EncryptString(sampleData)
+	require.NoError(t, err)
+	require.Equal(t, "remote_key", encrypted.KeyVersion)
+	require.NotEmpty(t, encrypted.WrappedKey)
+
+	decrypted, err := remote.DecryptString(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, sampleData, decrypted)
+
+	// data encrypted with the local key can still be decrypted, so that it
+	// can be rotated to the remote key
+	localEncrypted, err := local.EncryptString(sampleData)
+	require.NoError(t, err)
+	require.Empty(t, localEncrypted.WrappedKey)
+	decrypted, err = remote.DecryptString(localEncrypted)
+	require.NoError(t, err)
+	require.Equal(t, sampleData, decrypted)
+
+	// the local keystore cannot unwrap the data key
+	_, err = local.DecryptString(encrypted)
+	require.ErrorIs(t, err, keystores.ErrUnknownKeyID)
+
+	// the wrapped key is serialized only when set
+	serialized, err := localEncrypted.Serialize()
+	require.NoError(t, err)
+	require.NotContains(t, string(serialized), "WrappedKey")
+	serialized, err = encrypted.Serialize()
+	require.NoError(t, err)
+	deserialized, err := DeserializeEncryptedData(serialized)
+	require.NoError(t, err)
+	require.Equal(t, encrypted, deserialized)
+}
+
 var config = &server.Config{
 	Auth: server.AuthConfig{
 		TokenKey: "./testdata/test_encryption_key",
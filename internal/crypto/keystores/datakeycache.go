@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package keystores
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// dataKeyCacheSize is the maximum number of unwrapped data keys kept in
+// memory by remote keystores. Every secret has its own data key, so this
+// bounds the number of secrets which are decrypted without a request to
+// the remote keystore.
+const dataKeyCacheSize = 1024
+
+// dataKeyCache is a least recently used cache of unwrapped data keys, so
+// that decrypting the same secret repeatedly does not make a request to the
+// remote keystore every time. The unwrapped keys are only held in memory.
+type dataKeyCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type dataKeyEntry struct {
+	key     string
+	dataKey []byte
+}
+
+func newDataKeyCache(size int) *dataKeyCache {
+	return &dataKeyCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// dataKeyCacheKey identifies a data key by the key wrapping it and the
+// hash of the wrapped data key
+func dataKeyCacheKey(id string, wrapped []byte) string {
+	sum := sha256.Sum256(wrapped)
+	return id + "/" + hex.EncodeToString(sum[:])
+}
+
+func (c *dataKeyCache) get(id string, wrapped []byte) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[dataKeyCacheKey(id, wrapped)]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*dataKeyEntry).dataKey, true
+}
+
+func (c *dataKeyCache) put(id string, wrapped []byte, dataKey []byte) {
+	key := dataKeyCacheKey(id, wrapped)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&dataKeyEntry{key: key, dataKey: dataKey})
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*dataKeyEntry).key)
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package keystores
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataKeyCache(t *testing.T) {
+	t.Parallel()
+
+	cache := newDataKeyCache(2)
+	cache.put("key", []byte("wrapped-1"), []byte("data-key-1"))
+	cache.put("key", []byte("wrapped-2"), []byte("data-key-2"))
+
+	got, ok := cache.get("key", []byte("wrapped-1"))
+	require.True(t, ok)
+	require.Equal(t, []byte("data-key-1"), got)
+
+	// the same wrapped data key under another key is a different entry
+	_, ok = cache.get("other-key", []byte("wrapped-1"))
+	require.False(t, ok)
+
+	// the least recently used entry is evicted
+	cache.put("key", []byte("wrapped-3"), []byte("data-key-3"))
+	_, ok = cache.get("key", []byte("wrapped-2"))
+	require.False(t, ok)
+	_, ok = cache.get("key", []byte("wrapped-1"))
+	require.True(t, ok)
+	_, ok = cache.get("key", []byte("wrapped-3"))
+	require.True(t, ok)
+}
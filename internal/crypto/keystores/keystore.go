@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 )
@@ -19,8 +20,31 @@ type KeyStore interface {
 	GetKey(id string) ([]byte, error)
 }
 
-// LocalKeyStore is the config value for an on-disk key store
-const LocalKeyStore = "local"
+// EnvelopeKeyStore is a KeyStore whose keys are held by an external key
+// management service and never leave it. Data is encrypted with data keys
+// generated by the service, which are stored alongside the data wrapped by
+// the key.
+type EnvelopeKeyStore interface {
+	KeyStore
+	// GenerateDataKey generates a data key, and returns it along with the data
+	// key wrapped by the key with the specified ID. Like the keys returned by
+	// GetKey, the data key is base64 encoded.
+	GenerateDataKey(id string) (key []byte, wrapped []byte, err error)
+	// DecryptDataKey unwraps a data key wrapped by the key with the specified ID.
+	DecryptDataKey(id string, wrapped []byte) ([]byte, error)
+}
+
+const (
+	// LocalKeyStore is the config value for an on-disk key store
+	LocalKeyStore = "local"
+	// VaultKeyStore is the config value for a HashiCorp Vault Transit key store
+	VaultKeyStore = "vault"
+	// KMSKeyStore is the config value for an AWS KMS key store
+	KMSKeyStore = "kms"
+)
+
+// remoteKeyStoreTimeout is the timeout of the requests to remote key stores
+const remoteKeyStoreTimeout = 10 * time.Second
 
 // ErrUnknownKeyID is returned when the Key ID cannot be found by the keystore.
 var ErrUnknownKeyID = errors.New("unknown key id")
@@ -29,14 +53,22 @@ type keysByID map[string][]byte
 
 // NewKeyStoreFromConfig creates an instance of a KeyStore based on the
 // AuthConfig in Minder.
-// Since our only implementation is based on reading from the local disk, do
-// all key loading during construction of the struct.
 func NewKeyStoreFromConfig(config serverconfig.CryptoConfig) (KeyStore, error) {
-	// TODO: support other methods in future
-	if config.KeyStore.Type != LocalKeyStore {
+	switch config.KeyStore.Type {
+	case LocalKeyStore:
+		return newLocalKeyStoreFromConfig(config)
+	case VaultKeyStore:
+		return newVaultKeyStoreFromConfig(config)
+	case KMSKeyStore:
+		return newKMSKeyStoreFromConfig(config)
+	default:
 		return nil, fmt.Errorf("unexpected keystore type: %s", config.KeyStore.Type)
 	}
+}
 
+// newLocalKeyStoreFromConfig creates a keystore which reads its keys from
+// the local disk. All key loading is done during construction of the struct.
+func newLocalKeyStoreFromConfig(config serverconfig.CryptoConfig) (*localFileKeyStore, error) {
 	if config.KeyStore.Local.KeyDir == "" {
 		return nil, errors.New("key directory not defined in keystore config")
 	}
@@ -64,6 +96,28 @@ func NewKeyStoreFromConfig(config serverconfig.CryptoConfig) (KeyStore, error) {
 	}, nil
 }
 
+// newFallbackKeyStoreFromConfig creates the keystore holding the fallback key
+// of remote keystores. The fallback key is read from the local disk, so that
+// data encrypted with a local key can be rotated to a remote key.
+func newFallbackKeyStoreFromConfig(config serverconfig.CryptoConfig) (*localFileKeyStore, error) {
+	if config.Fallback.KeyID == "" {
+		return &localFileKeyStore{keys: keysByID{}}, nil
+	}
+
+	if config.KeyStore.Local.KeyDir == "" {
+		return nil, errors.New("key directory of the fallback key not defined in keystore config")
+	}
+
+	key, err := readKey(config.KeyStore.Local.KeyDir, config.Fallback.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key %s: %w", config.Fallback.KeyID, err)
+	}
+	return &localFileKeyStore{
+		keys:          keysByID{config.Fallback.KeyID: key},
+		fallbackKeyID: config.Fallback.KeyID,
+	}, nil
+}
+
 // NewKeyStoreFromMap constructs a keystore from a map of key ID to key bytes.
 // This is mostly useful for testing.
 func NewKeyStoreFromMap(keys keysByID, fallbackID string) KeyStore {
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package keystores
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// kmsKeyStore generates and unwraps data keys with AWS KMS, or with a local
+// service implementing its API. Unwrapped data keys are cached in memory.
+type kmsKeyStore struct {
+	// localFileKeyStore holds the local fallback key, if any
+	*localFileKeyStore
+	client   *kms.Client
+	dataKeys *dataKeyCache
+}
+
+var _ EnvelopeKeyStore = (*kmsKeyStore)(nil)
+
+func newKMSKeyStoreFromConfig(config serverconfig.CryptoConfig) (*kmsKeyStore, error) {
+	cfg := config.KeyStore.KMS
+	if cfg.Region == "" {
+		return nil, errors.New("kms region not defined in keystore config")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteKeyStoreTimeout)
+	defer cancel()
+
+	// Credentials are loaded from the environment, as for the other AWS services
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(cfg.Region))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load aws config: %w", err)
+	}
+
+	fallback, err := newFallbackKeyStoreFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKMSKeyStore(awsCfg, cfg.Endpoint, fallback), nil
+}
+
+func newKMSKeyStore(awsCfg aws.Config, endpoint string, fallback *localFileKeyStore) *kmsKeyStore {
+	client := kms.NewFromConfig(awsCfg, func(o *kms.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+
+	return &kmsKeyStore{
+		localFileKeyStore: fallback,
+		client:            client,
+		dataKeys:          newDataKeyCache(dataKeyCacheSize),
+	}
+}
+
+func (k *kmsKeyStore) GenerateDataKey(id string) ([]byte, []byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteKeyStoreTimeout)
+	defer cancel()
+
+	out, err := k.client.GenerateDataKey(ctx, &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(id),
+		KeySpec: types.DataKeySpecAes256,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to generate data key with kms key %s: %w", id, err)
+	}
+
+	key := encodeDataKey(out.Plaintext)
+	k.dataKeys.put(id, out.CiphertextBlob, key)
+	return key, out.CiphertextBlob, nil
+}
+
+func (k *kmsKeyStore) DecryptDataKey(id string, wrapped []byte) ([]byte, error) {
+	if key, ok := k.dataKeys.get(id, wrapped); ok {
+		return key, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteKeyStoreTimeout)
+	defer cancel()
+
+	out, err := k.client.Decrypt(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(id),
+		CiphertextBlob: wrapped,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt data key with kms key %s: %w", id, err)
+	}
+
+	key := encodeDataKey(out.Plaintext)
+	k.dataKeys.put(id, wrapped, key)
+	return key, nil
+}
+
+// encodeDataKey base64 encodes a data key, as the keys read from disk are
+func encodeDataKey(key []byte) []byte {
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(key)))
+	base64.StdEncoding.Encode(out, key)
+	return out
+}
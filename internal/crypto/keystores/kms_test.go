@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package keystores
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+const kmsKeyID = "alias/minder"
+
+// fakeKMS is a stand-in for the AWS KMS API, like local-kms or LocalStack
+type fakeKMS struct {
+	mu       sync.Mutex
+	wrapped  map[string][]byte
+	decrypts int
+}
+
+func (f *fakeKMS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	if body["KeyId"] != kmsKeyID {
+		writeKMSError(w, "NotFoundException", "key not found")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Header.Get("X-Amz-Target") {
+	case "TrentService.GenerateDataKey":
+		plaintext := []byte(fmt.Sprintf("%032d", len(f.wrapped)))
+		blob := []byte(fmt.Sprintf("blob-%d", len(f.wrapped)))
+		f.wrapped[string(blob)] = plaintext
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"KeyId":          kmsKeyID,
+			"Plaintext":      plaintext,
+			"CiphertextBlob": blob,
+		})
+	case "TrentService.Decrypt":
+		f.decrypts++
+		blob, _ := base64.StdEncoding.DecodeString(body["CiphertextBlob"].(string))
+		plaintext, ok := f.wrapped[string(blob)]
+		if !ok {
+			writeKMSError(w, "InvalidCiphertextException", "invalid ciphertext")
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"KeyId":     kmsKeyID,
+			"Plaintext": plaintext,
+		})
+	default:
+		writeKMSError(w, "UnknownOperationException", "unknown operation")
+	}
+}
+
+func writeKMSError(w http.ResponseWriter, errType string, msg string) {
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]any{"__type": errType, "message": msg})
+}
+
+func newTestKMSKeyStore(t *testing.T, fake *fakeKMS) *kmsKeyStore {
+	t.Helper()
+
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	awsCfg := aws.Config{
+		Region: "us-east-1",
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "test", SecretAccessKey: "test"}, nil
+		}),
+		RetryMaxAttempts: 1,
+	}
+	return newKMSKeyStore(awsCfg, srv.URL, &localFileKeyStore{keys: keysByID{}})
+}
+
+func TestKMSKeyStore(t *testing.T) {
+	t.Parallel()
+
+	keystore := newTestKMSKeyStore(t, &fakeKMS{wrapped: map[string][]byte{}})
+
+	key, wrapped, err := keystore.GenerateDataKey(kmsKeyID)
+	require.NoError(t, err)
+	require.Equal(t, "blob-0", string(wrapped))
+	// data keys are base64 encoded like the keys read from disk
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%032d", 0))), string(key))
+
+	unwrapped, err := keystore.DecryptDataKey(kmsKeyID, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+
+	_, err = keystore.DecryptDataKey(kmsKeyID, []byte("blob-unknown"))
+	require.ErrorContains(t, err, "invalid ciphertext")
+
+	_, _, err = keystore.GenerateDataKey("alias/other")
+	require.ErrorContains(t, err, "key not found")
+
+	// the key never leaves kms
+	_, err = keystore.GetKey(kmsKeyID)
+	require.ErrorIs(t, err, ErrUnknownKeyID)
+}
+
+func TestKMSKeyStore_CachesDataKeys(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeKMS{wrapped: map[string][]byte{}}
+	key, wrapped, err := newTestKMSKeyStore(t, fake).GenerateDataKey(kmsKeyID)
+	require.NoError(t, err)
+
+	// a new keystore, e.g. after a restart, unwraps the data key once
+	keystore := newTestKMSKeyStore(t, fake)
+	for range 3 {
+		unwrapped, err := keystore.DecryptDataKey(kmsKeyID, wrapped)
+		require.NoError(t, err)
+		require.Equal(t, key, unwrapped)
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	require.Equal(t, 1, fake.decrypts)
+}
+
+func TestNewKMSKeyStoreFromConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewKeyStoreFromConfig(serverconfig.CryptoConfig{
+		KeyStore: serverconfig.KeyStoreConfig{
+			Type: KMSKeyStore,
+		},
+		Default: serverconfig.DefaultCrypto{
+			KeyID: kmsKeyID,
+		},
+	})
+	require.ErrorContains(t, err, "kms region not defined in keystore config")
+}
@@ -19,6 +19,7 @@ import (
 type MockKeyStore struct {
 	ctrl     *gomock.Controller
 	recorder *MockKeyStoreMockRecorder
+	isgomock struct{}
 }
 
 // MockKeyStoreMockRecorder is the mock recorder for MockKeyStore.
@@ -52,3 +53,73 @@ func (mr *MockKeyStoreMockRecorder) GetKey(id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockKeyStore)(nil).GetKey), id)
 }
+
+// MockEnvelopeKeyStore is a mock of EnvelopeKeyStore interface.
+type MockEnvelopeKeyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvelopeKeyStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockEnvelopeKeyStoreMockRecorder is the mock recorder for MockEnvelopeKeyStore.
+type MockEnvelopeKeyStoreMockRecorder struct {
+	mock *MockEnvelopeKeyStore
+}
+
+// NewMockEnvelopeKeyStore creates a new mock instance.
+func NewMockEnvelopeKeyStore(ctrl *gomock.Controller) *MockEnvelopeKeyStore {
+	mock := &MockEnvelopeKeyStore{ctrl: ctrl}
+	mock.recorder = &MockEnvelopeKeyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvelopeKeyStore) EXPECT() *MockEnvelopeKeyStoreMockRecorder {
+	return m.recorder
+}
+
+// DecryptDataKey mocks base method.
+func (m *MockEnvelopeKeyStore) DecryptDataKey(id string, wrapped []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptDataKey", id, wrapped)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptDataKey indicates an expected call of DecryptDataKey.
+func (mr *MockEnvelopeKeyStoreMockRecorder) DecryptDataKey(id, wrapped any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptDataKey", reflect.TypeOf((*MockEnvelopeKeyStore)(nil).DecryptDataKey), id, wrapped)
+}
+
+// GenerateDataKey mocks base method.
+func (m *MockEnvelopeKeyStore) GenerateDataKey(id string) ([]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDataKey", id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateDataKey indicates an expected call of GenerateDataKey.
+func (mr *MockEnvelopeKeyStoreMockRecorder) GenerateDataKey(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDataKey", reflect.TypeOf((*MockEnvelopeKeyStore)(nil).GenerateDataKey), id)
+}
+
+// GetKey mocks base method.
+func (m *MockEnvelopeKeyStore) GetKey(id string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKey", id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKey indicates an expected call of GetKey.
+func (mr *MockEnvelopeKeyStoreMockRecorder) GetKey(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockEnvelopeKeyStore)(nil).GetKey), id)
+}
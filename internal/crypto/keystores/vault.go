@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package keystores
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// vaultTokenEnv is the environment variable holding the Vault token if no
+// token file is configured
+const vaultTokenEnv = "VAULT_TOKEN"
+
+// errVaultForbidden is returned when Vault rejects the token
+var errVaultForbidden = errors.New("vault token rejected")
+
+// vaultKeyStore generates and unwraps data keys with the HashiCorp Vault
+// Transit secrets engine. Unwrapped data keys are cached in memory.
+type vaultKeyStore struct {
+	// localFileKeyStore holds the local fallback key, if any
+	*localFileKeyStore
+	client    *http.Client
+	mountURL  *url.URL
+	namespace string
+	dataKeys  *dataKeyCache
+
+	// tokenFile is re-read when Vault rejects the token, as Vault Agent
+	// rewrites it when the token is renewed or replaced
+	tokenFile string
+	mu        sync.RWMutex
+	token     string
+}
+
+var _ EnvelopeKeyStore = (*vaultKeyStore)(nil)
+
+func newVaultKeyStoreFromConfig(config serverconfig.CryptoConfig) (*vaultKeyStore, error) {
+	cfg := config.KeyStore.Vault
+	if cfg.Address == "" {
+		return nil, errors.New("vault address not defined in keystore config")
+	}
+	if cfg.Mount == "" {
+		return nil, errors.New("vault transit mount not defined in keystore config")
+	}
+
+	address, err := url.Parse(cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vault address: %w", err)
+	}
+
+	token, err := readVaultToken(cfg.TokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	fallback, err := newFallbackKeyStoreFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vaultKeyStore{
+		localFileKeyStore: fallback,
+		client:            &http.Client{Timeout: remoteKeyStoreTimeout},
+		mountURL:          address.JoinPath("v1", strings.Trim(cfg.Mount, "/")),
+		namespace:         cfg.Namespace,
+		dataKeys:          newDataKeyCache(dataKeyCacheSize),
+		tokenFile:         cfg.TokenFile,
+		token:             token,
+	}, nil
+}
+
+func readVaultToken(tokenFile string) (string, error) {
+	if tokenFile == "" {
+		token := os.Getenv(vaultTokenEnv)
+		if token == "" {
+			return "", fmt.Errorf("vault token file not defined in keystore config and %s not set", vaultTokenEnv)
+		}
+		return token, nil
+	}
+
+	token, err := os.ReadFile(filepath.Clean(tokenFile))
+	if err != nil {
+		return "", fmt.Errorf("failed to read vault token: %w", err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+func (v *vaultKeyStore) GenerateDataKey(id string) ([]byte, []byte, error) {
+	var resp struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	}
+	if err := v.post("datakey/plaintext/"+id, map[string]any{"bits": 256}, &resp); err != nil {
+		return nil, nil, fmt.Errorf("unable to generate data key with vault key %s: %w", id, err)
+	}
+
+	// Vault returns the data key base64 encoded
+	key, wrapped := []byte(resp.Plaintext), []byte(resp.Ciphertext)
+	v.dataKeys.put(id, wrapped, key)
+	return key, wrapped, nil
+}
+
+func (v *vaultKeyStore) DecryptDataKey(id string, wrapped []byte) ([]byte, error) {
+	if key, ok := v.dataKeys.get(id, wrapped); ok {
+		return key, nil
+	}
+
+	var resp struct {
+		Plaintext string `json:"plaintext"`
+	}
+	if err := v.post("decrypt/"+id, map[string]any{"ciphertext": string(wrapped)}, &resp); err != nil {
+		return nil, fmt.Errorf("unable to decrypt data key with vault key %s: %w", id, err)
+	}
+
+	key := []byte(resp.Plaintext)
+	v.dataKeys.put(id, wrapped, key)
+	return key, nil
+}
+
+// post sends a request to the Transit secrets engine, and decodes the data of
+// the response into out. If Vault rejects the token, the token file is read
+// again in case the token was replaced, and the request is retried once.
+func (v *vaultKeyStore) post(path string, body any, out any) error {
+	v.mu.RLock()
+	token := v.token
+	v.mu.RUnlock()
+
+	err := v.postWithToken(path, body, out, token)
+	if !errors.Is(err, errVaultForbidden) || v.tokenFile == "" {
+		return err
+	}
+
+	newToken, readErr := readVaultToken(v.tokenFile)
+	if readErr != nil {
+		return errors.Join(err, readErr)
+	}
+	if newToken == token {
+		return err
+	}
+
+	v.mu.Lock()
+	v.token = newToken
+	v.mu.Unlock()
+
+	return v.postWithToken(path, body, out, newToken)
+}
+
+func (v *vaultKeyStore) postWithToken(path string, body any, out any, token string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteKeyStoreTimeout)
+	defer cancel()
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, v.mountURL.JoinPath(path).String(), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []string        `json:"errors"`
+	}
+	// Vault responses are small, limit them to guard against misbehaving servers
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&respBody); err != nil {
+		return fmt.Errorf("unexpected response with status %d: %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("%w: unexpected response with status %d: %s",
+			errVaultForbidden, resp.StatusCode, strings.Join(respBody.Errors, "; "))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response with status %d: %s",
+			resp.StatusCode, strings.Join(respBody.Errors, "; "))
+	}
+
+	return json.Unmarshal(respBody.Data, out)
+}
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package keystores_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/crypto/keystores"
+	"github.com/mindersec/minder/pkg/config/server"
+)
+
+const (
+	vaultToken   = "s.test-token"
+	vaultKeyName = "minder"
+)
+
+// fakeVault is a stand-in for the Vault Transit secrets engine
+type fakeVault struct {
+	mu       sync.Mutex
+	wrapped  map[string]string
+	decrypts int
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Vault-Token") != vaultToken {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/v1/transit/datakey/plaintext/" + vaultKeyName:
+		// base64 of 32 bytes, as returned by Vault
+		plaintext := fmt.Sprintf("%044d", len(f.wrapped))[:43] + "="
+		ciphertext := fmt.Sprintf("vault:v1:%d", len(f.wrapped))
+		f.wrapped[ciphertext] = plaintext
+		writeVaultData(w, map[string]any{"plaintext": plaintext, "ciphertext": ciphertext})
+	case "/v1/transit/decrypt/" + vaultKeyName:
+		f.decrypts++
+		plaintext, ok := f.wrapped[body["ciphertext"].(string)]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid ciphertext"]}`))
+			return
+		}
+		writeVaultData(w, map[string]any{"plaintext": plaintext})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[]}`))
+	}
+}
+
+func writeVaultData(w http.ResponseWriter, data map[string]any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func vaultConfig(t *testing.T, address string, token string) server.CryptoConfig {
+	t.Helper()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(token+"\n"), 0600))
+
+	return server.CryptoConfig{
+		KeyStore: server.KeyStoreConfig{
+			Type: keystores.VaultKeyStore,
+			Local: server.LocalKeyStoreConfig{
+				KeyDir: "../testdata",
+			},
+			Vault: server.VaultKeyStoreConfig{
+				Address:   address,
+				Mount:     "transit",
+				TokenFile: tokenFile,
+			},
+		},
+		Default: server.DefaultCrypto{
+			KeyID: vaultKeyName,
+		},
+	}
+}
+
+func TestVaultKeyStore(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(&fakeVault{wrapped: map[string]string{}})
+	t.Cleanup(srv.Close)
+
+	keystore, err := keystores.NewKeyStoreFromConfig(vaultConfig(t, srv.URL, vaultToken))
+	require.NoError(t, err)
+	envelope, ok := keystore.(keystores.EnvelopeKeyStore)
+	require.True(t, ok)
+
+	key, wrapped, err := envelope.GenerateDataKey(vaultKeyName)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(wrapped), "vault:v1:"))
+
+	unwrapped, err := envelope.DecryptDataKey(vaultKeyName, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+
+	_, err = envelope.DecryptDataKey(vaultKeyName, []byte("vault:v1:unknown"))
+	require.ErrorContains(t, err, "invalid ciphertext")
+
+	_, _, err = envelope.GenerateDataKey("not-a-key")
+	require.ErrorContains(t, err, "unexpected response with status 404")
+
+	// the key never leaves vault
+	_, err = keystore.GetKey(vaultKeyName)
+	require.ErrorIs(t, err, keystores.ErrUnknownKeyID)
+}
+
+func TestVaultKeyStore_BadToken(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(&fakeVault{wrapped: map[string]string{}})
+	t.Cleanup(srv.Close)
+
+	keystore, err := keystores.NewKeyStoreFromConfig(vaultConfig(t, srv.URL, "s.wrong"))
+	require.NoError(t, err)
+
+	_, _, err = keystore.(keystores.EnvelopeKeyStore).GenerateDataKey(vaultKeyName)
+	require.ErrorContains(t, err, "permission denied")
+}
+
+func TestVaultKeyStore_RereadsToken(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(&fakeVault{wrapped: map[string]string{}})
+	t.Cleanup(srv.Close)
+
+	config := vaultConfig(t, srv.URL, "s.expired")
+	keystore, err := keystores.NewKeyStoreFromConfig(config)
+	require.NoError(t, err)
+	envelope := keystore.(keystores.EnvelopeKeyStore)
+
+	_, _, err = envelope.GenerateDataKey(vaultKeyName)
+	require.ErrorContains(t, err, "permission denied")
+
+	// Vault Agent writes the new token to the token file
+	require.NoError(t, os.WriteFile(config.KeyStore.Vault.TokenFile, []byte(vaultToken+"\n"), 0600))
+
+	_, _, err = envelope.GenerateDataKey(vaultKeyName)
+	require.NoError(t, err)
+}
+
+func TestVaultKeyStore_CachesDataKeys(t *testing.T) {
+	t.Parallel()
+
+	vault := &fakeVault{wrapped: map[string]string{}}
+	srv := httptest.NewServer(vault)
+	t.Cleanup(srv.Close)
+
+	keystore, err := keystores.NewKeyStoreFromConfig(vaultConfig(t, srv.URL, vaultToken))
+	require.NoError(t, err)
+	key, wrapped, err := keystore.(keystores.EnvelopeKeyStore).GenerateDataKey(vaultKeyName)
+	require.NoError(t, err)
+
+	// a new keystore, e.g. after a restart, unwraps the data key once
+	keystore, err = keystores.NewKeyStoreFromConfig(vaultConfig(t, srv.URL, vaultToken))
+	require.NoError(t, err)
+	for range 3 {
+		unwrapped, err := keystore.(keystores.EnvelopeKeyStore).DecryptDataKey(vaultKeyName, wrapped)
+		require.NoError(t, err)
+		require.Equal(t, key, unwrapped)
+	}
+
+	vault.mu.Lock()
+	defer vault.mu.Unlock()
+	require.Equal(t, 1, vault.decrypts)
+}
+
+func TestVaultKeyStore_Fallback(t *testing.T) {
+	t.Parallel()
+
+	config := vaultConfig(t, "http://127.0.0.1:8200", vaultToken)
+	config.Fallback.KeyID = "test_encryption_key"
+	keystore, err := keystores.NewKeyStoreFromConfig(config)
+	require.NoError(t, err)
+
+	// the local fallback key is still available to rotate old data
+	key, err := keystore.GetKey("test_encryption_key")
+	require.NoError(t, err)
+	require.NotEmpty(t, key)
+
+	fallback, err := keystore.GetKey("")
+	require.NoError(t, err)
+	require.Equal(t, key, fallback)
+
+	config.Fallback.KeyID = "not-a-valid-file"
+	_, err = keystores.NewKeyStoreFromConfig(config)
+	require.ErrorContains(t, err, "unable to read key")
+}
+
+func TestNewVaultKeyStoreFromConfig(t *testing.T) {
+	t.Parallel()
+
+	config := vaultConfig(t, "", vaultToken)
+	_, err := keystores.NewKeyStoreFromConfig(config)
+	require.ErrorContains(t, err, "vault address not defined in keystore config")
+
+	config = vaultConfig(t, "http://127.0.0.1:8200", vaultToken)
+	config.KeyStore.Vault.TokenFile = filepath.Join(t.TempDir(), "missing")
+	_, err = keystores.NewKeyStoreFromConfig(config)
+	require.ErrorContains(t, err, "failed to read vault token")
+}
@@ -20,6 +20,9 @@ type EncryptedData struct {
 	// An identifier which specifies the key used.
 	// Used to handle multiple keys during key rotation.
 	KeyVersion string
+	// The base64 encoded data key, wrapped by the key specified by KeyVersion.
+	// Only set when the data is envelope encrypted by a remote keystore.
+	WrappedKey string `json:",omitempty"`
 }
 
 // Serialize converts the contents to JSON.
@@ -48,3 +51,26 @@ func DeserializeEncryptedData(contents json.RawMessage) (EncryptedData, error) {
 	}
 	return data, nil
 }
+
+// EncryptAndSerialize encrypts a string with the default key and algorithm,
+// and serializes the result for storage in a JSONB column.
+func EncryptAndSerialize(engine Engine, plaintext string) (json.RawMessage, error) {
+	encrypted, err := engine.EncryptString(plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt data: %w", err)
+	}
+	return encrypted.Serialize()
+}
+
+// DeserializeAndDecrypt decrypts a string serialized by EncryptAndSerialize.
+func DeserializeAndDecrypt(engine Engine, contents json.RawMessage) (string, error) {
+	encrypted, err := DeserializeEncryptedData(contents)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := engine.DecryptString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt data: %w", err)
+	}
+	return plaintext, nil
+}
@@ -7,6 +7,7 @@ package datasources
 import (
 	"fmt"
 
+	"github.com/mindersec/minder/internal/datasources/graphql"
 	"github.com/mindersec/minder/internal/datasources/rest"
 	"github.com/mindersec/minder/internal/datasources/structured"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -14,8 +15,8 @@ import (
 )
 
 // BuildFromProtobuf is a factory function that builds a new data source based on the given
-// data source type.
-func BuildFromProtobuf(ds *minderv1.DataSource) (v1datasources.DataSource, error) {
+// data source type. The options are only used by REST and GraphQL data sources.
+func BuildFromProtobuf(ds *minderv1.DataSource, opts ...rest.Option) (v1datasources.DataSource, error) {
 	if ds == nil {
 		return nil, fmt.Errorf("data source is nil")
 	}
@@ -28,7 +29,9 @@ func BuildFromProtobuf(ds *minderv1.DataSource) (v1datasources.DataSource, error
 	case *minderv1.DataSource_Structured:
 		return structured.NewStructDataSource(ds.GetStructured())
 	case *minderv1.DataSource_Rest:
-		return rest.NewRestDataSource(ds.GetRest())
+		return rest.NewRestDataSource(ds.GetRest(), opts...)
+	case *minderv1.DataSource_Graphql:
+		return graphql.NewGraphQLDataSource(ds.GetGraphql(), opts...)
 	default:
 		return nil, fmt.Errorf("unknown data source type: %T", ds)
 	}
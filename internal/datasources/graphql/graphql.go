@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package graphql implements a GraphQL data source.
+//
+// Each function of the data source sends a query to a GraphQL API, with
+// variables taken from the arguments of the call. The requests are sent
+// as those of a REST data source, so they are authenticated, cached and
+// limited the same way.
+//
+// An example of the output is:
+//
+//	{
+//	  "data": {
+//	    "organization": {
+//	      "name": "mindersec"
+//	    }
+//	  },
+//	  "errors": []
+//	}
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mindersec/minder/internal/datasources/rest"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+)
+
+// requestField is the argument of the underlying REST functions holding
+// the GraphQL request
+const requestField = "request"
+
+type graphqlDataSource struct {
+	handlers map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef
+}
+
+// ensure that graphqlDataSource implements the v1datasources.DataSource interface
+var _ v1datasources.DataSource = (*graphqlDataSource)(nil)
+
+// GetFuncs implements the v1datasources.DataSource interface.
+func (g *graphqlDataSource) GetFuncs() map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef {
+	return g.handlers
+}
+
+// NewGraphQLDataSource builds a new GraphQL data source. The options
+// configure the REST data source sending the requests.
+func NewGraphQLDataSource(gql *minderv1.GraphQLDataSource, opts ...rest.Option) (v1datasources.DataSource, error) {
+	if gql == nil {
+		return nil, errors.New("graphql data source is nil")
+	}
+
+	if gql.GetDef() == nil {
+		return nil, errors.New("graphql data source definition is nil")
+	}
+
+	requests, err := rest.NewRestDataSource(restDataSourceFromGraphQL(gql), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build graphql requests: %w", err)
+	}
+
+	out := &graphqlDataSource{
+		handlers: make(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef, len(gql.GetDef())),
+	}
+
+	for key, handlerCfg := range gql.GetDef() {
+		funcKey := v1datasources.DataSourceFuncKey(key)
+		handler, err := newHandlerFromDef(handlerCfg, requests.GetFuncs()[funcKey])
+		if err != nil {
+			return nil, err
+		}
+
+		out.handlers[funcKey] = handler
+	}
+
+	return out, nil
+}
+
+// restDataSourceFromGraphQL returns the REST data source sending the
+// requests of the functions of gql. Each REST function posts the JSON
+// request passed in its requestField argument.
+func restDataSourceFromGraphQL(gql *minderv1.GraphQLDataSource) *minderv1.RestDataSource {
+	out := &minderv1.RestDataSource{
+		Def:    make(map[string]*minderv1.RestDataSource_Def, len(gql.GetDef())),
+		Cache:  gql.GetCache(),
+		Limits: gql.GetLimits(),
+	}
+
+	for key, def := range gql.GetDef() {
+		headers := make(map[string]string, len(def.GetHeaders())+2)
+		for k, v := range def.GetHeaders() {
+			headers[k] = v
+		}
+		headers["Content-Type"] = "application/json"
+		headers["Accept"] = "application/json"
+
+		out.Def[key] = &minderv1.RestDataSource_Def{
+			Endpoint: def.GetEndpoint(),
+			Method:   http.MethodPost,
+			Headers:  headers,
+			Body: &minderv1.RestDataSource_Def_BodyFromField{
+				BodyFromField: requestField,
+			},
+			Parse:   "json",
+			Auth:    def.GetAuth(),
+			Timeout: def.GetTimeout(),
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/itchyny/gojq"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/schemaupdate"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+// DefaultMaxPages is the number of pages fetched by paginated functions
+// whose definition does not set one
+const DefaultMaxPages = 10
+
+// ErrQueryFailed is returned when a query returns errors and no data
+var ErrQueryFailed = errors.New("graphql query failed")
+
+var _ v1datasources.DataSourceFuncDef = (*graphqlHandler)(nil)
+
+type graphqlHandler struct {
+	rawInputSchema *structpb.Struct
+	inputSchema    *jsonschema.Schema
+	query          string
+	operationName  string
+	// variables maps the query variables to the call arguments
+	variables map[string]string
+	// result is the jq expression selecting the returned value, or empty
+	// if the whole data is returned
+	result     string
+	pagination *pagination
+	// request sends the GraphQL request passed in the requestField argument
+	request v1datasources.DataSourceFuncDef
+}
+
+type pagination struct {
+	nodes          string
+	pageInfo       string
+	cursorVariable string
+	maxPages       int
+}
+
+func newHandlerFromDef(
+	def *minderv1.GraphQLDataSource_Def, request v1datasources.DataSourceFuncDef,
+) (*graphqlHandler, error) {
+	if def == nil {
+		return nil, errors.New("graphql data source handler definition is nil")
+	}
+	if request == nil {
+		return nil, errors.New("graphql data source handler has no request function")
+	}
+
+	// schema may be nil
+	schema, err := schemavalidate.CompileSchemaFromPB(def.GetInputSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	h := &graphqlHandler{
+		rawInputSchema: def.GetInputSchema(),
+		inputSchema:    schema,
+		query:          def.GetQuery(),
+		operationName:  def.GetOperationName(),
+		variables:      def.GetVariables(),
+		result:         def.GetResult(),
+		request:        request,
+	}
+
+	if h.result != "" {
+		if _, err := gojq.Parse(h.result); err != nil {
+			return nil, fmt.Errorf("cannot parse result: %w", err)
+		}
+	}
+
+	if pg := def.GetPagination(); pg != nil {
+		h.pagination = &pagination{
+			nodes:          pg.GetNodes(),
+			pageInfo:       pg.GetPageInfo(),
+			cursorVariable: pg.GetCursorVariable(),
+			maxPages:       int(pg.GetMaxPages()),
+		}
+		if h.pagination.maxPages == 0 {
+			h.pagination.maxPages = DefaultMaxPages
+		}
+		if _, err := gojq.Parse(h.pagination.nodes); err != nil {
+			return nil, fmt.Errorf("cannot parse pagination nodes: %w", err)
+		}
+		if _, err := gojq.Parse(h.pagination.pageInfo); err != nil {
+			return nil, fmt.Errorf("cannot parse pagination page_info: %w", err)
+		}
+	}
+
+	return h, nil
+}
+
+func (h *graphqlHandler) GetArgsSchema() *structpb.Struct {
+	return h.rawInputSchema
+}
+
+func (h *graphqlHandler) ValidateArgs(args any) error {
+	if h.inputSchema == nil {
+		return errors.New("input schema cannot be nil")
+	}
+
+	mapobj, ok := args.(map[string]any)
+	if !ok {
+		return errors.New("args is not a map")
+	}
+
+	return schemavalidate.ValidateAgainstSchema(h.inputSchema, mapobj)
+}
+
+func (h *graphqlHandler) ValidateUpdate(argsSchema *structpb.Struct) error {
+	if argsSchema == nil {
+		return errors.New("update schema cannot be nil")
+	}
+
+	if _, err := schemavalidate.CompileSchemaFromPB(argsSchema); err != nil {
+		return fmt.Errorf("update validation failed due to invalid schema: %w", err)
+	}
+	return schemaupdate.ValidateSchemaUpdate(h.rawInputSchema, argsSchema)
+}
+
+func (h *graphqlHandler) Call(ctx context.Context, ingest *interfaces.Result, args any) (any, error) {
+	argsMap, ok := args.(map[string]any)
+	if !ok {
+		return nil, errors.New("args is not a map")
+	}
+
+	variables := h.variablesFromArgs(argsMap)
+
+	data, gqlErrors, err := h.send(ctx, ingest, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.pagination != nil && data != nil {
+		if data, gqlErrors, err = h.fetchPages(ctx, ingest, variables, data, gqlErrors); err != nil {
+			return nil, err
+		}
+	}
+
+	var out any = data
+	if h.result != "" {
+		// the result is null when the expression selects nothing
+		out, _ = util.JQReadFrom[any](ctx, h.result, data)
+	}
+
+	return buildGraphQLOutput(out, gqlErrors), nil
+}
+
+// variablesFromArgs returns the variables of the query. Arguments which
+// are not set leave their variables unset, so that they take the default
+// values of the query.
+func (h *graphqlHandler) variablesFromArgs(args map[string]any) map[string]any {
+	if len(h.variables) == 0 {
+		return maps.Clone(args)
+	}
+
+	out := make(map[string]any, len(h.variables))
+	for variable, arg := range h.variables {
+		if value, ok := args[arg]; ok {
+			out[variable] = value
+		}
+	}
+	return out
+}
+
+// fetchPages fetches the pages following the first one, whose data is
+// given, and returns the first page with the nodes of all the pages.
+func (h *graphqlHandler) fetchPages(
+	ctx context.Context, ingest *interfaces.Result, variables map[string]any, first any, gqlErrors []any,
+) (any, []any, error) {
+	pg := h.pagination
+	nodes, err := pageNodes(ctx, pg.nodes, first)
+	if err != nil {
+		return nil, nil, err
+	}
+	if nodes == nil {
+		return first, gqlErrors, nil
+	}
+
+	data := first
+	for page := 1; page < pg.maxPages; page++ {
+		cursor, ok := nextCursor(ctx, pg.pageInfo, data)
+		if !ok {
+			break
+		}
+
+		variables = maps.Clone(variables)
+		variables[pg.cursorVariable] = cursor
+
+		var pageErrors []any
+		data, pageErrors, err = h.send(ctx, ingest, variables)
+		if err != nil {
+			return nil, nil, err
+		}
+		gqlErrors = append(gqlErrors, pageErrors...)
+		if data == nil {
+			break
+		}
+
+		more, err := pageNodes(ctx, pg.nodes, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		nodes = append(nodes, more...)
+	}
+
+	merged, err := util.JQSetPath(ctx, pg.nodes, first, nodes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot merge pages: %w", err)
+	}
+	return merged, gqlErrors, nil
+}
+
+// pageNodes returns the nodes of a page, or nil if the page has none
+func pageNodes(ctx context.Context, expr string, data any) ([]any, error) {
+	value, err := util.JQReadFrom[any](ctx, expr, data)
+	if err != nil || value == nil {
+		return nil, nil
+	}
+
+	nodes, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("pagination nodes %s is not a list", expr)
+	}
+	return nodes, nil
+}
+
+// nextCursor returns the cursor of the next page, if there is one
+func nextCursor(ctx context.Context, expr string, data any) (string, bool) {
+	pageInfo, err := util.JQReadFrom[map[string]any](ctx, expr, data)
+	if err != nil {
+		return "", false
+	}
+
+	if hasNext, _ := pageInfo["hasNextPage"].(bool); !hasNext {
+		return "", false
+	}
+	cursor, ok := pageInfo["endCursor"].(string)
+	return cursor, ok && cursor != ""
+}
+
+// send sends a single query, and returns the data and errors of the
+// response. It fails if the response has no data.
+func (h *graphqlHandler) send(
+	ctx context.Context, ingest *interfaces.Result, variables map[string]any,
+) (any, []any, error) {
+	req := map[string]any{
+		"query":     h.query,
+		"variables": variables,
+	}
+	if h.operationName != "" {
+		req["operationName"] = h.operationName
+	}
+
+	out, err := h.request.Call(ctx, ingest, map[string]any{requestField: req})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, ok := out.(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected graphql response type %T", out)
+	}
+
+	statusCode, _ := resp["status_code"].(int)
+	body, _ := resp["body"].(map[string]any)
+	if body == nil {
+		return nil, nil, fmt.Errorf("%w: unexpected response with status %d", ErrQueryFailed, statusCode)
+	}
+
+	gqlErrors, _ := body["errors"].([]any)
+	data := body["data"]
+	if data == nil {
+		if len(gqlErrors) > 0 {
+			return nil, nil, fmt.Errorf("%w: %s", ErrQueryFailed, errorMessages(gqlErrors))
+		}
+		if statusCode < 200 || statusCode > 299 {
+			return nil, nil, fmt.Errorf("%w: unexpected response with status %d", ErrQueryFailed, statusCode)
+		}
+	}
+
+	return data, gqlErrors, nil
+}
+
+func errorMessages(gqlErrors []any) string {
+	msgs := make([]string, 0, len(gqlErrors))
+	for _, e := range gqlErrors {
+		if obj, ok := e.(map[string]any); ok {
+			if msg, ok := obj["message"].(string); ok {
+				msgs = append(msgs, msg)
+				continue
+			}
+		}
+		msgs = append(msgs, fmt.Sprint(e))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func buildGraphQLOutput(data any, gqlErrors []any) any {
+	if gqlErrors == nil {
+		gqlErrors = []any{}
+	}
+
+	return map[string]any{
+		"data":   data,
+		"errors": gqlErrors,
+	}
+}
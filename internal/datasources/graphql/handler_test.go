@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+// fakeRequest answers the GraphQL requests with the given responses, in
+// order, and records the requests
+type fakeRequest struct {
+	responses []string
+	status    int
+	requests  []map[string]any
+}
+
+func (*fakeRequest) GetArgsSchema() *structpb.Struct       { return nil }
+func (*fakeRequest) ValidateArgs(any) error                { return nil }
+func (*fakeRequest) ValidateUpdate(*structpb.Struct) error { return nil }
+
+func (f *fakeRequest) Call(_ context.Context, _ *interfaces.Result, args any) (any, error) {
+	req := args.(map[string]any)[requestField].(map[string]any)
+	f.requests = append(f.requests, req)
+
+	var body any
+	if err := json.Unmarshal([]byte(f.responses[len(f.requests)-1]), &body); err != nil {
+		return nil, err
+	}
+	status := f.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return map[string]any{"status_code": status, "body": body}, nil
+}
+
+func testInputSchema(t *testing.T) *structpb.Struct {
+	t.Helper()
+
+	schema, err := structpb.NewStruct(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"org": map[string]any{"type": "string"},
+		},
+	})
+	require.NoError(t, err)
+	return schema
+}
+
+func TestGraphQLHandler_Call(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		def           *minderv1.GraphQLDataSource_Def
+		responses     []string
+		status        int
+		want          any
+		wantVariables []map[string]any
+		wantErr       bool
+	}{
+		{
+			name:          "arguments as variables",
+			def:           &minderv1.GraphQLDataSource_Def{Query: "query($org: String!) { organization(login: $org) { name } }"},
+			responses:     []string{`{"data":{"organization":{"name":"mindersec"}}}`},
+			want:          buildGraphQLOutput(map[string]any{"organization": map[string]any{"name": "mindersec"}}, nil),
+			wantVariables: []map[string]any{{"org": "mindersec"}},
+		},
+		{
+			name: "mapped variables and result",
+			def: &minderv1.GraphQLDataSource_Def{
+				Query:     "query($login: String!) { organization(login: $login) { name } }",
+				Variables: map[string]string{"login": "org"},
+				Result:    ".organization.name",
+			},
+			responses:     []string{`{"data":{"organization":{"name":"mindersec"}}}`},
+			want:          buildGraphQLOutput("mindersec", nil),
+			wantVariables: []map[string]any{{"login": "mindersec"}},
+		},
+		{
+			name: "missing result",
+			def: &minderv1.GraphQLDataSource_Def{
+				Query:  "query { viewer { login } }",
+				Result: ".organization.name",
+			},
+			responses:     []string{`{"data":{"viewer":{"login":"octocat"}}}`},
+			want:          buildGraphQLOutput(nil, nil),
+			wantVariables: []map[string]any{{"org": "mindersec"}},
+		},
+		{
+			name: "partial data with errors",
+			def:  &minderv1.GraphQLDataSource_Def{Query: "query { a b }"},
+			responses: []string{
+				`{"data":{"a":1,"b":null},"errors":[{"message":"b is forbidden"}]}`,
+			},
+			want: buildGraphQLOutput(map[string]any{"a": float64(1), "b": nil},
+				[]any{map[string]any{"message": "b is forbidden"}}),
+			wantVariables: []map[string]any{{"org": "mindersec"}},
+		},
+		{
+			name:      "errors without data",
+			def:       &minderv1.GraphQLDataSource_Def{Query: "query { a }"},
+			responses: []string{`{"data":null,"errors":[{"message":"bad query"}]}`},
+			wantErr:   true,
+		},
+		{
+			name:      "unexpected status",
+			def:       &minderv1.GraphQLDataSource_Def{Query: "query { a }"},
+			responses: []string{`{"message":"Bad credentials"}`},
+			status:    http.StatusUnauthorized,
+			wantErr:   true,
+		},
+		{
+			name: "pagination",
+			def: &minderv1.GraphQLDataSource_Def{
+				Query:     "query($org: String!, $after: String) { organization(login: $org) { teams(after: $after) { ... } } }",
+				Variables: map[string]string{"org": "org"},
+				Result:    ".organization.teams.nodes",
+				Pagination: &minderv1.GraphQLDataSource_Def_Pagination{
+					Nodes:          ".organization.teams.nodes",
+					PageInfo:       ".organization.teams.pageInfo",
+					CursorVariable: "after",
+				},
+			},
+			responses: []string{
+				`{"data":{"organization":{"teams":{"nodes":["a","b"],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}}`,
+				`{"data":{"organization":{"teams":{"nodes":["c"],"pageInfo":{"hasNextPage":true,"endCursor":"c2"}}}}}`,
+				`{"data":{"organization":{"teams":{"nodes":["d"],"pageInfo":{"hasNextPage":false,"endCursor":"c3"}}}}}`,
+			},
+			want: buildGraphQLOutput([]any{"a", "b", "c", "d"}, nil),
+			wantVariables: []map[string]any{
+				{"org": "mindersec"},
+				{"org": "mindersec", "after": "c1"},
+				{"org": "mindersec", "after": "c2"},
+			},
+		},
+		{
+			name: "pagination stops at max pages",
+			def: &minderv1.GraphQLDataSource_Def{
+				Query:  "query($after: String) { teams(after: $after) { ... } }",
+				Result: ".teams.nodes",
+				Pagination: &minderv1.GraphQLDataSource_Def_Pagination{
+					Nodes:          ".teams.nodes",
+					PageInfo:       ".teams.pageInfo",
+					CursorVariable: "after",
+					MaxPages:       2,
+				},
+			},
+			responses: []string{
+				`{"data":{"teams":{"nodes":["a"],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`,
+				`{"data":{"teams":{"nodes":["b"],"pageInfo":{"hasNextPage":true,"endCursor":"c2"}}}}`,
+			},
+			want: buildGraphQLOutput([]any{"a", "b"}, nil),
+			wantVariables: []map[string]any{
+				{"org": "mindersec"},
+				{"org": "mindersec", "after": "c1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.def.InputSchema = testInputSchema(t)
+			req := &fakeRequest{responses: tt.responses, status: tt.status}
+			h, err := newHandlerFromDef(tt.def, req)
+			require.NoError(t, err)
+
+			args := map[string]any{"org": "mindersec"}
+			require.NoError(t, h.ValidateArgs(args))
+
+			got, err := h.Call(context.Background(), nil, args)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrQueryFailed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			gotVariables := make([]map[string]any, 0, len(req.requests))
+			for _, r := range req.requests {
+				assert.Equal(t, tt.def.GetQuery(), r["query"])
+				gotVariables = append(gotVariables, r["variables"].(map[string]any))
+			}
+			assert.Equal(t, tt.wantVariables, gotVariables)
+		})
+	}
+}
+
+func TestNewGraphQLDataSource(t *testing.T) {
+	t.Parallel()
+
+	ds, err := NewGraphQLDataSource(&minderv1.GraphQLDataSource{
+		Def: map[string]*minderv1.GraphQLDataSource_Def{
+			"org": {
+				Endpoint:    "https://api.github.com/graphql",
+				Query:       "query { viewer { login } }",
+				InputSchema: testInputSchema(t),
+			},
+		},
+	})
+	require.NoError(t, err)
+	fn, ok := ds.GetFuncs()[v1datasources.DataSourceFuncKey("org")]
+	require.True(t, ok)
+	assert.Equal(t, testInputSchema(t).AsMap(), fn.GetArgsSchema().AsMap())
+
+	_, err = NewGraphQLDataSource(&minderv1.GraphQLDataSource{
+		Def: map[string]*minderv1.GraphQLDataSource_Def{
+			"org": {
+				Endpoint: "https://api.github.com/graphql",
+				Query:    "query { viewer { login } }",
+				Result:   ".viewer.login[",
+			},
+		},
+	})
+	assert.Error(t, err, "the result is not a valid jq expression")
+}
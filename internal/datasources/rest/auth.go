@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var (
+	// ErrSecretsUnavailable is returned when a definition references
+	// secrets, but the data source was built without a way to read them
+	ErrSecretsUnavailable = errors.New("secrets are not available to this data source")
+	// ErrProviderUnavailable is returned when a definition uses provider
+	// auth, but the entity provider cannot make REST requests
+	ErrProviderUnavailable = errors.New("provider credentials are not available to this data source")
+)
+
+func (h *restHandler) secret(ctx context.Context, name string) (string, error) {
+	if h.secrets == nil {
+		return "", ErrSecretsUnavailable
+	}
+
+	value, err := h.secrets(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("cannot read secret %q: %w", name, err)
+	}
+	return value, nil
+}
+
+// authenticate adds the credentials configured in the definition to the
+// request. Provider auth is handled by callWithProvider instead.
+func (h *restHandler) authenticate(ctx context.Context, req *http.Request) error {
+	if bearer := h.auth.GetBearer(); bearer != nil {
+		token, err := h.secret(ctx, bearer.GetSecret())
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if basic := h.auth.GetBasic(); basic != nil {
+		password, err := h.secret(ctx, basic.GetPasswordSecret())
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(basic.GetUsername(), password)
+	} else if apiKey := h.auth.GetApiKey(); apiKey != nil {
+		key, err := h.secret(ctx, apiKey.GetSecret())
+		if err != nil {
+			return err
+		}
+		if apiKey.GetHeader() != "" {
+			req.Header.Set(apiKey.GetHeader(), key)
+		} else {
+			query := req.URL.Query()
+			query.Set(apiKey.GetQueryParam(), key)
+			req.URL.RawQuery = query.Encode()
+		}
+	}
+
+	return nil
+}
+
+func (h *restHandler) usesProviderAuth() bool {
+	return h.auth.GetProvider() != nil
+}
+
+// providerForEndpoint returns the provider to send the request through.
+// The provider credentials are only sent to the API of the provider, so
+// that a rule type cannot leak them to an arbitrary host.
+func (h *restHandler) providerForEndpoint(endpoint string) (provinfv1.REST, error) {
+	if h.provider == nil {
+		return nil, ErrProviderUnavailable
+	}
+
+	restProvider, err := provinfv1.As[provinfv1.REST](h.provider)
+	if err != nil {
+		return nil, ErrProviderUnavailable
+	}
+
+	baseURL, err := url.Parse(restProvider.GetBaseURL())
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse provider base URL: %w", err)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse endpoint: %w", err)
+	}
+
+	if !strings.EqualFold(endpointURL.Host, baseURL.Host) || endpointURL.Scheme != baseURL.Scheme {
+		return nil, fmt.Errorf("endpoint %s is not on the provider API %s", endpointURL.Host, baseURL.Host)
+	}
+
+	return restProvider, nil
+}
+
+// transport returns the transport for the requests, with the client
+// certificate and CA certificates of the definition if it sets any.
+func (h *restHandler) transport(ctx context.Context) (http.RoundTripper, error) {
+	tlsConfig, err := h.tlsConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConfig == nil {
+		if h.testOnlyTransport != nil {
+			return h.testOnlyTransport, nil
+		}
+		return rego.LimitedDialer(nil), nil
+	}
+
+	if testTransport, ok := h.testOnlyTransport.(*http.Transport); ok {
+		transport := testTransport.Clone()
+		transport.TLSClientConfig = tlsConfig
+		return transport, nil
+	}
+
+	var transport *http.Transport
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	} else {
+		transport = &http.Transport{}
+	}
+	transport.TLSClientConfig = tlsConfig
+	return rego.LimitedDialer(transport), nil
+}
+
+func (h *restHandler) tlsConfig(ctx context.Context) (*tls.Config, error) {
+	if h.tls == nil {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if h.tls.GetClientCertSecret() != "" {
+		certPEM, err := h.secret(ctx, h.tls.GetClientCertSecret())
+		if err != nil {
+			return nil, err
+		}
+		keyPEM, err := h.secret(ctx, h.tls.GetClientKeySecret())
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("cannot load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if h.tls.GetCaCertSecret() != "" {
+		caPEM, err := h.secret(ctx, h.tls.GetCaCertSecret())
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caPEM)) {
+			return nil, errors.New("cannot load CA certificates")
+		}
+		cfg.RootCAs = pool
+	}
+
+	return cfg, nil
+}
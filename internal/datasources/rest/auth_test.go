@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/mock/gomock"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+func testSecrets(values map[string]string) SecretGetter {
+	return func(_ context.Context, name string) (string, error) {
+		value, ok := values[name]
+		if !ok {
+			return "", errors.New("secret not found")
+		}
+		return value, nil
+	}
+}
+
+func Test_restHandler_Call_Auth(t *testing.T) {
+	t.Parallel()
+
+	secrets := testSecrets(map[string]string{
+		"token":    "s3cr3t",
+		"password": "hunter2",
+	})
+
+	tests := []struct {
+		name       string
+		auth       *minderv1.RestDataSource_Def_Auth
+		secrets    SecretGetter
+		wantHeader http.Header
+		wantQuery  string
+		wantErr    bool
+	}{
+		{
+			name:       "no auth",
+			secrets:    secrets,
+			wantHeader: http.Header{},
+		},
+		{
+			name: "bearer token",
+			auth: &minderv1.RestDataSource_Def_Auth{
+				Method: &minderv1.RestDataSource_Def_Auth_Bearer_{
+					Bearer: &minderv1.RestDataSource_Def_Auth_Bearer{Secret: "token"},
+				},
+			},
+			secrets:    secrets,
+			wantHeader: http.Header{"Authorization": []string{"Bearer s3cr3t"}},
+		},
+		{
+			name: "basic auth",
+			auth: &minderv1.RestDataSource_Def_Auth{
+				Method: &minderv1.RestDataSource_Def_Auth_Basic_{
+					Basic: &minderv1.RestDataSource_Def_Auth_Basic{
+						Username:       "minder",
+						PasswordSecret: "password",
+					},
+				},
+			},
+			secrets:    secrets,
+			wantHeader: http.Header{"Authorization": []string{"Basic bWluZGVyOmh1bnRlcjI="}},
+		},
+		{
+			name: "api key header",
+			auth: &minderv1.RestDataSource_Def_Auth{
+				Method: &minderv1.RestDataSource_Def_Auth_ApiKey{
+					ApiKey: &minderv1.RestDataSource_Def_Auth_APIKey{Header: "X-API-Key", Secret: "token"},
+				},
+			},
+			secrets:    secrets,
+			wantHeader: http.Header{"X-Api-Key": []string{"s3cr3t"}},
+		},
+		{
+			name: "api key query parameter",
+			auth: &minderv1.RestDataSource_Def_Auth{
+				Method: &minderv1.RestDataSource_Def_Auth_ApiKey{
+					ApiKey: &minderv1.RestDataSource_Def_Auth_APIKey{QueryParam: "key", Secret: "token"},
+				},
+			},
+			secrets:    secrets,
+			wantHeader: http.Header{},
+			wantQuery:  "key=s3cr3t",
+		},
+		{
+			name: "missing secret",
+			auth: &minderv1.RestDataSource_Def_Auth{
+				Method: &minderv1.RestDataSource_Def_Auth_Bearer_{
+					Bearer: &minderv1.RestDataSource_Def_Auth_Bearer{Secret: "missing"},
+				},
+			},
+			secrets: secrets,
+			wantErr: true,
+		},
+		{
+			name: "secrets unavailable",
+			auth: &minderv1.RestDataSource_Def_Auth{
+				Method: &minderv1.RestDataSource_Def_Auth_Bearer_{
+					Bearer: &minderv1.RestDataSource_Def_Auth_Bearer{Secret: "token"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotHeader http.Header
+			var gotQuery string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotHeader = http.Header{}
+				for _, k := range []string{"Authorization", "X-Api-Key"} {
+					if v, ok := r.Header[k]; ok {
+						gotHeader[k] = v
+					}
+				}
+				gotQuery = r.URL.RawQuery
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			h := &restHandler{
+				endpointTmpl:      server.URL,
+				method:            http.MethodGet,
+				auth:              tt.auth,
+				secrets:           tt.secrets,
+				timeout:           DefaultTimeout,
+				testOnlyTransport: http.DefaultTransport,
+			}
+			initMetrics()
+
+			_, err := h.Call(context.Background(), nil, map[string]any{})
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, gotHeader, "the request should not have been sent")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHeader, gotHeader)
+			assert.Equal(t, tt.wantQuery, gotQuery)
+		})
+	}
+}
+
+//nolint:paralleltest // sets the global meter provider
+func Test_restHandler_Call_APIKeyNotInMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	h := &restHandler{
+		endpointTmpl: server.URL + "/scan",
+		method:       http.MethodGet,
+		auth: &minderv1.RestDataSource_Def_Auth{
+			Method: &minderv1.RestDataSource_Def_Auth_ApiKey{
+				ApiKey: &minderv1.RestDataSource_Def_Auth_APIKey{QueryParam: "key", Secret: "token"},
+			},
+		},
+		secrets:           testSecrets(map[string]string{"token": "s3cr3t"}),
+		timeout:           DefaultTimeout,
+		testOnlyTransport: http.DefaultTransport,
+	}
+	initMetrics()
+
+	_, err := h.Call(context.Background(), nil, map[string]any{})
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	var endpoints []string
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			hist, ok := m.Data.(metricdata.Histogram[int64])
+			if !ok || m.Name != "datasource.rest.latency" {
+				continue
+			}
+			for _, dp := range hist.DataPoints {
+				for _, attr := range dp.Attributes.ToSlice() {
+					assert.NotContains(t, attr.Value.Emit(), "s3cr3t")
+				}
+				if endpoint, ok := dp.Attributes.Value("endpoint"); ok {
+					endpoints = append(endpoints, endpoint.AsString())
+				}
+			}
+		}
+	}
+	assert.Contains(t, endpoints, server.URL+"/scan")
+}
+
+func Test_restHandler_Call_ProviderAuth(t *testing.T) {
+	t.Parallel()
+
+	providerAuth := &minderv1.RestDataSource_Def_Auth{
+		Method: &minderv1.RestDataSource_Def_Auth_Provider_{
+			Provider: &minderv1.RestDataSource_Def_Auth_Provider{},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		wantErr  bool
+	}{
+		{
+			name:     "endpoint on the provider API",
+			endpoint: "https://api.example.com/repos/owner/name",
+		},
+		{
+			name:     "endpoint on another host",
+			endpoint: "https://evil.example.com/collect",
+			wantErr:  true,
+		},
+		{
+			name:     "endpoint with another scheme",
+			endpoint: "http://api.example.com/repos/owner/name",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			prov := mockprov.NewMockREST(ctrl)
+			prov.EXPECT().GetBaseURL().Return("https://api.example.com/").AnyTimes()
+			if !tt.wantErr {
+				prov.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *http.Request) (*http.Response, error) {
+						assert.Equal(t, tt.endpoint, req.URL.String())
+						rec := httptest.NewRecorder()
+						_, _ = fmt.Fprint(rec, `{"ok":true}`)
+						resp := rec.Result()
+						resp.Request = req
+						return resp, nil
+					})
+			}
+
+			h := &restHandler{
+				endpointTmpl: tt.endpoint,
+				method:       http.MethodGet,
+				parse:        "json",
+				auth:         providerAuth,
+				provider:     prov,
+				timeout:      DefaultTimeout,
+			}
+			initMetrics()
+
+			got, err := h.Call(context.Background(), nil, map[string]any{})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, buildRestOutput(http.StatusOK, map[string]any{"ok": true}), got)
+		})
+	}
+}
+
+func Test_restHandler_Call_ProviderAuthUnavailable(t *testing.T) {
+	t.Parallel()
+
+	h := &restHandler{
+		endpointTmpl: "https://api.example.com/",
+		method:       http.MethodGet,
+		auth: &minderv1.RestDataSource_Def_Auth{
+			Method: &minderv1.RestDataSource_Def_Auth_Provider_{
+				Provider: &minderv1.RestDataSource_Def_Auth_Provider{},
+			},
+		},
+		timeout: DefaultTimeout,
+	}
+
+	_, err := h.Call(context.Background(), nil, map[string]any{})
+	require.ErrorIs(t, err, ErrProviderUnavailable)
+}
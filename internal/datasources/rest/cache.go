@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/puzpuzpuz/xsync/v3"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// DefaultCacheSize is the maximum size in bytes of the response bodies
+// cached by a SharedState
+const DefaultCacheSize = 64 << 20
+
+// SharedState holds the state which REST data sources share between
+// evaluations: the cached responses, the requests in flight, and the
+// request limiters of each data source. A nil SharedState disables
+// caching, coalescing and limits.
+type SharedState struct {
+	responses *responseCache
+	inflight  singleflight.Group
+	limiters  *xsync.MapOf[string, *limiter]
+}
+
+// NewSharedState creates a SharedState caching at most maxBytes of
+// response bodies.
+func NewSharedState(maxBytes int) *SharedState {
+	return &SharedState{
+		responses: newResponseCache(maxBytes),
+		limiters:  xsync.NewMapOf[string, *limiter](),
+	}
+}
+
+// limiter returns the limiter of a data source, replacing it if the
+// limits of the data source changed since it was created.
+func (s *SharedState) limiter(dataSourceID string, limits *minderv1.RestDataSource_Limits) *limiter {
+	if limits == nil {
+		s.limiters.Delete(dataSourceID)
+		return nil
+	}
+
+	l, _ := s.limiters.Compute(dataSourceID, func(old *limiter, loaded bool) (*limiter, bool) {
+		if loaded && proto.Equal(old.limits, limits) {
+			return old, false
+		}
+		return newLimiter(limits), false
+	})
+	return l
+}
+
+// response is a response read by a REST data source, before parsing
+type response struct {
+	statusCode   int
+	body         []byte
+	etag         string
+	lastModified string
+	// expires is when the response must be revalidated. It is only set
+	// for cached responses.
+	expires time.Time
+}
+
+func readResponse(resp *http.Response) (*response, error) {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxBytesLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &response{
+		statusCode:   resp.StatusCode,
+		body:         body,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+func (r *response) hasValidators() bool {
+	return r.etag != "" || r.lastModified != ""
+}
+
+// setConditionalHeaders makes req a conditional request which the server
+// answers with 304 Not Modified if the response did not change.
+func (r *response) setConditionalHeaders(req *http.Request) {
+	if r.etag != "" {
+		req.Header.Set("If-None-Match", r.etag)
+	}
+	if r.lastModified != "" {
+		req.Header.Set("If-Modified-Since", r.lastModified)
+	}
+}
+
+// cacheTTL returns how long a response may be served from the cache
+// under the given policy, and whether it may be cached at all. Responses
+// with a zero TTL are cached only to be revalidated.
+func cacheTTL(policy *minderv1.RestDataSource_Cache, resp *http.Response, r *response) (time.Duration, bool) {
+	if policy == nil || r.statusCode < 200 || r.statusCode > 299 {
+		return 0, false
+	}
+
+	ttl := policy.GetTtl().AsDuration()
+	if policy.GetHonorCacheControl() {
+		directives := parseCacheControl(resp.Header.Get("Cache-Control"))
+		if _, ok := directives["no-store"]; ok {
+			return 0, false
+		}
+		if _, ok := directives["no-cache"]; ok {
+			ttl = 0
+		} else if maxAge, ok := directives["max-age"]; ok {
+			if secs, err := strconv.Atoi(maxAge); err == nil && secs >= 0 {
+				ttl = time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	if ttl <= 0 && !r.hasValidators() {
+		return 0, false
+	}
+	return ttl, true
+}
+
+func parseCacheControl(header string) map[string]string {
+	directives := make(map[string]string)
+	for _, part := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name == "" {
+			continue
+		}
+		directives[strings.ToLower(name)] = strings.Trim(value, `"`)
+	}
+	return directives
+}
+
+// requestKey identifies the requests which get the same response: the
+// scope, usually the data source, and the method, URL, headers and body
+// of the request.
+func requestKey(scope string, req *http.Request, body []byte) string {
+	h := sha256.New()
+	_, _ = io.WriteString(h, req.Method+" "+req.URL.String()+"\n")
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		_, _ = io.WriteString(h, name+": "+strings.Join(req.Header.Values(name), ", ")+"\n")
+	}
+	_, _ = h.Write(body)
+
+	return scope + "/" + hex.EncodeToString(h.Sum(nil))
+}
+
+// responseCache is a least recently used cache of responses, bounded by
+// the size of their bodies
+type responseCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	size     int
+	entries  map[string]*list.Element
+	lru      *list.List
+}
+
+type cacheEntry struct {
+	key  string
+	resp *response
+}
+
+func newResponseCache(maxBytes int) *responseCache {
+	return &responseCache{
+		maxBytes: maxBytes,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// get returns the cached response for key, whether or not it expired
+func (c *responseCache) get(key string) *response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).resp
+}
+
+func (c *responseCache) put(key string, resp *response) {
+	if len(resp.body) > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.size -= len(elem.Value.(*cacheEntry).resp.body)
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, resp: resp})
+	c.size += len(resp.body)
+
+	for c.size > c.maxBytes {
+		oldest := c.lru.Back()
+		entry := oldest.Value.(*cacheEntry)
+		c.size -= len(entry.resp.body)
+		c.lru.Remove(oldest)
+		delete(c.entries, entry.key)
+	}
+}
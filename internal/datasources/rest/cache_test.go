@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func newCachingTestHandler(
+	endpoint string, state *SharedState, policy *minderv1.RestDataSource_Cache, limits *minderv1.RestDataSource_Limits,
+) *restHandler {
+	initMetrics()
+	return &restHandler{
+		endpointTmpl:      endpoint,
+		method:            http.MethodGet,
+		parse:             "json",
+		timeout:           DefaultTimeout,
+		testOnlyTransport: http.DefaultTransport,
+		cachePolicy:       policy,
+		state:             state,
+		scope:             "test",
+		limiter:           state.limiter("test", limits),
+	}
+}
+
+func Test_restHandler_Call_Cache(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		policy       *minderv1.RestDataSource_Cache
+		headers      map[string]string
+		wantRequests int32
+	}{
+		{
+			name:         "no cache policy",
+			wantRequests: 3,
+		},
+		{
+			name:         "cached for ttl",
+			policy:       &minderv1.RestDataSource_Cache{Ttl: durationpb.New(time.Minute)},
+			wantRequests: 1,
+		},
+		{
+			name:         "cache control ignored by default",
+			policy:       &minderv1.RestDataSource_Cache{Ttl: durationpb.New(time.Minute)},
+			headers:      map[string]string{"Cache-Control": "no-store"},
+			wantRequests: 1,
+		},
+		{
+			name: "no-store honored",
+			policy: &minderv1.RestDataSource_Cache{
+				Ttl:               durationpb.New(time.Minute),
+				HonorCacheControl: true,
+			},
+			headers:      map[string]string{"Cache-Control": "no-store"},
+			wantRequests: 3,
+		},
+		{
+			name:         "max-age honored",
+			policy:       &minderv1.RestDataSource_Cache{HonorCacheControl: true},
+			headers:      map[string]string{"Cache-Control": "public, max-age=60"},
+			wantRequests: 1,
+		},
+		{
+			name:         "not cached without ttl or max-age",
+			policy:       &minderv1.RestDataSource_Cache{HonorCacheControl: true},
+			wantRequests: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				for k, v := range tt.headers {
+					w.Header().Set(k, v)
+				}
+				_, _ = fmt.Fprint(w, `{"key":"value"}`)
+			}))
+			defer server.Close()
+
+			h := newCachingTestHandler(server.URL, NewSharedState(DefaultCacheSize), tt.policy, nil)
+
+			for range 3 {
+				got, err := h.Call(context.Background(), nil, map[string]any{})
+				require.NoError(t, err)
+				assert.Equal(t, buildRestOutput(http.StatusOK, map[string]any{"key": "value"}), got)
+			}
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
+
+func Test_restHandler_Call_CacheRevalidation(t *testing.T) {
+	t.Parallel()
+
+	var requests, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Cache-Control", "no-cache")
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"key":"value"}`)
+	}))
+	defer server.Close()
+
+	h := newCachingTestHandler(server.URL, NewSharedState(DefaultCacheSize),
+		&minderv1.RestDataSource_Cache{HonorCacheControl: true}, nil)
+
+	for range 3 {
+		got, err := h.Call(context.Background(), nil, map[string]any{})
+		require.NoError(t, err)
+		assert.Equal(t, buildRestOutput(http.StatusOK, map[string]any{"key": "value"}), got)
+	}
+	assert.Equal(t, int32(3), requests.Load())
+	assert.Equal(t, int32(2), notModified.Load())
+}
+
+func Test_restHandler_Call_Coalescing(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		<-release
+		_, _ = fmt.Fprint(w, `{"key":"value"}`)
+	}))
+	defer server.Close()
+
+	h := newCachingTestHandler(server.URL, NewSharedState(DefaultCacheSize), nil, nil)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := h.Call(context.Background(), nil, map[string]any{})
+			assert.NoError(t, err)
+			assert.Equal(t, buildRestOutput(http.StatusOK, map[string]any{"key": "value"}), got)
+		}()
+	}
+
+	// Give the calls time to join the request in flight
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func Test_restHandler_Call_MaxConcurrent(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		_, _ = fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	state := NewSharedState(DefaultCacheSize)
+	limits := &minderv1.RestDataSource_Limits{MaxConcurrent: 2}
+
+	var wg sync.WaitGroup
+	for i := range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Distinct endpoints, so that the requests are not coalesced
+			h := newCachingTestHandler(fmt.Sprintf("%s/%d", server.URL, i), state, nil, limits)
+			_, err := h.Call(context.Background(), nil, map[string]any{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestSharedState_limiter(t *testing.T) {
+	t.Parallel()
+
+	state := NewSharedState(DefaultCacheSize)
+	limits := &minderv1.RestDataSource_Limits{RequestsPerSecond: 10}
+
+	first := state.limiter("ds", limits)
+	require.NotNil(t, first)
+	assert.Same(t, first, state.limiter("ds", &minderv1.RestDataSource_Limits{RequestsPerSecond: 10}),
+		"unchanged limits should reuse the limiter")
+	assert.NotSame(t, first, state.limiter("ds", &minderv1.RestDataSource_Limits{RequestsPerSecond: 20}),
+		"changed limits should replace the limiter")
+	assert.Nil(t, state.limiter("ds", nil))
+}
+
+func TestNewRestDataSource_CacheScope(t *testing.T) {
+	t.Parallel()
+
+	state := NewSharedState(DefaultCacheSize)
+	project := uuid.New()
+	provider := uuid.New()
+
+	newScope := func(t *testing.T, auth *minderv1.RestDataSource_Def_Auth, opts ...Option) string {
+		t.Helper()
+		ds, err := NewRestDataSource(&minderv1.RestDataSource{
+			Def: map[string]*minderv1.RestDataSource_Def{
+				"get": {Endpoint: "https://api.github.com/repos", Auth: auth},
+			},
+		}, append([]Option{WithSharedState(state, "ds"), WithProject(project)}, opts...)...)
+		require.NoError(t, err)
+		handler, ok := ds.GetFuncs()["get"].(*restHandler)
+		require.True(t, ok)
+		return handler.scope
+	}
+
+	providerAuth := &minderv1.RestDataSource_Def_Auth{
+		Method: &minderv1.RestDataSource_Def_Auth_Provider_{
+			Provider: &minderv1.RestDataSource_Def_Auth_Provider{},
+		},
+	}
+
+	assert.Equal(t, "ds", newScope(t, nil, WithProvider(provider, nil)),
+		"responses without provider auth are shared between projects and providers")
+
+	scope := newScope(t, providerAuth, WithProvider(provider, nil))
+	assert.Equal(t, "ds/"+project.String()+"/"+provider.String(), scope)
+	assert.NotEqual(t, scope, newScope(t, providerAuth, WithProvider(uuid.New(), nil)),
+		"responses with provider auth are not shared between providers")
+}
+
+func Test_responseCache_eviction(t *testing.T) {
+	t.Parallel()
+
+	cache := newResponseCache(10)
+	cache.put("a", &response{body: []byte("aaaa")})
+	cache.put("b", &response{body: []byte("bbbb")})
+	// a is now the most recently used
+	require.NotNil(t, cache.get("a"))
+	cache.put("c", &response{body: []byte("cccc")})
+
+	assert.NotNil(t, cache.get("a"))
+	assert.Nil(t, cache.get("b"))
+	assert.NotNil(t, cache.get("c"))
+
+	// responses larger than the cache are not stored
+	cache.put("d", &response{body: []byte("ddddddddddd")})
+	assert.Nil(t, cache.get("d"))
+}
@@ -4,12 +4,14 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -23,24 +25,28 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/protobuf/types/known/structpb"
 
-	"github.com/mindersec/minder/internal/engine/eval/rego"
 	"github.com/mindersec/minder/internal/util"
 	"github.com/mindersec/minder/internal/util/schemaupdate"
 	"github.com/mindersec/minder/internal/util/schemavalidate"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
 const (
 	// MaxBytesLimit is the maximum number of bytes to read from the response body
 	// We limit to 1MB to prevent abuse
 	MaxBytesLimit int64 = 1 << 20
+
+	// DefaultTimeout is the timeout of requests whose definition does not set one
+	DefaultTimeout = 5 * time.Second
 )
 
 var (
 	metricsInit sync.Once
 
 	dataSourceLatencyHistogram metric.Int64Histogram
+	dataSourceCacheCounter     metric.Int64Counter
 )
 
 type restHandler struct {
@@ -55,8 +61,18 @@ type restHandler struct {
 	bodyFromInput bool
 	headers       map[string]string
 	parse         string
+	timeout       time.Duration
+	auth          *minderv1.RestDataSource_Def_Auth
+	tls           *minderv1.RestDataSource_Def_TLS
+	// set from the data source settings and options
+	secrets     SecretGetter
+	provider    provinfv1.Provider
+	cachePolicy *minderv1.RestDataSource_Cache
+	state       *SharedState
+	// scope is the part of the cache keys which identifies the data source
+	scope   string
+	limiter *limiter
 	// TODO implement fallback
-	// TODO implement auth
 }
 
 func initMetrics() {
@@ -71,6 +87,13 @@ func initMetrics() {
 		if err != nil {
 			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating histogram for data source requests failed")
 		}
+		dataSourceCacheCounter, err = meter.Int64Counter(
+			"datasource.rest.cache",
+			metric.WithDescription("Number of data source calls by cache result: hit, miss, revalidated or coalesced"),
+		)
+		if err != nil {
+			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating counter for data source cache results failed")
+		}
 	})
 }
 
@@ -87,6 +110,11 @@ func newHandlerFromDef(def *minderv1.RestDataSource_Def) (*restHandler, error) {
 
 	bodyFromInput, body := parseRequestBodyConfig(def)
 
+	timeout := DefaultTimeout
+	if def.GetTimeout() != nil {
+		timeout = def.GetTimeout().AsDuration()
+	}
+
 	initMetrics()
 
 	return &restHandler{
@@ -98,6 +126,9 @@ func newHandlerFromDef(def *minderv1.RestDataSource_Def) (*restHandler, error) {
 		body:           body,
 		bodyFromInput:  bodyFromInput,
 		parse:          def.GetParse(),
+		timeout:        timeout,
+		auth:           def.GetAuth(),
+		tls:            def.GetTls(),
 	}, nil
 }
 
@@ -140,24 +171,55 @@ func (h *restHandler) Call(ctx context.Context, _ *interfaces.Result, args any)
 		return nil, err
 	}
 
-	transport := h.testOnlyTransport
-	if transport == nil {
-		transport = rego.LimitedDialer(nil)
+	b, err := h.getBody(argsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.usesProviderAuth() {
+		return h.callWithProvider(ctx, expandedEndpoint, b)
+	}
+
+	transport, err := h.transport(ctx)
+	if err != nil {
+		return nil, err
 	}
 	// TODO: Add option to use custom client
 	cli := &http.Client{
-		// TODO: Make timeout configurable
-		Timeout: 5 * time.Second,
+		Timeout: h.timeout,
 		// Don't allow calling non-public addresses.
 		Transport: transport,
 	}
 
-	b, err := h.getBody(argsMap)
+	req, err := http.NewRequestWithContext(ctx, h.method, expandedEndpoint, b)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, h.method, expandedEndpoint, b)
+	for k, v := range h.headers {
+		req.Header.Add(k, v)
+	}
+
+	if err := h.authenticate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return h.doRequest(cli.Do, req)
+}
+
+// callWithProvider sends the request through the provider of the evaluated
+// entity, which adds its own credentials. The timeout covers the whole
+// call, as the provider client is not ours to configure.
+func (h *restHandler) callWithProvider(ctx context.Context, endpoint string, body io.Reader) (any, error) {
+	restProvider, err := h.providerForEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, h.method, endpoint, body)
 	if err != nil {
 		return nil, err
 	}
@@ -166,22 +228,111 @@ func (h *restHandler) Call(ctx context.Context, _ *interfaces.Result, args any)
 		req.Header.Add(k, v)
 	}
 
-	return h.doRequest(cli, req)
+	return h.doRequest(func(req *http.Request) (*http.Response, error) {
+		return restProvider.Do(req.Context(), req)
+	}, req)
 }
 
 func recordMetrics(ctx context.Context, resp *http.Response, start time.Time) {
 	attrs := []attribute.KeyValue{
 		attribute.String("method", resp.Request.Method),
-		attribute.String("endpoint", resp.Request.URL.String()),
+		endpointAttribute(resp.Request.URL),
 		attribute.String("status_code", fmt.Sprintf("%d", resp.StatusCode)),
 	}
 
 	dataSourceLatencyHistogram.Record(ctx, time.Since(start).Milliseconds(), metric.WithAttributes(attrs...))
 }
 
-func (h *restHandler) doRequest(cli *http.Client, req *http.Request) (any, error) {
+func recordCacheResult(ctx context.Context, req *http.Request, result string) {
+	attrs := []attribute.KeyValue{
+		attribute.String("method", req.Method),
+		endpointAttribute(req.URL),
+		attribute.String("result", result),
+	}
+
+	dataSourceCacheCounter.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+// endpointAttribute returns the metric attribute of the endpoint requested.
+// The query string and the user info are left out, as they may hold
+// credentials, e.g. API keys sent as query parameters.
+func endpointAttribute(u *url.URL) attribute.KeyValue {
+	endpoint := url.URL{
+		Scheme:  u.Scheme,
+		Host:    u.Host,
+		Path:    u.Path,
+		RawPath: u.RawPath,
+	}
+	return attribute.String("endpoint", endpoint.String())
+}
+
+func (h *restHandler) doRequest(do doFunc, req *http.Request) (any, error) {
+	resp, err := h.fetch(h.limiter.wrap(do), req)
+	if err != nil {
+		return nil, err
+	}
+
+	bout, err := h.parseResponseBody(bytes.NewReader(resp.body))
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: Handle fallback here.
+
+	return buildRestOutput(resp.statusCode, bout), nil
+}
+
+// fetch returns the response to req, from the cache if the data source
+// caches responses and holds a fresh one. Identical requests sent at the
+// same time, e.g. by the evaluations of many entities, share a single
+// response.
+func (h *restHandler) fetch(do doFunc, req *http.Request) (*response, error) {
+	if h.state == nil {
+		return h.send(do, req, "", nil)
+	}
+
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	key := requestKey(h.scope, req, body)
+
+	var cached *response
+	if h.cachePolicy != nil {
+		cached = h.state.responses.get(key)
+		if cached != nil && time.Now().Before(cached.expires) {
+			recordCacheResult(req.Context(), req, "hit")
+			return cached, nil
+		}
+	}
+
+	out, err, shared := h.state.inflight.Do(key, func() (any, error) {
+		return h.send(do, req, key, cached)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		recordCacheResult(req.Context(), req, "coalesced")
+	}
+	return out.(*response), nil
+}
+
+// send sends req and reads the response. If cached is set, the request is
+// made conditional, and cached is returned again if it did not change.
+// Responses are stored under key if the cache policy allows it.
+func (h *restHandler) send(do doFunc, req *http.Request, key string, cached *response) (*response, error) {
+	if err := h.limiter.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	defer h.limiter.release()
+
+	if cached != nil {
+		cached.setConditionalHeaders(req)
+	}
+
 	start := time.Now()
-	resp, err := retriableDo(cli, req)
+	resp, err := retriableDo(do, req)
 	if err != nil {
 		return nil, err
 	}
@@ -189,14 +340,44 @@ func (h *restHandler) doRequest(cli *http.Client, req *http.Request) (any, error
 
 	recordMetrics(req.Context(), resp, start)
 
-	bout, err := h.parseResponseBody(resp.Body)
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		revalidated := *cached
+		ttl, _ := cacheTTL(h.cachePolicy, resp, &revalidated)
+		revalidated.expires = time.Now().Add(ttl)
+		h.state.responses.put(key, &revalidated)
+		recordCacheResult(req.Context(), req, "revalidated")
+		return &revalidated, nil
+	}
+
+	out, err := readResponse(resp)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("cannot read response body: %w", err)
 	}
 
-	// TODO: Handle fallback here.
+	if h.cachePolicy != nil {
+		recordCacheResult(req.Context(), req, "miss")
+		if ttl, ok := cacheTTL(h.cachePolicy, resp, out); ok {
+			out.expires = time.Now().Add(ttl)
+			h.state.responses.put(key, out)
+		}
+	}
+
+	return out, nil
+}
+
+// requestBody returns a copy of the body of req, if it has one
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody == nil {
+		return nil, nil
+	}
 
-	return buildRestOutput(resp.StatusCode, bout), nil
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read request body: %w", err)
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
 }
 
 func (h *restHandler) getBody(args map[string]any) (io.Reader, error) {
@@ -295,13 +476,16 @@ func buildRestOutput(statusCode int, body any) any {
 	}
 }
 
-func retriableDo(cli *http.Client, req *http.Request) (*http.Response, error) {
+// doFunc sends an HTTP request, e.g. http.Client.Do
+type doFunc func(req *http.Request) (*http.Response, error)
+
+func retriableDo(do doFunc, req *http.Request) (*http.Response, error) {
 	var resp *http.Response
 	retryCount := 0
 
 	err := backoff.Retry(func() error {
 		var err error
-		resp, err = cli.Do(req)
+		resp, err = do(req)
 		if err != nil {
 			zerolog.Ctx(req.Context()).Debug().
 				Err(err).
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/time/rate"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// limiter limits the rate and concurrency of the requests of a data source
+type limiter struct {
+	limits *minderv1.RestDataSource_Limits
+	// rate is nil if the rate is not limited
+	rate *rate.Limiter
+	// slots is nil if the concurrency is not limited
+	slots chan struct{}
+}
+
+func newLimiter(limits *minderv1.RestDataSource_Limits) *limiter {
+	l := &limiter{
+		limits: limits,
+	}
+
+	if rps := limits.GetRequestsPerSecond(); rps > 0 {
+		burst := int(limits.GetBurst())
+		if burst == 0 {
+			burst = 1
+		}
+		l.rate = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+
+	if limits.GetMaxConcurrent() > 0 {
+		l.slots = make(chan struct{}, limits.GetMaxConcurrent())
+	}
+
+	return l
+}
+
+// acquire waits for a request slot. The caller must call release once
+// it is done with the response.
+func (l *limiter) acquire(ctx context.Context) error {
+	if l == nil || l.slots == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *limiter) release() {
+	if l == nil || l.slots == nil {
+		return
+	}
+	<-l.slots
+}
+
+// wrap returns a doFunc which waits for the rate limit before each
+// request, including retries.
+func (l *limiter) wrap(do doFunc) doFunc {
+	if l == nil || l.rate == nil {
+		return do
+	}
+
+	return func(req *http.Request) (*http.Response, error) {
+		if err := l.rate.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+		return do(req)
+	}
+}
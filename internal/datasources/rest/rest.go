@@ -22,14 +22,70 @@
 package rest
 
 import (
+	"context"
 	"errors"
 
+	"github.com/google/uuid"
+
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
+// SecretGetter returns the value of the named secret of the project
+// evaluating the data source.
+type SecretGetter func(ctx context.Context, name string) (string, error)
+
+// Option configures a REST data source
+type Option func(*restDataSource)
+
+// WithSecrets sets the function used to look up the secrets referenced by
+// the auth and TLS settings of the data source.
+func WithSecrets(getter SecretGetter) Option {
+	return func(r *restDataSource) {
+		r.secrets = getter
+	}
+}
+
+// WithProvider sets the provider of the evaluated entity, whose
+// credentials are used by definitions with provider auth. Responses to
+// requests sent with provider auth are only shared between the evaluations
+// with the same provider, as the credentials of providers differ.
+func WithProvider(providerID uuid.UUID, provider provinfv1.Provider) Option {
+	return func(r *restDataSource) {
+		r.providerID = providerID
+		r.provider = provider
+	}
+}
+
+// WithSharedState sets the state shared with the other data sources and
+// evaluations, which caches the responses and limits the requests of the
+// data source with the given ID. Without it, responses are not cached and
+// requests are not limited.
+func WithSharedState(state *SharedState, dataSourceID string) Option {
+	return func(r *restDataSource) {
+		r.state = state
+		r.id = dataSourceID
+	}
+}
+
+// WithProject sets the project evaluating the data source. Responses to
+// requests sent with provider auth are only shared within the project,
+// as the provider credentials may grant access to private data.
+func WithProject(projectID uuid.UUID) Option {
+	return func(r *restDataSource) {
+		r.project = projectID
+	}
+}
+
 type restDataSource struct {
-	handlers map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef
+	handlers   map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef
+	secrets    SecretGetter
+	providerID uuid.UUID
+	provider   provinfv1.Provider
+	state      *SharedState
+	id         string
+	project    uuid.UUID
 }
 
 // ensure that restDataSource implements the v1datasources.DataSource interface
@@ -41,7 +97,7 @@ func (r *restDataSource) GetFuncs() map[v1datasources.DataSourceFuncKey]v1dataso
 }
 
 // NewRestDataSource builds a new REST data source.
-func NewRestDataSource(rest *minderv1.RestDataSource) (v1datasources.DataSource, error) {
+func NewRestDataSource(rest *minderv1.RestDataSource, opts ...Option) (v1datasources.DataSource, error) {
 	if rest == nil {
 		return nil, errors.New("rest data source is nil")
 	}
@@ -53,12 +109,29 @@ func NewRestDataSource(rest *minderv1.RestDataSource) (v1datasources.DataSource,
 	out := &restDataSource{
 		handlers: make(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef, len(rest.GetDef())),
 	}
+	for _, opt := range opts {
+		opt(out)
+	}
+
+	var lim *limiter
+	if out.state != nil {
+		lim = out.state.limiter(out.id, rest.GetLimits())
+	}
 
 	for key, handlerCfg := range rest.GetDef() {
 		handler, err := newHandlerFromDef(handlerCfg)
 		if err != nil {
 			return nil, err
 		}
+		handler.secrets = out.secrets
+		handler.provider = out.provider
+		handler.cachePolicy = rest.GetCache()
+		handler.state = out.state
+		handler.limiter = lim
+		handler.scope = out.id
+		if handler.usesProviderAuth() {
+			handler.scope += "/" + out.project.String() + "/" + out.providerID.String()
+		}
 
 		out.handlers[v1datasources.DataSourceFuncKey(key)] = handler
 	}
@@ -4,10 +4,12 @@
 package service
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/mindersec/minder/internal/db"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -36,18 +38,28 @@ func dataSourceDBToProtobuf(ds db.DataSource, dsfuncs []db.DataSourcesFunction)
 	case v1datasources.DataSourceDriverStruct:
 		return dataSourceStructDBToProtobuf(outds, dsfuncs)
 	case v1datasources.DataSourceDriverRest:
-		return dataSourceRestDBToProtobuf(outds, dsfuncs)
+		return dataSourceRestDBToProtobuf(outds, ds.Settings, dsfuncs)
+	case v1datasources.DataSourceDriverGraphQL:
+		return dataSourceGraphQLDBToProtobuf(outds, ds.Settings, dsfuncs)
 	default:
 		return nil, fmt.Errorf("unknown data source type: %s", dsfType)
 	}
 }
 
-func dataSourceRestDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSourcesFunction) (*minderv1.DataSource, error) {
+func dataSourceRestDBToProtobuf(
+	ds *minderv1.DataSource, settings json.RawMessage, dsfuncs []db.DataSourcesFunction,
+) (*minderv1.DataSource, error) {
+	rest := &minderv1.RestDataSource{}
+	if len(settings) > 0 {
+		if err := protojson.Unmarshal(settings, rest); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data source settings: %w", err)
+		}
+	}
+
 	// At this point we have already validated that we have at least one function.
+	rest.Def = make(map[string]*minderv1.RestDataSource_Def, len(dsfuncs))
 	ds.Driver = &minderv1.DataSource_Rest{
-		Rest: &minderv1.RestDataSource{
-			Def: make(map[string]*minderv1.RestDataSource_Def, len(dsfuncs)),
-		},
+		Rest: rest,
 	}
 
 	for _, dsf := range dsfuncs {
@@ -63,6 +75,34 @@ func dataSourceRestDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSource
 	return ds, nil
 }
 
+func dataSourceGraphQLDBToProtobuf(
+	ds *minderv1.DataSource, settings json.RawMessage, dsfuncs []db.DataSourcesFunction,
+) (*minderv1.DataSource, error) {
+	graphql := &minderv1.GraphQLDataSource{}
+	if len(settings) > 0 {
+		if err := protojson.Unmarshal(settings, graphql); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data source settings: %w", err)
+		}
+	}
+
+	graphql.Def = make(map[string]*minderv1.GraphQLDataSource_Def, len(dsfuncs))
+	ds.Driver = &minderv1.DataSource_Graphql{
+		Graphql: graphql,
+	}
+
+	for _, dsf := range dsfuncs {
+		key := dsf.Name
+		dsfToParse := &minderv1.GraphQLDataSource_Def{}
+		if err := protojson.Unmarshal(dsf.Definition, dsfToParse); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data source definition for %s: %w", key, err)
+		}
+
+		graphql.Def[key] = dsfToParse
+	}
+
+	return ds, nil
+}
+
 func dataSourceStructDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSourcesFunction) (*minderv1.DataSource, error) {
 	structured := &minderv1.StructDataSource{
 		Def: make(map[string]*minderv1.StructDataSource_Def, len(dsfuncs)),
@@ -86,3 +126,34 @@ func dataSourceStructDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSour
 
 	return ds, nil
 }
+
+// dataSourceSettings serializes the driver settings of a data source which
+// apply to all its functions. These are stored as the driver message
+// without the function definitions, which are stored separately.
+func dataSourceSettings(ds *minderv1.DataSource) (json.RawMessage, error) {
+	var settings proto.Message
+	switch drv := ds.GetDriver().(type) {
+	case *minderv1.DataSource_Rest:
+		rest, ok := proto.Clone(drv.Rest).(*minderv1.RestDataSource)
+		if !ok {
+			return nil, errors.New("failed to copy REST data source")
+		}
+		rest.Def = nil
+		settings = rest
+	case *minderv1.DataSource_Graphql:
+		graphql, ok := proto.Clone(drv.Graphql).(*minderv1.GraphQLDataSource)
+		if !ok {
+			return nil, errors.New("failed to copy GraphQL data source")
+		}
+		graphql.Def = nil
+		settings = graphql
+	default:
+		return json.RawMessage("{}"), nil
+	}
+
+	out, err := protojson.Marshal(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data source settings: %w", err)
+	}
+	return out, nil
+}
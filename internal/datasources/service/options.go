@@ -7,11 +7,17 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/mindersec/minder/internal/db"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
 // Options is a struct that contains the options for a service call
 type Options struct {
 	qtx db.ExtendQuerier
+
+	// provider is the provider of the evaluated entity, used by REST
+	// data sources with provider auth.
+	providerID uuid.UUID
+	provider   provinfv1.Provider
 }
 
 // OptionsBuilder is a function that returns a new Options struct
@@ -28,6 +34,24 @@ func (o *Options) WithTransaction(qtx db.ExtendQuerier) *Options {
 	return o
 }
 
+// WithProvider sets the provider whose credentials REST data sources with
+// provider auth use. It is only used when building data source registries.
+func (o *Options) WithProvider(providerID uuid.UUID, provider provinfv1.Provider) *Options {
+	if o == nil {
+		return nil
+	}
+	o.providerID = providerID
+	o.provider = provider
+	return o
+}
+
+func (o *Options) getProvider() (uuid.UUID, provinfv1.Provider) {
+	if o == nil {
+		return uuid.Nil, nil
+	}
+	return o.providerID, o.provider
+}
+
 func (o *Options) getTransaction() db.ExtendQuerier {
 	if o == nil {
 		return nil
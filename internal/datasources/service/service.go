@@ -9,6 +9,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -16,8 +17,10 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/mindersec/minder/internal/datasources"
+	"github.com/mindersec/minder/internal/datasources/rest"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/marketplaces/namespaces"
+	"github.com/mindersec/minder/internal/secrets"
 	"github.com/mindersec/minder/internal/util"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
@@ -76,6 +79,15 @@ type DataSourcesService interface {
 type dataSourceService struct {
 	store db.Store
 
+	// secrets resolves the project secrets referenced by REST data sources.
+	// If nil, data sources which reference secrets fail when called.
+	secrets secrets.Resolver
+
+	// restState caches the responses and limits the requests of REST
+	// data sources. If nil, responses are not cached and requests are
+	// not limited.
+	restState *rest.SharedState
+
 	// This is a function that will begin a transaction for the service.
 	// We make this a function so that we can mock it in tests.
 	txBuilder func(d *dataSourceService, opts txGetter) (serviceTX, error)
@@ -96,6 +108,20 @@ func (d *dataSourceService) WithTransactionBuilder(txBuilder func(d *dataSourceS
 	d.txBuilder = txBuilder
 }
 
+// WithSecretResolver sets the resolver for the project secrets referenced
+// by the REST data sources in the registries built by the service.
+func (d *dataSourceService) WithSecretResolver(resolver secrets.Resolver) *dataSourceService {
+	d.secrets = resolver
+	return d
+}
+
+// WithRESTState sets the state which the REST data sources in the
+// registries built by the service share with other evaluations.
+func (d *dataSourceService) WithRESTState(state *rest.SharedState) *dataSourceService {
+	d.restState = state
+	return d
+}
+
 // Ensure that dataSourceService implements DataSourcesService.
 var _ DataSourcesService = (*dataSourceService)(nil)
 
@@ -226,12 +252,18 @@ func (d *dataSourceService) Create(
 		return nil, ErrDataSourceAlreadyExists
 	}
 
+	settings, err := dataSourceSettings(ds)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create data source record
 	dsRecord, err := tx.CreateDataSource(ctx, db.CreateDataSourceParams{
 		ProjectID:      projectID,
 		Name:           ds.GetName(),
 		DisplayName:    ds.GetName(),
 		SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: subscriptionID != uuid.Nil},
+		Settings:       settings,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create data source: %w", err)
@@ -308,10 +340,16 @@ func (d *dataSourceService) Update(
 		return nil, err
 	}
 
+	settings, err := dataSourceSettings(ds)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, err := tx.UpdateDataSource(ctx, db.UpdateDataSourceParams{
 		ID:          existingDS.ID,
 		ProjectID:   projectID,
 		DisplayName: ds.GetName(),
+		Settings:    settings,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to update data source: %w", err)
 	}
@@ -472,13 +510,27 @@ func (d *dataSourceService) BuildDataSourceRegistry(
 		return nil, fmt.Errorf("failed to get project hierarchy: %w", err)
 	}
 
+	restOpts := d.restOptions(projectHierarchy, opts)
+
 	for _, ref := range instantiations {
 		inst, err := d.instantiateDataSource(ctx, ref, projectHierarchy, tx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to instantiate data source: %w", err)
 		}
 
-		impl, err := datasources.BuildFromProtobuf(inst)
+		instOpts := slices.Clip(restOpts)
+		if d.secrets != nil {
+			owner, err := uuid.Parse(inst.GetContext().GetProjectId())
+			if err != nil {
+				return nil, fmt.Errorf("invalid project of data source %s: %w", inst.GetName(), err)
+			}
+			instOpts = append(instOpts, rest.WithSecrets(d.secretsOf(owner)))
+		}
+		if d.restState != nil {
+			instOpts = append(instOpts, rest.WithSharedState(d.restState, inst.GetId()))
+		}
+
+		impl, err := datasources.BuildFromProtobuf(inst, instOpts...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build data source from protobuf: %w", err)
 		}
@@ -491,6 +543,29 @@ func (d *dataSourceService) BuildDataSourceRegistry(
 	return reg, nil
 }
 
+// restOptions returns the options for the REST data sources of a rule type
+// evaluated in the first project of projectHierarchy.
+func (d *dataSourceService) restOptions(projectHierarchy []uuid.UUID, opts *Options) []rest.Option {
+	var out []rest.Option
+	if len(projectHierarchy) > 0 {
+		out = append(out, rest.WithProject(projectHierarchy[0]))
+	}
+	if providerID, provider := opts.getProvider(); provider != nil {
+		out = append(out, rest.WithProvider(providerID, provider))
+	}
+	return out
+}
+
+// secretsOf returns the function looking up the secrets of a data source
+// owned by project. Only the secrets of the owning project are used, so that
+// a data source defined in a child project cannot send the secrets of its
+// parents to the endpoints of its choice.
+func (d *dataSourceService) secretsOf(project uuid.UUID) rest.SecretGetter {
+	return func(ctx context.Context, name string) (string, error) {
+		return d.secrets.GetSecret(ctx, project, name)
+	}
+}
+
 // getDataSourceReferenceAlias gets the alias that the data source will be referred to by
 // in the registry.
 func getDataSourceReferenceAlias(dsr *minderv1.DataSourceReference) string {
@@ -544,6 +619,23 @@ func addDataSourceFunctions(
 				return fmt.Errorf("failed to create data source function: %w", err)
 			}
 		}
+	case *minderv1.DataSource_Graphql:
+		for name, def := range drv.Graphql.GetDef() {
+			defBytes, err := protojson.Marshal(def)
+			if err != nil {
+				return fmt.Errorf("failed to marshal GraphQL definition: %w", err)
+			}
+
+			if _, err := tx.AddDataSourceFunction(ctx, db.AddDataSourceFunctionParams{
+				DataSourceID: dsID,
+				ProjectID:    projectID,
+				Name:         name,
+				Type:         v1datasources.DataSourceDriverGraphQL,
+				Definition:   defBytes,
+			}); err != nil {
+				return fmt.Errorf("failed to create data source function: %w", err)
+			}
+		}
 	default:
 		return fmt.Errorf("unsupported data source driver type: %T", drv)
 	}
@@ -868,6 +868,77 @@ func TestBuildDataSourceRegistry(t *testing.T) {
 	}
 }
 
+// fakeSecretResolver records the projects secrets are looked up in
+type fakeSecretResolver struct {
+	projects []uuid.UUID
+}
+
+func (f *fakeSecretResolver) GetSecret(_ context.Context, project uuid.UUID, _ string) (string, error) {
+	f.projects = append(f.projects, project)
+	return "", errors.New("no secrets here")
+}
+
+func TestBuildDataSourceRegistry_SecretsOfOwningProject(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	childID := uuid.New()
+	parentID := uuid.New()
+	dsID := uuid.New()
+
+	mockStore.EXPECT().GetParentProjects(gomock.Any(), childID).Return([]uuid.UUID{childID, parentID}, nil)
+	mockStore.EXPECT().GetDataSourceByName(gomock.Any(), gomock.Any()).Return(db.DataSource{
+		ID:        dsID,
+		Name:      "parent_data_source",
+		ProjectID: parentID,
+	}, nil)
+	mockStore.EXPECT().ListDataSourceFunctions(gomock.Any(), gomock.Any()).
+		Return([]db.DataSourcesFunction{
+			{
+				ID:           uuid.New(),
+				DataSourceID: dsID,
+				ProjectID:    parentID,
+				Name:         "fetch",
+				Type:         string(v1.DataSourceDriverRest),
+				Definition: restDriverToJson(t, &minderv1.RestDataSource_Def{
+					Endpoint: "http://127.0.0.1:1/",
+					Auth: &minderv1.RestDataSource_Def_Auth{
+						Method: &minderv1.RestDataSource_Def_Auth_Bearer_{
+							Bearer: &minderv1.RestDataSource_Def_Auth_Bearer{Secret: "token"},
+						},
+					},
+				}),
+			},
+		}, nil)
+
+	resolver := &fakeSecretResolver{}
+	svc := NewDataSourceService(mockStore).WithSecretResolver(resolver)
+	svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
+		return &fakeTxBuilder{store: mockStore}, nil
+	}
+
+	reg, err := svc.BuildDataSourceRegistry(context.Background(), &minderv1.RuleType{
+		Context: &minderv1.Context{Project: ptr.Ptr(childID.String())},
+		Def: &minderv1.RuleType_Definition{
+			Eval: &minderv1.RuleType_Definition_Eval{
+				DataSources: []*minderv1.DataSourceReference{{Name: "parent_data_source"}},
+			},
+		},
+	}, &Options{})
+	require.NoError(t, err)
+
+	fn, ok := reg.GetFuncs()["parent_data_source.fetch"]
+	require.True(t, ok)
+	_, err = fn.Call(context.Background(), nil, map[string]any{})
+	require.Error(t, err)
+
+	// the secrets of the project owning the data source are used, not those
+	// of the project evaluating it
+	assert.Equal(t, []uuid.UUID{parentID}, resolver.projects)
+}
+
 type fakeTxBuilder struct {
 	store         db.Store
 	errorOnCommit bool
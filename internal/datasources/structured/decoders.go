@@ -4,20 +4,39 @@
 package structured
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
+	"github.com/magiconair/properties"
 	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/ini.v1"
 	"gopkg.in/yaml.v3"
 )
 
 var (
 	// ErrNoInput triggers if a decoder is called without input
 	ErrNoInput = errors.New("unable to decode, no input defined")
+
+	// ErrUnknownFormat triggers if Decode is called with a format
+	// without a decoder
+	ErrUnknownFormat = errors.New("unknown structured data format")
 )
 
+// Decode parses structured data in the given format, e.g. "json" or "hcl"
+func Decode(format string, r io.Reader) (any, error) {
+	d, ok := decoders[decoderType(format)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+	return d.Parse(r)
+}
+
 // This file contains various decoders that can be used to decode structured data
 
 // jsonDecoder decodes JSON data
@@ -75,3 +94,210 @@ func (*tomlDecoder) Parse(r io.Reader) (any, error) {
 func (*tomlDecoder) Extensions() []string {
 	return []string{"toml"}
 }
+
+// csvDecoder decodes CSV data with a header row into a list of objects
+// keyed by the column names
+type csvDecoder struct{}
+
+func (*csvDecoder) Parse(r io.Reader) (any, error) {
+	if r == nil {
+		return nil, ErrNoInput
+	}
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("decoding csv header: %w", err)
+	}
+
+	res := []any{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding csv data: %w", err)
+		}
+
+		row := make(map[string]any, len(header))
+		for i, name := range header {
+			row[name] = record[i]
+		}
+		res = append(res, row)
+	}
+	return res, nil
+}
+
+func (*csvDecoder) Extensions() []string {
+	return []string{"csv"}
+}
+
+// iniDecoder decodes INI data, e.g. setup.cfg or .editorconfig files. Keys
+// outside any section are at the top level, and the keys of each section
+// are in an object named after it. All values are strings.
+type iniDecoder struct{}
+
+func (*iniDecoder) Parse(r io.Reader) (any, error) {
+	if r == nil {
+		return nil, ErrNoInput
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading ini data: %w", err)
+	}
+
+	f, err := ini.LoadSources(ini.LoadOptions{
+		AllowPythonMultilineValues: true,
+		SpaceBeforeInlineComment:   true,
+	}, data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ini data: %w", err)
+	}
+
+	res := map[string]any{}
+	for _, section := range f.Sections() {
+		keys := res
+		if section.Name() != ini.DefaultSection {
+			keys = map[string]any{}
+			res[section.Name()] = keys
+		}
+		for _, key := range section.Keys() {
+			keys[key.Name()] = key.Value()
+		}
+	}
+	return res, nil
+}
+
+func (*iniDecoder) Extensions() []string {
+	return []string{"ini", "cfg", "editorconfig"}
+}
+
+// propertiesDecoder decodes Java .properties data into an object of
+// strings. References to other properties are not expanded.
+type propertiesDecoder struct{}
+
+func (*propertiesDecoder) Parse(r io.Reader) (any, error) {
+	if r == nil {
+		return nil, ErrNoInput
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading properties data: %w", err)
+	}
+
+	l := &properties.Loader{Encoding: properties.UTF8, DisableExpansion: true}
+	p, err := l.LoadBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding properties data: %w", err)
+	}
+
+	res := make(map[string]any, p.Len())
+	for k, v := range p.Map() {
+		res[k] = v
+	}
+	return res, nil
+}
+
+func (*propertiesDecoder) Extensions() []string {
+	return []string{"properties"}
+}
+
+// xmlDecoder decodes XML data into an object with the root element. Each
+// element is decoded as its text if it has no attributes or children, and
+// otherwise as an object with its attributes prefixed with "@", its text
+// as "#text", and its children by name. Repeated children are decoded as
+// a list.
+type xmlDecoder struct{}
+
+func (*xmlDecoder) Parse(r io.Reader) (any, error) {
+	if r == nil {
+		return nil, ErrNoInput
+	}
+	dec := xml.NewDecoder(r)
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("decoding xml data: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			root, err := decodeXMLElement(dec, start)
+			if err != nil {
+				return nil, fmt.Errorf("decoding xml data: %w", err)
+			}
+			return map[string]any{start.Name.Local: root}, nil
+		}
+	}
+}
+
+func decodeXMLElement(dec *xml.Decoder, start xml.StartElement) (any, error) {
+	elem := map[string]any{}
+	for _, attr := range start.Attr {
+		elem["@"+attr.Name.Local] = attr.Value
+	}
+
+	var text strings.Builder
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			child, err := decodeXMLElement(dec, t)
+			if err != nil {
+				return nil, err
+			}
+			name := t.Name.Local
+			switch existing := elem[name].(type) {
+			case nil:
+				elem[name] = child
+			case []any:
+				elem[name] = append(existing, child)
+			default:
+				elem[name] = []any{existing, child}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(elem) == 0 {
+				return content, nil
+			}
+			if content != "" {
+				elem["#text"] = content
+			}
+			return elem, nil
+		}
+	}
+}
+
+func (*xmlDecoder) Extensions() []string {
+	return []string{"xml"}
+}
+
+// hclDecoder decodes HCL native syntax, e.g. Terraform files
+type hclDecoder struct{}
+
+func (*hclDecoder) Parse(r io.Reader) (any, error) {
+	if r == nil {
+		return nil, ErrNoInput
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("reading hcl data: %w", err)
+	}
+
+	res, err := parseHCL(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("decoding hcl data: %w", err)
+	}
+	return res, nil
+}
+
+func (*hclDecoder) Extensions() []string {
+	return []string{"hcl", "tf", "tfvars"}
+}
@@ -5,6 +5,7 @@ package structured
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -108,3 +109,168 @@ func TestTomlDecoder(t *testing.T) {
 
 	}
 }
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		format  string
+		data    string
+		mustErr bool
+		expect  any
+	}{
+		{
+			name:   "csv",
+			format: "csv",
+			data:   "path,owner\n/docs,docs-team\n\"/src, lib\",core\n",
+			expect: []any{
+				map[string]any{"path": "/docs", "owner": "docs-team"},
+				map[string]any{"path": "/src, lib", "owner": "core"},
+			},
+		},
+		{
+			name:    "csv_wrong_field_count",
+			format:  "csv",
+			data:    "path,owner\n/docs\n",
+			mustErr: true,
+		},
+		{
+			name:   "ini",
+			format: "ini",
+			data: "root = true\n\n[*.go]\nindent_style = tab\n\n" +
+				"[options]\ninstall_requires =\n    requests\n    pyyaml\n",
+			expect: map[string]any{
+				"root":    "true",
+				"*.go":    map[string]any{"indent_style": "tab"},
+				"options": map[string]any{"install_requires": "\n    requests\n    pyyaml"},
+			},
+		},
+		{
+			name:   "properties",
+			format: "properties",
+			data:   "# comment\napp.name = minder\napp.title: ${app.name} server\n",
+			expect: map[string]any{
+				"app.name":  "minder",
+				"app.title": "${app.name} server",
+			},
+		},
+		{
+			name:   "xml",
+			format: "xml",
+			data: `<?xml version="1.0"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <groupId>org.example</groupId>
+  <dependencies>
+    <dependency scope="test"><artifactId>junit</artifactId></dependency>
+    <dependency><artifactId>guava</artifactId></dependency>
+  </dependencies>
+</project>`,
+			expect: map[string]any{
+				"project": map[string]any{
+					"@xmlns":  "http://maven.apache.org/POM/4.0.0",
+					"groupId": "org.example",
+					"dependencies": map[string]any{
+						"dependency": []any{
+							map[string]any{"@scope": "test", "artifactId": "junit"},
+							map[string]any{"artifactId": "guava"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid_xml",
+			format:  "xml",
+			data:    "<project><name>x</project>",
+			mustErr: true,
+		},
+		{
+			name:   "hcl",
+			format: "hcl",
+			data: `
+# Terraform configuration
+terraform {
+  required_version = ">= 1.0"
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket        = "logs-${var.env}"
+  acl           = var.acl // a reference
+  force_destroy = true
+  versions      = [1, 2.5]
+  tags = {
+    Name  = "logs"
+    "env" = local.env
+  }
+  policy = <<-EOT
+    {"Version": "2012-10-17"}
+  EOT
+
+  lifecycle { prevent_destroy = false }
+}
+
+resource "aws_s3_bucket" "assets" {
+  bucket = upper("assets")
+  names  = [for s in var.names : lower(s)]
+}
+`,
+			expect: map[string]any{
+				"terraform": []any{
+					map[string]any{"required_version": ">= 1.0"},
+				},
+				"resource": map[string]any{
+					"aws_s3_bucket": map[string]any{
+						"logs": []any{map[string]any{
+							"bucket":        "logs-${var.env}",
+							"acl":           "${var.acl}",
+							"force_destroy": true,
+							"versions":      []any{float64(1), 2.5},
+							"tags":          "${{\n    Name  = \"logs\"\n    \"env\" = local.env\n  }}",
+							"policy":        "{\"Version\": \"2012-10-17\"}\n",
+							"lifecycle": []any{
+								map[string]any{"prevent_destroy": false},
+							},
+						}},
+						"assets": []any{map[string]any{
+							"bucket": `${upper("assets")}`,
+							"names":  "${[for s in var.names : lower(s)]}",
+						}},
+					},
+				},
+			},
+		},
+		{
+			name:   "hcl_literal_object",
+			format: "hcl",
+			data:   "tags = { Name = \"logs\", \"env\" = \"prod\" }\nempty = []\n",
+			expect: map[string]any{
+				"tags":  map[string]any{"Name": "logs", "env": "prod"},
+				"empty": []any{},
+			},
+		},
+		{
+			name:    "hcl_unterminated_block",
+			format:  "hcl",
+			data:    "terraform {\n  required_version = \">= 1.0\"\n",
+			mustErr: true,
+		},
+		{
+			name:    "unknown_format",
+			format:  "docx",
+			data:    "",
+			mustErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := Decode(tc.format, strings.NewReader(tc.data))
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, res)
+		})
+	}
+}
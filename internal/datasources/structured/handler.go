@@ -23,9 +23,14 @@ import (
 )
 
 const (
-	jsonType decoderType = "json"
-	yamlType decoderType = "yaml"
-	tomlType decoderType = "toml"
+	jsonType       decoderType = "json"
+	yamlType       decoderType = "yaml"
+	tomlType       decoderType = "toml"
+	csvType        decoderType = "csv"
+	iniType        decoderType = "ini"
+	xmlType        decoderType = "xml"
+	hclType        decoderType = "hcl"
+	propertiesType decoderType = "properties"
 )
 
 type decoder interface {
@@ -37,11 +42,21 @@ type decoderType string
 
 // Catalog of decoders enabled by default
 var decoders = map[decoderType]decoder{
-	jsonType: &jsonDecoder{},
-	yamlType: &yamlDecoder{},
-	tomlType: &tomlDecoder{},
+	jsonType:       &jsonDecoder{},
+	yamlType:       &yamlDecoder{},
+	tomlType:       &tomlDecoder{},
+	csvType:        &csvDecoder{},
+	iniType:        &iniDecoder{},
+	xmlType:        &xmlDecoder{},
+	hclType:        &hclDecoder{},
+	propertiesType: &propertiesDecoder{},
 }
 
+// fallbackDecoders are tried, in order, on files whose extension has no
+// decoder or whose decoder failed. The formats which accept almost any
+// text, such as CSV or .properties, are only used by extension.
+var fallbackDecoders = []decoderType{jsonType, yamlType, tomlType, xmlType}
+
 var _ v1datasources.DataSourceFuncDef = (*structHandler)(nil)
 
 // ErrorNoFileMatchInPath triggers if the path specification can't match a
@@ -117,7 +132,7 @@ func parseFileAlternatives(fs billy.Filesystem, mainPath string, alternatives []
 func parseFile(f billy.File) (any, error) {
 	// Get the file extension, perhaps we can shortcut before trying
 	// to brute force through all decoders
-	ext := filepath.Ext(f.Name())
+	ext := strings.TrimPrefix(filepath.Ext(f.Name()), ".")
 	tried := map[decoderType]struct{}{}
 	for t, d := range decoders {
 		exts := d.Extensions()
@@ -134,10 +149,11 @@ func parseFile(f billy.File) (any, error) {
 	}
 
 	// no dice, try the rest of the decoders
-	for t, d := range decoders {
+	for _, t := range fallbackDecoders {
 		if _, ok := tried[t]; ok {
 			continue
 		}
+		d := decoders[t]
 		if _, err := f.Seek(0, 0); err != nil {
 			return nil, fmt.Errorf("unable to rewind file")
 		}
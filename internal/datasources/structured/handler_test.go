@@ -148,6 +148,53 @@ func TestParseFileAlternatives(t *testing.T) {
 	}
 }
 
+func TestParseFileByExtension(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		path   string
+		data   string
+		expect any
+	}{
+		{
+			name:   "properties",
+			path:   "app.properties",
+			data:   "name=minder\n",
+			expect: map[string]any{"name": "minder"},
+		},
+		{
+			name:   "editorconfig",
+			path:   ".editorconfig",
+			data:   "root = true\n",
+			expect: map[string]any{"root": "true"},
+		},
+		{
+			name:   "terraform",
+			path:   "main.tf",
+			data:   "region = var.region\n",
+			expect: map[string]any{"region": "${var.region}"},
+		},
+		{
+			name:   "unknown extension falls back",
+			path:   "config.txt",
+			data:   `{"a": "b"}`,
+			expect: map[string]any{"a": "b"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fs := memfs.New()
+			writeFSFile(t, fs, tc.path, []byte(tc.data))
+
+			res, err := parseFileAlternatives(fs, tc.path, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, res)
+		})
+	}
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	h, err := newHandlerFromDef(&minderv1.StructDataSource_Def{
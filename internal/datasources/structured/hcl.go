@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package structured
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// parseHCL parses the structure of a document in HCL native syntax.
+//
+// Attributes are decoded as their values when they are literals: strings,
+// numbers, booleans, null, and tuples and objects of literals. Any other
+// expression, e.g. a reference such as var.name or a function call, is
+// decoded as an interpolation string, e.g. "${var.name}". Quoted strings
+// with interpolations are decoded as is, e.g. "bucket-${var.env}".
+//
+// Blocks are decoded as nested objects keyed by their type and labels,
+// holding the list of the bodies of the blocks, e.g.
+//
+//	resource "aws_s3_bucket" "b" { bucket = "logs" }
+//
+// is decoded as
+//
+//	{"resource": {"aws_s3_bucket": {"b": [{"bucket": "logs"}]}}}
+func parseHCL(src []byte) (map[string]any, error) {
+	file, diags := hclsyntax.ParseConfig(src, "input.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("unexpected body type %T", file.Body)
+	}
+	return decodeHCLBody(body, src)
+}
+
+func decodeHCLBody(body *hclsyntax.Body, src []byte) (map[string]any, error) {
+	out := make(map[string]any, len(body.Attributes)+len(body.Blocks))
+	for name, attr := range body.Attributes {
+		out[name] = decodeHCLExpression(attr.Expr, src)
+	}
+
+	for _, block := range body.Blocks {
+		decoded, err := decodeHCLBody(block.Body, src)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := append([]string{block.Type}, block.Labels...)
+		node := out
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := node[key]
+			if !ok {
+				child = map[string]any{}
+				node[key] = child
+			}
+			if node, ok = child.(map[string]any); !ok {
+				return nil, fmt.Errorf("%s: block %q conflicts with attribute %q", block.DefRange(), block.Type, key)
+			}
+		}
+
+		last := keys[len(keys)-1]
+		switch existing := node[last].(type) {
+		case nil:
+			node[last] = []any{decoded}
+		case []any:
+			node[last] = append(existing, decoded)
+		default:
+			return nil, fmt.Errorf("%s: block %q conflicts with attribute %q", block.DefRange(), block.Type, last)
+		}
+	}
+	return out, nil
+}
+
+// decodeHCLExpression returns the value of an expression if it is a
+// literal, and its source as an interpolation otherwise
+func decodeHCLExpression(expr hclsyntax.Expression, src []byte) any {
+	// Without an evaluation context, references and function calls fail to
+	// evaluate, so only literals have a value.
+	if value, diags := expr.Value(nil); !diags.HasErrors() && value.IsWhollyKnown() {
+		return ctyToGo(value)
+	}
+
+	raw := string(expr.Range().SliceBytes(src))
+	switch expr.(type) {
+	case *hclsyntax.TemplateExpr, *hclsyntax.TemplateWrapExpr:
+		if len(raw) >= 2 && raw[0] == '"' && raw[len(raw)-1] == '"' {
+			return raw[1 : len(raw)-1]
+		}
+	}
+	return "${" + raw + "}"
+}
+
+func ctyToGo(value cty.Value) any {
+	if value.IsNull() {
+		return nil
+	}
+
+	ty := value.Type()
+	switch {
+	case ty == cty.String:
+		return value.AsString()
+	case ty == cty.Number:
+		f, _ := value.AsBigFloat().Float64()
+		return f
+	case ty == cty.Bool:
+		return value.True()
+	case ty.IsTupleType() || ty.IsListType() || ty.IsSetType():
+		out := make([]any, 0, value.LengthInt())
+		for it := value.ElementIterator(); it.Next(); {
+			_, elem := it.Element()
+			out = append(out, ctyToGo(elem))
+		}
+		return out
+	case ty.IsObjectType() || ty.IsMapType():
+		out := make(map[string]any, value.LengthInt())
+		for it := value.ElementIterator(); it.Next(); {
+			key, elem := it.Element()
+			out[key.AsString()] = ctyToGo(elem)
+		}
+		return out
+	}
+	return nil
+}
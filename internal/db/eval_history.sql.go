@@ -15,6 +15,44 @@ import (
 	"github.com/lib/pq"
 )
 
+const countEvaluationHistoryToPurge = `-- name: CountEvaluationHistoryToPurge :one
+
+WITH history AS (
+    SELECT s.id,
+           s.evaluation_time,
+           s.status,
+           LAG(s.status) OVER (PARTITION BY s.rule_entity_id ORDER BY s.evaluation_time) AS previous_status,
+           l.evaluation_history_id IS NOT NULL AS is_latest
+      FROM evaluation_statuses s
+           JOIN evaluation_rule_entities ere ON s.rule_entity_id = ere.id
+           JOIN rule_instances ri ON ere.rule_id = ri.id
+           LEFT JOIN latest_evaluation_statuses l
+	       ON l.rule_entity_id = s.rule_entity_id
+	       AND l.evaluation_history_id = s.id
+     WHERE ri.project_id = $3
+)
+SELECT COUNT(*)
+  FROM history
+ WHERE NOT is_latest
+   AND (evaluation_time < $1
+        OR ($2::boolean AND status = previous_status))
+`
+
+type CountEvaluationHistoryToPurgeParams struct {
+	Threshold       time.Time `json:"threshold"`
+	TransitionsOnly bool      `json:"transitions_only"`
+	ProjectID       uuid.UUID `json:"project_id"`
+}
+
+// CountEvaluationHistoryToPurge counts the records ListEvaluationHistoryToPurge
+// would mark to be purged, over the whole history in one go.
+func (q *Queries) CountEvaluationHistoryToPurge(ctx context.Context, arg CountEvaluationHistoryToPurgeParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countEvaluationHistoryToPurge, arg.Threshold, arg.TransitionsOnly, arg.ProjectID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const deleteEvaluationHistoryByIDs = `-- name: DeleteEvaluationHistoryByIDs :execrows
 DELETE FROM evaluation_statuses s
  WHERE s.id = ANY($1::uuid[])
@@ -431,55 +469,190 @@ func (q *Queries) ListEvaluationHistory(ctx context.Context, arg ListEvaluationH
 	return items, nil
 }
 
-const listEvaluationHistoryStaleRecords = `-- name: ListEvaluationHistoryStaleRecords :many
-SELECT s.evaluation_time,
-       s.id,
-       ere.rule_id,
-       -- entity type
-       ere.entity_type,
-       -- entity id
-       ere.entity_instance_id as entity_id
-  FROM evaluation_statuses s
-       JOIN evaluation_rule_entities ere ON s.rule_entity_id = ere.id
-       LEFT JOIN latest_evaluation_statuses l
-	   ON l.rule_entity_id = s.rule_entity_id
-	   AND l.evaluation_history_id = s.id
- WHERE s.evaluation_time < $1
-  -- the following predicate ensures we get only "stale" records
-   AND l.evaluation_history_id IS NULL
- -- listing from oldest to newest
- ORDER BY s.evaluation_time ASC, rule_id ASC, entity_id ASC
- LIMIT $2::integer
+const listEvaluationHistoryForReport = `-- name: ListEvaluationHistoryForReport :many
+
+WITH history AS (
+    SELECT s.rule_entity_id,
+           s.evaluation_time,
+           s.status,
+           ere.entity_type,
+           rt.name AS rule_type,
+           ri.name AS rule_name,
+           p.name AS profile_name,
+           -- failure streaks are delimited by successful evaluations
+           COUNT(*) FILTER (WHERE s.status = 'success') OVER (
+               PARTITION BY s.rule_entity_id
+               ORDER BY s.evaluation_time
+               ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+           ) AS streak
+      FROM evaluation_statuses s
+      JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+      JOIN rule_instances ri ON ere.rule_id = ri.id
+      JOIN rule_type rt ON ri.rule_type_id = rt.id
+      JOIN profiles p ON ri.profile_id = p.id
+      JOIN entity_instances ei ON ere.entity_instance_id = ei.id
+     WHERE ei.project_id = $1
+       AND s.evaluation_time < $2::timestamp without time zone
+       AND (s.evaluation_time >= $3::timestamp without time zone
+            OR s.id = (SELECT prev.id
+                         FROM evaluation_statuses prev
+                        WHERE prev.rule_entity_id = s.rule_entity_id
+                          AND prev.evaluation_time < $3::timestamp without time zone
+                        ORDER BY prev.evaluation_time DESC
+                        LIMIT 1))
+       -- inclusion filters
+       AND ($4::entities[] IS NULL OR ere.entity_type = ANY($4::entities[]))
+       AND ($5::text[] IS NULL OR ei.name = ANY($5::text[]))
+       AND ($6::text[] IS NULL OR p.name = ANY($6::text[]))
+       -- exclusion filters
+       AND ($7::entities[] IS NULL OR ere.entity_type != ALL($7::entities[]))
+       AND ($8::text[] IS NULL OR ei.name != ALL($8::text[]))
+       AND ($9::text[] IS NULL OR p.name != ALL($9::text[]))
+       -- implicit filter by profile labels
+       AND (($10::text[] IS NULL AND p.labels = array[]::text[]) -- include only unlabelled records
+	    OR (($10::text[] IS NOT NULL AND $10::text[] = array['*']::text[]) -- include all labels
+	        OR ($10::text[] IS NOT NULL AND p.labels && $10::text[]) -- include only specified labels
+	    )
+       )
+       AND ($11::text[] IS NULL OR NOT p.labels && $11::text[]) -- exclude only specified labels
+), streaks AS (
+    SELECT h.*,
+           MIN(h.evaluation_time) FILTER (WHERE h.status = 'failure') OVER (
+               PARTITION BY h.rule_entity_id, h.streak
+           ) AS failing_since
+      FROM history h
+), remediations AS (
+    SELECT date_trunc('day', st.evaluation_time) AS day,
+           st.profile_name,
+           st.rule_name,
+           st.rule_type,
+           st.entity_type,
+           COUNT(*) AS remediated,
+           AVG(EXTRACT(EPOCH FROM st.evaluation_time - st.failing_since)) AS mean_seconds
+      FROM streaks st
+     WHERE st.status = 'success'
+       AND st.failing_since IS NOT NULL
+       AND st.evaluation_time >= $3::timestamp without time zone
+     GROUP BY 1, 2, 3, 4, 5
+), posture AS (
+    -- the status of each rule and entity at the end of the days it was
+    -- evaluated, which holds until the next day it was evaluated
+    SELECT d.*,
+           LEAD(d.day) OVER (PARTITION BY d.rule_entity_id ORDER BY d.day) AS next_day
+      FROM (SELECT DISTINCT ON (h.rule_entity_id, date_trunc('day', h.evaluation_time))
+                   h.rule_entity_id,
+                   date_trunc('day', h.evaluation_time) AS day,
+                   h.status,
+                   h.profile_name,
+                   h.rule_name,
+                   h.rule_type,
+                   h.entity_type
+              FROM history h
+             ORDER BY h.rule_entity_id, date_trunc('day', h.evaluation_time), h.evaluation_time DESC) d
+), days AS (
+    SELECT g.day::timestamp without time zone AS day
+      FROM generate_series(
+               date_trunc('day', $3::timestamp without time zone),
+               $2::timestamp without time zone,
+               interval '1 day'
+           ) AS g(day)
+     WHERE g.day < $2::timestamp without time zone
+)
+SELECT days.day,
+       p.profile_name,
+       p.rule_name,
+       p.rule_type,
+       p.entity_type,
+       COUNT(*) FILTER (WHERE p.status = 'success') AS passing,
+       COUNT(*) FILTER (WHERE p.status = 'failure') AS failing,
+       COUNT(*) FILTER (WHERE p.status = 'error') AS errored,
+       COUNT(*) FILTER (WHERE p.status = 'skipped') AS skipped,
+       COALESCE(MAX(r.remediated), 0)::bigint AS remediated,
+       COALESCE(MAX(r.mean_seconds), 0)::float8 AS mean_remediation_seconds
+  FROM days
+  JOIN posture p ON p.day <= days.day AND (p.next_day IS NULL OR days.day < p.next_day)
+  LEFT JOIN remediations r
+    ON r.day = days.day
+   AND r.profile_name = p.profile_name
+   AND r.rule_name = p.rule_name
+   AND r.rule_type = p.rule_type
+   AND r.entity_type = p.entity_type
+ GROUP BY days.day, p.profile_name, p.rule_name, p.rule_type, p.entity_type
+ ORDER BY days.day, p.profile_name, p.rule_name, p.entity_type
 `
 
-type ListEvaluationHistoryStaleRecordsParams struct {
-	Threshold time.Time `json:"threshold"`
-	Size      int32     `json:"size"`
+type ListEvaluationHistoryForReportParams struct {
+	Projectid       uuid.UUID  `json:"projectid"`
+	Tots            time.Time  `json:"tots"`
+	Fromts          time.Time  `json:"fromts"`
+	Entitytypes     []Entities `json:"entitytypes"`
+	Entitynames     []string   `json:"entitynames"`
+	Profilenames    []string   `json:"profilenames"`
+	Notentitytypes  []Entities `json:"notentitytypes"`
+	Notentitynames  []string   `json:"notentitynames"`
+	Notprofilenames []string   `json:"notprofilenames"`
+	Labels          []string   `json:"labels"`
+	Notlabels       []string   `json:"notlabels"`
 }
 
-type ListEvaluationHistoryStaleRecordsRow struct {
-	EvaluationTime time.Time `json:"evaluation_time"`
-	ID             uuid.UUID `json:"id"`
-	RuleID         uuid.UUID `json:"rule_id"`
-	EntityType     Entities  `json:"entity_type"`
-	EntityID       uuid.UUID `json:"entity_id"`
+type ListEvaluationHistoryForReportRow struct {
+	Day                    time.Time `json:"day"`
+	ProfileName            string    `json:"profile_name"`
+	RuleName               string    `json:"rule_name"`
+	RuleType               string    `json:"rule_type"`
+	EntityType             Entities  `json:"entity_type"`
+	Passing                int64     `json:"passing"`
+	Failing                int64     `json:"failing"`
+	Errored                int64     `json:"errored"`
+	Skipped                int64     `json:"skipped"`
+	Remediated             int64     `json:"remediated"`
+	MeanRemediationSeconds float64   `json:"mean_remediation_seconds"`
 }
 
-func (q *Queries) ListEvaluationHistoryStaleRecords(ctx context.Context, arg ListEvaluationHistoryStaleRecordsParams) ([]ListEvaluationHistoryStaleRecordsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listEvaluationHistoryStaleRecords, arg.Threshold, arg.Size)
+// ListEvaluationHistoryForReport aggregates the evaluations of a project
+// into daily snapshots covering the [fromts, tots) time range, per profile,
+// rule and entity type.  Days are aligned to midnight UTC and the posture of
+// a day is the status of the latest evaluation of each rule and entity at
+// its end, or at tots for the last, possibly partial, day.  The last
+// evaluation preceding the range is included, so that the posture at the
+// beginning of the range is known.
+//
+// Time to remediation is measured from the first failing evaluation of a
+// streak, as far as the history above goes, to the next successful one.
+// Errored and skipped evaluations do not interrupt a streak.
+func (q *Queries) ListEvaluationHistoryForReport(ctx context.Context, arg ListEvaluationHistoryForReportParams) ([]ListEvaluationHistoryForReportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEvaluationHistoryForReport,
+		arg.Projectid,
+		arg.Tots,
+		arg.Fromts,
+		pq.Array(arg.Entitytypes),
+		pq.Array(arg.Entitynames),
+		pq.Array(arg.Profilenames),
+		pq.Array(arg.Notentitytypes),
+		pq.Array(arg.Notentitynames),
+		pq.Array(arg.Notprofilenames),
+		pq.Array(arg.Labels),
+		pq.Array(arg.Notlabels),
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListEvaluationHistoryStaleRecordsRow{}
+	items := []ListEvaluationHistoryForReportRow{}
 	for rows.Next() {
-		var i ListEvaluationHistoryStaleRecordsRow
+		var i ListEvaluationHistoryForReportRow
 		if err := rows.Scan(
-			&i.EvaluationTime,
-			&i.ID,
-			&i.RuleID,
+			&i.Day,
+			&i.ProfileName,
+			&i.RuleName,
+			&i.RuleType,
 			&i.EntityType,
-			&i.EntityID,
+			&i.Passing,
+			&i.Failing,
+			&i.Errored,
+			&i.Skipped,
+			&i.Remediated,
+			&i.MeanRemediationSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -494,6 +667,110 @@ func (q *Queries) ListEvaluationHistoryStaleRecords(ctx context.Context, arg Lis
 	return items, nil
 }
 
+const listEvaluationHistoryToPurge = `-- name: ListEvaluationHistoryToPurge :many
+
+WITH batch AS (
+    SELECT s.id,
+           s.rule_entity_id,
+           s.evaluation_time,
+           s.status
+      FROM evaluation_statuses s
+           JOIN evaluation_rule_entities ere ON s.rule_entity_id = ere.id
+           JOIN rule_instances ri ON ere.rule_id = ri.id
+     WHERE ri.project_id = $3
+       AND ($2::boolean OR s.evaluation_time < $1)
+       AND ($4::timestamp without time zone IS NULL
+            OR (s.evaluation_time, s.id) > ($4::timestamp without time zone, $5::uuid))
+     ORDER BY s.evaluation_time, s.id
+     LIMIT $6::integer
+), predecessors AS (
+    SELECT prev.*
+      FROM (SELECT DISTINCT rule_entity_id FROM batch) b
+           CROSS JOIN LATERAL (
+               SELECT p.id,
+                      p.rule_entity_id,
+                      p.evaluation_time,
+                      p.status
+                 FROM evaluation_statuses p
+                WHERE p.rule_entity_id = b.rule_entity_id
+                  AND (p.evaluation_time, p.id) <= ($4::timestamp without time zone, $5::uuid)
+                ORDER BY p.evaluation_time DESC, p.id DESC
+                LIMIT 1
+           ) prev
+), history AS (
+    SELECT h.*,
+           LAG(h.status) OVER (PARTITION BY h.rule_entity_id ORDER BY h.evaluation_time, h.id) AS previous_status
+      FROM (SELECT batch.*, true AS in_batch FROM batch
+            UNION ALL
+            SELECT predecessors.*, false AS in_batch FROM predecessors) h
+)
+SELECT h.id,
+       h.evaluation_time,
+       (l.evaluation_history_id IS NULL
+        AND (h.evaluation_time < $1
+             OR ($2::boolean AND COALESCE(h.status = h.previous_status, false))))::boolean AS purge
+  FROM history h
+       LEFT JOIN latest_evaluation_statuses l
+	   ON l.rule_entity_id = h.rule_entity_id
+	   AND l.evaluation_history_id = h.id
+ WHERE h.in_batch
+ ORDER BY h.evaluation_time, h.id
+`
+
+type ListEvaluationHistoryToPurgeParams struct {
+	Threshold       time.Time     `json:"threshold"`
+	TransitionsOnly bool          `json:"transitions_only"`
+	ProjectID       uuid.UUID     `json:"project_id"`
+	AfterTime       sql.NullTime  `json:"after_time"`
+	AfterID         uuid.NullUUID `json:"after_id"`
+	Size            int32         `json:"size"`
+}
+
+type ListEvaluationHistoryToPurgeRow struct {
+	ID             uuid.UUID `json:"id"`
+	EvaluationTime time.Time `json:"evaluation_time"`
+	Purge          bool      `json:"purge"`
+}
+
+// ListEvaluationHistoryToPurge lists a batch of evaluation history records
+// of a project, from oldest to newest, following the record identified by
+// after_time and after_id, and whether each of them is outside of its
+// retention policy, i.e. older than the threshold or, when transitions_only
+// is set, with the same status as the previous record for the same rule and
+// entity.  The latest record for any rule/entity pair is never purged.
+// Only the records of the batch and the last record preceding the batch for
+// each rule and entity are looked at, so that the cost of a batch does not
+// depend on the size of the history.
+func (q *Queries) ListEvaluationHistoryToPurge(ctx context.Context, arg ListEvaluationHistoryToPurgeParams) ([]ListEvaluationHistoryToPurgeRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEvaluationHistoryToPurge,
+		arg.Threshold,
+		arg.TransitionsOnly,
+		arg.ProjectID,
+		arg.AfterTime,
+		arg.AfterID,
+		arg.Size,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEvaluationHistoryToPurgeRow{}
+	for rows.Next() {
+		var i ListEvaluationHistoryToPurgeRow
+		if err := rows.Scan(&i.ID, &i.EvaluationTime, &i.Purge); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertLatestEvaluationStatus = `-- name: UpsertLatestEvaluationStatus :exec
 INSERT INTO latest_evaluation_statuses(
     rule_entity_id,
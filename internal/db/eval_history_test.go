@@ -962,6 +962,118 @@ func TestGetEvaluationHistory(t *testing.T) {
 	}
 }
 
+func TestListEvaluationHistoryForReport(t *testing.T) {
+	t.Parallel()
+
+	org := createRandomOrganization(t)
+	proj := createRandomProject(t, org.ID)
+	prov := createRandomProvider(t, proj.ID)
+	repo1 := createRandomRepository(t, proj.ID, prov)
+	repo2 := createRandomRepository(t, proj.ID, prov)
+	ruleType := createRandomRuleType(t, proj.ID)
+	profile := createRandomProfile(t, proj.ID, []string{})
+	riID := createRandomRuleInstance(t, proj.ID, profile.ID, ruleType.ID)
+	ruleInstances, err := testQueries.GetRuleInstancesForProfile(context.Background(), profile.ID)
+	require.NoError(t, err)
+	require.Len(t, ruleInstances, 1)
+	ere1 := createRandomEvaluationRuleEntity(t, riID, repo1.ID)
+	ere2 := createRandomEvaluationRuleEntity(t, riID, repo2.ID)
+
+	day := 24 * time.Hour
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(3 * day)
+
+	// these are not part of the report, except for the last one
+	// preceding the range which sets the initial status
+	createEvaluationStatusAt(t, ere1, EvalStatusTypesSuccess, from.Add(-3*day))
+	createEvaluationStatusAt(t, ere1, EvalStatusTypesFailure, from.Add(-2*day))
+	createEvaluationStatusAt(t, ere1, EvalStatusTypesSuccess, from.Add(day+time.Hour))
+	createEvaluationStatusAt(t, ere1, EvalStatusTypesFailure, to.Add(time.Hour))
+	createEvaluationStatusAt(t, ere2, EvalStatusTypesError, from.Add(time.Hour))
+	createEvaluationStatusAt(t, ere2, EvalStatusTypesSkipped, from.Add(2*time.Hour))
+
+	rows, err := testQueries.ListEvaluationHistoryForReport(
+		context.Background(),
+		ListEvaluationHistoryForReportParams{
+			Projectid: proj.ID,
+			Fromts:    from,
+			Tots:      to,
+		},
+	)
+	require.NoError(t, err)
+
+	row := func(d time.Time, passing, failing, skipped int64) ListEvaluationHistoryForReportRow {
+		return ListEvaluationHistoryForReportRow{
+			Day:         d,
+			ProfileName: profile.Name,
+			RuleName:    ruleInstances[0].Name,
+			RuleType:    ruleType.Name,
+			EntityType:  EntitiesRepository,
+			Passing:     passing,
+			Failing:     failing,
+			Skipped:     skipped,
+		}
+	}
+	remediated := row(from.Add(day), 1, 0, 1)
+	remediated.Remediated = 1
+	remediated.MeanRemediationSeconds = (3*day + time.Hour).Seconds()
+
+	for i := range rows {
+		rows[i].Day = rows[i].Day.UTC()
+	}
+	require.Equal(t, []ListEvaluationHistoryForReportRow{
+		row(from, 0, 1, 1),
+		remediated,
+		row(from.Add(2*day), 1, 0, 1),
+	}, rows)
+}
+
+func TestListEvaluationHistoryToPurge(t *testing.T) {
+	t.Parallel()
+
+	org := createRandomOrganization(t)
+	proj := createRandomProject(t, org.ID)
+	prov := createRandomProvider(t, proj.ID)
+	repo := createRandomRepository(t, proj.ID, prov)
+	ruleType := createRandomRuleType(t, proj.ID)
+	profile := createRandomProfile(t, proj.ID, []string{})
+	riID := createRandomRuleInstance(t, proj.ID, profile.ID, ruleType.ID)
+	ere := createRandomEvaluationRuleEntity(t, riID, repo.ID)
+
+	day := 24 * time.Hour
+	now := time.Now().UTC().Truncate(time.Second)
+	es1 := createEvaluationStatusAt(t, ere, EvalStatusTypesFailure, now.Add(-10*day))
+	es2 := createEvaluationStatusAt(t, ere, EvalStatusTypesFailure, now.Add(-9*day))
+	es3 := createEvaluationStatusAt(t, ere, EvalStatusTypesSuccess, now.Add(-2*day))
+	es4 := createEvaluationStatusAt(t, ere, EvalStatusTypesSuccess, now.Add(-day))
+
+	params := ListEvaluationHistoryToPurgeParams{
+		ProjectID:       proj.ID,
+		Threshold:       now.Add(-7 * day),
+		TransitionsOnly: true,
+		Size:            2,
+	}
+	rows, err := testQueries.ListEvaluationHistoryToPurge(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, es1, rows[0].ID)
+	require.True(t, rows[0].Purge)
+	require.Equal(t, es2, rows[1].ID)
+	require.True(t, rows[1].Purge)
+
+	// the next batch compares its first record with the last record of
+	// the previous one
+	params.AfterTime = sql.NullTime{Time: rows[1].EvaluationTime, Valid: true}
+	params.AfterID = uuid.NullUUID{UUID: rows[1].ID, Valid: true}
+	rows, err = testQueries.ListEvaluationHistoryToPurge(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, es3, rows[0].ID)
+	require.False(t, rows[0].Purge)
+	require.Equal(t, es4, rows[1].ID)
+	require.True(t, rows[1].Purge)
+}
+
 func fullRepoName(r Repository) string {
 	return fmt.Sprintf("%s/%s", r.RepoOwner, r.RepoName)
 }
@@ -1018,3 +1130,33 @@ func createRandomEvaluationStatus(
 
 	return esID
 }
+
+func createEvaluationStatusAt(
+	t *testing.T,
+	ereID uuid.UUID,
+	status EvalStatusTypes,
+	evaluatedAt time.Time,
+) uuid.UUID {
+	t.Helper()
+
+	esID, err := testQueries.InsertEvaluationStatus(
+		context.Background(),
+		InsertEvaluationStatusParams{
+			RuleEntityID: ereID,
+			Status:       status,
+			Details:      "",
+			Checkpoint:   json.RawMessage(`{}`),
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = testDB.ExecContext(
+		context.Background(),
+		"UPDATE evaluation_statuses SET evaluation_time = $1 WHERE id = $2",
+		evaluatedAt,
+		esID,
+	)
+	require.NoError(t, err)
+
+	return esID
+}
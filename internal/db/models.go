@@ -248,6 +248,7 @@ const (
 	ProviderClassGhcr      ProviderClass = "ghcr"
 	ProviderClassDockerhub ProviderClass = "dockerhub"
 	ProviderClassGitlab    ProviderClass = "gitlab"
+	ProviderClassGitea     ProviderClass = "gitea"
 )
 
 func (e *ProviderClass) Scan(src interface{}) error {
@@ -476,6 +477,15 @@ type AlertEvent struct {
 	CreatedAt    time.Time        `json:"created_at"`
 }
 
+type AlertWebhook struct {
+	ID                   uuid.UUID       `json:"id"`
+	ProjectID            uuid.UUID       `json:"project_id"`
+	Name                 string          `json:"name"`
+	EncryptedDestination json.RawMessage `json:"encrypted_destination"`
+	CreatedAt            time.Time       `json:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+}
+
 type Artifact struct {
 	ID                 uuid.UUID     `json:"id"`
 	RepositoryID       uuid.NullUUID `json:"repository_id"`
@@ -496,13 +506,14 @@ type Bundle struct {
 }
 
 type DataSource struct {
-	ID             uuid.UUID     `json:"id"`
-	Name           string        `json:"name"`
-	DisplayName    string        `json:"display_name"`
-	ProjectID      uuid.UUID     `json:"project_id"`
-	CreatedAt      time.Time     `json:"created_at"`
-	UpdatedAt      time.Time     `json:"updated_at"`
-	SubscriptionID uuid.NullUUID `json:"subscription_id"`
+	ID             uuid.UUID       `json:"id"`
+	Name           string          `json:"name"`
+	DisplayName    string          `json:"display_name"`
+	ProjectID      uuid.UUID       `json:"project_id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	SubscriptionID uuid.NullUUID   `json:"subscription_id"`
+	Settings       json.RawMessage `json:"settings"`
 }
 
 type DataSourcesFunction struct {
@@ -552,6 +563,11 @@ type EntityProfile struct {
 	Migrated        bool            `json:"migrated"`
 }
 
+type EntityReminder struct {
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	LastSent         time.Time `json:"last_sent"`
+}
+
 type EvaluationRuleEntity struct {
 	ID               uuid.UUID     `json:"id"`
 	RuleID           uuid.UUID     `json:"rule_id"`
@@ -593,18 +609,19 @@ type LatestEvaluationStatus struct {
 }
 
 type Profile struct {
-	ID             uuid.UUID      `json:"id"`
-	Name           string         `json:"name"`
-	Provider       sql.NullString `json:"provider"`
-	ProjectID      uuid.UUID      `json:"project_id"`
-	Remediate      NullActionType `json:"remediate"`
-	Alert          NullActionType `json:"alert"`
-	CreatedAt      time.Time      `json:"created_at"`
-	UpdatedAt      time.Time      `json:"updated_at"`
-	ProviderID     uuid.NullUUID  `json:"provider_id"`
-	SubscriptionID uuid.NullUUID  `json:"subscription_id"`
-	DisplayName    string         `json:"display_name"`
-	Labels         []string       `json:"labels"`
+	ID                 uuid.UUID      `json:"id"`
+	Name               string         `json:"name"`
+	Provider           sql.NullString `json:"provider"`
+	ProjectID          uuid.UUID      `json:"project_id"`
+	Remediate          NullActionType `json:"remediate"`
+	Alert              NullActionType `json:"alert"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	ProviderID         uuid.NullUUID  `json:"provider_id"`
+	SubscriptionID     uuid.NullUUID  `json:"subscription_id"`
+	DisplayName        string         `json:"display_name"`
+	Labels             []string       `json:"labels"`
+	EvaluationSchedule sql.NullString `json:"evaluation_schedule"`
 }
 
 type ProfileSelector struct {
@@ -642,6 +659,15 @@ type Project struct {
 	UpdatedAt      time.Time       `json:"updated_at"`
 }
 
+type ProjectSecret struct {
+	ID             uuid.UUID       `json:"id"`
+	ProjectID      uuid.UUID       `json:"project_id"`
+	Name           string          `json:"name"`
+	EncryptedValue json.RawMessage `json:"encrypted_value"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
 type Property struct {
 	ID        uuid.UUID       `json:"id"`
 	EntityID  uuid.UUID       `json:"entity_id"`
@@ -116,34 +116,46 @@ func (q *Queries) GetProfileStatusByProject(ctx context.Context, projectID uuid.
 	return items, nil
 }
 
-const listOldestRuleEvaluationsByRepositoryId = `-- name: ListOldestRuleEvaluationsByRepositoryId :many
+const listOldestRuleEvaluationsByEntityId = `-- name: ListOldestRuleEvaluationsByEntityId :many
 
-SELECT ere.entity_instance_id::uuid AS repository_id, MIN(es.evaluation_time)::timestamp AS oldest_last_updated
+SELECT ere.entity_instance_id::uuid AS entity_id,
+       p.id AS profile_id,
+       p.evaluation_schedule,
+       MIN(es.evaluation_time)::timestamp AS oldest_last_updated
 FROM evaluation_rule_entities AS ere
     INNER JOIN latest_evaluation_statuses AS les ON ere.id = les.rule_entity_id
     INNER JOIN evaluation_statuses AS es ON les.evaluation_history_id = es.id
+    INNER JOIN rule_instances AS ri ON ere.rule_id = ri.id
+    INNER JOIN profiles AS p ON ri.profile_id = p.id
 WHERE ere.entity_instance_id = ANY ($1::uuid[])
-AND ere.entity_type = 'repository'
-GROUP BY ere.entity_instance_id
+GROUP BY ere.entity_instance_id, p.id
 `
 
-type ListOldestRuleEvaluationsByRepositoryIdRow struct {
-	RepositoryID      uuid.UUID `json:"repository_id"`
-	OldestLastUpdated time.Time `json:"oldest_last_updated"`
+type ListOldestRuleEvaluationsByEntityIdRow struct {
+	EntityID           uuid.UUID      `json:"entity_id"`
+	ProfileID          uuid.UUID      `json:"profile_id"`
+	EvaluationSchedule sql.NullString `json:"evaluation_schedule"`
+	OldestLastUpdated  time.Time      `json:"oldest_last_updated"`
 }
 
-// ListOldestRuleEvaluationsByRepositoryId has casts in select statement as sqlc generates incorrect types.
+// ListOldestRuleEvaluationsByEntityId has casts in select statement as sqlc generates incorrect types.
 // cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
-func (q *Queries) ListOldestRuleEvaluationsByRepositoryId(ctx context.Context, repositoryIds []uuid.UUID) ([]ListOldestRuleEvaluationsByRepositoryIdRow, error) {
-	rows, err := q.db.QueryContext(ctx, listOldestRuleEvaluationsByRepositoryId, pq.Array(repositoryIds))
+// One row is returned for each entity and profile pair, along with the profile's evaluation schedule.
+func (q *Queries) ListOldestRuleEvaluationsByEntityId(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityIdRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOldestRuleEvaluationsByEntityId, pq.Array(entityIds))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListOldestRuleEvaluationsByRepositoryIdRow{}
+	items := []ListOldestRuleEvaluationsByEntityIdRow{}
 	for rows.Next() {
-		var i ListOldestRuleEvaluationsByRepositoryIdRow
-		if err := rows.Scan(&i.RepositoryID, &i.OldestLastUpdated); err != nil {
+		var i ListOldestRuleEvaluationsByEntityIdRow
+		if err := rows.Scan(
+			&i.EntityID,
+			&i.ProfileID,
+			&i.EvaluationSchedule,
+			&i.OldestLastUpdated,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 
 	"github.com/google/uuid"
@@ -23,7 +24,7 @@ WITH helper AS(
     WHERE pr.id = ANY($1::UUID[])
     GROUP BY pr.id
 )
-SELECT profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels,
+SELECT profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels, profiles.evaluation_schedule,
        helper.selectors::profile_selector[] AS profiles_with_selectors
 FROM profiles
 LEFT JOIN helper ON profiles.id = helper.profid
@@ -57,6 +58,7 @@ func (q *Queries) BulkGetProfilesByID(ctx context.Context, profileIds []uuid.UUI
 			&i.Profile.SubscriptionID,
 			&i.Profile.DisplayName,
 			pq.Array(&i.Profile.Labels),
+			&i.Profile.EvaluationSchedule,
 			pq.Array(&i.ProfilesWithSelectors),
 		); err != nil {
 			return nil, err
@@ -139,18 +141,23 @@ INSERT INTO profiles (
     name,
     subscription_id,
     display_name,
-    labels
-) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7::text[], '{}'::text[])) RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels
+    labels,
+    evaluation_schedule
+) VALUES (
+    $1, $2, $3, $4, $5, $6,
+    COALESCE($7::text[], '{}'::text[]), $8
+) RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, evaluation_schedule
 `
 
 type CreateProfileParams struct {
-	ProjectID      uuid.UUID      `json:"project_id"`
-	Remediate      NullActionType `json:"remediate"`
-	Alert          NullActionType `json:"alert"`
-	Name           string         `json:"name"`
-	SubscriptionID uuid.NullUUID  `json:"subscription_id"`
-	DisplayName    string         `json:"display_name"`
-	Labels         []string       `json:"labels"`
+	ProjectID          uuid.UUID      `json:"project_id"`
+	Remediate          NullActionType `json:"remediate"`
+	Alert              NullActionType `json:"alert"`
+	Name               string         `json:"name"`
+	SubscriptionID     uuid.NullUUID  `json:"subscription_id"`
+	DisplayName        string         `json:"display_name"`
+	Labels             []string       `json:"labels"`
+	EvaluationSchedule sql.NullString `json:"evaluation_schedule"`
 }
 
 func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (Profile, error) {
@@ -162,6 +169,7 @@ func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (P
 		arg.SubscriptionID,
 		arg.DisplayName,
 		pq.Array(arg.Labels),
+		arg.EvaluationSchedule,
 	)
 	var i Profile
 	err := row.Scan(
@@ -177,6 +185,7 @@ func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (P
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.EvaluationSchedule,
 	)
 	return i, err
 }
@@ -246,7 +255,7 @@ func (q *Queries) DeleteProfileForEntity(ctx context.Context, arg DeleteProfileF
 }
 
 const getProfileByID = `-- name: GetProfileByID :one
-SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels FROM profiles WHERE id = $1 AND project_id = $2
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, evaluation_schedule FROM profiles WHERE id = $1 AND project_id = $2
 `
 
 type GetProfileByIDParams struct {
@@ -270,12 +279,13 @@ func (q *Queries) GetProfileByID(ctx context.Context, arg GetProfileByIDParams)
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.EvaluationSchedule,
 	)
 	return i, err
 }
 
 const getProfileByIDAndLock = `-- name: GetProfileByIDAndLock :one
-SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels FROM profiles WHERE id = $1 AND project_id = $2 FOR UPDATE
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, evaluation_schedule FROM profiles WHERE id = $1 AND project_id = $2 FOR UPDATE
 `
 
 type GetProfileByIDAndLockParams struct {
@@ -299,12 +309,13 @@ func (q *Queries) GetProfileByIDAndLock(ctx context.Context, arg GetProfileByIDA
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.EvaluationSchedule,
 	)
 	return i, err
 }
 
 const getProfileByNameAndLock = `-- name: GetProfileByNameAndLock :one
-SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels FROM profiles WHERE lower(name) = lower($2) AND project_id = $1 FOR UPDATE
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, evaluation_schedule FROM profiles WHERE lower(name) = lower($2) AND project_id = $1 FOR UPDATE
 `
 
 type GetProfileByNameAndLockParams struct {
@@ -328,6 +339,7 @@ func (q *Queries) GetProfileByNameAndLock(ctx context.Context, arg GetProfileByN
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.EvaluationSchedule,
 	)
 	return i, err
 }
@@ -343,7 +355,7 @@ WITH helper AS(
     GROUP BY pr.id
 )
 SELECT
-    profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels,
+    profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels, profiles.evaluation_schedule,
     profiles_with_entity_profiles.id, profiles_with_entity_profiles.entity, profiles_with_entity_profiles.profile_id, profiles_with_entity_profiles.contextual_rules, profiles_with_entity_profiles.created_at, profiles_with_entity_profiles.updated_at, profiles_with_entity_profiles.profid,
     helper.selectors::profile_selector[] AS profiles_with_selectors
 FROM profiles
@@ -385,6 +397,7 @@ func (q *Queries) GetProfileByProjectAndID(ctx context.Context, arg GetProfileBy
 			&i.Profile.SubscriptionID,
 			&i.Profile.DisplayName,
 			pq.Array(&i.Profile.Labels),
+			&i.Profile.EvaluationSchedule,
 			&i.ProfilesWithEntityProfile.ID,
 			&i.ProfilesWithEntityProfile.Entity,
 			&i.ProfilesWithEntityProfile.ProfileID,
@@ -418,7 +431,7 @@ WITH helper AS(
     GROUP BY pr.id
 )
 SELECT
-    profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels,
+    profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels, profiles.evaluation_schedule,
     profiles_with_entity_profiles.id, profiles_with_entity_profiles.entity, profiles_with_entity_profiles.profile_id, profiles_with_entity_profiles.contextual_rules, profiles_with_entity_profiles.created_at, profiles_with_entity_profiles.updated_at, profiles_with_entity_profiles.profid,
     helper.selectors::profile_selector[] AS profiles_with_selectors
 FROM profiles
@@ -460,6 +473,7 @@ func (q *Queries) GetProfileByProjectAndName(ctx context.Context, arg GetProfile
 			&i.Profile.SubscriptionID,
 			&i.Profile.DisplayName,
 			pq.Array(&i.Profile.Labels),
+			&i.Profile.EvaluationSchedule,
 			&i.ProfilesWithEntityProfile.ID,
 			&i.ProfilesWithEntityProfile.Entity,
 			&i.ProfilesWithEntityProfile.ProfileID,
@@ -492,7 +506,7 @@ WITH helper AS(
       WHERE pr.project_id = $1
       GROUP BY pr.id
 )
-SELECT profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels,
+SELECT profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels, profiles.evaluation_schedule,
        profiles_with_entity_profiles.id, profiles_with_entity_profiles.entity, profiles_with_entity_profiles.profile_id, profiles_with_entity_profiles.contextual_rules, profiles_with_entity_profiles.created_at, profiles_with_entity_profiles.updated_at, profiles_with_entity_profiles.profid,
        helper.selectors::profile_selector[] AS profiles_with_selectors
 FROM profiles
@@ -549,6 +563,7 @@ func (q *Queries) ListProfilesByProjectIDAndLabel(ctx context.Context, arg ListP
 			&i.Profile.SubscriptionID,
 			&i.Profile.DisplayName,
 			pq.Array(&i.Profile.Labels),
+			&i.Profile.EvaluationSchedule,
 			&i.ProfilesWithEntityProfile.ID,
 			&i.ProfilesWithEntityProfile.Entity,
 			&i.ProfilesWithEntityProfile.ProfileID,
@@ -607,17 +622,19 @@ UPDATE profiles SET
     alert = $4,
     updated_at = NOW(),
     display_name = $5,
-    labels = COALESCE($6::TEXT[], '{}'::TEXT[])
-WHERE id = $1 AND project_id = $2 RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels
+    labels = COALESCE($6::TEXT[], '{}'::TEXT[]),
+    evaluation_schedule = $7
+WHERE id = $1 AND project_id = $2 RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, evaluation_schedule
 `
 
 type UpdateProfileParams struct {
-	ID          uuid.UUID      `json:"id"`
-	ProjectID   uuid.UUID      `json:"project_id"`
-	Remediate   NullActionType `json:"remediate"`
-	Alert       NullActionType `json:"alert"`
-	DisplayName string         `json:"display_name"`
-	Labels      []string       `json:"labels"`
+	ID                 uuid.UUID      `json:"id"`
+	ProjectID          uuid.UUID      `json:"project_id"`
+	Remediate          NullActionType `json:"remediate"`
+	Alert              NullActionType `json:"alert"`
+	DisplayName        string         `json:"display_name"`
+	Labels             []string       `json:"labels"`
+	EvaluationSchedule sql.NullString `json:"evaluation_schedule"`
 }
 
 func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error) {
@@ -628,6 +645,7 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) (P
 		arg.Alert,
 		arg.DisplayName,
 		pq.Array(arg.Labels),
+		arg.EvaluationSchedule,
 	)
 	var i Profile
 	err := row.Scan(
@@ -643,6 +661,7 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) (P
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.EvaluationSchedule,
 	)
 	return i, err
 }
@@ -368,6 +368,42 @@ func (q *Queries) GetRootProjectByID(ctx context.Context, id uuid.UUID) (Project
 	return i, err
 }
 
+const listAllProjects = `-- name: ListAllProjects :many
+SELECT id, name, is_organization, metadata, parent_id, created_at, updated_at FROM projects
+ORDER BY id
+`
+
+func (q *Queries) ListAllProjects(ctx context.Context) ([]Project, error) {
+	rows, err := q.db.QueryContext(ctx, listAllProjects)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Project{}
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.IsOrganization,
+			&i.Metadata,
+			&i.ParentID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAllRootProjects = `-- name: ListAllRootProjects :many
 SELECT id, name, is_organization, metadata, parent_id, created_at, updated_at FROM projects
 WHERE parent_id IS NULL AND is_organization = FALSE
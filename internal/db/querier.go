@@ -20,6 +20,9 @@ type Querier interface {
 	//
 	AddRuleTypeDataSourceReference(ctx context.Context, arg AddRuleTypeDataSourceReferenceParams) (RuleTypeDataSource, error)
 	BulkGetProfilesByID(ctx context.Context, profileIds []uuid.UUID) ([]BulkGetProfilesByIDRow, error)
+	// CountEvaluationHistoryToPurge counts the records ListEvaluationHistoryToPurge
+	// would mark to be purged, over the whole history in one go.
+	CountEvaluationHistoryToPurge(ctx context.Context, arg CountEvaluationHistoryToPurgeParams) (int64, error)
 	CountProfilesByEntityType(ctx context.Context) ([]CountProfilesByEntityTypeRow, error)
 	CountProfilesByName(ctx context.Context, name string) (int64, error)
 	CountProfilesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
@@ -53,6 +56,7 @@ type Querier interface {
 	// Subscriptions --
 	CreateSubscription(ctx context.Context, arg CreateSubscriptionParams) (Subscription, error)
 	CreateUser(ctx context.Context, identitySubject string) (User, error)
+	DeleteAlertWebhook(ctx context.Context, arg DeleteAlertWebhookParams) (AlertWebhook, error)
 	DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error
 	DeleteArtifact(ctx context.Context, id uuid.UUID) error
 	DeleteDataSource(ctx context.Context, arg DeleteDataSourceParams) (DataSource, error)
@@ -73,6 +77,7 @@ type Querier interface {
 	DeleteProfile(ctx context.Context, arg DeleteProfileParams) error
 	DeleteProfileForEntity(ctx context.Context, arg DeleteProfileForEntityParams) error
 	DeleteProject(ctx context.Context, id uuid.UUID) ([]DeleteProjectRow, error)
+	DeleteProjectSecret(ctx context.Context, arg DeleteProjectSecretParams) (ProjectSecret, error)
 	DeleteProperty(ctx context.Context, arg DeletePropertyParams) error
 	DeleteProvider(ctx context.Context, arg DeleteProviderParams) error
 	DeleteRepository(ctx context.Context, id uuid.UUID) error
@@ -84,6 +89,7 @@ type Querier interface {
 	DeleteSessionStateByProjectID(ctx context.Context, arg DeleteSessionStateByProjectIDParams) error
 	DeleteUser(ctx context.Context, id int32) error
 	EnqueueFlush(ctx context.Context, arg EnqueueFlushParams) (FlushCache, error)
+	EntityExistsAfterID(ctx context.Context, arg EntityExistsAfterIDParams) (bool, error)
 	// FindProviders allows us to take a trait and filter
 	// providers by it. It also optionally takes a name, in case we want to
 	// filter by name as well.
@@ -93,6 +99,7 @@ type Querier interface {
 	GetAccessTokenByProjectID(ctx context.Context, arg GetAccessTokenByProjectIDParams) (ProviderAccessToken, error)
 	GetAccessTokenByProvider(ctx context.Context, provider string) ([]ProviderAccessToken, error)
 	GetAccessTokenSinceDate(ctx context.Context, arg GetAccessTokenSinceDateParams) (ProviderAccessToken, error)
+	GetAlertWebhookByName(ctx context.Context, arg GetAlertWebhookByNameParams) (AlertWebhook, error)
 	GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]Property, error)
 	GetArtifactByID(ctx context.Context, arg GetArtifactByIDParams) (Artifact, error)
 	GetArtifactByName(ctx context.Context, arg GetArtifactByNameParams) (Artifact, error)
@@ -162,6 +169,7 @@ type Querier interface {
 	GetProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
 	GetProjectByName(ctx context.Context, name string) (Project, error)
 	GetProjectIDBySessionState(ctx context.Context, sessionState string) (GetProjectIDBySessionStateRow, error)
+	GetProjectSecretByName(ctx context.Context, arg GetProjectSecretByNameParams) (ProjectSecret, error)
 	GetProperty(ctx context.Context, arg GetPropertyParams) (Property, error)
 	GetProviderByID(ctx context.Context, id uuid.UUID) (Provider, error)
 	GetProviderByIDAndProject(ctx context.Context, arg GetProviderByIDAndProjectParams) (Provider, error)
@@ -200,6 +208,12 @@ type Querier interface {
 	InsertEvaluationRuleEntity(ctx context.Context, arg InsertEvaluationRuleEntityParams) (uuid.UUID, error)
 	InsertEvaluationStatus(ctx context.Context, arg InsertEvaluationStatusParams) (uuid.UUID, error)
 	InsertRemediationEvent(ctx context.Context, arg InsertRemediationEventParams) error
+	ListAlertWebhooksByProject(ctx context.Context, projectID uuid.UUID) ([]AlertWebhook, error)
+	// ListAlertWebhooksToMigrate lists the webhooks which are not encrypted with
+	// the default key version and algorithm, for key rotation. Webhooks no longer
+	// match once they are re-encrypted, so batches are not offset.
+	ListAlertWebhooksToMigrate(ctx context.Context, arg ListAlertWebhooksToMigrateParams) ([]AlertWebhook, error)
+	ListAllProjects(ctx context.Context) ([]Project, error)
 	ListAllRootProjects(ctx context.Context) ([]Project, error)
 	ListArtifactsByRepoID(ctx context.Context, repositoryID uuid.NullUUID) ([]Artifact, error)
 	// ListDataSourceFunctions retrieves all functions for a datasource.
@@ -209,19 +223,50 @@ type Querier interface {
 	// Note that to get a datasource for a given project, one can simply
 	// pass one project id in the project_id array.
 	ListDataSources(ctx context.Context, projects []uuid.UUID) ([]DataSource, error)
+	// ListEntitiesAfterID lists a batch of entities of the given type, ordered by
+	// ID and starting after the given ID, along with the last time a reminder was
+	// sent for each of them.
+	ListEntitiesAfterID(ctx context.Context, arg ListEntitiesAfterIDParams) ([]ListEntitiesAfterIDRow, error)
 	ListEvaluationHistory(ctx context.Context, arg ListEvaluationHistoryParams) ([]ListEvaluationHistoryRow, error)
-	ListEvaluationHistoryStaleRecords(ctx context.Context, arg ListEvaluationHistoryStaleRecordsParams) ([]ListEvaluationHistoryStaleRecordsRow, error)
+	// ListEvaluationHistoryForReport aggregates the evaluations of a project
+	// into daily snapshots covering the [fromts, tots) time range, per profile,
+	// rule and entity type.  Days are aligned to midnight UTC and the posture of
+	// a day is the status of the latest evaluation of each rule and entity at
+	// its end, or at tots for the last, possibly partial, day.  The last
+	// evaluation preceding the range is included, so that the posture at the
+	// beginning of the range is known.
+	//
+	// Time to remediation is measured from the first failing evaluation of a
+	// streak, as far as the history above goes, to the next successful one.
+	// Errored and skipped evaluations do not interrupt a streak.
+	ListEvaluationHistoryForReport(ctx context.Context, arg ListEvaluationHistoryForReportParams) ([]ListEvaluationHistoryForReportRow, error)
+	// ListEvaluationHistoryToPurge lists a batch of evaluation history records
+	// of a project, from oldest to newest, following the record identified by
+	// after_time and after_id, and whether each of them is outside of its
+	// retention policy, i.e. older than the threshold or, when transitions_only
+	// is set, with the same status as the previous record for the same rule and
+	// entity.  The latest record for any rule/entity pair is never purged.
+	// Only the records of the batch and the last record preceding the batch for
+	// each rule and entity are looked at, so that the cost of a batch does not
+	// depend on the size of the history.
+	ListEvaluationHistoryToPurge(ctx context.Context, arg ListEvaluationHistoryToPurgeParams) ([]ListEvaluationHistoryToPurgeRow, error)
 	ListFlushCache(ctx context.Context) ([]FlushCache, error)
 	// ListInvitationsForProject collects the information visible to project
 	// administrators after an invitation has been issued.  In particular, it
 	// *does not* report the invitation code, which is a secret intended for
 	// the invitee.
 	ListInvitationsForProject(ctx context.Context, project uuid.UUID) ([]ListInvitationsForProjectRow, error)
-	// ListOldestRuleEvaluationsByRepositoryId has casts in select statement as sqlc generates incorrect types.
+	// ListOldestRuleEvaluationsByEntityId has casts in select statement as sqlc generates incorrect types.
 	// cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
-	ListOldestRuleEvaluationsByRepositoryId(ctx context.Context, repositoryIds []uuid.UUID) ([]ListOldestRuleEvaluationsByRepositoryIdRow, error)
+	// One row is returned for each entity and profile pair, along with the profile's evaluation schedule.
+	ListOldestRuleEvaluationsByEntityId(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityIdRow, error)
 	ListProfilesByProjectIDAndLabel(ctx context.Context, arg ListProfilesByProjectIDAndLabelParams) ([]ListProfilesByProjectIDAndLabelRow, error)
 	ListProfilesInstantiatingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]string, error)
+	ListProjectSecretsByProject(ctx context.Context, projectID uuid.UUID) ([]ProjectSecret, error)
+	// ListProjectSecretsToMigrate lists the secrets which are not encrypted with
+	// the default key version and algorithm, for key rotation. Secrets no longer
+	// match once they are re-encrypted, so batches are not offset.
+	ListProjectSecretsToMigrate(ctx context.Context, arg ListProjectSecretsToMigrateParams) ([]ProjectSecret, error)
 	// ListProvidersByProjectID allows us to list all providers
 	// for a given array of projects.
 	ListProvidersByProjectID(ctx context.Context, projects []uuid.UUID) ([]Provider, error)
@@ -229,7 +274,6 @@ type Querier interface {
 	// with pagination taken into account. In this case, the cursor is the creation date.
 	ListProvidersByProjectIDPaginated(ctx context.Context, arg ListProvidersByProjectIDPaginatedParams) ([]Provider, error)
 	ListRegisteredRepositoriesByProjectIDAndProvider(ctx context.Context, arg ListRegisteredRepositoriesByProjectIDAndProviderParams) ([]Repository, error)
-	ListRepositoriesAfterID(ctx context.Context, arg ListRepositoriesAfterIDParams) ([]Repository, error)
 	ListRepositoriesByProjectID(ctx context.Context, arg ListRepositoriesByProjectIDParams) ([]Repository, error)
 	ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRuleEvaluationsByProfileIdParams) ([]ListRuleEvaluationsByProfileIdRow, error)
 	ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]RuleType, error)
@@ -259,8 +303,8 @@ type Querier interface {
 	// entity_execution_lock record if the lock is held by the given locked_by
 	// value.
 	ReleaseLock(ctx context.Context, arg ReleaseLockParams) error
-	RepositoryExistsAfterID(ctx context.Context, id uuid.UUID) (bool, error)
 	SetSubscriptionBundleVersion(ctx context.Context, arg SetSubscriptionBundleVersionParams) error
+	UpdateAlertWebhookEncryptedDestination(ctx context.Context, arg UpdateAlertWebhookEncryptedDestinationParams) error
 	// UpdateDataSource updates a datasource in a given project.
 	UpdateDataSource(ctx context.Context, arg UpdateDataSourceParams) (DataSource, error)
 	// UpdateDataSourceFunction updates a function in a datasource. We're
@@ -274,11 +318,18 @@ type Querier interface {
 	UpdateLease(ctx context.Context, arg UpdateLeaseParams) error
 	UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error)
 	UpdateProjectMeta(ctx context.Context, arg UpdateProjectMetaParams) (Project, error)
+	UpdateProjectSecretEncryptedValue(ctx context.Context, arg UpdateProjectSecretEncryptedValueParams) error
 	UpdateProvider(ctx context.Context, arg UpdateProviderParams) error
-	UpdateReminderLastSentForRepositories(ctx context.Context, repositoryIds []uuid.UUID) error
+	UpdateReminderLastSentForEntities(ctx context.Context, entityIds []uuid.UUID) error
 	UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams) (RuleType, error)
 	UpdateSelector(ctx context.Context, arg UpdateSelectorParams) (ProfileSelector, error)
+	UpdateSubscriptionVersion(ctx context.Context, arg UpdateSubscriptionVersionParams) error
 	UpsertAccessToken(ctx context.Context, arg UpsertAccessTokenParams) (ProviderAccessToken, error)
+	// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	// UpsertAlertWebhook creates an alert webhook in a project, or replaces
+	// the destination of the webhook with the same name.
+	UpsertAlertWebhook(ctx context.Context, arg UpsertAlertWebhookParams) (AlertWebhook, error)
 	UpsertArtifact(ctx context.Context, arg UpsertArtifactParams) (Artifact, error)
 	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
@@ -287,6 +338,11 @@ type Querier interface {
 	UpsertInstallationID(ctx context.Context, arg UpsertInstallationIDParams) (ProviderGithubAppInstallation, error)
 	UpsertLatestEvaluationStatus(ctx context.Context, arg UpsertLatestEvaluationStatusParams) error
 	UpsertProfileForEntity(ctx context.Context, arg UpsertProfileForEntityParams) (EntityProfile, error)
+	// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	// UpsertProjectSecret creates a secret in a project, or replaces the
+	// value of the secret with the same name.
+	UpsertProjectSecret(ctx context.Context, arg UpsertProjectSecretParams) (ProjectSecret, error)
 	UpsertProperty(ctx context.Context, arg UpsertPropertyParams) (Property, error)
 	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
@@ -10,7 +10,6 @@ import (
 	"database/sql"
 
 	"github.com/google/uuid"
-	"github.com/lib/pq"
 )
 
 const countRepositories = `-- name: CountRepositories :one
@@ -318,61 +317,6 @@ func (q *Queries) ListRegisteredRepositoriesByProjectIDAndProvider(ctx context.C
 	return items, nil
 }
 
-const listRepositoriesAfterID = `-- name: ListRepositoriesAfterID :many
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, default_branch, license, provider_id, reminder_last_sent
-FROM repositories
-WHERE id > $1
-ORDER BY id
-LIMIT $2::bigint
-`
-
-type ListRepositoriesAfterIDParams struct {
-	ID    uuid.UUID `json:"id"`
-	Limit int64     `json:"limit"`
-}
-
-func (q *Queries) ListRepositoriesAfterID(ctx context.Context, arg ListRepositoriesAfterIDParams) ([]Repository, error) {
-	rows, err := q.db.QueryContext(ctx, listRepositoriesAfterID, arg.ID, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Repository{}
-	for rows.Next() {
-		var i Repository
-		if err := rows.Scan(
-			&i.ID,
-			&i.Provider,
-			&i.ProjectID,
-			&i.RepoOwner,
-			&i.RepoName,
-			&i.RepoID,
-			&i.IsPrivate,
-			&i.IsFork,
-			&i.WebhookID,
-			&i.WebhookUrl,
-			&i.DeployUrl,
-			&i.CloneUrl,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.DefaultBranch,
-			&i.License,
-			&i.ProviderID,
-			&i.ReminderLastSent,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listRepositoriesByProjectID = `-- name: ListRepositoriesByProjectID :many
 SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, default_branch, license, provider_id, reminder_last_sent FROM repositories
 WHERE project_id = $1
@@ -435,29 +379,3 @@ func (q *Queries) ListRepositoriesByProjectID(ctx context.Context, arg ListRepos
 	}
 	return items, nil
 }
-
-const repositoryExistsAfterID = `-- name: RepositoryExistsAfterID :one
-SELECT EXISTS (
-  SELECT 1
-  FROM repositories
-  WHERE id > $1)
-AS exists
-`
-
-func (q *Queries) RepositoryExistsAfterID(ctx context.Context, id uuid.UUID) (bool, error) {
-	row := q.db.QueryRowContext(ctx, repositoryExistsAfterID, id)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
-}
-
-const updateReminderLastSentForRepositories = `-- name: UpdateReminderLastSentForRepositories :exec
-UPDATE repositories
-SET reminder_last_sent = NOW()
-WHERE id = ANY ($1::uuid[])
-`
-
-func (q *Queries) UpdateReminderLastSentForRepositories(ctx context.Context, repositoryIds []uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, updateReminderLastSentForRepositories, pq.Array(repositoryIds))
-	return err
-}
@@ -91,6 +91,20 @@ func (q *Queries) SetSubscriptionBundleVersion(ctx context.Context, arg SetSubsc
 	return err
 }
 
+const updateSubscriptionVersion = `-- name: UpdateSubscriptionVersion :exec
+UPDATE subscriptions SET current_version = $2 WHERE id = $1
+`
+
+type UpdateSubscriptionVersionParams struct {
+	ID             uuid.UUID `json:"id"`
+	CurrentVersion string    `json:"current_version"`
+}
+
+func (q *Queries) UpdateSubscriptionVersion(ctx context.Context, arg UpdateSubscriptionVersionParams) error {
+	_, err := q.db.ExecContext(ctx, updateSubscriptionVersion, arg.ID, arg.CurrentVersion)
+	return err
+}
+
 const upsertBundle = `-- name: UpsertBundle :exec
 
 
@@ -17,6 +17,8 @@ import (
 
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/actions/alert"
+	"github.com/mindersec/minder/internal/engine/actions/alert/issue"
+	"github.com/mindersec/minder/internal/engine/actions/alert/webhook"
 	"github.com/mindersec/minder/internal/engine/actions/remediate"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/pull_request"
 	enginerr "github.com/mindersec/minder/internal/engine/errors"
@@ -31,12 +33,17 @@ type RuleActionsEngine struct {
 	actions map[engif.ActionType]engif.Action
 }
 
-// NewRuleActions creates a new rule actions engine
+// NewRuleActions creates a new rule actions engine. The webhooks resolver
+// may be nil, in which case webhook alerts are skipped. The repositories
+// resolver may be nil, in which case issue alerts only find the repository
+// of pull requests and artifacts from their properties.
 func NewRuleActions(
 	ctx context.Context,
 	ruletype *minderv1.RuleType,
 	provider provinfv1.Provider,
 	actionConfig *models.ActionConfiguration,
+	webhooks webhook.DestinationResolver,
+	repos issue.RepositoryResolver,
 ) (*RuleActionsEngine, error) {
 	// Create the remediation engine
 	remEngine, err := remediate.NewRuleRemediator(ruletype, provider, actionConfig.Remediate)
@@ -45,7 +52,7 @@ func NewRuleActions(
 	}
 
 	// Create the alert engine
-	alertEngine, err := alert.NewRuleAlert(ctx, ruletype, provider, actionConfig.Alert)
+	alertEngine, err := alert.NewRuleAlert(ctx, ruletype, provider, actionConfig.Alert, webhooks, repos)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create rule alerter: %w", err)
 	}
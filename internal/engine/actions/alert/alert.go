@@ -11,9 +11,11 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/mindersec/minder/internal/engine/actions/alert/issue"
 	"github.com/mindersec/minder/internal/engine/actions/alert/noop"
 	"github.com/mindersec/minder/internal/engine/actions/alert/pull_request_comment"
 	"github.com/mindersec/minder/internal/engine/actions/alert/security_advisory"
+	"github.com/mindersec/minder/internal/engine/actions/alert/webhook"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/profiles/models"
@@ -23,12 +25,16 @@ import (
 // ActionType is the type of the alert engine
 const ActionType engif.ActionType = "alert"
 
-// NewRuleAlert creates a new rule alert engine
+// NewRuleAlert creates a new rule alert engine. The webhooks resolver is used
+// by webhook alerts, and may be nil when they are not supported. The
+// repositories resolver is used by issue alerts, and may be nil.
 func NewRuleAlert(
 	ctx context.Context,
 	ruletype *pb.RuleType,
 	provider provinfv1.Provider,
 	setting models.ActionOpt,
+	webhooks webhook.DestinationResolver,
+	repos issue.RepositoryResolver,
 ) (engif.Action, error) {
 	alertCfg := ruletype.Def.GetAlert()
 	if alertCfg == nil {
@@ -53,14 +59,38 @@ func NewRuleAlert(
 		if alertCfg.GetPullRequestComment() == nil {
 			return nil, fmt.Errorf("alert engine missing pull_request_review configuration")
 		}
-		client, err := provinfv1.As[provinfv1.GitHub](provider)
-		if err != nil {
+		_, ghErr := provinfv1.As[provinfv1.GitHub](provider)
+		_, commentErr := provinfv1.As[provinfv1.PullRequestCommenter](provider)
+		if ghErr != nil && commentErr != nil {
 			zerolog.Ctx(ctx).Debug().Str("rule-type", ruletype.GetName()).
-				Msg("provider is not a GitHub provider. Silently skipping alerts.")
+				Msg("provider cannot comment on pull requests. Silently skipping alerts.")
 			return noop.NewNoopAlert(ActionType)
 		}
 		return pull_request_comment.NewPullRequestCommentAlert(
-			ActionType, alertCfg.GetPullRequestComment(), client, setting)
+			ActionType, alertCfg.GetPullRequestComment(), provider, setting)
+	case webhook.AlertType:
+		if alertCfg.GetWebhook() == nil {
+			return nil, fmt.Errorf("alert engine missing webhook configuration")
+		}
+		if webhooks == nil {
+			zerolog.Ctx(ctx).Debug().Str("rule-type", ruletype.GetName()).
+				Msg("webhook alerts are not available. Silently skipping alerts.")
+			return noop.NewNoopAlert(ActionType)
+		}
+		return webhook.NewWebhookAlert(
+			ActionType, ruletype, alertCfg.GetWebhook(), webhooks, setting)
+	case issue.AlertType:
+		if alertCfg.GetIssue() == nil {
+			return nil, fmt.Errorf("alert engine missing issue configuration")
+		}
+		client, err := provinfv1.As[provinfv1.IssueTracker](provider)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Str("rule-type", ruletype.GetName()).
+				Msg("provider cannot open issues. Silently skipping alerts.")
+			return noop.NewNoopAlert(ActionType)
+		}
+		return issue.NewIssueAlert(
+			ActionType, ruletype, alertCfg.GetIssue(), client, repos, setting)
 	}
 
 	return nil, fmt.Errorf("unknown alert type: %s", alertCfg.GetType())
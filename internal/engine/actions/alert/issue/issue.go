@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package issue provides necessary interfaces and implementations for
+// creating alerts of type issue.
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/db"
+	enginerr "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// AlertType is the type of the issue alert engine
+	AlertType = "issue"
+	// TitleMaxLength is the maximum length of the issue title
+	TitleMaxLength = 255
+	// BodyMaxLength is the maximum length of the issue body
+	BodyMaxLength = 65536
+
+	defaultTitle = `minder: rule {{ .RuleName }} of profile {{ .Profile }} failed`
+	defaultBody  = `{{ with .EvalErrorDetails }}{{ . }}
+
+{{ end }}Minder has found that the repository **{{ .Repository }}** does not comply with the **{{ .RuleName }}** rule ` +
+		`of the **{{ .Profile }}** profile. This issue will be closed automatically once the rule passes.
+
+**Guidance**
+
+{{ .Guidance }}
+
+**Details**
+
+- Profile: {{ .Profile }}
+- Rule type: {{ .RuleType }}
+- Rule: {{ .RuleName }}
+- Severity: {{ .Severity }}
+`
+	closeComment = "The rule now passes, closing this issue."
+)
+
+// Alert is the structure backing the issue alert action
+type Alert struct {
+	actionType interfaces.ActionType
+	cli        provifv1.IssueTracker
+	repos      RepositoryResolver
+	ruleType   *pb.RuleType
+	issueCfg   *pb.RuleType_Definition_Alert_AlertTypeIssue
+	titleTmpl  *util.SafeTemplate
+	bodyTmpl   *util.SafeTemplate
+	setting    models.ActionOpt
+}
+
+// TemplateParams is the parameters for the issue title and body templates
+type TemplateParams struct {
+	Repository string
+	RuleType   string
+	RuleName   string
+	Profile    string
+	Severity   string
+	Guidance   string
+
+	// EvalErrorDetails is the details of the error that occurred during evaluation, which may be empty
+	EvalErrorDetails string
+
+	// EvalResult is the output of the evaluation, which may be empty
+	EvalResultOutput any
+}
+
+type paramsIssue struct {
+	Repo       *pb.Repository
+	Options    *provifv1.IssueOptions
+	Metadata   *alertMetadata
+	evalErr    error
+	prevStatus *db.ListRuleEvaluationsByProfileIdRow
+}
+
+type alertMetadata struct {
+	Number int    `json:"issue_number,omitempty"`
+	URL    string `json:"issue_url,omitempty"`
+}
+
+// NewIssueAlert creates a new issue alert action. The repository resolver
+// may be nil, in which case the repository of pull requests and artifacts
+// is only resolved from their properties.
+func NewIssueAlert(
+	actionType interfaces.ActionType,
+	ruleType *pb.RuleType,
+	issueCfg *pb.RuleType_Definition_Alert_AlertTypeIssue,
+	cli provifv1.IssueTracker,
+	repos RepositoryResolver,
+	setting models.ActionOpt,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	title := issueCfg.GetTitle()
+	if title == "" {
+		title = defaultTitle
+	}
+	titleTmpl, err := util.NewSafeTextTemplate(&title, "title")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse title template: %w", err)
+	}
+
+	body := issueCfg.GetBody()
+	if body == "" {
+		body = defaultBody
+	}
+	bodyTmpl, err := util.NewSafeTextTemplate(&body, "body")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse body template: %w", err)
+	}
+
+	return &Alert{
+		actionType: actionType,
+		cli:        cli,
+		repos:      repos,
+		ruleType:   ruleType,
+		issueCfg:   issueCfg,
+		titleTmpl:  titleTmpl,
+		bodyTmpl:   bodyTmpl,
+		setting:    setting,
+	}, nil
+}
+
+// Class returns the action type of the issue alert engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the issue alert engine
+func (*Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (alert *Alert) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(alert.setting, models.ActionOptOff)
+}
+
+// Do alerts through an issue on the repository
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	p, err := alert.getParamsForIssue(ctx, entity, params, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting details: %w", err)
+	}
+
+	// Process the command based on the action setting
+	switch alert.setting {
+	case models.ActionOptOn:
+		return alert.run(ctx, p, cmd)
+	case models.ActionOptDryRun:
+		return alert.runDry(ctx, p, cmd)
+	case models.ActionOptOff, models.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// run runs the issue action
+func (alert *Alert) run(ctx context.Context, params *paramsIssue, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	// Open an issue, or reopen the one opened before
+	case interfaces.ActionCmdOn:
+		if params.Metadata != nil && params.Metadata.Number != 0 {
+			err := alert.cli.UpdateIssue(ctx, params.Repo, params.Metadata.Number, params.Options)
+			if err == nil {
+				logger.Info().Int("issue_number", params.Metadata.Number).Msg("issue reopened")
+				return json.Marshal(params.Metadata)
+			}
+			if !errors.Is(err, provifv1.ErrEntityNotFound) {
+				return nil, fmt.Errorf("error updating issue: %w, %w", err, enginerr.ErrActionFailed)
+			}
+			// The issue was deleted, open a new one
+		}
+
+		issue, err := alert.cli.CreateIssue(ctx, params.Repo, params.Options)
+		if err != nil {
+			return nil, fmt.Errorf("error creating issue: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		newMeta, err := json.Marshal(alertMetadata{Number: issue.Number, URL: issue.URL})
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+		}
+		// Success - return the new metadata for storing the issue reference
+		logger.Info().Int("issue_number", issue.Number).Msg("issue opened")
+		return newMeta, nil
+	// Close the issue
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.Number == 0 {
+			// We cannot do anything without the issue number, so we assume that closing this is a success
+			return nil, fmt.Errorf("no issue number provided: %w", enginerr.ErrActionTurnedOff)
+		}
+		err := alert.cli.CloseIssue(ctx, params.Repo, params.Metadata.Number, closeComment)
+		if err != nil {
+			if errors.Is(err, provifv1.ErrEntityNotFound) {
+				// There's no issue with that number anymore (perhaps it was deleted manually).
+				// We exit by stating that the action was turned off.
+				return nil, fmt.Errorf("issue already deleted: %w, %w", err, enginerr.ErrActionTurnedOff)
+			}
+			return nil, fmt.Errorf("error closing issue: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		logger.Info().Int("issue_number", params.Metadata.Number).Msg("issue closed")
+		// Success - return ErrActionTurnedOff to indicate the action was successful
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		if alert.shouldUpdate(params) {
+			return alert.runUpdate(ctx, params)
+		}
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// runDry runs the issue action in dry run mode
+func (alert *Alert) runDry(ctx context.Context, params *paramsIssue, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		logger.Info().Msgf("dry run: open an issue in repo %s/%s with the title %q and the following body: %s",
+			params.Repo.GetOwner(), params.Repo.GetName(), params.Options.Title, params.Options.Body)
+		return nil, nil
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.Number == 0 {
+			// We cannot do anything without the issue number, so we assume that closing this is a success
+			return nil, fmt.Errorf("no issue number provided: %w", enginerr.ErrActionTurnedOff)
+		}
+		logger.Info().Msgf("dry run: close issue %d in repo %s/%s", params.Metadata.Number,
+			params.Repo.GetOwner(), params.Repo.GetName())
+	case interfaces.ActionCmdDoNothing:
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// shouldUpdate returns whether the rule is still failing, but for a
+// different reason than when the issue was last written
+func (*Alert) shouldUpdate(params *paramsIssue) bool {
+	if params.Metadata == nil || params.Metadata.Number == 0 || params.prevStatus == nil {
+		return false
+	}
+	if params.prevStatus.AlertStatus != db.AlertStatusTypesOn {
+		return false
+	}
+	status := enginerr.ErrorAsEvalStatus(params.evalErr)
+	if status != db.EvalStatusTypesFailure && status != db.EvalStatusTypesError {
+		return false
+	}
+	return enginerr.ErrorAsEvalDetails(params.evalErr) != params.prevStatus.EvalDetails
+}
+
+// runUpdate refreshes the contents of the issue opened for a rule which
+// keeps failing
+func (alert *Alert) runUpdate(ctx context.Context, params *paramsIssue) (json.RawMessage, error) {
+	err := alert.cli.UpdateIssue(ctx, params.Repo, params.Metadata.Number, params.Options)
+	if errors.Is(err, provifv1.ErrEntityNotFound) {
+		// The issue was deleted, open a new one
+		return alert.run(ctx, &paramsIssue{Repo: params.Repo, Options: params.Options}, interfaces.ActionCmdOn)
+	} else if err != nil {
+		return nil, fmt.Errorf("error updating issue: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	zerolog.Ctx(ctx).Info().Int("issue_number", params.Metadata.Number).Msg("issue updated")
+	return json.Marshal(params.Metadata)
+}
+
+// runDoNothing returns the previous alert status
+func (*Alert) runDoNothing(ctx context.Context, params *paramsIssue) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx).With().Str("repo", params.Repo.GetName()).Logger()
+
+	logger.Debug().Msg("Running do nothing")
+
+	// Return the previous alert status.
+	err := enginerr.AlertStatusAsError(params.prevStatus)
+	// If there is a valid alert metadata, return it too
+	if params.prevStatus != nil {
+		return params.prevStatus.AlertMetadata, err
+	}
+	// If there is no alert metadata, return nil as the metadata and the error
+	return nil, err
+}
+
+// getParamsForIssue extracts the details from the entity
+func (alert *Alert) getParamsForIssue(
+	ctx context.Context,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (*paramsIssue, error) {
+	logger := zerolog.Ctx(ctx)
+	result := &paramsIssue{
+		evalErr:    params.GetEvalErr(),
+		prevStatus: params.GetEvalStatusFromDb(),
+	}
+
+	// Issues are opened on the repository the entity belongs to
+	switch entity := entity.(type) {
+	case *pb.Repository:
+		result.Repo = entity
+	case *pbinternal.PullRequest:
+		result.Repo = &pb.Repository{
+			Owner:  entity.GetRepoOwner(),
+			Name:   entity.GetRepoName(),
+			RepoId: repoIDFromProperties(entity.GetProperties(), properties.PullRequestRepoUpstreamID),
+		}
+	case *pb.Artifact:
+		result.Repo = &pb.Repository{Owner: entity.GetOwner(), Name: entity.GetRepository()}
+	default:
+		return nil, fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+	}
+	// Some issue trackers need the upstream ID of the repository. When the
+	// entity does not carry it, look up the repository it originated from.
+	if result.Repo.GetRepoId() == 0 && alert.repos != nil {
+		repoID, err := alert.repos.GetOriginatingRepoID(ctx, params.GetEntityID())
+		if err != nil {
+			logger.Debug().Err(err).Msg("cannot resolve the upstream ID of the repository")
+		}
+		result.Repo.RepoId = repoID
+	}
+
+	tmplParams := &TemplateParams{
+		Repository:       fmt.Sprintf("%s/%s", result.Repo.GetOwner(), result.Repo.GetName()),
+		RuleType:         alert.ruleType.GetName(),
+		Severity:         alert.ruleType.GetSeverity().GetValue().Enum().AsString(),
+		Guidance:         alert.ruleType.GetGuidance(),
+		EvalErrorDetails: enginerr.ErrorAsEvalDetails(params.GetEvalErr()),
+	}
+	if rule := params.GetRule(); rule != nil {
+		tmplParams.RuleName = rule.Name
+	}
+	if profile := params.GetProfile(); profile != nil {
+		tmplParams.Profile = profile.Name
+	}
+	if params.GetEvalResult() != nil {
+		tmplParams.EvalResultOutput = params.GetEvalResult().Output
+	}
+
+	title, err := alert.titleTmpl.Render(ctx, tmplParams, TitleMaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute title template: %w", err)
+	}
+	body, err := alert.bodyTmpl.Render(ctx, tmplParams, BodyMaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute body template: %w", err)
+	}
+
+	result.Options = &provifv1.IssueOptions{
+		Title:     title,
+		Body:      body,
+		Labels:    alert.issueCfg.GetLabels(),
+		Assignees: alert.issueCfg.GetAssignees(),
+	}
+
+	// Unmarshal the existing alert metadata, if any
+	if metadata != nil {
+		meta := &alertMetadata{}
+		err := json.Unmarshal(*metadata, meta)
+		if err != nil {
+			// There's nothing saved apparently, so no need to fail here, but do log the error
+			logger.Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		} else {
+			result.Metadata = meta
+		}
+	}
+
+	return result, nil
+}
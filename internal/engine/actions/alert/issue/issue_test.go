@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/db"
+	enginerr "github.com/mindersec/minder/internal/engine/errors"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	mockghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var TestActionTypeValid engif.ActionType = "alert-test"
+
+const evaluationFailureDetails = "evaluation failure reason"
+
+var entityID = uuid.New()
+
+// fakeRepositoryResolver resolves the repository of a single entity
+type fakeRepositoryResolver map[uuid.UUID]int64
+
+func (f fakeRepositoryResolver) GetOriginatingRepoID(_ context.Context, id uuid.UUID) (int64, error) {
+	repoID, ok := f[id]
+	if !ok {
+		return 0, errors.New("entity not found")
+	}
+	return repoID, nil
+}
+
+func TestIssueAlert(t *testing.T) {
+	t.Parallel()
+
+	issueMetadata := json.RawMessage(`{"issue_number":42,"issue_url":"https://example.com/issues/42"}`)
+	testRepo := &pb.Repository{Owner: "stacklok", Name: "minder"}
+
+	tests := []struct {
+		name             string
+		cmd              engif.ActionCmd
+		entity           protoreflect.ProtoMessage
+		cfg              *pb.RuleType_Definition_Alert_AlertTypeIssue
+		inputMetadata    *json.RawMessage
+		prevStatus       *db.ListRuleEvaluationsByProfileIdRow
+		repos            RepositoryResolver
+		mockSetup        func(*mockghclient.MockIssueTracker)
+		expectedErr      error
+		expectedMetadata json.RawMessage
+	}{
+		{
+			name:   "open an issue",
+			cmd:    engif.ActionCmdOn,
+			entity: testRepo,
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeIssue{
+				Title:     "{{ .RuleType }} failed on {{ .Repository }}",
+				Body:      "{{ .EvalErrorDetails }}",
+				Labels:    []string{"security"},
+				Assignees: []string{"octocat"},
+			},
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					CreateIssue(gomock.Any(), testRepo, &provifv1.IssueOptions{
+						Title:     "test_rule failed on stacklok/minder",
+						Body:      evaluationFailureDetails,
+						Labels:    []string{"security"},
+						Assignees: []string{"octocat"},
+					}).
+					Return(&provifv1.Issue{Number: 42, URL: "https://example.com/issues/42"}, nil)
+			},
+			expectedMetadata: issueMetadata,
+		},
+		{
+			name:   "open an issue for a pull request",
+			cmd:    engif.ActionCmdOn,
+			entity: &pbinternal.PullRequest{RepoOwner: "stacklok", RepoName: "minder"},
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					CreateIssue(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, repo *pb.Repository, _ *provifv1.IssueOptions) (*provifv1.Issue, error) {
+						if repo.GetOwner() != "stacklok" || repo.GetName() != "minder" {
+							return nil, errors.New("unexpected repository")
+						}
+						return &provifv1.Issue{Number: 42, URL: "https://example.com/issues/42"}, nil
+					})
+			},
+			expectedMetadata: issueMetadata,
+		},
+		{
+			name: "open an issue for a gitlab merge request",
+			cmd:  engif.ActionCmdOn,
+			entity: &pbinternal.PullRequest{
+				RepoOwner: "stacklok",
+				RepoName:  "minder",
+				Properties: &structpb.Struct{Fields: map[string]*structpb.Value{
+					properties.PullRequestRepoUpstreamID: structpb.NewStringValue("1234"),
+				}},
+			},
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					CreateIssue(gomock.Any(), &pb.Repository{Owner: "stacklok", Name: "minder", RepoId: 1234}, gomock.Any()).
+					Return(&provifv1.Issue{Number: 42, URL: "https://example.com/issues/42"}, nil)
+			},
+			expectedMetadata: issueMetadata,
+		},
+		{
+			name:   "open an issue for an artifact",
+			cmd:    engif.ActionCmdOn,
+			entity: &pb.Artifact{Owner: "stacklok", Repository: "minder"},
+			repos:  fakeRepositoryResolver{entityID: 5678},
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					CreateIssue(gomock.Any(), &pb.Repository{Owner: "stacklok", Name: "minder", RepoId: 5678}, gomock.Any()).
+					Return(&provifv1.Issue{Number: 42, URL: "https://example.com/issues/42"}, nil)
+			},
+			expectedMetadata: issueMetadata,
+		},
+		{
+			name:          "reopen an existing issue",
+			cmd:           engif.ActionCmdOn,
+			entity:        testRepo,
+			inputMetadata: &issueMetadata,
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					UpdateIssue(gomock.Any(), testRepo, 42, gomock.Any()).
+					Return(nil)
+			},
+			expectedMetadata: issueMetadata,
+		},
+		{
+			name:          "open a new issue if the previous one was deleted",
+			cmd:           engif.ActionCmdOn,
+			entity:        testRepo,
+			inputMetadata: &issueMetadata,
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					UpdateIssue(gomock.Any(), testRepo, 42, gomock.Any()).
+					Return(provifv1.ErrEntityNotFound)
+				m.EXPECT().
+					CreateIssue(gomock.Any(), testRepo, gomock.Any()).
+					Return(&provifv1.Issue{Number: 43, URL: "https://example.com/issues/43"}, nil)
+			},
+			expectedMetadata: json.RawMessage(`{"issue_number":43,"issue_url":"https://example.com/issues/43"}`),
+		},
+		{
+			name:   "error from provider opening an issue",
+			cmd:    engif.ActionCmdOn,
+			entity: testRepo,
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					CreateIssue(gomock.Any(), testRepo, gomock.Any()).
+					Return(nil, errors.New("failed to create issue"))
+			},
+			expectedErr: enginerr.ErrActionFailed,
+		},
+		{
+			name:          "close an issue",
+			cmd:           engif.ActionCmdOff,
+			entity:        testRepo,
+			inputMetadata: &issueMetadata,
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					CloseIssue(gomock.Any(), testRepo, 42, closeComment).
+					Return(nil)
+			},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:          "close an issue which was deleted",
+			cmd:           engif.ActionCmdOff,
+			entity:        testRepo,
+			inputMetadata: &issueMetadata,
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					CloseIssue(gomock.Any(), testRepo, 42, closeComment).
+					Return(provifv1.ErrEntityNotFound)
+			},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:        "close without an issue number",
+			cmd:         engif.ActionCmdOff,
+			entity:      testRepo,
+			mockSetup:   func(_ *mockghclient.MockIssueTracker) {},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:          "update an issue when the failure changes",
+			cmd:           engif.ActionCmdDoNothing,
+			entity:        testRepo,
+			inputMetadata: &issueMetadata,
+			prevStatus: &db.ListRuleEvaluationsByProfileIdRow{
+				AlertStatus:   db.AlertStatusTypesOn,
+				AlertMetadata: issueMetadata,
+				EvalDetails:   "an older failure reason",
+			},
+			mockSetup: func(m *mockghclient.MockIssueTracker) {
+				m.EXPECT().
+					UpdateIssue(gomock.Any(), testRepo, 42, gomock.Any()).
+					Return(nil)
+			},
+			expectedMetadata: issueMetadata,
+		},
+		{
+			name:          "do nothing when the failure is unchanged",
+			cmd:           engif.ActionCmdDoNothing,
+			entity:        testRepo,
+			inputMetadata: &issueMetadata,
+			prevStatus: &db.ListRuleEvaluationsByProfileIdRow{
+				AlertStatus:   db.AlertStatusTypesOn,
+				AlertMetadata: issueMetadata,
+				EvalDetails:   evaluationFailureDetails,
+			},
+			mockSetup:        func(_ *mockghclient.MockIssueTracker) {},
+			expectedMetadata: issueMetadata,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockClient := mockghclient.NewMockIssueTracker(ctrl)
+			tt.mockSetup(mockClient)
+
+			ruleType := &pb.RuleType{
+				Name:     "test_rule",
+				Severity: &pb.Severity{Value: pb.Severity_VALUE_HIGH},
+			}
+			issueAlert, err := NewIssueAlert(TestActionTypeValid, ruleType, tt.cfg, mockClient, tt.repos, models.ActionOptOn)
+			require.NoError(t, err)
+			require.NotNil(t, issueAlert)
+
+			prevStatus := tt.prevStatus
+			if prevStatus == nil {
+				prevStatus = &db.ListRuleEvaluationsByProfileIdRow{}
+			}
+			evalParams := &engif.EvalStatusParams{
+				EvalStatusFromDb: prevStatus,
+				EntityID:         entityID,
+				Profile:          &models.ProfileAggregate{Name: "test_profile"},
+				Rule:             &models.RuleInstance{Name: "test_rule"},
+			}
+			evalParams.SetEvalErr(enginerr.NewErrEvaluationFailed(evaluationFailureDetails))
+
+			retMeta, err := issueAlert.Do(
+				context.Background(),
+				tt.cmd,
+				tt.entity,
+				evalParams,
+				tt.inputMetadata,
+			)
+			require.ErrorIs(t, err, tt.expectedErr, "expected error")
+			require.Equal(t, tt.expectedMetadata, retMeta)
+		})
+	}
+}
+
+func TestIssueAlertTitleTooLong(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockClient := mockghclient.NewMockIssueTracker(ctrl)
+
+	cfg := &pb.RuleType_Definition_Alert_AlertTypeIssue{
+		Title: "{{ .EvalErrorDetails }}{{ .EvalErrorDetails }}{{ .EvalErrorDetails }}",
+	}
+	issueAlert, err := NewIssueAlert(TestActionTypeValid, &pb.RuleType{}, cfg, mockClient, nil, models.ActionOptOn)
+	require.NoError(t, err)
+
+	evalParams := &engif.EvalStatusParams{
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{},
+		Profile:          &models.ProfileAggregate{},
+		Rule:             &models.RuleInstance{},
+	}
+	evalParams.SetEvalErr(enginerr.NewErrEvaluationFailed("%s", strings.Repeat("a", TitleMaxLength)))
+
+	_, err = issueAlert.Do(context.Background(), engif.ActionCmdOn,
+		&pb.Repository{Owner: "stacklok", Name: "minder"}, evalParams, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// RepositoryResolver looks up the upstream ID of the repository an entity
+// originated from, for entities which do not carry it in their properties
+type RepositoryResolver interface {
+	GetOriginatingRepoID(ctx context.Context, entityID uuid.UUID) (int64, error)
+}
+
+type dbRepositoryResolver struct {
+	store db.Querier
+}
+
+// NewRepositoryResolver creates a RepositoryResolver which reads the
+// entities and their properties stored in the database
+func NewRepositoryResolver(store db.Querier) RepositoryResolver {
+	return &dbRepositoryResolver{
+		store: store,
+	}
+}
+
+func (r *dbRepositoryResolver) GetOriginatingRepoID(ctx context.Context, entityID uuid.UUID) (int64, error) {
+	ent, err := r.store.GetEntityByID(ctx, entityID)
+	if err != nil {
+		return 0, fmt.Errorf("error getting entity: %w", err)
+	}
+	if !ent.OriginatedFrom.Valid {
+		return 0, fmt.Errorf("entity %s did not originate from a repository", entityID)
+	}
+
+	dbProp, err := r.store.GetProperty(ctx, db.GetPropertyParams{
+		EntityID: ent.OriginatedFrom.UUID,
+		Key:      properties.PropertyUpstreamID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error getting repository upstream ID: %w", err)
+	}
+	prop, err := models.DbPropToModel(dbProp)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing repository upstream ID: %w", err)
+	}
+
+	return strconv.ParseInt(prop.GetString(), 10, 64)
+}
+
+// repoIDFromProperties returns the upstream ID of a repository stored
+// under key in the properties of an entity, or 0 if it is not set
+func repoIDFromProperties(props *structpb.Struct, key string) int64 {
+	id, err := strconv.ParseInt(props.GetFields()[key].GetStringValue(), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
@@ -24,6 +24,7 @@ import (
 	pbinternal "github.com/mindersec/minder/internal/proto"
 	"github.com/mindersec/minder/internal/util"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
 	"github.com/mindersec/minder/pkg/profiles/models"
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
@@ -39,9 +40,12 @@ const (
 // Alert is the structure backing the noop alert
 type Alert struct {
 	actionType interfaces.ActionType
-	gh         provifv1.GitHub
-	reviewCfg  *pb.RuleType_Definition_Alert_AlertTypePRComment
-	setting    models.ActionOpt
+	// gh is set for GitHub providers, which get a pull request review
+	gh provifv1.GitHub
+	// commenter is set for other providers, which get a plain comment
+	commenter provifv1.PullRequestCommenter
+	reviewCfg *pb.RuleType_Definition_Alert_AlertTypePRComment
+	setting   models.ActionOpt
 }
 
 // PrCommentTemplateParams is the parameters for the PR comment templates
@@ -54,6 +58,7 @@ type PrCommentTemplateParams struct {
 }
 
 type paramsPR struct {
+	Props      *properties.Properties
 	Owner      string
 	Repo       string
 	CommitSha  string
@@ -67,22 +72,44 @@ type alertMetadata struct {
 	ReviewID       string     `json:"review_id,omitempty"`
 	SubmittedAt    *time.Time `json:"submitted_at,omitempty"`
 	PullRequestUrl *string    `json:"pull_request_url,omitempty"`
+	// CommentID and ThreadID identify the comment left through the
+	// PullRequestCommenter trait
+	CommentID string `json:"comment_id,omitempty"`
+	ThreadID  string `json:"thread_id,omitempty"`
+}
+
+// alertID returns the ID of the review or comment left by the alert, if any
+func (m *alertMetadata) alertID() string {
+	if m == nil {
+		return ""
+	}
+	if m.ReviewID != "" {
+		return m.ReviewID
+	}
+	return m.CommentID
 }
 
 // NewPullRequestCommentAlert creates a new pull request comment alert action
 func NewPullRequestCommentAlert(
 	actionType interfaces.ActionType,
 	reviewCfg *pb.RuleType_Definition_Alert_AlertTypePRComment,
-	gh provifv1.GitHub,
+	provider provifv1.Provider,
 	setting models.ActionOpt,
 ) (*Alert, error) {
 	if actionType == "" {
 		return nil, fmt.Errorf("action type cannot be empty")
 	}
 
+	gh, _ := provider.(provifv1.GitHub)
+	commenter, _ := provider.(provifv1.PullRequestCommenter)
+	if gh == nil && commenter == nil {
+		return nil, fmt.Errorf("provider cannot comment on pull requests")
+	}
+
 	return &Alert{
 		actionType: actionType,
 		gh:         gh,
+		commenter:  commenter,
 		reviewCfg:  reviewCfg,
 		setting:    setting,
 	}, nil
@@ -134,6 +161,10 @@ func (alert *Alert) Do(
 }
 
 func (alert *Alert) run(ctx context.Context, params *paramsPR, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	if alert.gh == nil {
+		return alert.runComment(ctx, params, cmd)
+	}
+
 	logger := zerolog.Ctx(ctx)
 
 	// Process the command
@@ -203,6 +234,68 @@ func (alert *Alert) run(ctx context.Context, params *paramsPR, cmd interfaces.Ac
 	return nil, enginerr.ErrActionSkipped
 }
 
+// runComment runs the pull request comment action for providers which are
+// not GitHub. Instead of creating a review, it leaves a comment which is
+// kept up to date while the rule fails and resolved once the rule passes.
+func (alert *Alert) runComment(ctx context.Context, params *paramsPR, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		if params.Metadata != nil && params.Metadata.CommentID != "" {
+			comment := &provifv1.PullRequestComment{ID: params.Metadata.CommentID, ThreadID: params.Metadata.ThreadID}
+			err := alert.commenter.UpdatePullRequestComment(ctx, params.Props, comment, params.Comment)
+			if err == nil {
+				logger.Info().Str("comment_id", comment.ID).Msg("PR comment updated")
+				return json.Marshal(params.Metadata)
+			}
+			if !errors.Is(err, provifv1.ErrEntityNotFound) {
+				return nil, fmt.Errorf("error updating PR comment: %w, %w", err, enginerr.ErrActionFailed)
+			}
+			// The comment was deleted, leave a new one
+		}
+
+		comment, err := alert.commenter.CreatePullRequestComment(ctx, params.Props, params.Comment)
+		if err != nil {
+			return nil, fmt.Errorf("error creating PR comment: %w, %w", err, enginerr.ErrActionFailed)
+		}
+
+		newMeta, err := json.Marshal(alertMetadata{
+			CommentID: comment.ID,
+			ThreadID:  comment.ThreadID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+		}
+
+		logger.Info().Str("comment_id", comment.ID).Msg("PR comment created")
+		return newMeta, nil
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.CommentID == "" {
+			// We cannot do anything without the PR comment ID, so we assume that turning the alert off is a success
+			return nil, fmt.Errorf("no PR comment ID provided: %w", enginerr.ErrActionTurnedOff)
+		}
+
+		comment := &provifv1.PullRequestComment{ID: params.Metadata.CommentID, ThreadID: params.Metadata.ThreadID}
+		err := alert.commenter.ResolvePullRequestComment(ctx, params.Props, comment)
+		if err != nil {
+			if errors.Is(err, provifv1.ErrEntityNotFound) {
+				// There's no PR comment with that ID anymore.
+				// We exit by stating that the action was turned off.
+				return nil, fmt.Errorf("PR comment already deleted: %w, %w", err, enginerr.ErrActionTurnedOff)
+			}
+			return nil, fmt.Errorf("error resolving PR comment: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		logger.Info().Str("comment_id", comment.ID).Msg("PR comment resolved")
+		// Success - return ErrActionTurnedOff to indicate the action was successful
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
 // runDry runs the pull request comment action in dry run mode, which logs the comment that would be made
 func (alert *Alert) runDry(ctx context.Context, params *paramsPR, cmd interfaces.ActionCmd) (json.RawMessage, error) {
 	logger := zerolog.Ctx(ctx)
@@ -215,11 +308,11 @@ func (alert *Alert) runDry(ctx context.Context, params *paramsPR, cmd interfaces
 			params.Number, params.Owner, params.Repo, *body)
 		return nil, nil
 	case interfaces.ActionCmdOff:
-		if params.Metadata == nil || params.Metadata.ReviewID == "" {
+		if params.Metadata.alertID() == "" {
 			// We cannot do anything without the PR review ID, so we assume that turning the alert off is a success
 			return nil, fmt.Errorf("no PR comment ID provided: %w", enginerr.ErrActionTurnedOff)
 		}
-		logger.Info().Msgf("dry run: dismiss PR comment %s on PR %d in repo %s/%s", params.Metadata.ReviewID,
+		logger.Info().Msgf("dry run: dismiss PR comment %s on PR %d in repo %s/%s", params.Metadata.alertID(),
 			params.Number, params.Owner, params.Repo)
 	case interfaces.ActionCmdDoNothing:
 		// Return the previous alert status.
@@ -254,6 +347,7 @@ func (alert *Alert) getParamsForPRComment(
 ) (*paramsPR, error) {
 	logger := zerolog.Ctx(ctx)
 	result := &paramsPR{
+		Props:      properties.NewProperties(pr.GetProperties().AsMap()),
 		prevStatus: params.GetEvalStatusFromDb(),
 		Owner:      pr.GetRepoOwner(),
 		Repo:       pr.GetRepoName(),
@@ -22,6 +22,7 @@ import (
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
 var TestActionTypeValid engif.ActionType = "alert-test"
@@ -169,3 +170,139 @@ func validateReviewBodyAndReturn(expectedBody string, reviewID int64) func(_ con
 		return &github.PullRequestReview{ID: &reviewID}, nil
 	}
 }
+
+func TestPullRequestCommentAlertWithCommenter(t *testing.T) {
+	t.Parallel()
+
+	comment := &provifv1.PullRequestComment{ID: "99", ThreadID: "abc123"}
+	commentMetadata := json.RawMessage(`{"comment_id":"99","thread_id":"abc123"}`)
+
+	tests := []struct {
+		name             string
+		cmd              engif.ActionCmd
+		inputMetadata    *json.RawMessage
+		mockSetup        func(*mockghclient.MockPullRequestCommenter)
+		expectedErr      error
+		expectedMetadata json.RawMessage
+	}{
+		{
+			name: "create a PR comment",
+			cmd:  engif.ActionCmdOn,
+			mockSetup: func(mockCommenter *mockghclient.MockPullRequestCommenter) {
+				mockCommenter.EXPECT().
+					CreatePullRequestComment(gomock.Any(), gomock.Any(), evaluationFailureDetails).
+					Return(comment, nil)
+			},
+			expectedMetadata: commentMetadata,
+		},
+		{
+			name:          "update an existing PR comment",
+			cmd:           engif.ActionCmdOn,
+			inputMetadata: &commentMetadata,
+			mockSetup: func(mockCommenter *mockghclient.MockPullRequestCommenter) {
+				mockCommenter.EXPECT().
+					UpdatePullRequestComment(gomock.Any(), gomock.Any(), comment, evaluationFailureDetails).
+					Return(nil)
+			},
+			expectedMetadata: commentMetadata,
+		},
+		{
+			name:          "recreate a deleted PR comment",
+			cmd:           engif.ActionCmdOn,
+			inputMetadata: &commentMetadata,
+			mockSetup: func(mockCommenter *mockghclient.MockPullRequestCommenter) {
+				mockCommenter.EXPECT().
+					UpdatePullRequestComment(gomock.Any(), gomock.Any(), comment, evaluationFailureDetails).
+					Return(provifv1.ErrEntityNotFound)
+				mockCommenter.EXPECT().
+					CreatePullRequestComment(gomock.Any(), gomock.Any(), evaluationFailureDetails).
+					Return(&provifv1.PullRequestComment{ID: "100", ThreadID: "def456"}, nil)
+			},
+			expectedMetadata: json.RawMessage(`{"comment_id":"100","thread_id":"def456"}`),
+		},
+		{
+			name: "error from provider creating PR comment",
+			cmd:  engif.ActionCmdOn,
+			mockSetup: func(mockCommenter *mockghclient.MockPullRequestCommenter) {
+				mockCommenter.EXPECT().
+					CreatePullRequestComment(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("failed to create PR comment"))
+			},
+			expectedErr: enginerr.ErrActionFailed,
+		},
+		{
+			name:          "resolve PR comment",
+			cmd:           engif.ActionCmdOff,
+			inputMetadata: &commentMetadata,
+			mockSetup: func(mockCommenter *mockghclient.MockPullRequestCommenter) {
+				mockCommenter.EXPECT().
+					ResolvePullRequestComment(gomock.Any(), gomock.Any(), comment).
+					Return(nil)
+			},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:          "resolve deleted PR comment",
+			cmd:           engif.ActionCmdOff,
+			inputMetadata: &commentMetadata,
+			mockSetup: func(mockCommenter *mockghclient.MockPullRequestCommenter) {
+				mockCommenter.EXPECT().
+					ResolvePullRequestComment(gomock.Any(), gomock.Any(), comment).
+					Return(provifv1.ErrEntityNotFound)
+			},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:        "no PR comment to resolve",
+			cmd:         engif.ActionCmdOff,
+			mockSetup:   func(*mockghclient.MockPullRequestCommenter) {},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			prCommentCfg := pb.RuleType_Definition_Alert_AlertTypePRComment{
+				ReviewMessage: "{{ .EvalErrorDetails }}",
+			}
+
+			mockCommenter := mockghclient.NewMockPullRequestCommenter(ctrl)
+			tt.mockSetup(mockCommenter)
+
+			prCommentAlert, err := NewPullRequestCommentAlert(
+				TestActionTypeValid, &prCommentCfg, mockCommenter, models.ActionOptOn)
+			require.NoError(t, err)
+
+			evalParams := &engif.EvalStatusParams{
+				EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{},
+				Profile:          &models.ProfileAggregate{},
+				Rule:             &models.RuleInstance{},
+			}
+			evalParams.SetEvalErr(enginerr.NewErrEvaluationFailed(evaluationFailureDetails))
+
+			retMeta, err := prCommentAlert.Do(
+				context.Background(),
+				tt.cmd,
+				&pbinternal.PullRequest{},
+				evalParams,
+				tt.inputMetadata,
+			)
+			require.ErrorIs(t, err, tt.expectedErr, "expected error")
+			require.Equal(t, tt.expectedMetadata, retMeta)
+		})
+	}
+}
+
+func TestNewPullRequestCommentAlertUnsupportedProvider(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	_, err := NewPullRequestCommentAlert(
+		TestActionTypeValid, &pb.RuleType_Definition_Alert_AlertTypePRComment{},
+		mockghclient.NewMockGit(ctrl), models.ActionOptOn)
+	require.Error(t, err)
+}
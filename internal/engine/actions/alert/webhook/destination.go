@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+)
+
+// ErrDestinationNotFound is returned when a project has no alert webhook
+// with the requested name
+var ErrDestinationNotFound = errors.New("alert webhook not found")
+
+// Destination is where the webhook alerts of a project are posted
+type Destination struct {
+	// URL is the URL the alert payloads are posted to
+	URL string `json:"url"`
+	// Secret is used to sign the alert payloads. If empty, payloads
+	// are not signed.
+	Secret string `json:"secret,omitempty"`
+}
+
+// DestinationResolver looks up the alert webhooks of a project
+type DestinationResolver interface {
+	GetDestination(ctx context.Context, projectID uuid.UUID, name string) (*Destination, error)
+}
+
+type dbDestinationResolver struct {
+	store        db.Querier
+	cryptoEngine crypto.Engine
+}
+
+// NewDestinationResolver creates a DestinationResolver which reads the
+// encrypted alert webhooks stored in the database
+func NewDestinationResolver(store db.Querier, cryptoEngine crypto.Engine) DestinationResolver {
+	return &dbDestinationResolver{
+		store:        store,
+		cryptoEngine: cryptoEngine,
+	}
+}
+
+func (r *dbDestinationResolver) GetDestination(
+	ctx context.Context, projectID uuid.UUID, name string,
+) (*Destination, error) {
+	wh, err := r.store.GetAlertWebhookByName(ctx, db.GetAlertWebhookByNameParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrDestinationNotFound, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting alert webhook: %w", err)
+	}
+
+	return DecryptDestination(r.cryptoEngine, wh.EncryptedDestination)
+}
+
+// EncryptDestination encrypts a destination and serializes it for storage
+func EncryptDestination(cryptoEngine crypto.Engine, dest *Destination) (json.RawMessage, error) {
+	plain, err := json.Marshal(dest)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling alert webhook: %w", err)
+	}
+
+	encrypted, err := crypto.EncryptAndSerialize(cryptoEngine, string(plain))
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting alert webhook: %w", err)
+	}
+	return encrypted, nil
+}
+
+// DecryptDestination decrypts a destination serialized by EncryptDestination
+func DecryptDestination(cryptoEngine crypto.Engine, raw json.RawMessage) (*Destination, error) {
+	plain, err := crypto.DeserializeAndDecrypt(cryptoEngine, raw)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting alert webhook: %w", err)
+	}
+
+	dest := &Destination{}
+	if err := json.Unmarshal([]byte(plain), dest); err != nil {
+		return nil, fmt.Errorf("error unmarshalling alert webhook: %w", err)
+	}
+	return dest, nil
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// PresetGeneric posts the alert as a JSON document describing the
+	// rule evaluation
+	PresetGeneric = "generic"
+	// PresetSlack posts the alert in the format of Slack incoming webhooks
+	PresetSlack = "slack"
+	// PresetTeams posts the alert as a Microsoft Teams message card
+	PresetTeams = "teams"
+)
+
+const (
+	// StatusFailing is the status of an alert sent when a rule starts failing
+	StatusFailing = "failing"
+	// StatusRecovered is the status of an alert sent when a rule passes again
+	StatusRecovered = "recovered"
+)
+
+// Payload is the body of the alerts posted with the generic preset
+type Payload struct {
+	Status     string    `json:"status"`
+	Message    string    `json:"message"`
+	RuleType   string    `json:"rule_type"`
+	RuleName   string    `json:"rule_name"`
+	Profile    string    `json:"profile"`
+	ProjectID  string    `json:"project_id"`
+	EntityType string    `json:"entity_type"`
+	EntityID   string    `json:"entity_id"`
+	Details    string    `json:"details,omitempty"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+type teamsPayload struct {
+	Type       string `json:"@type"`
+	Context    string `json:"@context"`
+	Summary    string `json:"summary"`
+	ThemeColor string `json:"themeColor"`
+	Title      string `json:"title"`
+	Text       string `json:"text"`
+}
+
+// formatPayload returns the document to post for the given preset
+func formatPayload(preset string, payload *Payload) any {
+	title := fmt.Sprintf("Minder: rule %s is %s", payload.RuleName, payload.Status)
+
+	switch preset {
+	case PresetSlack:
+		return &slackPayload{
+			Text: fmt.Sprintf("*%s*\n%s", title, payload.Message),
+		}
+	case PresetTeams:
+		color := "D93F0B"
+		if payload.Status == StatusRecovered {
+			color = "2EA44F"
+		}
+		return &teamsPayload{
+			Type:       "MessageCard",
+			Context:    "https://schema.org/extensions",
+			Summary:    title,
+			ThemeColor: color,
+			Title:      title,
+			Text:       payload.Message,
+		}
+	}
+	return payload
+}
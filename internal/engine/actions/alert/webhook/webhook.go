@@ -0,0 +1,403 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook provides necessary interfaces and implementations for
+// posting alerts to webhooks, such as chat service incoming webhooks.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	enginerr "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+const (
+	// AlertType is the type of the webhook alert engine
+	AlertType = "webhook"
+	// DefaultWebhookName is the name of the project webhook used by rule
+	// types which do not name one
+	DefaultWebhookName = "default"
+	// MessageMaxLength is the maximum length of the alert message
+	MessageMaxLength = 65536
+	// SignatureHeader is the header carrying the HMAC-SHA256 signature of
+	// the payload, when the webhook has a secret
+	SignatureHeader = "X-Minder-Signature"
+
+	defaultFailingMessage = `Rule "{{ .RuleName }}" ({{ .RuleType }}) of profile "{{ .Profile }}" is failing ` +
+		`for {{ .EntityType }} {{ .EntityID }}{{ with .EvalErrorDetails }}: {{ . }}{{ end }}`
+	defaultRecoveredMessage = `Rule "{{ .RuleName }}" ({{ .RuleType }}) of profile "{{ .Profile }}" ` +
+		`has recovered for {{ .EntityType }} {{ .EntityID }}`
+
+	requestTimeout = 10 * time.Second
+	maxRetries     = 4
+)
+
+// Alert is the structure backing the webhook alert
+type Alert struct {
+	actionType interfaces.ActionType
+	ruleType   *pb.RuleType
+	webhookCfg *pb.RuleType_Definition_Alert_AlertTypeWebhook
+	resolver   DestinationResolver
+	cli        *http.Client
+	newBackOff func() backoff.BackOff
+	setting    models.ActionOpt
+}
+
+// TemplateParams is the parameters for the webhook message templates
+type TemplateParams struct {
+	// Status is either "failing" or "recovered"
+	Status     string
+	RuleType   string
+	RuleName   string
+	Profile    string
+	EntityType string
+	EntityID   string
+
+	// EvalErrorDetails is the details of the error that occurred during evaluation, which may be empty
+	EvalErrorDetails string
+
+	// EvalResult is the output of the evaluation, which may be empty
+	EvalResultOutput any
+}
+
+type alertMetadata struct {
+	Status string     `json:"status,omitempty"`
+	SentAt *time.Time `json:"sent_at,omitempty"`
+}
+
+// NewWebhookAlert creates a new webhook alert action
+func NewWebhookAlert(
+	actionType interfaces.ActionType,
+	ruleType *pb.RuleType,
+	webhookCfg *pb.RuleType_Definition_Alert_AlertTypeWebhook,
+	resolver DestinationResolver,
+	setting models.ActionOpt,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+	if resolver == nil {
+		return nil, fmt.Errorf("webhook destination resolver cannot be nil")
+	}
+
+	return &Alert{
+		actionType: actionType,
+		ruleType:   ruleType,
+		webhookCfg: webhookCfg,
+		resolver:   resolver,
+		cli:        newClient(),
+		newBackOff: func() backoff.BackOff { return backoff.NewExponentialBackOff() },
+		setting:    setting,
+	}, nil
+}
+
+// newClient creates the client webhooks are posted with. Webhook URLs are
+// set by project admins, so requests to non-public addresses are refused
+// when dialing, which also covers redirects.
+func newClient() *http.Client {
+	var transport *http.Transport
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	} else {
+		transport = &http.Transport{}
+	}
+	return &http.Client{
+		Timeout:   requestTimeout,
+		Transport: rego.LimitedDialer(transport),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return errors.New("refusing to follow redirect to a non-https URL")
+			}
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return nil
+		},
+	}
+}
+
+// Class returns the action type of the webhook alert engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the webhook alert engine
+func (*Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (alert *Alert) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(alert.setting, models.ActionOptOff)
+}
+
+// Do posts an alert to the project's webhook
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	_ protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	// Process the command based on the action setting
+	switch alert.setting {
+	case models.ActionOptOn:
+		return alert.run(ctx, params, cmd, metadata)
+	case models.ActionOptDryRun:
+		return alert.runDry(ctx, params, cmd, metadata)
+	case models.ActionOptOff, models.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+func (alert *Alert) run(
+	ctx context.Context,
+	params interfaces.ActionsParams,
+	cmd interfaces.ActionCmd,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		if err := alert.send(ctx, params, StatusFailing); err != nil {
+			return nil, err
+		}
+		logger.Info().Str("webhook", alert.webhookName()).Msg("failing alert posted to webhook")
+		return newMetadata(StatusFailing)
+	case interfaces.ActionCmdOff:
+		if !wasFailing(ctx, metadata) {
+			// No failing alert was posted, so there is nothing to recover from
+			return nil, fmt.Errorf("no failing alert was posted: %w", enginerr.ErrActionTurnedOff)
+		}
+		if err := alert.send(ctx, params, StatusRecovered); err != nil {
+			return nil, err
+		}
+		logger.Info().Str("webhook", alert.webhookName()).Msg("recovered alert posted to webhook")
+		// Success - return ErrActionTurnedOff to indicate the action was successful
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// runDry runs the webhook alert in dry run mode, which logs the alert that would be posted
+func (alert *Alert) runDry(
+	ctx context.Context,
+	params interfaces.ActionsParams,
+	cmd interfaces.ActionCmd,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		payload, err := alert.buildPayload(ctx, params, StatusFailing)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info().Msgf("dry run: post a failing alert to webhook %s with the following message: %s",
+			alert.webhookName(), payload.Message)
+		return nil, nil
+	case interfaces.ActionCmdOff:
+		if !wasFailing(ctx, metadata) {
+			return nil, fmt.Errorf("no failing alert was posted: %w", enginerr.ErrActionTurnedOff)
+		}
+		logger.Info().Msgf("dry run: post a recovered alert to webhook %s", alert.webhookName())
+	case interfaces.ActionCmdDoNothing:
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// runDoNothing returns the previous alert status
+func (*Alert) runDoNothing(ctx context.Context, params interfaces.ActionsParams) (json.RawMessage, error) {
+	zerolog.Ctx(ctx).Debug().Msg("Running do nothing")
+
+	prevStatus := params.GetEvalStatusFromDb()
+	// Return the previous alert status.
+	err := enginerr.AlertStatusAsError(prevStatus)
+	// If there is a valid alert metadata, return it too
+	if prevStatus != nil {
+		return prevStatus.AlertMetadata, err
+	}
+	// If there is no alert metadata, return nil as the metadata and the error
+	return nil, err
+}
+
+// send posts the alert to the webhook, retrying on transient errors
+func (alert *Alert) send(ctx context.Context, params interfaces.ActionsParams, status string) error {
+	dest, err := alert.resolver.GetDestination(ctx, params.GetProjectID(), alert.webhookName())
+	if err != nil {
+		return fmt.Errorf("error getting webhook destination: %w, %w", err, enginerr.ErrActionFailed)
+	}
+
+	if destURL, err := url.Parse(dest.URL); err != nil || destURL.Scheme != "https" {
+		return fmt.Errorf("webhook URL is not an https URL: %w", enginerr.ErrActionFailed)
+	}
+
+	payload, err := alert.buildPayload(ctx, params, status)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(formatPayload(alert.webhookCfg.GetPreset(), payload))
+	if err != nil {
+		return fmt.Errorf("error marshalling webhook payload: %w", err)
+	}
+
+	retries := 0
+	err = backoff.Retry(func() error {
+		err := alert.post(ctx, dest, body)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Int("retry", retries).Msg("posting alert to webhook failed")
+			retries++
+		}
+		return err
+	}, backoff.WithContext(backoff.WithMaxRetries(alert.newBackOff(), maxRetries), ctx))
+	if err != nil {
+		return fmt.Errorf("error posting alert to webhook: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	return nil
+}
+
+// post makes a single attempt at posting the payload. Errors which will not
+// go away on retry are marked as permanent.
+func (alert *Alert) post(ctx context.Context, dest *Destination, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dest.URL, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(fmt.Errorf("error creating request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if dest.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(dest.Secret, body))
+	}
+
+	resp, err := alert.cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err = fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return err
+	}
+	return backoff.Permanent(err)
+}
+
+func (alert *Alert) buildPayload(
+	ctx context.Context, params interfaces.ActionsParams, status string,
+) (*Payload, error) {
+	tmplParams := &TemplateParams{
+		Status:           status,
+		RuleType:         alert.ruleType.GetName(),
+		EntityType:       string(params.GetEntityType()),
+		EntityID:         params.GetEntityID().String(),
+		EvalErrorDetails: enginerr.ErrorAsEvalDetails(params.GetEvalErr()),
+	}
+	if rule := params.GetRule(); rule != nil {
+		tmplParams.RuleName = rule.Name
+	}
+	if profile := params.GetProfile(); profile != nil {
+		tmplParams.Profile = profile.Name
+	}
+	if params.GetEvalResult() != nil {
+		tmplParams.EvalResultOutput = params.GetEvalResult().Output
+	}
+
+	msg := alert.webhookCfg.GetMessage()
+	if msg == "" {
+		msg = defaultFailingMessage
+		if status == StatusRecovered {
+			msg = defaultRecoveredMessage
+		}
+	}
+
+	tmpl, err := util.NewSafeTextTemplate(&msg, "message")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse webhook message template: %w", err)
+	}
+
+	rendered, err := tmpl.Render(ctx, tmplParams, MessageMaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute webhook message template: %w", err)
+	}
+
+	return &Payload{
+		Status:     status,
+		Message:    rendered,
+		RuleType:   tmplParams.RuleType,
+		RuleName:   tmplParams.RuleName,
+		Profile:    tmplParams.Profile,
+		ProjectID:  params.GetProjectID().String(),
+		EntityType: tmplParams.EntityType,
+		EntityID:   tmplParams.EntityID,
+		Details:    tmplParams.EvalErrorDetails,
+		Timestamp:  time.Now().UTC(),
+	}, nil
+}
+
+func (alert *Alert) webhookName() string {
+	if name := alert.webhookCfg.GetName(); name != "" {
+		return name
+	}
+	return DefaultWebhookName
+}
+
+// Sign returns the value of the signature header for a payload
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newMetadata(status string) (json.RawMessage, error) {
+	now := time.Now().UTC()
+	newMeta, err := json.Marshal(alertMetadata{Status: status, SentAt: &now})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+	}
+	return newMeta, nil
+}
+
+// wasFailing returns whether the alert metadata records a failing alert
+func wasFailing(ctx context.Context, metadata *json.RawMessage) bool {
+	if metadata == nil {
+		return false
+	}
+	meta := &alertMetadata{}
+	if err := json.Unmarshal(*metadata, meta); err != nil {
+		// There's nothing saved apparently, so no need to fail here, but do log the error
+		zerolog.Ctx(ctx).Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		return false
+	}
+	return meta.Status == StatusFailing
+}
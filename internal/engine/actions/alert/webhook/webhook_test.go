@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
+	enginerr "github.com/mindersec/minder/internal/engine/errors"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+type fakeResolver struct {
+	dest *Destination
+}
+
+func (r *fakeResolver) GetDestination(_ context.Context, _ uuid.UUID, name string) (*Destination, error) {
+	if r.dest == nil || name != DefaultWebhookName {
+		return nil, ErrDestinationNotFound
+	}
+	return r.dest, nil
+}
+
+func newTestParams() *engif.EvalStatusParams {
+	params := &engif.EvalStatusParams{
+		Profile:    &models.ProfileAggregate{Name: "my-profile"},
+		Rule:       &models.RuleInstance{Name: "my-rule"},
+		ProjectID:  uuid.New(),
+		EntityType: db.EntitiesRepository,
+		EntityID:   uuid.New(),
+	}
+	params.SetEvalErr(enginerr.NewErrEvaluationFailed("branch protection is disabled"))
+	return params
+}
+
+func newTestAlert(
+	t *testing.T, cfg *pb.RuleType_Definition_Alert_AlertTypeWebhook, dest *Destination, setting models.ActionOpt,
+) *Alert {
+	t.Helper()
+
+	alert, err := NewWebhookAlert("alert", &pb.RuleType{Name: "branch_protection"}, cfg, &fakeResolver{dest: dest}, setting)
+	require.NoError(t, err)
+	alert.newBackOff = func() backoff.BackOff { return &backoff.ZeroBackOff{} }
+	return alert
+}
+
+func failingMetadata(t *testing.T) *json.RawMessage {
+	t.Helper()
+
+	meta, err := newMetadata(StatusFailing)
+	require.NoError(t, err)
+	return &meta
+}
+
+func TestWebhookAlert_Presets(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		preset string
+		check  func(t *testing.T, body map[string]any)
+	}{
+		{
+			name:   "generic",
+			preset: "",
+			check: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Equal(t, StatusFailing, body["status"])
+				assert.Equal(t, "branch_protection", body["rule_type"])
+				assert.Equal(t, "my-rule", body["rule_name"])
+				assert.Equal(t, "my-profile", body["profile"])
+				assert.Equal(t, "repository", body["entity_type"])
+				assert.Equal(t, "branch protection is disabled", body["details"])
+				assert.Contains(t, body["message"], "is failing")
+			},
+		},
+		{
+			name:   "slack",
+			preset: PresetSlack,
+			check: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Len(t, body, 1)
+				assert.Contains(t, body["text"], "*Minder: rule my-rule is failing*")
+			},
+		},
+		{
+			name:   "teams",
+			preset: PresetTeams,
+			check: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Equal(t, "MessageCard", body["@type"])
+				assert.Equal(t, "Minder: rule my-rule is failing", body["title"])
+				assert.Equal(t, "D93F0B", body["themeColor"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				raw, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, Sign("s3cr3t", raw), r.Header.Get(SignatureHeader))
+
+				body := map[string]any{}
+				assert.NoError(t, json.Unmarshal(raw, &body))
+				tt.check(t, body)
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(ts.Close)
+
+			alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{Preset: tt.preset},
+				&Destination{URL: ts.URL, Secret: "s3cr3t"}, models.ActionOptOn)
+			alert.cli = ts.Client()
+
+			meta, err := alert.Do(context.Background(), engif.ActionCmdOn, nil, newTestParams(), nil)
+			require.NoError(t, err)
+
+			raw := json.RawMessage(meta)
+			assert.True(t, wasFailing(context.Background(), &raw))
+		})
+	}
+}
+
+func TestWebhookAlert_Do(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		cmd           engif.ActionCmd
+		setting       models.ActionOpt
+		metadata      func(t *testing.T) *json.RawMessage
+		noDestination bool
+		statusCodes   []int
+		wantRequests  int32
+		wantErr       error
+		wantStatus    string
+	}{
+		{
+			name:         "retries transient errors",
+			cmd:          engif.ActionCmdOn,
+			setting:      models.ActionOptOn,
+			statusCodes:  []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			wantRequests: 3,
+			wantStatus:   StatusFailing,
+		},
+		{
+			name:         "does not retry client errors",
+			cmd:          engif.ActionCmdOn,
+			setting:      models.ActionOptOn,
+			statusCodes:  []int{http.StatusBadRequest},
+			wantRequests: 1,
+			wantErr:      enginerr.ErrActionFailed,
+		},
+		{
+			name:          "missing destination",
+			cmd:           engif.ActionCmdOn,
+			setting:       models.ActionOptOn,
+			noDestination: true,
+			wantErr:       enginerr.ErrActionFailed,
+		},
+		{
+			name:         "recovery is posted after a failing alert",
+			cmd:          engif.ActionCmdOff,
+			setting:      models.ActionOptOn,
+			metadata:     failingMetadata,
+			statusCodes:  []int{http.StatusNoContent},
+			wantRequests: 1,
+			wantErr:      enginerr.ErrActionTurnedOff,
+			wantStatus:   StatusRecovered,
+		},
+		{
+			name:    "no recovery without a failing alert",
+			cmd:     engif.ActionCmdOff,
+			setting: models.ActionOptOn,
+			wantErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:    "dry run does not post",
+			cmd:     engif.ActionCmdOn,
+			setting: models.ActionOptDryRun,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := requests.Add(1)
+				if tt.wantStatus != "" {
+					body := map[string]any{}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, tt.wantStatus, body["status"])
+				}
+				w.WriteHeader(tt.statusCodes[n-1])
+			}))
+			t.Cleanup(ts.Close)
+
+			dest := &Destination{URL: ts.URL}
+			if tt.noDestination {
+				dest = nil
+			}
+			alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{}, dest, tt.setting)
+			alert.cli = ts.Client()
+
+			var metadata *json.RawMessage
+			if tt.metadata != nil {
+				metadata = tt.metadata(t)
+			}
+
+			_, err := alert.Do(context.Background(), tt.cmd, nil, newTestParams(), metadata)
+			if tt.wantErr != nil {
+				require.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
+
+func TestWebhookAlert_RefusesNonPublicDestinations(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(ts.Close)
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{
+			name: "loopback address",
+			url:  ts.URL,
+		},
+		{
+			name: "plain http",
+			url:  strings.Replace(ts.URL, "https://", "http://", 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Keep the default client, which must refuse to dial the test server
+			alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{},
+				&Destination{URL: tt.url}, models.ActionOptOn)
+
+			_, err := alert.Do(context.Background(), engif.ActionCmdOn, nil, newTestParams(), nil)
+			require.ErrorIs(t, err, enginerr.ErrActionFailed)
+			assert.Zero(t, requests.Load())
+		})
+	}
+}
+
+func TestWebhookAlert_GetOnOffState(t *testing.T) {
+	t.Parallel()
+
+	alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{}, nil, models.ActionOptUnknown)
+	assert.Equal(t, models.ActionOptOff, alert.GetOnOffState())
+
+	alert = newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{}, nil, models.ActionOptDryRun)
+	assert.Equal(t, models.ActionOptDryRun, alert.GetOnOffState())
+}
@@ -19,7 +19,6 @@ import (
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
-	"github.com/google/go-github/v63/github"
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
@@ -64,6 +63,9 @@ type pullRequestMetadata struct {
 
 // Remediator is the remediation engine for the Pull Request remediation type
 type Remediator struct {
+	prCli provifv1.PullRequestProvider
+	// ghCli is only set for GitHub providers, it is used to resolve
+	// GitHub Actions tags and to print the dry-run commands
 	ghCli      provifv1.GitHub
 	actionType interfaces.ActionType
 	setting    models.ActionOpt
@@ -89,7 +91,7 @@ type paramsPR struct {
 func NewPullRequestRemediate(
 	actionType interfaces.ActionType,
 	prCfg *pb.RuleType_Definition_Remediate_PullRequestRemediation,
-	ghCli provifv1.GitHub,
+	prCli provifv1.PullRequestProvider,
 	setting models.ActionOpt,
 ) (*Remediator, error) {
 	err := prCfg.Validate()
@@ -110,7 +112,11 @@ func NewPullRequestRemediate(
 	modRegistry := newModificationRegistry()
 	modRegistry.registerBuiltIn()
 
+	// not every provider is GitHub, in which case ghCli is nil
+	ghCli, _ := prCli.(provifv1.GitHub)
+
 	return &Remediator{
+		prCli:                prCli,
 		ghCli:                ghCli,
 		prCfg:                prCfg,
 		actionType:           actionType,
@@ -263,6 +269,10 @@ func (r *Remediator) dryRun(
 			// We cannot do anything without a PR number, so we assume that closing this is a success
 			return nil, fmt.Errorf("no pull request number provided: %w", enginerr.ErrActionSkipped)
 		}
+		if r.ghCli == nil {
+			logger.Msgf("pull request %d would be closed\n", p.metadata.Number)
+			return nil, nil
+		}
 		endpoint := fmt.Sprintf("repos/%v/%v/pulls/%d", p.repo.GetOwner(), p.repo.GetName(), p.metadata.Number)
 		body := "{\"state\": \"closed\"}"
 		curlCmd, err := util.GenerateCurlCommand(ctx, "PATCH", r.ghCli.GetBaseURL(), endpoint, body)
@@ -292,9 +302,9 @@ func (r *Remediator) runOn(
 	}
 
 	logger.Debug().Msg("Getting authenticated user details")
-	email, err := r.ghCli.GetPrimaryEmail(ctx)
+	name, email, err := r.prCli.GetCommitAuthor(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("cannot get primary email: %w", err)
+		return nil, fmt.Errorf("cannot get commit author: %w", err)
 	}
 
 	currentHeadReference, err := repo.Head()
@@ -332,7 +342,7 @@ func (r *Remediator) runOn(
 	logger.Debug().Msg("Committing changes")
 	_, err = wt.Commit(p.title, &git.CommitOptions{
 		Author: &object.Signature{
-			Name:  userNameForCommit(ctx, r.ghCli),
+			Name:  name,
 			Email: email,
 			When:  time.Now(),
 		},
@@ -346,28 +356,35 @@ func (r *Remediator) runOn(
 	l := logger.With().Str("branchBaseName", branchBaseName(p.title)).Logger()
 
 	// Check if a PR already exists for this branch
-	prNumber := getPRNumberFromBranch(ctx, r.ghCli, p.repo, branchBaseName(p.title))
+	prNumber, err := r.prCli.FindOpenPullRequest(ctx, p.repo, branchBaseName(p.title))
+	if err != nil {
+		// we can still try to create the pull request
+		l.Debug().Err(err).Msg("cannot look up existing pull request")
+	}
 
 	// If no PR exists, push the branch and create a PR
 	if prNumber == 0 {
-		err = pushBranch(ctx, repo, refspec, r.ghCli)
+		err = pushBranch(ctx, repo, refspec, r.prCli)
 		if err != nil {
 			return nil, fmt.Errorf("cannot push branch: %w", err)
 		}
 
-		pr, err := r.ghCli.CreatePullRequest(
-			ctx, p.repo.GetOwner(), p.repo.GetName(),
+		prNumber, err = r.prCli.OpenPullRequest(
+			ctx, p.repo,
 			p.title, p.body,
-			refspec,
+			branchBaseName(p.title),
 			dflBranchTo,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("cannot create pull request: %w, %w", err, enginerr.ErrActionFailed)
 		}
-		// Return the new PR number
-		prNumber = pr.GetNumber()
 		l = l.With().Str("pr_origin", "newly_created").Logger()
 	} else {
+		// Keep the title and body in sync with the rendered templates
+		err = r.prCli.EditPullRequest(ctx, p.repo, prNumber, p.title, p.body)
+		if err != nil {
+			return nil, fmt.Errorf("cannot update pull request %d: %w, %w", prNumber, err, enginerr.ErrActionFailed)
+		}
 		l = l.With().Str("pr_origin", "already_existed").Logger()
 	}
 
@@ -380,29 +397,6 @@ func (r *Remediator) runOn(
 	return newMeta, enginerr.ErrActionPending
 }
 
-func getPRNumberFromBranch(
-	ctx context.Context,
-	cli provifv1.GitHub,
-	repo *pb.Repository,
-	branchName string,
-) int {
-	opts := &github.PullRequestListOptions{
-		// TODO: This is not working as expected, need to fix this
-		// Head: fmt.Sprintf("%s:%s", repo.GetOwner(), branchName),
-		State: "open",
-	}
-	openPrs, err := cli.ListPullRequests(ctx, repo.GetOwner(), repo.GetName(), opts)
-	if err != nil {
-		return 0
-	}
-	for _, pr := range openPrs {
-		if pr.GetHead().GetRef() == branchName {
-			return pr.GetNumber()
-		}
-	}
-	return 0
-}
-
 func (r *Remediator) runOff(
 	ctx context.Context,
 	p *paramsPR,
@@ -414,11 +408,11 @@ func (r *Remediator) runOff(
 		return nil, fmt.Errorf("no pull request number provided: %w", enginerr.ErrActionSkipped)
 	}
 
-	pr, err := r.ghCli.ClosePullRequest(ctx, p.repo.GetOwner(), p.repo.GetName(), p.metadata.Number)
+	err := r.prCli.WithdrawPullRequest(ctx, p.repo, p.metadata.Number)
 	if err != nil {
 		return nil, fmt.Errorf("error closing pull request %d: %w, %w", p.metadata.Number, err, enginerr.ErrActionFailed)
 	}
-	logger.Info().Int("pr_number", pr.GetNumber()).Msg("pull request closed")
+	logger.Info().Int("pr_number", p.metadata.Number).Msg("pull request closed")
 	return nil, enginerr.ErrActionSkipped
 }
 
@@ -439,7 +433,7 @@ func (r *Remediator) run(
 	return nil, enginerr.ErrActionSkipped
 }
 
-func pushBranch(ctx context.Context, repo *git.Repository, refspec string, gh provifv1.GitHub) error {
+func pushBranch(ctx context.Context, repo *git.Repository, refspec string, cli provifv1.PullRequestProvider) error {
 	var b bytes.Buffer
 	pushOptions := &git.PushOptions{
 		RemoteName: guessRemote(repo),
@@ -451,7 +445,7 @@ func pushBranch(ctx context.Context, repo *git.Repository, refspec string, gh pr
 		},
 		Progress: &b,
 	}
-	err := gh.AddAuthToPushOptions(ctx, pushOptions)
+	err := cli.AddAuthToPushOptions(ctx, pushOptions)
 	if err != nil {
 		return fmt.Errorf("cannot add auth to push options: %w", err)
 	}
@@ -493,18 +487,6 @@ func branchBaseName(prTitle string) string {
 	return fmt.Sprintf("%s_%s", baseName, normalizedPrTitle)
 }
 
-func userNameForCommit(ctx context.Context, gh provifv1.GitHub) string {
-	var name string
-
-	// we ignore errors here, as we can still create a commit without a name
-	// and errors are checked when getting the primary email
-	name, _ = gh.GetName(ctx)
-	if name == "" {
-		name, _ = gh.GetLogin(ctx)
-	}
-	return name
-}
-
 func (r *Remediator) getPrBodyText(ctx context.Context, tmplParams *PrTemplateParams) (string, error) {
 	body := new(bytes.Buffer)
 	if err := r.bodyTemplate.Execute(ctx, body, tmplParams, BodyMaxLength); err != nil {
@@ -218,11 +218,9 @@ func createTestRemArgsWithExcludes() *remediateArgs {
 func happyPathMockSetup(mockGitHub *mockghclient.MockGitHub) {
 	// no pull request so far
 	mockGitHub.EXPECT().
-		ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).Return([]*github.PullRequest{}, nil)
+		FindOpenPullRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, nil)
 	mockGitHub.EXPECT().
-		GetName(gomock.Any()).Return("stacklok-bot", nil)
-	mockGitHub.EXPECT().
-		GetPrimaryEmail(gomock.Any()).Return("test@stacklok.com", nil)
+		GetCommitAuthor(gomock.Any()).Return("stacklok-bot", "test@stacklok.com", nil)
 	mockGitHub.EXPECT().
 		AddAuthToPushOptions(gomock.Any(), gomock.Any()).Return(nil)
 }
@@ -418,12 +416,11 @@ func TestPullRequestRemediate(t *testing.T) {
 				happyPathMockSetup(mockGitHub)
 
 				mockGitHub.EXPECT().
-					CreatePullRequest(
-						gomock.Any(),
-						repoOwner, repoName,
+					OpenPullRequest(
+						gomock.Any(), gomock.Any(),
 						commitTitle, prBody,
-						refFromBranch(branchBaseName(commitTitle)), dflBranchTo).
-					Return(&github.PullRequest{Number: github.Int(42)}, nil)
+						branchBaseName(commitTitle), dflBranchTo).
+					Return(42, nil)
 			},
 			expectedErr:      errors.ErrActionPending,
 			expectedMetadata: json.RawMessage(`{"pr_number":42}`),
@@ -440,12 +437,11 @@ func TestPullRequestRemediate(t *testing.T) {
 				happyPathMockSetup(mockGitHub)
 
 				mockGitHub.EXPECT().
-					CreatePullRequest(
-						gomock.Any(),
-						repoOwner, repoName,
+					OpenPullRequest(
+						gomock.Any(), gomock.Any(),
 						commitTitle, prBody,
-						refFromBranch(branchBaseName(commitTitle)), dflBranchTo).
-					Return(nil, fmt.Errorf("failed to create PR"))
+						branchBaseName(commitTitle), dflBranchTo).
+					Return(0, fmt.Errorf("failed to create PR"))
 			},
 			expectedErr:      errors.ErrActionFailed,
 			expectedMetadata: json.RawMessage(nil),
@@ -462,12 +458,11 @@ func TestPullRequestRemediate(t *testing.T) {
 				happyPathMockSetup(mockGitHub)
 
 				mockGitHub.EXPECT().
-					CreatePullRequest(
-						gomock.Any(),
-						repoOwner, repoName,
+					OpenPullRequest(
+						gomock.Any(), gomock.Any(),
 						commitTitle, prBody,
-						refFromBranch(branchBaseName(commitTitle)), dflBranchTo).
-					Return(&github.PullRequest{Number: github.Int(41)}, nil)
+						branchBaseName(commitTitle), dflBranchTo).
+					Return(41, nil)
 			},
 			expectedErr:      errors.ErrActionPending,
 			expectedMetadata: json.RawMessage(`{"pr_number":41}`),
@@ -482,24 +477,38 @@ func TestPullRequestRemediate(t *testing.T) {
 			repoSetup: defaultMockRepoSetup,
 			mockSetup: func(_ *testing.T, mockGitHub *mockghclient.MockGitHub) {
 				mockGitHub.EXPECT().
-					GetName(gomock.Any()).Return("stacklok-bot", nil)
+					GetCommitAuthor(gomock.Any()).Return("stacklok-bot", "test@stacklok.com", nil)
 				mockGitHub.EXPECT().
-					GetPrimaryEmail(gomock.Any()).Return("test@stacklok.com", nil)
+					FindOpenPullRequest(gomock.Any(), gomock.Any(), "minder_add_dependabot_configuration_for_gomod").
+					Return(143, nil)
 				mockGitHub.EXPECT().
-					ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).
-					Return([]*github.PullRequest{
-						{
-							Body: github.String(prBody),
-							Head: &github.PullRequestBranch{
-								Ref: github.String("minder_add_dependabot_configuration_for_gomod"),
-							},
-							Number: github.Int(143),
-						},
-					}, nil)
+					EditPullRequest(gomock.Any(), gomock.Any(), 143, commitTitle, prBody).
+					Return(nil)
 			},
 			expectedErr:      errors.ErrActionPending,
 			expectedMetadata: json.RawMessage(`{"pr_number":143}`),
 		},
+		{
+			name: "A PR already exists but cannot be updated",
+			newRemArgs: &newPullRequestRemediateArgs{
+				prRem:      dependabotPrRem(),
+				actionType: TestActionTypeValid,
+			},
+			remArgs:   createTestRemArgs(),
+			repoSetup: defaultMockRepoSetup,
+			mockSetup: func(_ *testing.T, mockGitHub *mockghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetCommitAuthor(gomock.Any()).Return("stacklok-bot", "test@stacklok.com", nil)
+				mockGitHub.EXPECT().
+					FindOpenPullRequest(gomock.Any(), gomock.Any(), "minder_add_dependabot_configuration_for_gomod").
+					Return(143, nil)
+				mockGitHub.EXPECT().
+					EditPullRequest(gomock.Any(), gomock.Any(), 143, commitTitle, prBody).
+					Return(fmt.Errorf("failed to update PR"))
+			},
+			expectedErr:      errors.ErrActionFailed,
+			expectedMetadata: json.RawMessage(nil),
+		},
 		//
 		//{
 		//	name: "A branch for this PR already exists, shouldn't open a new PR, but only update the branch",
@@ -550,12 +559,11 @@ func TestPullRequestRemediate(t *testing.T) {
 				resolveActionMockSetup(t, mockGitHub, "repos/actions/setup-go/git/refs/tags/v5", setupV5Ref)
 
 				mockGitHub.EXPECT().
-					CreatePullRequest(
-						gomock.Any(),
-						repoOwner, repoName,
+					OpenPullRequest(
+						gomock.Any(), gomock.Any(),
 						frizbeeCommitTitle, frizbeePrBody,
-						refFromBranch(branchBaseName(frizbeeCommitTitle)), dflBranchTo).
-					Return(&github.PullRequest{Number: github.Int(40)}, nil)
+						branchBaseName(frizbeeCommitTitle), dflBranchTo).
+					Return(40, nil)
 			},
 			expectedErr:      errors.ErrActionPending,
 			expectedMetadata: json.RawMessage(`{"pr_number":40}`),
@@ -575,12 +583,11 @@ func TestPullRequestRemediate(t *testing.T) {
 
 				resolveActionMockSetup(t, mockGitHub, "repos/actions/checkout/git/refs/tags/v4", checkoutV4Ref)
 				mockGitHub.EXPECT().
-					CreatePullRequest(
-						gomock.Any(),
-						repoOwner, repoName,
+					OpenPullRequest(
+						gomock.Any(), gomock.Any(),
 						frizbeeCommitTitle, frizbeePrBodyWithExcludes,
-						refFromBranch(branchBaseName(frizbeeCommitTitle)), dflBranchTo).
-					Return(&github.PullRequest{Number: github.Int(43)}, nil)
+						branchBaseName(frizbeeCommitTitle), dflBranchTo).
+					Return(43, nil)
 			},
 			expectedErr:      errors.ErrActionPending,
 			expectedMetadata: json.RawMessage(`{"pr_number":43}`),
@@ -601,12 +608,11 @@ func TestPullRequestRemediate(t *testing.T) {
 				resolveActionMockSetup(t, mockGitHub, "repos/actions/checkout/git/refs/tags/v4", checkoutV4Ref)
 
 				mockGitHub.EXPECT().
-					CreatePullRequest(
-						gomock.Any(),
-						repoOwner, repoName,
+					OpenPullRequest(
+						gomock.Any(), gomock.Any(),
 						frizbeeCommitTitle, frizbeePrBodyWithExcludes,
-						refFromBranch(branchBaseName(frizbeeCommitTitle)), dflBranchTo).
-					Return(&github.PullRequest{Number: github.Int(44)}, nil)
+						branchBaseName(frizbeeCommitTitle), dflBranchTo).
+					Return(44, nil)
 			},
 			expectedErr:      errors.ErrActionPending,
 			expectedMetadata: json.RawMessage(`{"pr_number":44}`),
@@ -624,12 +630,11 @@ func TestPullRequestRemediate(t *testing.T) {
 				happyPathMockSetup(mockGitHub)
 
 				mockGitHub.EXPECT().
-					CreatePullRequest(
-						gomock.Any(),
-						repoOwner, repoName,
+					OpenPullRequest(
+						gomock.Any(), gomock.Any(),
 						yqCommitTitle, yqPrBody,
-						refFromBranch(branchBaseName(yqCommitTitle)), dflBranchTo).
-					Return(&github.PullRequest{Number: github.Int(45)}, nil)
+						branchBaseName(yqCommitTitle), dflBranchTo).
+					Return(45, nil)
 			},
 			remArgs:          createTestRemArgs(),
 			expectedErr:      errors.ErrActionPending,
@@ -661,6 +666,7 @@ func TestPullRequestRemediate(t *testing.T) {
 
 			require.NoError(t, err, "unexpected error creating remediate engine")
 			// TODO(jakub): providerBuilder should be an interface so we can pass in mock more easily
+			engine.prCli = mockClient
 			engine.ghCli = mockClient
 
 			require.NoError(t, err, "unexpected error creating remediate engine")
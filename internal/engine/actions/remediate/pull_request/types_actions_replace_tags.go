@@ -5,6 +5,7 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-git/go-billy/v5"
@@ -31,6 +32,10 @@ var _ modificationConstructor = newFrizbeeTagResolveModification
 func newFrizbeeTagResolveModification(
 	params *modificationConstructorParams,
 ) (fsModifier, error) {
+	if params.ghCli == nil {
+		return nil, errors.New("resolving actions tags requires a GitHub provider")
+	}
+
 	exclude := []string{}
 	if ex := parseExcludesFromRepoConfig(params.bfs); ex != nil {
 		exclude = ex
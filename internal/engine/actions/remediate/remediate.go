@@ -57,9 +57,9 @@ func NewRuleRemediator(
 			ActionType, remediate.GetGhBranchProtection(), client, setting)
 
 	case pull_request.RemediateType:
-		client, err := provinfv1.As[provinfv1.GitHub](provider)
+		client, err := provinfv1.As[provinfv1.PullRequestProvider](provider)
 		if err != nil {
-			return nil, errors.New("provider does not implement git trait")
+			return nil, errors.New("provider does not implement pull request trait")
 		}
 		if remediate.GetPullRequest() == nil {
 			return nil, fmt.Errorf("remediations engine missing pull request configuration")
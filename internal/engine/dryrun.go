@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/ingestcache"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	eoptions "github.com/mindersec/minder/internal/engine/options"
+	"github.com/mindersec/minder/internal/engine/rtengine"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	rtengine2 "github.com/mindersec/minder/pkg/engine/v1/rtengine"
+	"github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// DryRunResult is the outcome of evaluating a single rule during a dry run
+type DryRunResult struct {
+	// RuleType is the name of the rule type
+	RuleType string
+	// RuleName is the name of the rule instance
+	RuleName string
+	// EvalErr is the error returned by the evaluation, if any. It has the
+	// same meaning as in a regular evaluation, e.g. a rule which does not
+	// pass is reported as an evaluation failure.
+	EvalErr error
+	// Result is the output of the evaluation, if any
+	Result *interfaces.EvaluationResult
+}
+
+// DryRunEntityEvent evaluates the entity against the given profile without
+// storing the evaluation results, taking the lock on the entity or running
+// alerts and remediations. Rule types in ruleTypes take precedence over the
+// rule types of the same name in the project hierarchy.
+//
+// Problems which only affect a single rule, such as a rule type which cannot
+// be found, are reported as an evaluation error for that rule.
+func (e *executor) DryRunEntityEvent(
+	ctx context.Context,
+	inf *entities.EntityInfoWrapper,
+	profile *pb.Profile,
+	ruleTypes []*pb.RuleType,
+	fn func(*DryRunResult) error,
+) error {
+	logger := zerolog.Ctx(ctx).With().
+		Str("entity_type", inf.Type.ToString()).
+		Str("provider_id", inf.ProviderID.String()).
+		Str("project_id", inf.ProjectID.String()).
+		Str("profile_name", profile.GetName()).
+		Logger()
+	logger.Info().Msg("entity dry run - started")
+	ctx = logger.WithContext(ctx)
+
+	rules, err := profiles.GetRulesForEntity(profile, inf.Type)
+	if err != nil {
+		return fmt.Errorf("error getting rules for entity: %w", err)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	provider, err := e.providerManager.InstantiateFromID(ctx, inf.ProviderID)
+	if err != nil {
+		return fmt.Errorf("could not instantiate provider: %w", err)
+	}
+
+	hierarchy, err := e.querier.GetParentProjects(ctx, inf.ProjectID)
+	if err != nil {
+		return fmt.Errorf("error getting parent projects: %w", err)
+	}
+
+	overrides := make(map[string]*pb.RuleType, len(ruleTypes))
+	for _, rt := range ruleTypes {
+		overrides[rt.GetName()] = rt
+	}
+
+	ingestCache := e.newIngestCache(inf.Type)
+	dssvc := datasourceservice.NewDataSourceService(e.querier).
+		WithSecretResolver(e.projectSecrets).
+		WithRESTState(e.restState)
+	engines := make(map[string]*rtengine2.RuleTypeEngine)
+
+	// Selectors are evaluated once for the whole profile, as in a regular
+	// evaluation.
+	profileEvalStatus := e.profileEvalStatus(ctx, inf, models.ProfileAggregate{
+		Name:      profile.GetName(),
+		Selectors: models.SelectorSliceFromPB(profile.GetSelection()),
+	})
+
+	for _, rule := range rules {
+		res := &DryRunResult{
+			RuleType: rule.GetType(),
+			RuleName: profiles.ComputeRuleName(rule, rule.GetType()),
+		}
+
+		if profileEvalStatus != nil {
+			res.EvalErr = profileEvalStatus
+		} else {
+			ruleEngine, ok := engines[rule.GetType()]
+			if !ok {
+				ruleEngine, err = e.dryRunRuleEngine(
+					ctx, inf.ProjectID, hierarchy, overrides[rule.GetType()], rule.GetType(),
+					inf.ProviderID, provider, ingestCache, dssvc)
+				if err == nil {
+					engines[rule.GetType()] = ruleEngine
+				}
+			}
+
+			if err != nil {
+				res.EvalErr = err
+			} else {
+				// The params only collect the ingestion result, they are never stored
+				res.Result, res.EvalErr = ruleEngine.Eval(
+					ctx, inf.Entity, rule.GetDef().AsMap(), rule.GetParams().AsMap(), &engif.EvalStatusParams{})
+			}
+		}
+
+		logger.Info().
+			Str("rule_type", res.RuleType).
+			Str("rule_name", res.RuleName).
+			Str("eval_status", string(evalerrors.ErrorAsEvalStatus(res.EvalErr))).
+			Msg("entity dry run - rule evaluated")
+
+		if err := fn(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dryRunRuleEngine builds the rule type engine for a dry run. If override is
+// nil, the rule type is looked up by name in the project hierarchy.
+func (e *executor) dryRunRuleEngine(
+	ctx context.Context,
+	projectID uuid.UUID,
+	hierarchy []uuid.UUID,
+	override *pb.RuleType,
+	name string,
+	providerID uuid.UUID,
+	provider provinfv1.Provider,
+	ingestCache ingestcache.Cache,
+	dssvc datasourceservice.DataSourcesService,
+) (*rtengine2.RuleTypeEngine, error) {
+	ruleType := override
+	if ruleType != nil {
+		// The rule type engine expects the rule type to belong to a project,
+		// which it doesn't if it's not stored yet.
+		ruleType = proto.Clone(ruleType).(*pb.RuleType)
+		project := projectID.String()
+		ruleType.Context = &pb.Context{Project: &project}
+	} else {
+		dbRuleType, err := e.querier.GetRuleTypeByName(ctx, db.GetRuleTypeByNameParams{
+			Projects: hierarchy,
+			Name:     name,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("rule type %s not found", name)
+		} else if err != nil {
+			return nil, fmt.Errorf("error getting rule type %s: %w", name, err)
+		}
+
+		ruleType, err = ruletypes.RuleTypePBFromDB(&dbRuleType)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing rule type %s: %w", name, err)
+		}
+	}
+
+	return rtengine.NewRuleEngine(ctx, ruleType, providerID, provider, e.featureFlags, ingestCache, dssvc,
+		eoptions.WithFlagsClient(e.featureFlags))
+}
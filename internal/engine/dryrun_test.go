@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine"
+	"github.com/mindersec/minder/internal/engine/entities"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
+	mockhistory "github.com/mindersec/minder/internal/history/mock"
+	"github.com/mindersec/minder/internal/metrics/meters"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/flags"
+	"github.com/mindersec/minder/pkg/profiles"
+	tkv1 "github.com/mindersec/minder/pkg/testkit/v1"
+)
+
+func passthroughRuleTypeDef(regoDef string) *minderv1.RuleType_Definition {
+	return &minderv1.RuleType_Definition{
+		InEntity:   minderv1.RepositoryEntity.String(),
+		RuleSchema: &structpb.Struct{},
+		Ingest: &minderv1.RuleType_Definition_Ingest{
+			Type: "builtin",
+			Builtin: &minderv1.BuiltinType{
+				Method: "Passthrough",
+			},
+		},
+		Eval: &minderv1.RuleType_Definition_Eval{
+			Type: "rego",
+			Rego: &minderv1.RuleType_Definition_Eval_Rego{
+				Type: "deny-by-default",
+				Def:  regoDef,
+			},
+		},
+	}
+}
+
+func TestExecutor_DryRunEntityEvent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+	repositoryID := uuid.New()
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().
+		GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID}, nil)
+
+	// The stored rule type does not pass
+	storedDef, err := json.Marshal(passthroughRuleTypeDef(`package minder
+default allow = false`))
+	require.NoError(t, err)
+	mockStore.EXPECT().
+		GetRuleTypeByName(gomock.Any(), db.GetRuleTypeByNameParams{
+			Projects: []uuid.UUID{projectID},
+			Name:     "stored",
+		}).
+		Return(db.RuleType{
+			ID:         uuid.New(),
+			Name:       "stored",
+			ProjectID:  projectID,
+			Definition: storedDef,
+		}, nil)
+	mockStore.EXPECT().
+		GetRuleTypeByName(gomock.Any(), db.GetRuleTypeByNameParams{
+			Projects: []uuid.UUID{projectID},
+			Name:     "missing",
+		}).
+		Return(db.RuleType{}, sql.ErrNoRows)
+
+	// Nothing is ever written to the evaluation history
+	historyService := mockhistory.NewMockEvaluationHistoryService(ctrl)
+
+	providerManager := mockmanager.NewMockProviderManager(ctrl)
+	providerManager.EXPECT().
+		InstantiateFromID(gomock.Any(), providerID).
+		Return(tkv1.NewTestKit(), nil)
+
+	execMetrics, err := engine.NewExecutorMetrics(&meters.NoopMeterFactory{})
+	require.NoError(t, err)
+
+	executor := engine.NewExecutor(
+		mockStore,
+		providerManager,
+		execMetrics,
+		historyService,
+		&flags.FakeClient{},
+		profiles.NewProfileStore(mockStore),
+		selectors.NewEnv(),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	profile := &minderv1.Profile{
+		Name: "test-profile",
+		Repository: []*minderv1.Profile_Rule{
+			{Type: "override", Name: "overridden", Def: &structpb.Struct{}},
+			{Type: "stored", Def: &structpb.Struct{}},
+			{Type: "missing", Def: &structpb.Struct{}},
+		},
+		Artifact: []*minderv1.Profile_Rule{
+			{Type: "not-for-repos", Def: &structpb.Struct{}},
+		},
+	}
+	ruleTypes := []*minderv1.RuleType{
+		{
+			Name: "override",
+			Def: passthroughRuleTypeDef(`package minder
+default allow = true`),
+		},
+	}
+
+	eiw := entities.NewEntityInfoWrapper().
+		WithProviderID(providerID).
+		WithProjectID(projectID).
+		WithRepository(&minderv1.Repository{
+			Owner: "foo",
+			Name:  "test",
+		}).
+		WithID(repositoryID)
+
+	var results []*engine.DryRunResult
+	err = executor.DryRunEntityEvent(context.Background(), eiw, profile, ruleTypes, func(res *engine.DryRunResult) error {
+		results = append(results, res)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, results, 3, "only the repository rules should be evaluated")
+
+	require.Equal(t, "override", results[0].RuleType)
+	require.Equal(t, "overridden", results[0].RuleName)
+	require.NoError(t, results[0].EvalErr)
+
+	require.Equal(t, "stored", results[1].RuleType)
+	require.Equal(t, "stored", results[1].RuleName)
+	require.ErrorIs(t, results[1].EvalErr, evalerrors.ErrEvaluationFailed)
+
+	require.Equal(t, "missing", results[2].RuleType)
+	require.Equal(t, db.EvalStatusTypesError, evalerrors.ErrorAsEvalStatus(results[2].EvalErr))
+	require.ErrorContains(t, results[2].EvalErr, "not found")
+}
@@ -5,6 +5,7 @@ package entities
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/uuid"
@@ -39,6 +40,9 @@ type EntityInfoWrapper struct {
 	OwnershipData map[string]string
 	ExecutionID   *uuid.UUID
 	ActionEvent   string
+	// ProfileIDs restricts the evaluation to the given profiles. All the
+	// profiles are evaluated when empty.
+	ProfileIDs []uuid.UUID
 }
 
 const (
@@ -60,6 +64,9 @@ const (
 	pullRequestIDEventKey = "pull_request_id"
 	// ExecutionIDKey is the key for the execution ID. This is set when acquiring a lock.
 	ExecutionIDKey = "execution_id"
+	// ProfileIDsEventKey is the key for the comma-separated IDs of the
+	// profiles to evaluate. All the profiles are evaluated when unset.
+	ProfileIDsEventKey = "profile_ids"
 )
 
 // NewEntityInfoWrapper creates a new EntityInfoWrapper
@@ -138,6 +145,13 @@ func (eiw *EntityInfoWrapper) WithExecutionID(id uuid.UUID) *EntityInfoWrapper {
 	return eiw
 }
 
+// WithProfileIDs restricts the evaluation to the given profiles
+func (eiw *EntityInfoWrapper) WithProfileIDs(ids []uuid.UUID) *EntityInfoWrapper {
+	eiw.ProfileIDs = ids
+
+	return eiw
+}
+
 // AsRepository sets the entity type to a repository
 func (eiw *EntityInfoWrapper) AsRepository() *EntityInfoWrapper {
 	eiw.Type = minderv1.Entity_ENTITY_REPOSITORIES
@@ -215,6 +229,8 @@ func (eiw *EntityInfoWrapper) ToMessage(msg *message.Message) error {
 		msg.Metadata.Set(ExecutionIDKey, eiw.ExecutionID.String())
 	}
 
+	SetProfileIDs(msg, eiw.ProfileIDs)
+
 	if eiw.Type == minderv1.Entity_ENTITY_UNSPECIFIED {
 		return fmt.Errorf("entity type is required")
 	}
@@ -346,6 +362,36 @@ func (eiw *EntityInfoWrapper) WithExecutionIDFromMessage(msg *message.Message) e
 	return nil
 }
 
+// SetProfileIDs restricts the evaluation of the entity of a message to the
+// given profiles
+func SetProfileIDs(msg *message.Message, ids []uuid.UUID) {
+	if len(ids) == 0 {
+		return
+	}
+
+	strIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strIDs = append(strIDs, id.String())
+	}
+	msg.Metadata.Set(ProfileIDsEventKey, strings.Join(strIDs, ","))
+}
+
+func (eiw *EntityInfoWrapper) withProfileIDsFromMessage(msg *message.Message) error {
+	rawIDs := msg.Metadata.Get(ProfileIDsEventKey)
+	if rawIDs == "" {
+		return nil
+	}
+
+	for _, rawID := range strings.Split(rawIDs, ",") {
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			return fmt.Errorf("malformed profile id %s", rawID)
+		}
+		eiw.ProfileIDs = append(eiw.ProfileIDs, id)
+	}
+	return nil
+}
+
 func (eiw *EntityInfoWrapper) withIDFromMessage(msg *message.Message, key string) error {
 	id, err := getIDFromMessage(msg, key)
 	if err != nil {
@@ -403,6 +449,10 @@ func ParseEntityEvent(msg *message.Message) (*EntityInfoWrapper, error) {
 		return nil, err
 	}
 
+	if err := out.withProfileIDsFromMessage(msg); err != nil {
+		return nil, err
+	}
+
 	if err := out.withEntityInstanceIDFromMessage(msg); err != nil {
 		// We don't fail, but instead log the error and continue
 		// We'll fall back to the other entity ID keys.
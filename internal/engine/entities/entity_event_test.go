@@ -278,12 +278,33 @@ func TestEntityInfoWrapper_ToMessage(t *testing.T) {
 	providerID := uuid.New()
 	artifactID := uuid.New()
 	pullRequestID := uuid.New()
+	profileID1 := uuid.New()
+	profileID2 := uuid.New()
 
 	tests := []struct {
 		name     string
 		eiw      *EntityInfoWrapper
 		expected map[string]string
 	}{
+		{
+			name: "repository event for some profiles",
+			eiw: NewEntityInfoWrapper().
+				WithProviderID(providerID).
+				WithProjectID(projectID).
+				WithRepository(&pb.Repository{
+					Owner:  "test",
+					RepoId: 123,
+				}).
+				WithID(repoID).
+				WithProfileIDs([]uuid.UUID{profileID1, profileID2}),
+			expected: map[string]string{
+				ProviderIDEventKey: providerID.String(),
+				EntityTypeEventKey: pb.Entity_ENTITY_REPOSITORIES.ToString(),
+				ProjectIDEventKey:  projectID.String(),
+				EntityIDEventKey:   repoID.String(),
+				ProfileIDsEventKey: profileID1.String() + "," + profileID2.String(),
+			},
+		},
 		{
 			name: "repository event",
 			eiw: NewEntityInfoWrapper().
@@ -394,6 +415,29 @@ func TestEntityInfoWrapper_ToMessage(t *testing.T) {
 	}
 }
 
+func TestEntityInfoWrapper_ProfileIDsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	profileIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	eiw := NewEntityInfoWrapper().
+		WithProviderID(uuid.New()).
+		WithProjectID(uuid.New()).
+		WithRepository(&pb.Repository{}).
+		WithID(uuid.New()).
+		WithProfileIDs(profileIDs)
+
+	msg, err := eiw.BuildMessage()
+	require.NoError(t, err)
+
+	parsed, err := ParseEntityEvent(msg)
+	require.NoError(t, err)
+	require.Equal(t, profileIDs, parsed.ProfileIDs)
+
+	msg.Metadata.Set(ProfileIDsEventKey, "not-a-uuid")
+	_, err = ParseEntityEvent(msg)
+	require.Error(t, err)
+}
+
 func TestEntityInfoWrapper_FailsWithoutProjectID(t *testing.T) {
 	t.Parallel()
 
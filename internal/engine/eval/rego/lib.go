@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-billy/v5"
@@ -30,6 +31,7 @@ import (
 	"github.com/stacklok/frizbee/pkg/utils/config"
 	"gopkg.in/yaml.v3"
 
+	"github.com/mindersec/minder/internal/datasources/structured"
 	"github.com/mindersec/minder/internal/deps/scalibr"
 	"github.com/mindersec/minder/internal/util"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
@@ -49,6 +51,11 @@ var MinderRegoLib = []func(res *interfaces.Result) func(*rego.Rego){
 	ListGithubActions,
 	ParseYaml,
 	ParseToml,
+	ParseCSV,
+	ParseINI,
+	ParseXML,
+	ParseHCL,
+	ParseProperties,
 	JQIsTrue,
 }
 
@@ -801,6 +808,102 @@ func parseToml(_ rego.BuiltinContext, content *ast.Term) (*ast.Term, error) {
 	return ast.NewTerm(value), nil
 }
 
+// ParseCSV adds the `parse_csv` function to the Rego engine.
+func ParseCSV(_ *interfaces.Result) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "parse_csv",
+			Description: `parse_csv parses a CSV string with a header row.
+			It takes one argument: the CSV content as a string, and returns an
+			array with an object for each row, keyed by the column names.`,
+			Decl: types.NewFunction(types.Args(types.S), types.A),
+		},
+		parseStructured("csv"),
+	)
+}
+
+// ParseINI adds the `parse_ini` function to the Rego engine.
+func ParseINI(_ *interfaces.Result) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "parse_ini",
+			Description: `parse_ini parses an INI string, e.g. a setup.cfg or
+			.editorconfig file, into object data. It takes one argument: the INI
+			content as a string, and returns an object with the keys outside
+			any section, and an object for each section.`,
+			Decl: types.NewFunction(types.Args(types.S), types.A),
+		},
+		parseStructured("ini"),
+	)
+}
+
+// ParseXML adds the `parse_xml` function to the Rego engine.
+func ParseXML(_ *interfaces.Result) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "parse_xml",
+			Description: `parse_xml parses an XML string into object data.
+			It takes one argument: the XML content as a string, and returns an
+			object with the root element. Attributes are prefixed with "@",
+			and repeated child elements are returned as arrays.`,
+			Decl: types.NewFunction(types.Args(types.S), types.A),
+		},
+		parseStructured("xml"),
+	)
+}
+
+// ParseHCL adds the `parse_hcl` function to the Rego engine.
+func ParseHCL(_ *interfaces.Result) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "parse_hcl",
+			Description: `parse_hcl parses an HCL string, e.g. a Terraform file,
+			into object data. It takes one argument: the HCL content as a string,
+			and returns an object with the attributes and blocks. Expressions
+			which are not literals are returned as "${...}" strings.`,
+			Decl: types.NewFunction(types.Args(types.S), types.A),
+		},
+		parseStructured("hcl"),
+	)
+}
+
+// ParseProperties adds the `parse_properties` function to the Rego engine.
+func ParseProperties(_ *interfaces.Result) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "parse_properties",
+			Description: `parse_properties parses a Java .properties string into
+			object data. It takes one argument: the properties content as a
+			string, and returns an object of strings.`,
+			Decl: types.NewFunction(types.Args(types.S), types.A),
+		},
+		parseStructured("properties"),
+	)
+}
+
+// parseStructured returns a function parsing a string in the given format
+// with the decoders of the structured data source
+func parseStructured(format string) func(rego.BuiltinContext, *ast.Term) (*ast.Term, error) {
+	return func(_ rego.BuiltinContext, content *ast.Term) (*ast.Term, error) {
+		var str string
+		if err := ast.As(content.Value, &str); err != nil {
+			return nil, err
+		}
+
+		obj, err := structured.Decode(format, strings.NewReader(str))
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := ast.InterfaceToValue(obj)
+		if err != nil {
+			return nil, fmt.Errorf("error converting to AST value: %w", err)
+		}
+
+		return ast.NewTerm(value), nil
+	}
+}
+
 // DependencyExtract adds the `file.deps` function to the Rego engine.
 func DependencyExtract(res *interfaces.Result) func(*rego.Rego) {
 	return rego.Function1(
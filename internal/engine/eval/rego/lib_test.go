@@ -1198,6 +1198,90 @@ allow {
 	}
 }
 
+func TestParseStructured(t *testing.T) {
+	t.Parallel()
+
+	scenario := []struct {
+		name     string
+		function string
+		content  string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "csv",
+			function: "parse_csv",
+			content:  "path,owner\n/docs,docs-team\n",
+			want:     `[{"path": "/docs", "owner": "docs-team"}]`,
+		},
+		{
+			name:     "ini",
+			function: "parse_ini",
+			content:  "root = true\n[*.go]\nindent_style = tab\n",
+			want:     `{"root": "true", "*.go": {"indent_style": "tab"}}`,
+		},
+		{
+			name:     "xml",
+			function: "parse_xml",
+			content:  `<project><artifactId>minder</artifactId></project>`,
+			want:     `{"project": {"artifactId": "minder"}}`,
+		},
+		{
+			name:     "hcl",
+			function: "parse_hcl",
+			content:  "resource \"aws_s3_bucket\" \"b\" {\n  acl = var.acl\n}\n",
+			want:     `{"resource": {"aws_s3_bucket": {"b": [{"acl": "${var.acl}"}]}}}`,
+		},
+		{
+			name:     "properties",
+			function: "parse_properties",
+			content:  "app.name=minder\n",
+			want:     `{"app.name": "minder"}`,
+		},
+		{
+			name:     "invalid xml",
+			function: "parse_xml",
+			content:  "<project>",
+			wantErr:  true,
+		},
+	}
+
+	for _, s := range scenario {
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			regoCode := fmt.Sprintf(`
+package minder
+
+default allow = false
+
+allow {
+	parsed := %s(%q)
+	expected := json.unmarshal(%q)
+	parsed == expected
+}`, s.function, s.content, s.want)
+
+			e, err := rego.NewRegoEvaluator(
+				&minderv1.RuleType_Definition_Eval_Rego{
+					Type: rego.DenyByDefaultEvaluationType.String(),
+					Def:  regoCode,
+				},
+				nil,
+			)
+
+			require.NoError(t, err, "could not create evaluator")
+
+			_, err = e.Eval(context.Background(), map[string]any{}, nil, &interfaces.Result{})
+
+			if s.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestExtractDeps(t *testing.T) {
 	t.Parallel()
 
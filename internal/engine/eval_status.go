@@ -16,6 +16,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/entities"
 	evalerrors "github.com/mindersec/minder/internal/engine/errors"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/export"
 	"github.com/mindersec/minder/pkg/profiles/models"
 )
 
@@ -45,6 +46,7 @@ func (e *executor) createEvalStatusParams(
 	if err != nil {
 		return nil, fmt.Errorf("error while retrieving rule type name: %w", err)
 	}
+	params.RuleTypeName = ruleTypeName
 
 	nullableRuleTypeName := sql.NullString{
 		String: ruleTypeName,
@@ -156,9 +158,32 @@ func (e *executor) createOrUpdateEvalStatus(
 		return err
 	}
 
+	e.exportStatusChanges(ctx, params)
+
 	return err
 }
 
+// exportStatusChanges queues the evaluation, remediation and alert status
+// changes for export to external systems, if exporting is enabled.
+func (e *executor) exportStatusChanges(ctx context.Context, params *engif.EvalStatusParams) {
+	if e.exportPub == nil {
+		return
+	}
+
+	logger := params.DecorateLogger(zerolog.Ctx(ctx).With().Logger())
+	msgs, err := export.NewMessages(params)
+	if err != nil {
+		logger.Err(err).Msg("error creating export events")
+		return
+	}
+	if len(msgs) == 0 {
+		return
+	}
+	if err := e.exportPub.Publish(export.TopicQueueExportEvent, msgs...); err != nil {
+		logger.Err(err).Msg("error queueing export events")
+	}
+}
+
 func errorAsActionDetails(err error) string {
 	if evalerrors.IsActionFatalError(err) {
 		return err.Error()
@@ -6,15 +6,19 @@ package engine
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
+	"github.com/mindersec/minder/internal/datasources/rest"
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/actions"
 	"github.com/mindersec/minder/internal/engine/actions/alert"
+	"github.com/mindersec/minder/internal/engine/actions/alert/issue"
+	"github.com/mindersec/minder/internal/engine/actions/alert/webhook"
 	"github.com/mindersec/minder/internal/engine/actions/remediate"
 	"github.com/mindersec/minder/internal/engine/entities"
 	evalerrors "github.com/mindersec/minder/internal/engine/errors"
@@ -27,9 +31,11 @@ import (
 	minderlogger "github.com/mindersec/minder/internal/logger"
 	"github.com/mindersec/minder/internal/providers/manager"
 	provsel "github.com/mindersec/minder/internal/providers/selectors"
+	"github.com/mindersec/minder/internal/secrets"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/engine/selectors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	events "github.com/mindersec/minder/pkg/eventer/interfaces"
 	"github.com/mindersec/minder/pkg/flags"
 	"github.com/mindersec/minder/pkg/profiles"
 	"github.com/mindersec/minder/pkg/profiles/models"
@@ -41,6 +47,16 @@ import (
 // Executor is the engine that executes the rules for a given event
 type Executor interface {
 	EvalEntityEvent(ctx context.Context, inf *entities.EntityInfoWrapper) error
+	// DryRunEntityEvent evaluates the entity against a profile which does not
+	// need to be stored. The results are passed to fn rather than recorded,
+	// and no actions are performed.
+	DryRunEntityEvent(
+		ctx context.Context,
+		inf *entities.EntityInfoWrapper,
+		profile *pb.Profile,
+		ruleTypes []*pb.RuleType,
+		fn func(*DryRunResult) error,
+	) error
 }
 
 type executor struct {
@@ -52,6 +68,21 @@ type executor struct {
 	profileStore    profiles.ProfileStore
 	selBuilder      selectors.SelectionBuilder
 	propService     service.PropertiesService
+	// sharedCache is an ingest cache which is shared between entity
+	// evaluations. It may be nil.
+	sharedCache ingestcache.Cache
+	// alertWebhooks resolves the destinations of webhook alerts. It may
+	// be nil, in which case webhook alerts are skipped.
+	alertWebhooks webhook.DestinationResolver
+	// projectSecrets resolves the secrets used by REST data sources. It
+	// may be nil, in which case data sources using secrets fail.
+	projectSecrets secrets.Resolver
+	// restState is shared by the REST data sources of all evaluations,
+	// to cache their responses and limit their requests.
+	restState *rest.SharedState
+	// exportPub queues status changes for export. It may be nil, in
+	// which case nothing is exported.
+	exportPub events.Publisher
 }
 
 // NewExecutor creates a new executor
@@ -64,6 +95,10 @@ func NewExecutor(
 	profileStore profiles.ProfileStore,
 	selBuilder selectors.SelectionBuilder,
 	propService service.PropertiesService,
+	sharedCache ingestcache.Cache,
+	alertWebhooks webhook.DestinationResolver,
+	projectSecrets secrets.Resolver,
+	exportPub events.Publisher,
 ) Executor {
 	return &executor{
 		querier:         querier,
@@ -74,6 +109,11 @@ func NewExecutor(
 		profileStore:    profileStore,
 		selBuilder:      selBuilder,
 		propService:     propService,
+		sharedCache:     sharedCache,
+		alertWebhooks:   alertWebhooks,
+		projectSecrets:  projectSecrets,
+		restState:       rest.NewSharedState(rest.DefaultCacheSize),
+		exportPub:       exportPub,
 	}
 }
 
@@ -99,22 +139,13 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 		return fmt.Errorf("could not instantiate provider: %w", err)
 	}
 
-	// This is a cache, so we can avoid querying the ingester upstream
-	// for every rule. We use a sync.Map because it's safe for concurrent
-	// access.
-	var ingestCache ingestcache.Cache
-	if inf.Type == pb.Entity_ENTITY_ARTIFACTS {
-		// We use a noop cache for artifacts because we don't want to cache
-		// anything for them. The signature information is essentially another artifact version,
-		// and so we don't want to cache that.
-		ingestCache = ingestcache.NewNoopCache()
-	} else {
-		ingestCache = ingestcache.NewCache()
-	}
+	ingestCache := e.newIngestCache(inf.Type)
 
 	defer e.releaseLockAndFlush(ctx, inf)
 
-	dssvc := datasourceservice.NewDataSourceService(e.querier)
+	dssvc := datasourceservice.NewDataSourceService(e.querier).
+		WithSecretResolver(e.projectSecrets).
+		WithRESTState(e.restState)
 
 	entityType := entities.EntityTypeToDB(inf.Type)
 	// Load all the relevant rule type engines for this entity
@@ -123,6 +154,7 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 		e.querier,
 		entityType,
 		inf.ProjectID,
+		inf.ProviderID,
 		provider,
 		e.featureFlags,
 		ingestCache,
@@ -144,6 +176,10 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 	// evaluate each rule and store the outcome in the database. If profileEvalStatus is non-nil,
 	// just store it for all rules without evaluation.
 	for _, profile := range profileAggregates {
+		if len(inf.ProfileIDs) > 0 && !slices.Contains(inf.ProfileIDs, profile.ID) {
+			// e.g. a reminder for the profiles whose evaluation is due
+			continue
+		}
 
 		profileEvalStatus := e.profileEvalStatus(ctx, inf, profile)
 
@@ -157,6 +193,22 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 	return nil
 }
 
+// newIngestCache returns a cache, so we can avoid querying the ingester
+// upstream for every rule.
+func (e *executor) newIngestCache(entityType pb.Entity) ingestcache.Cache {
+	if entityType == pb.Entity_ENTITY_ARTIFACTS {
+		// We use a noop cache for artifacts because we don't want to cache
+		// anything for them. The signature information is essentially another artifact version,
+		// and so we don't want to cache that.
+		return ingestcache.NewNoopCache()
+	} else if e.sharedCache != nil {
+		// Results which aren't in the per-evaluation cache may have been
+		// ingested by an earlier evaluation.
+		return ingestcache.NewTieredCache(ingestcache.NewCache(), e.sharedCache)
+	}
+	return ingestcache.NewCache()
+}
+
 func (e *executor) evaluateRule(
 	ctx context.Context,
 	inf *entities.EntityInfoWrapper,
@@ -180,7 +232,9 @@ func (e *executor) evaluateRule(
 
 	// create the action engine for this rule instance
 	// unlike the rule type engine, this cannot be cached
-	actionEngine, err := actions.NewRuleActions(ctx, ruleEngine.GetRuleType(), provider, &profile.ActionConfig)
+	actionEngine, err := actions.NewRuleActions(
+		ctx, ruleEngine.GetRuleType(), provider, &profile.ActionConfig, e.alertWebhooks,
+		issue.NewRepositoryResolver(e.querier))
 	if err != nil {
 		return fmt.Errorf("cannot create rule actions engine: %w", err)
 	}
@@ -30,6 +30,8 @@ import (
 	"github.com/mindersec/minder/internal/engine/entities"
 	"github.com/mindersec/minder/internal/entities/models"
 	mockprops "github.com/mindersec/minder/internal/entities/properties/service/mock"
+	"github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/export"
 	mockhistory "github.com/mindersec/minder/internal/history/mock"
 	"github.com/mindersec/minder/internal/logger"
 	"github.com/mindersec/minder/internal/metrics/meters"
@@ -328,6 +330,7 @@ default allow = true`,
 			},
 		}, nil)
 
+	exportPub := &stubs.StubEventer{}
 	executor := engine.NewExecutor(
 		mockStore,
 		providerManager,
@@ -337,6 +340,10 @@ default allow = true`,
 		profiles.NewProfileStore(mockStore),
 		selectors.NewEnv(),
 		mockPropSvc,
+		nil,
+		nil,
+		nil,
+		exportPub,
 	)
 
 	eiw := entities.NewEntityInfoWrapper().
@@ -367,6 +374,13 @@ default allow = true`,
 	require.Equal(t, "passthrough", requredEval.RuleType.Name)
 	require.Equal(t, "off", requredEval.Actions[alert.ActionType].State)
 	require.Equal(t, "off", requredEval.Actions[remediate.ActionType].State)
+
+	require.Equal(t, []string{export.TopicQueueExportEvent}, exportPub.Topics)
+	var evalEvent export.Event
+	require.NoError(t, json.Unmarshal(exportPub.Sent[0].Payload, &evalEvent))
+	require.Equal(t, export.EventTypeEvaluation, evalEvent.Type)
+	require.Equal(t, "success", evalEvent.Data.Status)
+	require.Equal(t, "passthrough", evalEvent.Data.RuleType)
 }
 
 func generateFakeAccessToken(t *testing.T, cryptoEngine crypto.Engine) pqtype.NullRawMessage {
@@ -163,6 +163,9 @@ func (e *ExecutorEventHandler) HandleEntityEvent(msg *message.Message) error {
 
 		// We don't need to unset the execution ID because the event is going to be
 		// deleted from the database anyway. The aggregator will take care of that.
+		// The flush cache doesn't record which profiles the queued events were
+		// for, so the flushed event evaluates all the profiles.
+		inf.ProfileIDs = nil
 		msg, err := inf.BuildMessage()
 		if err != nil {
 			logger.Err(err).Msg("error building message")
@@ -0,0 +1,631 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingestcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/osfs"
+	gitcache "github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
+)
+
+const (
+	metaFileName = "meta.json"
+	fsDirName    = "fs"
+	baseFsDir    = "basefs"
+	storerDir    = "git"
+	tmpDirName   = ".tmp"
+)
+
+var (
+	// ErrUnsupportedResult is returned when an ingestion result cannot be
+	// persisted to disk
+	ErrUnsupportedResult = errors.New("ingestion result cannot be persisted")
+
+	errExpiredEntry = errors.New("cache entry expired")
+	errInvalidEntry = errors.New("invalid cache entry")
+
+	// REST responses may change without a push to the repository, so they
+	// are only cached when explicitly configured.
+	defaultCachedIngesters = []string{"git", "deps"}
+)
+
+// entryMeta is the on-disk metadata of a cache entry
+type entryMeta struct {
+	Ingester   string                            `json:"ingester"`
+	Version    string                            `json:"version,omitempty"`
+	CreatedAt  time.Time                         `json:"created_at"`
+	Object     json.RawMessage                   `json:"object,omitempty"`
+	Checkpoint *checkpoints.CheckpointEnvelopeV1 `json:"checkpoint,omitempty"`
+	HasFs      bool                              `json:"has_fs,omitempty"`
+	HasBaseFs  bool                              `json:"has_base_fs,omitempty"`
+	HasStorer  bool                              `json:"has_storer,omitempty"`
+}
+
+// indexEntry tracks the size and usage of an entry for eviction purposes
+type indexEntry struct {
+	size       int64
+	lastAccess time.Time
+	// generation tells apart the successive entries stored for a key, so
+	// that a stale reader does not remove a newer entry
+	generation uint64
+}
+
+// entryLock serializes the access to the directory of an entry: entries
+// are restored under the read side, and replaced or removed under the
+// write side. It is dropped once no one holds or waits for it.
+type entryLock struct {
+	sync.RWMutex
+	refs int
+}
+
+type diskCache struct {
+	dir       string
+	ttl       time.Duration
+	maxBytes  int64
+	ingesters []string
+
+	mu             sync.Mutex
+	index          map[string]*indexEntry
+	locks          map[string]*entryLock
+	totalSize      int64
+	lastGeneration uint64
+
+	// now is overridable for tests
+	now func() time.Time
+}
+
+// NewDiskCache returns a Cache which persists ingestion results to a
+// content-addressed store on the local filesystem. Entries are keyed by
+// the ingester, rule parameters and entity, plus the entity's version
+// (e.g. the commit SHA of a pull request). Results for entities whose
+// version is unknown are not cached.
+// The cache may be shared between entity evaluations.
+func NewDiskCache(cfg *serverconfig.DiskIngestCacheConfig) (Cache, error) {
+	if cfg == nil || cfg.Dir == "" {
+		return nil, errors.New("disk cache directory must be set")
+	}
+
+	ingesters := cfg.Ingesters
+	if len(ingesters) == 0 {
+		ingesters = defaultCachedIngesters
+	}
+
+	if err := os.MkdirAll(filepath.Join(cfg.Dir, tmpDirName), 0750); err != nil {
+		return nil, fmt.Errorf("cannot create cache directory: %w", err)
+	}
+
+	initMetrics()
+
+	dc := &diskCache{
+		dir:       cfg.Dir,
+		ttl:       cfg.TTL,
+		maxBytes:  cfg.MaxBytes,
+		ingesters: ingesters,
+		index:     make(map[string]*indexEntry),
+		locks:     make(map[string]*entryLock),
+		now:       time.Now,
+	}
+
+	if err := dc.loadIndex(); err != nil {
+		return nil, fmt.Errorf("cannot load cache index: %w", err)
+	}
+
+	return dc, nil
+}
+
+// Get attempts to get a result from the on-disk cache
+func (d *diskCache) Get(
+	ingester interfaces.Ingester,
+	entity protoreflect.ProtoMessage,
+	params map[string]any,
+) (*interfaces.Result, bool) {
+	if !d.cacheable(ingester, entity) {
+		return nil, false
+	}
+
+	key, err := d.entryKey(ingester, entity, params)
+	if err != nil {
+		log.Printf("error building cache key: %v", err)
+		return nil, false
+	}
+
+	res, err := d.load(key)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Printf("error loading cache entry %s: %v", key, err)
+		}
+		recordMiss(ingester.GetType())
+		return nil, false
+	}
+
+	recordHit(ingester.GetType())
+	return res, true
+}
+
+// Set stores a result in the on-disk cache
+func (d *diskCache) Set(
+	ingester interfaces.Ingester,
+	entity protoreflect.ProtoMessage,
+	params map[string]any,
+	result *interfaces.Result,
+) {
+	if !d.cacheable(ingester, entity) || result == nil {
+		return
+	}
+
+	key, err := d.entryKey(ingester, entity, params)
+	if err != nil {
+		log.Printf("error building cache key: %v", err)
+		return
+	}
+
+	version, _ := entityVersion(entity)
+	if err := d.store(key, ingester.GetType(), version, result); err != nil {
+		log.Printf("error storing cache entry %s: %v", key, err)
+	}
+}
+
+// cacheable returns whether the results of the ingester for the entity are
+// shared. Results for entities whose version is unknown are not, as they
+// could not be told apart from those for older versions of the entity.
+func (d *diskCache) cacheable(ingester interfaces.Ingester, entity protoreflect.ProtoMessage) bool {
+	if ingester == nil || !slices.Contains(d.ingesters, ingester.GetType()) {
+		return false
+	}
+	_, ok := entityVersion(entity)
+	return ok
+}
+
+func (d *diskCache) entryKey(
+	ingester interfaces.Ingester,
+	entity protoreflect.ProtoMessage,
+	params map[string]any,
+) (string, error) {
+	digest, err := buildCacheKey(ingester, entity, params)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.New()
+	// writes to a hash never fail
+	_, _ = sum.Write([]byte(digest))
+	_, _ = sum.Write([]byte{0})
+	version, _ := entityVersion(entity)
+	_, _ = sum.Write([]byte(version))
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+func (d *diskCache) entryDir(key string) string {
+	return filepath.Join(d.dir, key[:2], key)
+}
+
+func (d *diskCache) load(key string) (*interfaces.Result, error) {
+	res, generation, err := d.restore(key)
+	switch {
+	case errors.Is(err, errExpiredEntry):
+		d.evict(key, generation)
+		return nil, os.ErrNotExist
+	case errors.Is(err, errInvalidEntry):
+		d.remove(key, generation)
+	}
+	return res, err
+}
+
+// restore reads an entry from the disk, returning it along with its
+// generation.
+func (d *diskCache) restore(key string) (*interfaces.Result, uint64, error) {
+	unlock := d.lockEntry(key, false)
+	defer unlock()
+
+	d.mu.Lock()
+	ent, ok := d.index[key]
+	d.mu.Unlock()
+	if !ok {
+		return nil, 0, os.ErrNotExist
+	}
+
+	dir := d.entryDir(key)
+	metaPath := filepath.Clean(filepath.Join(dir, metaFileName))
+	rawMeta, err := os.ReadFile(metaPath)
+	if err != nil {
+		return nil, ent.generation, fmt.Errorf("%w: %w", errInvalidEntry, err)
+	}
+
+	var meta entryMeta
+	if err := json.Unmarshal(rawMeta, &meta); err != nil {
+		return nil, ent.generation, fmt.Errorf("%w: cannot parse entry metadata: %w", errInvalidEntry, err)
+	}
+
+	if d.expired(meta.CreatedAt) {
+		return nil, ent.generation, errExpiredEntry
+	}
+
+	res := &interfaces.Result{
+		Checkpoint: meta.Checkpoint,
+	}
+
+	if len(meta.Object) > 0 {
+		if err := json.Unmarshal(meta.Object, &res.Object); err != nil {
+			return nil, ent.generation, fmt.Errorf("cannot parse cached object: %w", err)
+		}
+	}
+
+	if meta.HasFs {
+		if res.Fs, err = restoreFs(filepath.Join(dir, fsDirName)); err != nil {
+			return nil, ent.generation, err
+		}
+	}
+
+	if meta.HasBaseFs {
+		if res.BaseFs, err = restoreFs(filepath.Join(dir, baseFsDir)); err != nil {
+			return nil, ent.generation, err
+		}
+	}
+
+	if meta.HasStorer {
+		storerFs, err := restoreFs(filepath.Join(dir, storerDir))
+		if err != nil {
+			return nil, ent.generation, err
+		}
+		res.Storer = filesystem.NewStorage(storerFs, gitcache.NewObjectLRUDefault())
+	}
+
+	now := d.now()
+	d.mu.Lock()
+	ent.lastAccess = now
+	d.mu.Unlock()
+	// the modification time of the metadata file records the last access
+	// across restarts
+	_ = os.Chtimes(metaPath, now, now)
+
+	return res, ent.generation, nil
+}
+
+func (d *diskCache) store(key, ingesterType, version string, result *interfaces.Result) error {
+	meta := entryMeta{
+		Ingester:   ingesterType,
+		Version:    version,
+		CreatedAt:  d.now(),
+		Checkpoint: result.Checkpoint,
+		HasFs:      result.Fs != nil,
+		HasBaseFs:  result.BaseFs != nil,
+		HasStorer:  result.Storer != nil,
+	}
+
+	var storerFs billy.Filesystem
+	if result.Storer != nil {
+		fsStorer, ok := result.Storer.(*filesystem.Storage)
+		if !ok {
+			return fmt.Errorf("%w: unsupported git storer %T", ErrUnsupportedResult, result.Storer)
+		}
+		storerFs = fsStorer.Filesystem()
+	}
+
+	if result.Object != nil {
+		obj, err := json.Marshal(result.Object)
+		if err != nil {
+			return fmt.Errorf("%w: cannot marshal object: %w", ErrUnsupportedResult, err)
+		}
+		meta.Object = obj
+	}
+
+	tmpDir, err := os.MkdirTemp(filepath.Join(d.dir, tmpDirName), key+"-")
+	if err != nil {
+		return fmt.Errorf("cannot create temporary directory: %w", err)
+	}
+	// no-op once the entry has been moved in place
+	defer os.RemoveAll(tmpDir)
+
+	var size int64
+	for _, snap := range []struct {
+		fs  billy.Filesystem
+		dir string
+	}{
+		{result.Fs, fsDirName},
+		{result.BaseFs, baseFsDir},
+		{storerFs, storerDir},
+	} {
+		if snap.fs == nil {
+			continue
+		}
+		n, err := copyTree(osfs.New(filepath.Join(tmpDir, snap.dir)), snap.fs, "/")
+		size += n
+		if err != nil {
+			return fmt.Errorf("cannot snapshot filesystem: %w", err)
+		}
+		if d.maxBytes > 0 && size > d.maxBytes {
+			return fmt.Errorf("%w: entry exceeds maximum cache size", ErrUnsupportedResult)
+		}
+	}
+
+	rawMeta, err := json.Marshal(&meta)
+	if err != nil {
+		return fmt.Errorf("cannot marshal entry metadata: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, metaFileName), rawMeta, 0600); err != nil {
+		return fmt.Errorf("cannot write entry metadata: %w", err)
+	}
+	size += int64(len(rawMeta))
+
+	if err := d.replace(key, tmpDir, size, meta.CreatedAt); err != nil {
+		return err
+	}
+
+	d.enforceLimit()
+	return nil
+}
+
+// replace moves a new entry in place of any previous entry for the key
+func (d *diskCache) replace(key, tmpDir string, size int64, createdAt time.Time) error {
+	unlock := d.lockEntry(key, true)
+	defer unlock()
+
+	d.mu.Lock()
+	old, ok := d.unindex(key)
+	d.mu.Unlock()
+	if ok {
+		recordSize(-old.size)
+	}
+
+	dir := d.entryDir(key)
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("cannot remove previous entry: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dir), 0750); err != nil {
+		return fmt.Errorf("cannot create entry directory: %w", err)
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return fmt.Errorf("cannot move entry in place: %w", err)
+	}
+
+	d.mu.Lock()
+	d.addToIndex(key, &indexEntry{size: size, lastAccess: createdAt})
+	d.mu.Unlock()
+	recordSize(size)
+	return nil
+}
+
+func (d *diskCache) expired(created time.Time) bool {
+	return d.ttl > 0 && d.now().Sub(created) > d.ttl
+}
+
+// enforceLimit evicts the least recently used entries until the cache
+// fits within the configured maximum size.
+func (d *diskCache) enforceLimit() {
+	if d.maxBytes <= 0 {
+		return
+	}
+
+	d.mu.Lock()
+	if d.totalSize <= d.maxBytes {
+		d.mu.Unlock()
+		return
+	}
+
+	keys := make([]string, 0, len(d.index))
+	for k := range d.index {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return d.index[keys[i]].lastAccess.Before(d.index[keys[j]].lastAccess)
+	})
+
+	victims := make(map[string]uint64)
+	remaining := d.totalSize
+	for _, k := range keys {
+		if remaining <= d.maxBytes {
+			break
+		}
+		remaining -= d.index[k].size
+		victims[k] = d.index[k].generation
+	}
+	d.mu.Unlock()
+
+	for k, generation := range victims {
+		d.evict(k, generation)
+	}
+}
+
+func (d *diskCache) evict(key string, generation uint64) {
+	if d.remove(key, generation) {
+		recordEviction()
+	}
+}
+
+// remove deletes the given generation of an entry from the index and the
+// disk, returning whether it was present. Newer entries for the key are
+// left alone.
+func (d *diskCache) remove(key string, generation uint64) bool {
+	unlock := d.lockEntry(key, true)
+	defer unlock()
+
+	d.mu.Lock()
+	ent, ok := d.index[key]
+	if ok && ent.generation == generation {
+		d.unindex(key)
+	} else {
+		ok = false
+	}
+	d.mu.Unlock()
+	if !ok {
+		return false
+	}
+
+	recordSize(-ent.size)
+	if err := os.RemoveAll(d.entryDir(key)); err != nil {
+		log.Printf("error removing cache entry %s: %v", key, err)
+	}
+	return true
+}
+
+// addToIndex records a new entry, assigning it the next generation.
+// d.mu must be held.
+func (d *diskCache) addToIndex(key string, ent *indexEntry) {
+	d.lastGeneration++
+	ent.generation = d.lastGeneration
+	d.index[key] = ent
+	d.totalSize += ent.size
+}
+
+// unindex drops an entry from the index, returning it if it was present.
+// d.mu must be held.
+func (d *diskCache) unindex(key string) (*indexEntry, bool) {
+	ent, ok := d.index[key]
+	if ok {
+		delete(d.index, key)
+		d.totalSize -= ent.size
+	}
+	return ent, ok
+}
+
+// lockEntry locks the entry for the key, exclusively for writers, and
+// returns the function to unlock it.
+func (d *diskCache) lockEntry(key string, exclusive bool) func() {
+	d.mu.Lock()
+	l, ok := d.locks[key]
+	if !ok {
+		l = &entryLock{}
+		d.locks[key] = l
+	}
+	l.refs++
+	d.mu.Unlock()
+
+	if exclusive {
+		l.Lock()
+	} else {
+		l.RLock()
+	}
+
+	return func() {
+		if exclusive {
+			l.Unlock()
+		} else {
+			l.RUnlock()
+		}
+
+		d.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(d.locks, key)
+		}
+		d.mu.Unlock()
+	}
+}
+
+// loadIndex rebuilds the in-memory index from the entries already on disk,
+// so that the cache survives restarts.
+func (d *diskCache) loadIndex() error {
+	// leftovers from interrupted writes
+	if err := os.RemoveAll(filepath.Join(d.dir, tmpDirName)); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(d.dir, tmpDirName), 0750); err != nil {
+		return err
+	}
+
+	prefixes, err := os.ReadDir(d.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, prefix := range prefixes {
+		if !prefix.IsDir() || prefix.Name() == tmpDirName {
+			continue
+		}
+		entries, err := os.ReadDir(filepath.Join(d.dir, prefix.Name()))
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			info, err := os.Stat(filepath.Join(d.dir, prefix.Name(), e.Name(), metaFileName))
+			if err != nil {
+				// incomplete entry, drop it
+				_ = os.RemoveAll(filepath.Join(d.dir, prefix.Name(), e.Name()))
+				continue
+			}
+			size, err := dirSize(filepath.Join(d.dir, prefix.Name(), e.Name()))
+			if err != nil {
+				return err
+			}
+			d.addToIndex(e.Name(), &indexEntry{size: size, lastAccess: info.ModTime()})
+		}
+	}
+
+	recordSize(d.totalSize)
+	d.enforceLimit()
+	return nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, de os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if de.Type().IsRegular() {
+			info, err := de.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// restoreFs copies a snapshot into a fresh in-memory filesystem so that
+// callers are free to modify it without affecting the cached copy.
+func restoreFs(dir string) (billy.Filesystem, error) {
+	mfs := memfs.New()
+	if _, err := copyTree(mfs, osfs.New(dir), "/"); err != nil {
+		return nil, fmt.Errorf("cannot restore filesystem: %w", err)
+	}
+	return mfs, nil
+}
+
+// entityVersion returns a string identifying the version of the entity
+// the ingestion was done for, so that changes to the entity invalidate
+// previously cached results, and whether the version is known.
+// Repositories are versioned by the time of their last push. Only the
+// GitHub provider sets it, so repositories of other providers are never
+// cached. The property is read from the properties cache, so a push may
+// take a few minutes to invalidate the entries of a repository.
+func entityVersion(entity protoreflect.ProtoMessage) (string, bool) {
+	var version string
+	switch ent := entity.(type) {
+	case *pbinternal.PullRequest:
+		version = ent.GetCommitSha()
+	case *pb.Artifact:
+		if len(ent.GetVersions()) > 0 {
+			version = ent.GetVersions()[0].GetSha()
+		}
+	case *pb.Repository:
+		version = ent.GetProperties().GetFields()[properties.RepoPropertyPushedAt].GetStringValue()
+	}
+	return version, version != ""
+}
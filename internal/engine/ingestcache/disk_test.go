@@ -0,0 +1,344 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingestcache
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	gogit "github.com/go-git/go-git/v5"
+	gitcache "github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/engine/ingester/git"
+	"github.com/mindersec/minder/internal/engine/ingester/rest"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
+)
+
+var testIngesters = []string{"git", "rest"}
+
+func newTestDiskCache(t *testing.T, maxBytes int64) *diskCache {
+	t.Helper()
+
+	c, err := NewDiskCache(&serverconfig.DiskIngestCacheConfig{
+		Dir:       t.TempDir(),
+		TTL:       time.Hour,
+		MaxBytes:  maxBytes,
+		Ingesters: testIngesters,
+	})
+	require.NoError(t, err)
+	dc, ok := c.(*diskCache)
+	require.True(t, ok)
+	return dc
+}
+
+// testRepo returns a repository last pushed to at pushedAt
+func testRepo(repoID int64, pushedAt string) *minderv1.Repository {
+	props, _ := structpb.NewStruct(map[string]any{properties.RepoPropertyPushedAt: pushedAt})
+	return &minderv1.Repository{
+		Owner:      "foo",
+		Name:       "bar",
+		RepoId:     repoID,
+		CloneUrl:   "https://example.com/foo/bar",
+		Properties: props,
+	}
+}
+
+func TestDiskCacheObject(t *testing.T) {
+	t.Parallel()
+
+	dc := newTestDiskCache(t, 0)
+	ing := &rest.Ingestor{}
+	repo := testRepo(1, "2025-01-01T00:00:00Z")
+	params := map[string]any{"foo": "bar"}
+
+	_, ok := dc.Get(ing, repo, params)
+	require.False(t, ok, "cache should be empty")
+
+	res := &interfaces.Result{
+		Object:     map[string]any{"foo": "bar", "num": float64(1)},
+		Checkpoint: checkpoints.NewCheckpointV1Now().WithHTTP("http://localhost", "GET"),
+	}
+	dc.Set(ing, repo, params, res)
+
+	got, ok := dc.Get(ing, repo, params)
+	require.True(t, ok, "cache should have value")
+	require.Equal(t, res.Object, got.Object)
+	require.Equal(t, res.Checkpoint.Checkpoint.HTTPURL, got.Checkpoint.Checkpoint.HTTPURL)
+
+	// The entry survives a restart
+	reopened, err := NewDiskCache(&serverconfig.DiskIngestCacheConfig{
+		Dir: dc.dir, TTL: time.Hour, Ingesters: testIngesters,
+	})
+	require.NoError(t, err)
+	got, ok = reopened.Get(ing, repo, params)
+	require.True(t, ok, "reopened cache should have value")
+	require.Equal(t, res.Object, got.Object)
+}
+
+func TestDiskCacheEntityVersion(t *testing.T) {
+	t.Parallel()
+
+	dc := newTestDiskCache(t, 0)
+	ing := &rest.Ingestor{}
+	pr := &pbinternal.PullRequest{Number: 1, CommitSha: "aaaa"}
+
+	dc.Set(ing, pr, nil, &interfaces.Result{Object: "data"})
+
+	_, ok := dc.Get(ing, pr, nil)
+	require.True(t, ok)
+
+	newPr := &pbinternal.PullRequest{Number: 1, CommitSha: "bbbb"}
+	_, ok = dc.Get(ing, newPr, nil)
+	require.False(t, ok, "a new commit should not hit the cache")
+
+	repo := testRepo(1, "2025-01-01T00:00:00Z")
+	dc.Set(ing, repo, nil, &interfaces.Result{Object: "data"})
+	_, ok = dc.Get(ing, repo, nil)
+	require.True(t, ok)
+
+	_, ok = dc.Get(ing, testRepo(1, "2025-01-02T00:00:00Z"), nil)
+	require.False(t, ok, "a push should not hit the cache")
+}
+
+func TestDiskCacheUnknownVersion(t *testing.T) {
+	t.Parallel()
+
+	dc := newTestDiskCache(t, 0)
+	ing := &rest.Ingestor{}
+	repo := &minderv1.Repository{Owner: "foo", Name: "bar"}
+
+	dc.Set(ing, repo, nil, &interfaces.Result{Object: "data"})
+	_, ok := dc.Get(ing, repo, nil)
+	require.False(t, ok, "entities without a version should not be cached")
+	require.Empty(t, dc.index)
+}
+
+func TestDiskCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	dc := newTestDiskCache(t, 0)
+	ing := &rest.Ingestor{}
+	repo := testRepo(1, "2025-01-01T00:00:00Z")
+
+	now := time.Now()
+	dc.now = func() time.Time { return now }
+	dc.Set(ing, repo, nil, &interfaces.Result{Object: "data"})
+
+	dc.now = func() time.Time { return now.Add(2 * time.Hour) }
+	_, ok := dc.Get(ing, repo, nil)
+	require.False(t, ok, "expired entries should not be returned")
+	require.Empty(t, dc.index)
+	require.Zero(t, dc.totalSize)
+}
+
+func TestDiskCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	dc := newTestDiskCache(t, 600)
+	ing := &rest.Ingestor{}
+	payload := make([]byte, 200)
+	for i := range payload {
+		payload[i] = 'a'
+	}
+
+	now := time.Now()
+	repos := make([]*minderv1.Repository, 0, 4)
+	for i := range 4 {
+		repo := testRepo(int64(i), "2025-01-01T00:00:00Z")
+		repos = append(repos, repo)
+		dc.now = func() time.Time { return now.Add(time.Duration(i) * time.Minute) }
+		dc.Set(ing, repo, nil, &interfaces.Result{Object: string(payload)})
+	}
+
+	require.LessOrEqual(t, dc.totalSize, int64(600))
+	_, ok := dc.Get(ing, repos[0], nil)
+	require.False(t, ok, "least recently used entry should have been evicted")
+	_, ok = dc.Get(ing, repos[3], nil)
+	require.True(t, ok, "most recently used entry should be kept")
+}
+
+func TestDiskCacheStaleRemoval(t *testing.T) {
+	t.Parallel()
+
+	dc := newTestDiskCache(t, 0)
+	ing := &rest.Ingestor{}
+	repo := testRepo(1, "2025-01-01T00:00:00Z")
+
+	key, err := dc.entryKey(ing, repo, nil)
+	require.NoError(t, err)
+
+	dc.Set(ing, repo, nil, &interfaces.Result{Object: "old"})
+	stale := dc.index[key].generation
+	dc.Set(ing, repo, nil, &interfaces.Result{Object: "new"})
+
+	require.False(t, dc.remove(key, stale), "a stale generation should not remove the newer entry")
+	got, ok := dc.Get(ing, repo, nil)
+	require.True(t, ok)
+	require.Equal(t, "new", got.Object)
+}
+
+func TestDiskCacheConcurrentAccess(t *testing.T) {
+	t.Parallel()
+
+	// Each entry is a little over 500 bytes, so storing one evicts the other
+	dc := newTestDiskCache(t, 1000)
+	ing := &rest.Ingestor{}
+	repos := []*minderv1.Repository{
+		testRepo(1, "2025-01-01T00:00:00Z"),
+		testRepo(2, "2025-01-01T00:00:00Z"),
+	}
+
+	files := []string{"a", "b", "c", "d", "e"}
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		repo := repos[i%len(repos)]
+		fs := memfs.New()
+		for _, name := range files {
+			require.NoError(t, util.WriteFile(fs, name, make([]byte, 100), 0644))
+		}
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				dc.Set(ing, repo, nil, &interfaces.Result{Fs: fs})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				got, ok := dc.Get(ing, repo, nil)
+				if !ok {
+					continue
+				}
+				for _, name := range files {
+					info, err := got.Fs.Stat(name)
+					if assert.NoError(t, err, "restored filesystem should be complete") {
+						assert.Equal(t, int64(100), info.Size())
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	require.Empty(t, dc.locks, "entry locks should be released")
+}
+
+func TestDiskCacheSkipsUncachedIngesters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		ingesters []string
+	}{
+		{
+			name:      "not listed",
+			ingesters: []string{"git"},
+		},
+		{
+			name: "rest is not cached by default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := NewDiskCache(&serverconfig.DiskIngestCacheConfig{
+				Dir:       t.TempDir(),
+				Ingesters: tt.ingesters,
+			})
+			require.NoError(t, err)
+
+			ing := &rest.Ingestor{}
+			repo := testRepo(1, "2025-01-01T00:00:00Z")
+			c.Set(ing, repo, nil, &interfaces.Result{Object: "data"})
+			_, ok := c.Get(ing, repo, nil)
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestDiskCacheGitRepository(t *testing.T) {
+	t.Parallel()
+
+	dc := newTestDiskCache(t, 0)
+
+	wt := memfs.New()
+	storer := filesystem.NewStorage(memfs.New(), gitcache.NewObjectLRUDefault())
+	r, err := gogit.Init(storer, wt)
+	require.NoError(t, err)
+	require.NoError(t, util.WriteFile(wt, "dir/README.md", []byte("hello"), 0644))
+	w, err := r.Worktree()
+	require.NoError(t, err)
+	_, err = w.Add("dir/README.md")
+	require.NoError(t, err)
+	hash, err := w.Commit("initial", &gogit.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	ing := &git.Git{}
+	repo := testRepo(1, "2025-01-01T00:00:00Z")
+	dc.Set(ing, repo, nil, &interfaces.Result{Fs: wt, Storer: storer})
+
+	got, ok := dc.Get(ing, repo, nil)
+	require.True(t, ok)
+
+	f, err := got.Fs.Open("dir/README.md")
+	require.NoError(t, err)
+	contents, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, "hello", string(contents))
+
+	restored, err := gogit.Open(got.Storer, got.Fs)
+	require.NoError(t, err)
+	head, err := restored.Head()
+	require.NoError(t, err)
+	require.Equal(t, hash, head.Hash())
+
+	// Modifying the restored filesystem must not change the cached copy
+	require.NoError(t, got.Fs.Remove("dir/README.md"))
+	again, ok := dc.Get(ing, repo, nil)
+	require.True(t, ok)
+	_, err = again.Fs.Stat("dir/README.md")
+	require.NoError(t, err)
+}
+
+func TestTieredCache(t *testing.T) {
+	t.Parallel()
+
+	shared := newTestDiskCache(t, 0)
+	ing := &rest.Ingestor{}
+	repo := testRepo(1, "2025-01-01T00:00:00Z")
+	res := &interfaces.Result{Object: map[string]any{"foo": "bar"}}
+
+	first := NewTieredCache(NewCache(), shared)
+	first.Set(ing, repo, nil, res)
+
+	// A second evaluation gets the result from the shared cache
+	second := NewTieredCache(NewCache(), shared)
+	got, ok := second.Get(ing, repo, nil)
+	require.True(t, ok)
+	require.Equal(t, res.Object, got.Object)
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingestcache
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/go-git/go-billy/v5"
+)
+
+// copyTree recursively copies the contents of dir in src to the same
+// location in dst, returning the number of bytes copied.
+func copyTree(dst, src billy.Filesystem, dir string) (int64, error) {
+	infos, err := src.ReadDir(dir)
+	if err != nil {
+		return 0, fmt.Errorf("cannot read directory %s: %w", dir, err)
+	}
+
+	if err := dst.MkdirAll(dir, 0750); err != nil {
+		return 0, fmt.Errorf("cannot create directory %s: %w", dir, err)
+	}
+
+	var total int64
+	for _, info := range infos {
+		name := path.Join(dir, info.Name())
+		switch {
+		case info.IsDir():
+			n, err := copyTree(dst, src, name)
+			total += n
+			if err != nil {
+				return total, err
+			}
+		case info.Mode()&os.ModeSymlink != 0:
+			target, err := src.Readlink(name)
+			if err != nil {
+				return total, fmt.Errorf("cannot read link %s: %w", name, err)
+			}
+			if err := dst.Symlink(target, name); err != nil {
+				return total, fmt.Errorf("cannot create link %s: %w", name, err)
+			}
+		default:
+			n, err := copyFile(dst, src, name, info.Mode())
+			total += n
+			if err != nil {
+				return total, err
+			}
+		}
+	}
+
+	return total, nil
+}
+
+func copyFile(dst, src billy.Filesystem, name string, mode os.FileMode) (int64, error) {
+	in, err := src.Open(name)
+	if err != nil {
+		return 0, fmt.Errorf("cannot open %s: %w", name, err)
+	}
+	defer in.Close()
+
+	out, err := dst.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return 0, fmt.Errorf("cannot create %s: %w", name, err)
+	}
+	defer out.Close()
+
+	n, err := io.Copy(out, in)
+	if err != nil {
+		return n, fmt.Errorf("cannot copy %s: %w", name, err)
+	}
+	return n, nil
+}
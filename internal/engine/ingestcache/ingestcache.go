@@ -14,9 +14,17 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 )
 
+const (
+	// IngestCacheTypeNone disables the shared ingest cache
+	IngestCacheTypeNone = "none"
+	// IngestCacheTypeDisk stores shared ingest results on the local disk
+	IngestCacheTypeDisk = "disk"
+)
+
 // ErrBuildingCacheKey is the error returned when building a cache key fails
 var ErrBuildingCacheKey = errors.New("error building cache key")
 
@@ -110,3 +118,17 @@ func buildCacheKey(
 
 	return string(chsum.Sum(nil)), nil
 }
+
+// NewSharedCacheFromConfig returns the cache to share between entity
+// evaluations based on the server configuration. It returns nil if no
+// shared cache is configured.
+func NewSharedCacheFromConfig(cfg *serverconfig.IngestCacheConfig) (Cache, error) {
+	switch cfg.Type {
+	case "", IngestCacheTypeNone:
+		return nil, nil
+	case IngestCacheTypeDisk:
+		return NewDiskCache(&cfg.Disk)
+	default:
+		return nil, fmt.Errorf("unknown ingest cache type: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingestcache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	metricsInit sync.Once
+
+	hitCounter      metric.Int64Counter
+	missCounter     metric.Int64Counter
+	evictionCounter metric.Int64Counter
+	sizeCounter     metric.Int64UpDownCounter
+)
+
+func initMetrics() {
+	metricsInit.Do(func() {
+		meter := otel.Meter("minder")
+		var err error
+		hitCounter, err = meter.Int64Counter(
+			"ingest_cache.hits",
+			metric.WithDescription("Number of ingestion results served from the shared ingest cache"),
+		)
+		if err != nil {
+			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating counter for ingest cache hits failed")
+		}
+		missCounter, err = meter.Int64Counter(
+			"ingest_cache.misses",
+			metric.WithDescription("Number of ingestion results not found in the shared ingest cache"),
+		)
+		if err != nil {
+			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating counter for ingest cache misses failed")
+		}
+		evictionCounter, err = meter.Int64Counter(
+			"ingest_cache.evictions",
+			metric.WithDescription("Number of entries evicted from the shared ingest cache"),
+		)
+		if err != nil {
+			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating counter for ingest cache evictions failed")
+		}
+		sizeCounter, err = meter.Int64UpDownCounter(
+			"ingest_cache.size",
+			metric.WithDescription("Total size of the shared ingest cache"),
+			metric.WithUnit("By"),
+		)
+		if err != nil {
+			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating counter for ingest cache size failed")
+		}
+	})
+}
+
+func recordHit(ingester string) {
+	if hitCounter != nil {
+		hitCounter.Add(context.Background(), 1, metric.WithAttributes(attribute.String("ingester", ingester)))
+	}
+}
+
+func recordMiss(ingester string) {
+	if missCounter != nil {
+		missCounter.Add(context.Background(), 1, metric.WithAttributes(attribute.String("ingester", ingester)))
+	}
+}
+
+func recordEviction() {
+	if evictionCounter != nil {
+		evictionCounter.Add(context.Background(), 1)
+	}
+}
+
+func recordSize(delta int64) {
+	if sizeCounter != nil && delta != 0 {
+		sizeCounter.Add(context.Background(), delta)
+	}
+}
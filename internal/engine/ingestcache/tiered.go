@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingestcache
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+type tieredCache struct {
+	front Cache
+	back  Cache
+}
+
+// NewTieredCache returns a cache which looks up results in front first,
+// falling back to back. Results found in back are promoted to front, and
+// new results are stored in both.
+//
+// This is used to put the per-evaluation in-memory cache in front of a
+// cache which is shared between evaluations.
+func NewTieredCache(front, back Cache) Cache {
+	return &tieredCache{
+		front: front,
+		back:  back,
+	}
+}
+
+// Get attempts to get a result from the cache
+func (t *tieredCache) Get(
+	ingester interfaces.Ingester,
+	entity protoreflect.ProtoMessage,
+	params map[string]any,
+) (*interfaces.Result, bool) {
+	if res, ok := t.front.Get(ingester, entity, params); ok {
+		return res, true
+	}
+
+	res, ok := t.back.Get(ingester, entity, params)
+	if !ok {
+		return nil, false
+	}
+
+	t.front.Set(ingester, entity, params, res)
+	return res, true
+}
+
+// Set sets a result in the cache
+func (t *tieredCache) Set(
+	ingester interfaces.Ingester,
+	entity protoreflect.ProtoMessage,
+	params map[string]any,
+	result *interfaces.Result,
+) {
+	t.front.Set(ingester, entity, params, result)
+	t.back.Set(ingester, entity, params, result)
+}
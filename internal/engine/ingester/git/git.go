@@ -146,7 +146,12 @@ func (gi *Git) fetchClone(
 	// allow for direct access to the underlying filesystem. This is
 	// because we want to be able to run this in a sandboxed environment
 	// where we don't have access to the underlying filesystem.
-	r, err := gi.gitprov.Clone(ctx, url, branch)
+	var opts []provifv1.CloneOption
+	if paths := gi.cfg.GetSparsePaths(); len(paths) > 0 {
+		opts = append(opts, provifv1.WithSparsePaths(paths...))
+	}
+
+	r, err := gi.gitprov.Clone(ctx, url, branch, opts...)
 	if err != nil {
 		if errors.Is(err, provifv1.ErrProviderGitBranchNotFound) {
 			return nil, nil, nil, fmt.Errorf("%w: %s: branch %s", engerrors.ErrEvaluationFailed,
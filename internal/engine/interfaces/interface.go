@@ -51,6 +51,7 @@ type EvalStatusParams struct {
 	Result           *interfaces.Result
 	Profile          *models.ProfileAggregate
 	Rule             *models.RuleInstance
+	RuleTypeName     string
 	ProjectID        uuid.UUID
 	ReleaseID        uuid.UUID
 	PipelineRunID    uuid.UUID
@@ -138,6 +139,21 @@ func (e *EvalStatusParams) GetProfile() *models.ProfileAggregate {
 	return e.Profile
 }
 
+// GetProjectID returns the ID of the project the entity belongs to
+func (e *EvalStatusParams) GetProjectID() uuid.UUID {
+	return e.ProjectID
+}
+
+// GetEntityType returns the type of the evaluated entity
+func (e *EvalStatusParams) GetEntityType() db.Entities {
+	return e.EntityType
+}
+
+// GetEntityID returns the ID of the evaluated entity
+func (e *EvalStatusParams) GetEntityID() uuid.UUID {
+	return e.EntityID
+}
+
 // SetIngestResult sets the result of the ingestion for use later on in the actions
 func (e *EvalStatusParams) SetIngestResult(res *interfaces.Result) {
 	e.Result = res
@@ -177,4 +193,7 @@ type ActionsParams interface {
 	GetEvalResult() *interfaces.EvaluationResult
 	GetEvalStatusFromDb() *db.ListRuleEvaluationsByProfileIdRow
 	GetProfile() *models.ProfileAggregate
+	GetProjectID() uuid.UUID
+	GetEntityType() db.Entities
+	GetEntityID() uuid.UUID
 }
@@ -13,7 +13,9 @@ import (
 	context "context"
 	reflect "reflect"
 
+	engine "github.com/mindersec/minder/internal/engine"
 	entities "github.com/mindersec/minder/internal/engine/entities"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +43,20 @@ func (m *MockExecutor) EXPECT() *MockExecutorMockRecorder {
 	return m.recorder
 }
 
+// DryRunEntityEvent mocks base method.
+func (m *MockExecutor) DryRunEntityEvent(ctx context.Context, inf *entities.EntityInfoWrapper, profile *v1.Profile, ruleTypes []*v1.RuleType, fn func(*engine.DryRunResult) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunEntityEvent", ctx, inf, profile, ruleTypes, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DryRunEntityEvent indicates an expected call of DryRunEntityEvent.
+func (mr *MockExecutorMockRecorder) DryRunEntityEvent(ctx, inf, profile, ruleTypes, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunEntityEvent", reflect.TypeOf((*MockExecutor)(nil).DryRunEntityEvent), ctx, inf, profile, ruleTypes, fn)
+}
+
 // EvalEntityEvent mocks base method.
 func (m *MockExecutor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfoWrapper) error {
 	m.ctrl.T.Helper()
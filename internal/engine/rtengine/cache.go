@@ -15,6 +15,7 @@ import (
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/ingestcache"
 	eoptions "github.com/mindersec/minder/internal/engine/options"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	rtengine2 "github.com/mindersec/minder/pkg/engine/v1/rtengine"
 	"github.com/mindersec/minder/pkg/flags"
 	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
@@ -31,6 +32,7 @@ type cacheType = map[uuid.UUID]*rtengine2.RuleTypeEngine
 
 type ruleEngineCache struct {
 	store        db.Store
+	providerID   uuid.UUID
 	provider     provinfv1.Provider
 	featureFlags flags.Interface
 	ingestCache  ingestcache.Cache
@@ -47,6 +49,7 @@ func NewRuleEngineCache(
 	store db.Store,
 	entityType db.Entities,
 	projectID uuid.UUID,
+	providerID uuid.UUID,
 	provider provinfv1.Provider,
 	featureFlags flags.Interface,
 	ingestCache ingestcache.Cache,
@@ -74,7 +77,7 @@ func NewRuleEngineCache(
 	engines := make(cacheType, len(ruleTypes))
 	for _, ruleType := range ruleTypes {
 		ruleEngine, err := cacheRuleEngine(
-			ctx, &ruleType, provider, featureFlags, ingestCache, engines, dssvc, opts...)
+			ctx, &ruleType, providerID, provider, featureFlags, ingestCache, engines, dssvc, opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -83,6 +86,7 @@ func NewRuleEngineCache(
 
 	return &ruleEngineCache{
 		store:        store,
+		providerID:   providerID,
 		provider:     provider,
 		featureFlags: featureFlags,
 		ingestCache:  ingestCache,
@@ -117,7 +121,7 @@ func (r *ruleEngineCache) GetRuleEngine(ctx context.Context, ruleTypeID uuid.UUI
 
 	// If we find the rule type, insert into the cache and return.
 	ruleTypeEngine, err := cacheRuleEngine(
-		ctx, &ruleType, r.provider, r.featureFlags, r.ingestCache, r.engines, r.dssvc, r.opts...)
+		ctx, &ruleType, r.providerID, r.provider, r.featureFlags, r.ingestCache, r.engines, r.dssvc, r.opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error while caching rule type engine: %w", err)
 	}
@@ -127,6 +131,7 @@ func (r *ruleEngineCache) GetRuleEngine(ctx context.Context, ruleTypeID uuid.UUI
 func cacheRuleEngine(
 	ctx context.Context,
 	ruleType *db.RuleType,
+	providerID uuid.UUID,
 	provider provinfv1.Provider,
 	featureFlags flags.Interface,
 	ingestCache ingestcache.Cache,
@@ -140,6 +145,29 @@ func cacheRuleEngine(
 		return nil, fmt.Errorf("error parsing rule type when parsing rule type %s: %w", ruleType.ID, err)
 	}
 
+	ruleEngine, err := NewRuleEngine(ctx, pbRuleType, providerID, provider, featureFlags, ingestCache, dssvc, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the rule type engine to the cache
+	engineCache[ruleType.ID] = ruleEngine
+	return ruleEngine, nil
+}
+
+// NewRuleEngine creates a rule type engine for the given rule type, along
+// with the data sources it uses. The rule type does not need to be stored
+// in the database, but its context must refer to a project.
+func NewRuleEngine(
+	ctx context.Context,
+	pbRuleType *minderv1.RuleType,
+	providerID uuid.UUID,
+	provider provinfv1.Provider,
+	featureFlags flags.Interface,
+	ingestCache ingestcache.Cache,
+	dssvc datasourceservice.DataSourcesService,
+	opts ...eoptions.Option,
+) (*rtengine2.RuleTypeEngine, error) {
 	// Build a registry instance per rule type. This allows us to have an
 	// isolated data source list per instance of the rule type engine which is
 	// what we want. We don't want rule types using data sources they haven't
@@ -148,7 +176,8 @@ func cacheRuleEngine(
 	//
 	// TODO: Do we need to pass in a transaction here?
 	// TODO: We _might_ want to pass in a slice of the hierarchy here.
-	dsreg, err := dssvc.BuildDataSourceRegistry(ctx, pbRuleType, nil)
+	dsreg, err := dssvc.BuildDataSourceRegistry(ctx, pbRuleType,
+		datasourceservice.OptionsBuilder().WithProvider(providerID, provider))
 	if err != nil {
 		return nil, fmt.Errorf("error building data source registry: %w", err)
 	}
@@ -161,8 +190,5 @@ func cacheRuleEngine(
 		return nil, fmt.Errorf("error creating rule type engine: %w", err)
 	}
 
-	// Add the rule type engine to the cache
-	ruleEngine = ruleEngine.WithIngesterCache(ingestCache)
-	engineCache[ruleType.ID] = ruleEngine
-	return ruleEngine, nil
+	return ruleEngine.WithIngesterCache(ingestCache), nil
 }
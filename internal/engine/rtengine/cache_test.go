@@ -119,7 +119,7 @@ func TestNewRuleTypeEngineCacheConstructor(t *testing.T) {
 			}
 
 			cache, err := NewRuleEngineCache(
-				ctx, store, db.EntitiesRepository, uuid.New(),
+				ctx, store, db.EntitiesRepository, uuid.New(), uuid.New(),
 				testproviders.NewGitProvider(nil), nil, ingestcache.NewNoopCache(),
 				dssvc)
 			if scenario.ExpectedError != "" {
@@ -41,6 +41,9 @@ type HandleEntityAndDoMessage struct {
 	// use-case is to include the hook ID in the MatchProps to match against
 	// the entity's hook ID to avoid forwading the message to the wrong entity.
 	MatchProps map[string]any `json:"match_props"`
+	// ProfileIDs restricts the evaluation of the entity to the given
+	// profiles. All the profiles are evaluated when empty.
+	ProfileIDs []uuid.UUID `json:"profile_ids,omitempty"`
 }
 
 // NewEntityRefreshAndDoMessage creates a new HandleEntityAndDoMessage struct.
@@ -74,6 +77,12 @@ func (e *HandleEntityAndDoMessage) WithEntityID(entityID uuid.UUID) *HandleEntit
 	return e
 }
 
+// WithProfileIDs restricts the evaluation of the entity to the given profiles.
+func (e *HandleEntityAndDoMessage) WithProfileIDs(profileIDs []uuid.UUID) *HandleEntityAndDoMessage {
+	e.ProfileIDs = profileIDs
+	return e
+}
+
 // WithProviderImplementsHint sets the provider hint for the entity that will be used when looking up the entity.
 // to the provider implements hint
 func (e *HandleEntityAndDoMessage) WithProviderImplementsHint(providerHint string) *HandleEntityAndDoMessage {
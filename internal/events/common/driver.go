@@ -4,5 +4,41 @@
 // Package common contains common interfaces and types used by the eventer.
 package common
 
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
 // DriverCloser is a function that can be used to close an eventer driver
 type DriverCloser func()
+
+// DeadLetter is a message stored in the dead letter queue of an eventer driver
+type DeadLetter struct {
+	// ID identifies the message in the driver's storage
+	ID string
+	// CreatedAt is when the message was added to the dead letter queue
+	CreatedAt time.Time
+	// Message is the dead-lettered message, including the metadata
+	// added by the poison queue middleware
+	Message *message.Message
+}
+
+// ErrDeadLetterNotFound is returned when a message is not in the dead letter queue
+var ErrDeadLetterNotFound = errors.New("message not found in dead letter queue")
+
+// DeadLetterStore gives access to the messages in the dead letter queue of
+// an eventer driver, and allows publishing messages back to the driver.
+type DeadLetterStore interface {
+	message.Publisher
+
+	// List returns up to limit messages from the dead letter queue, oldest first
+	List(ctx context.Context, limit int) ([]*DeadLetter, error)
+	// Get returns the message with the given ID from the dead letter queue,
+	// or ErrDeadLetterNotFound
+	Get(ctx context.Context, id string) (*DeadLetter, error)
+	// Delete removes the message with the given ID from the dead letter queue
+	Delete(ctx context.Context, id string) error
+}
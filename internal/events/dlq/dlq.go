@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dlq provides access to the messages in the dead letter queue of
+// the eventer, so that they can be inspected, replayed onto their original
+// topic or discarded.
+package dlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+
+	"github.com/mindersec/minder/internal/events/common"
+	"github.com/mindersec/minder/internal/events/nats"
+	eventersql "github.com/mindersec/minder/internal/events/sql"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// ReplayCountKey is the metadata key counting how many times a message has
+// been replayed from the dead letter queue.
+const ReplayCountKey = "dlq_replay_count"
+
+var (
+	// ErrMessageNotFound is returned when a message is not in the dead letter queue
+	ErrMessageNotFound = common.ErrDeadLetterNotFound
+	// ErrUnsupportedDriver is returned when the event driver does not
+	// persist its dead letter queue
+	ErrUnsupportedDriver = errors.New("the dead letter queue is only available with the sql and nats event drivers")
+)
+
+// Message is a message in the dead letter queue
+type Message struct {
+	// ID identifies the message in the dead letter queue
+	ID string
+	// UUID is the watermill UUID of the message
+	UUID string
+	// Topic is the topic the message was originally published to
+	Topic string
+	// Handler is the name of the handler which failed to process the message
+	Handler string
+	// Error is the error returned by the handler
+	Error string
+	// FailureCount is the number of times the message was dead-lettered,
+	// including the times it failed again after being replayed
+	FailureCount int
+	// Metadata is the metadata of the message
+	Metadata map[string]string
+	// Payload is the payload of the message
+	Payload []byte
+	// CreatedAt is when the message was added to the dead letter queue
+	CreatedAt time.Time
+}
+
+// Queue gives access to the dead letter queue
+//
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+type Queue interface {
+	// List returns up to limit messages from the dead letter queue, oldest first
+	List(ctx context.Context, limit int) ([]*Message, error)
+	// Replay publishes the given messages back onto their original topic,
+	// and removes them from the dead letter queue
+	Replay(ctx context.Context, ids []string) error
+	// Discard removes the given messages from the dead letter queue
+	Discard(ctx context.Context, ids []string) error
+}
+
+type queue struct {
+	store common.DeadLetterStore
+}
+
+// NewQueue creates a Queue for the configured event driver
+func NewQueue(ctx context.Context, cfg *serverconfig.EventConfig) (Queue, common.DriverCloser, error) {
+	var store common.DeadLetterStore
+	var err error
+	switch cfg.Driver {
+	case constants.SQLDriver:
+		store, err = eventersql.NewDeadLetterStore(ctx, cfg)
+	case constants.NATSDriver:
+		store, err = nats.NewDeadLetterStore(ctx, cfg)
+	default:
+		return nil, nil, fmt.Errorf("%w, not %q", ErrUnsupportedDriver, cfg.Driver)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return NewQueueFromStore(store), func() {
+		//nolint:gosec // Nothing to do if closing fails
+		store.Close()
+	}, nil
+}
+
+// NewQueueFromStore creates a Queue for the given dead letter store
+func NewQueueFromStore(store common.DeadLetterStore) Queue {
+	return &queue{store: store}
+}
+
+// List implements Queue
+func (q *queue) List(ctx context.Context, limit int) ([]*Message, error) {
+	dls, err := q.store.List(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*Message, 0, len(dls))
+	for _, dl := range dls {
+		out = append(out, toMessage(dl))
+	}
+	return out, nil
+}
+
+// Replay implements Queue
+func (q *queue) Replay(ctx context.Context, ids []string) error {
+	// Look up all the messages first, so that nothing is replayed if an ID is wrong
+	dls, err := q.getAll(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, dl := range dls {
+		topic := dl.Message.Metadata.Get(middleware.PoisonedTopicKey)
+		if topic == "" {
+			return fmt.Errorf("message %s has no original topic", dl.ID)
+		}
+
+		if err := q.store.Publish(topic, replayMessage(dl.Message)); err != nil {
+			return fmt.Errorf("error replaying message %s to %q: %w", dl.ID, topic, err)
+		}
+		if err := q.store.Delete(ctx, dl.ID); err != nil {
+			return fmt.Errorf("error removing replayed message %s: %w", dl.ID, err)
+		}
+	}
+	return nil
+}
+
+// Discard implements Queue
+func (q *queue) Discard(ctx context.Context, ids []string) error {
+	if _, err := q.getAll(ctx, ids); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := q.store.Delete(ctx, id); err != nil {
+			return fmt.Errorf("error discarding message %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func (q *queue) getAll(ctx context.Context, ids []string) ([]*common.DeadLetter, error) {
+	dls := make([]*common.DeadLetter, 0, len(ids))
+	for _, id := range ids {
+		dl, err := q.store.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting message %s: %w", id, err)
+		}
+		dls = append(dls, dl)
+	}
+	return dls, nil
+}
+
+// replayMessage returns a copy of msg without the poison queue metadata,
+// keeping track of how many times it was replayed.
+func replayMessage(msg *message.Message) *message.Message {
+	out := message.NewMessage(msg.UUID, msg.Payload)
+	for k, v := range msg.Metadata {
+		switch k {
+		case middleware.ReasonForPoisonedKey, middleware.PoisonedTopicKey,
+			middleware.PoisonedHandlerKey, middleware.PoisonedSubscriberKey:
+			continue
+		}
+		out.Metadata.Set(k, v)
+	}
+	out.Metadata.Set(ReplayCountKey, strconv.Itoa(replayCount(msg)+1))
+	return out
+}
+
+func replayCount(msg *message.Message) int {
+	count, err := strconv.Atoi(msg.Metadata.Get(ReplayCountKey))
+	if err != nil {
+		return 0
+	}
+	return count
+}
+
+func toMessage(dl *common.DeadLetter) *Message {
+	return &Message{
+		ID:           dl.ID,
+		UUID:         dl.Message.UUID,
+		Topic:        dl.Message.Metadata.Get(middleware.PoisonedTopicKey),
+		Handler:      dl.Message.Metadata.Get(middleware.PoisonedHandlerKey),
+		Error:        dl.Message.Metadata.Get(middleware.ReasonForPoisonedKey),
+		FailureCount: replayCount(dl.Message) + 1,
+		Metadata:     dl.Message.Metadata,
+		Payload:      dl.Message.Payload,
+		CreatedAt:    dl.CreatedAt,
+	}
+}
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dlq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/events/common"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+type fakeStore struct {
+	messages   []*common.DeadLetter
+	published  map[string][]*message.Message
+	publishErr error
+}
+
+var _ common.DeadLetterStore = (*fakeStore)(nil)
+
+func (f *fakeStore) List(_ context.Context, limit int) ([]*common.DeadLetter, error) {
+	if limit > len(f.messages) {
+		limit = len(f.messages)
+	}
+	return f.messages[:limit], nil
+}
+
+func (f *fakeStore) Get(_ context.Context, id string) (*common.DeadLetter, error) {
+	for _, dl := range f.messages {
+		if dl.ID == id {
+			return dl, nil
+		}
+	}
+	return nil, common.ErrDeadLetterNotFound
+}
+
+func (f *fakeStore) Delete(_ context.Context, id string) error {
+	for i, dl := range f.messages {
+		if dl.ID == id {
+			f.messages = append(f.messages[:i], f.messages[i+1:]...)
+			return nil
+		}
+	}
+	return common.ErrDeadLetterNotFound
+}
+
+func (f *fakeStore) Publish(topic string, messages ...*message.Message) error {
+	if f.publishErr != nil {
+		return f.publishErr
+	}
+	if f.published == nil {
+		f.published = make(map[string][]*message.Message)
+	}
+	f.published[topic] = append(f.published[topic], messages...)
+	return nil
+}
+
+func (*fakeStore) Close() error {
+	return nil
+}
+
+func newDeadLetter(id int, topic string, replays int) *common.DeadLetter {
+	msg := message.NewMessage("uuid-"+strconv.Itoa(id), []byte(`{"id":`+strconv.Itoa(id)+`}`))
+	msg.Metadata.Set("correlation_id", "corr")
+	msg.Metadata.Set(middleware.ReasonForPoisonedKey, "boom")
+	msg.Metadata.Set(middleware.PoisonedHandlerKey, "handler")
+	msg.Metadata.Set(middleware.PoisonedSubscriberKey, "subscriber")
+	if topic != "" {
+		msg.Metadata.Set(middleware.PoisonedTopicKey, topic)
+	}
+	if replays > 0 {
+		msg.Metadata.Set(ReplayCountKey, strconv.Itoa(replays))
+	}
+	return &common.DeadLetter{
+		ID:        strconv.Itoa(id),
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Message:   msg,
+	}
+}
+
+func TestQueueList(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeStore{messages: []*common.DeadLetter{
+		newDeadLetter(1, "topic.a", 0),
+		newDeadLetter(2, "topic.b", 2),
+		newDeadLetter(3, "topic.c", 0),
+	}}
+	q := NewQueueFromStore(store)
+
+	msgs, err := q.List(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	require.Equal(t, "1", msgs[0].ID)
+	require.Equal(t, "uuid-1", msgs[0].UUID)
+	require.Equal(t, "topic.a", msgs[0].Topic)
+	require.Equal(t, "handler", msgs[0].Handler)
+	require.Equal(t, "boom", msgs[0].Error)
+	require.Equal(t, 1, msgs[0].FailureCount)
+	require.Equal(t, "corr", msgs[0].Metadata["correlation_id"])
+	require.Equal(t, []byte(`{"id":1}`), msgs[0].Payload)
+	require.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), msgs[0].CreatedAt)
+
+	require.Equal(t, "2", msgs[1].ID)
+	require.Equal(t, 3, msgs[1].FailureCount)
+}
+
+func TestQueueReplay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		messages          []*common.DeadLetter
+		ids               []string
+		publishErr        error
+		expectedErr       error
+		expectedPublished map[string][]string
+		expectedRemaining []string
+	}{
+		{
+			name: "replays selected messages",
+			messages: []*common.DeadLetter{
+				newDeadLetter(1, "topic.a", 0),
+				newDeadLetter(2, "topic.b", 1),
+				newDeadLetter(3, "topic.a", 0),
+			},
+			ids: []string{"1", "2"},
+			expectedPublished: map[string][]string{
+				"topic.a": {"uuid-1"},
+				"topic.b": {"uuid-2"},
+			},
+			expectedRemaining: []string{"3"},
+		},
+		{
+			name: "unknown ID replays nothing",
+			messages: []*common.DeadLetter{
+				newDeadLetter(1, "topic.a", 0),
+			},
+			ids:               []string{"1", "42"},
+			expectedErr:       ErrMessageNotFound,
+			expectedRemaining: []string{"1"},
+		},
+		{
+			name: "publish error keeps the message",
+			messages: []*common.DeadLetter{
+				newDeadLetter(1, "topic.a", 0),
+			},
+			ids:               []string{"1"},
+			publishErr:        errors.New("unavailable"),
+			expectedRemaining: []string{"1"},
+		},
+		{
+			name: "message without topic",
+			messages: []*common.DeadLetter{
+				newDeadLetter(1, "", 0),
+			},
+			ids:               []string{"1"},
+			expectedRemaining: []string{"1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &fakeStore{messages: tt.messages, publishErr: tt.publishErr}
+			q := NewQueueFromStore(store)
+
+			err := q.Replay(context.Background(), tt.ids)
+			switch {
+			case tt.expectedErr != nil:
+				require.ErrorIs(t, err, tt.expectedErr)
+			case tt.expectedPublished == nil:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+			}
+
+			published := make(map[string][]string)
+			for topic, msgs := range store.published {
+				for _, msg := range msgs {
+					published[topic] = append(published[topic], msg.UUID)
+				}
+			}
+			if tt.expectedPublished == nil {
+				require.Empty(t, published)
+			} else {
+				require.Equal(t, tt.expectedPublished, published)
+			}
+
+			var remaining []string
+			for _, dl := range store.messages {
+				remaining = append(remaining, dl.ID)
+			}
+			require.Equal(t, tt.expectedRemaining, remaining)
+		})
+	}
+}
+
+func TestQueueReplayMetadata(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeStore{messages: []*common.DeadLetter{newDeadLetter(1, "topic.a", 1)}}
+	q := NewQueueFromStore(store)
+
+	require.NoError(t, q.Replay(context.Background(), []string{"1"}))
+	require.Len(t, store.published["topic.a"], 1)
+
+	msg := store.published["topic.a"][0]
+	require.Equal(t, []byte(`{"id":1}`), []byte(msg.Payload))
+	require.Equal(t, message.Metadata{
+		"correlation_id": "corr",
+		ReplayCountKey:   "2",
+	}, msg.Metadata)
+}
+
+func TestQueueDiscard(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeStore{messages: []*common.DeadLetter{
+		newDeadLetter(1, "topic.a", 0),
+		newDeadLetter(2, "topic.b", 0),
+	}}
+	q := NewQueueFromStore(store)
+
+	require.ErrorIs(t, q.Discard(context.Background(), []string{"2", "3"}), ErrMessageNotFound)
+	require.Len(t, store.messages, 2)
+
+	require.NoError(t, q.Discard(context.Background(), []string{"2"}))
+	require.Len(t, store.messages, 1)
+	require.Equal(t, "1", store.messages[0].ID)
+	require.Empty(t, store.published)
+}
+
+func TestNewQueueUnsupportedDriver(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := NewQueue(context.Background(), &serverconfig.EventConfig{Driver: constants.GoChannelDriver})
+	require.ErrorIs(t, err, ErrUnsupportedDriver)
+}
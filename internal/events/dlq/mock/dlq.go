@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./dlq.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_dlq -destination=./mock/dlq.go -source=./dlq.go
+//
+
+// Package mock_dlq is a generated GoMock package.
+package mock_dlq
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dlq "github.com/mindersec/minder/internal/events/dlq"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQueue is a mock of Queue interface.
+type MockQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueueMockRecorder
+	isgomock struct{}
+}
+
+// MockQueueMockRecorder is the mock recorder for MockQueue.
+type MockQueueMockRecorder struct {
+	mock *MockQueue
+}
+
+// NewMockQueue creates a new mock instance.
+func NewMockQueue(ctrl *gomock.Controller) *MockQueue {
+	mock := &MockQueue{ctrl: ctrl}
+	mock.recorder = &MockQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueue) EXPECT() *MockQueueMockRecorder {
+	return m.recorder
+}
+
+// Discard mocks base method.
+func (m *MockQueue) Discard(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Discard", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Discard indicates an expected call of Discard.
+func (mr *MockQueueMockRecorder) Discard(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discard", reflect.TypeOf((*MockQueue)(nil).Discard), ctx, ids)
+}
+
+// List mocks base method.
+func (m *MockQueue) List(ctx context.Context, limit int) ([]*dlq.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit)
+	ret0, _ := ret[0].([]*dlq.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockQueueMockRecorder) List(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockQueue)(nil).List), ctx, limit)
+}
+
+// Replay mocks base method.
+func (m *MockQueue) Replay(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockQueueMockRecorder) Replay(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockQueue)(nil).Replay), ctx, ids)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	cejsm "github.com/cloudevents/sdk-go/protocol/nats_jetstream/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/mindersec/minder/internal/events/common"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// DeadLetterStore gives access to the dead letter queue stored in the
+// JetStream stream of the NATS driver.  Messages are identified by their
+// sequence number in the stream.
+type DeadLetterStore struct {
+	conn    *nats.Conn
+	stream  jetstream.Stream
+	subject string
+	adapter *cloudEventsNatsAdapter
+}
+
+var _ common.DeadLetterStore = (*DeadLetterStore)(nil)
+
+// NewDeadLetterStore creates a DeadLetterStore for the NATS driver
+func NewDeadLetterStore(ctx context.Context, cfg *serverconfig.EventConfig) (*DeadLetterStore, error) {
+	adapter := &cloudEventsNatsAdapter{cfg: &cfg.Nats}
+	if err := adapter.ensureStream(ctx); err != nil {
+		return nil, fmt.Errorf("error creating stream %q: %w", cfg.Nats.Prefix, err)
+	}
+
+	conn, err := nats.Connect(cfg.Nats.URL, nats.Name("minder"))
+	if err != nil {
+		return nil, err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	stream, err := js.Stream(ctx, cfg.Nats.Prefix)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error getting stream %q: %w", cfg.Nats.Prefix, err)
+	}
+
+	return &DeadLetterStore{
+		conn:    conn,
+		stream:  stream,
+		subject: fmt.Sprintf("%s.%s", cfg.Nats.Prefix, constants.DeadLetterQueueTopic),
+		adapter: adapter,
+	}, nil
+}
+
+// List implements common.DeadLetterStore
+func (s *DeadLetterStore) List(ctx context.Context, limit int) ([]*common.DeadLetter, error) {
+	var out []*common.DeadLetter
+	seq := uint64(1)
+	for len(out) < limit {
+		// Fetch the next message on the dead letter queue subject, starting at seq
+		raw, err := s.stream.GetMsg(ctx, seq, jetstream.WithGetMsgSubject(s.subject))
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error listing dead letter queue: %w", err)
+		}
+
+		dl, err := rawToDeadLetter(ctx, raw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, dl)
+		seq = raw.Sequence + 1
+	}
+	return out, nil
+}
+
+// Get implements common.DeadLetterStore
+func (s *DeadLetterStore) Get(ctx context.Context, id string) (*common.DeadLetter, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, common.ErrDeadLetterNotFound
+	}
+
+	raw, err := s.stream.GetMsg(ctx, seq)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		return nil, common.ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting message %s: %w", id, err)
+	}
+	// The sequence is shared by all the topics in the stream
+	if raw.Subject != s.subject {
+		return nil, common.ErrDeadLetterNotFound
+	}
+
+	return rawToDeadLetter(ctx, raw)
+}
+
+// Delete implements common.DeadLetterStore
+func (s *DeadLetterStore) Delete(ctx context.Context, id string) error {
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return common.ErrDeadLetterNotFound
+	}
+
+	err = s.stream.DeleteMsg(ctx, seq)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		return common.ErrDeadLetterNotFound
+	} else if err != nil {
+		return fmt.Errorf("error deleting message %s: %w", id, err)
+	}
+	return nil
+}
+
+// Publish implements message.Publisher
+func (s *DeadLetterStore) Publish(topic string, messages ...*message.Message) error {
+	return s.adapter.Publish(topic, messages...)
+}
+
+// Close implements message.Publisher
+func (s *DeadLetterStore) Close() error {
+	s.conn.Close()
+	return s.adapter.Close()
+}
+
+func rawToDeadLetter(ctx context.Context, raw *jetstream.RawStreamMsg) (*common.DeadLetter, error) {
+	event, err := binding.ToEvent(ctx, cejsm.NewMessage(&nats.Msg{
+		Subject: raw.Subject,
+		Header:  raw.Header,
+		Data:    raw.Data,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding message %d: %w", raw.Sequence, err)
+	}
+
+	return &common.DeadLetter{
+		ID:        strconv.FormatUint(raw.Sequence, 10),
+		CreatedAt: raw.Time,
+		Message:   cloudEventToMessage(*event),
+	}, nil
+}
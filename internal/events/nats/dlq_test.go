@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/events/common"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestDeadLetterStore(t *testing.T) {
+	t.Parallel()
+	server := natsserver.RunRandClientPortServer()
+	require.NoError(t, server.EnableJetStream(nil))
+	defer server.Shutdown()
+
+	cfg := &serverconfig.EventConfig{
+		Nats: serverconfig.NatsConfig{
+			URL:    server.ClientURL(),
+			Prefix: "dlqtest",
+			Queue:  "minder",
+		},
+	}
+	ctx := context.Background()
+
+	pub, _, closer, err := BuildNatsChannelDriver(cfg)
+	require.NoError(t, err)
+	defer closer()
+	defer pub.Close()
+
+	// A message on another topic shares the sequence numbers of the stream
+	require.NoError(t, pub.Publish("other", message.NewMessage("000", []byte(`{"msg":"other"}`))))
+	for _, id := range []string{"123", "456"} {
+		msg := message.NewMessage(id, []byte(`{"msg":"hello"}`))
+		// Metadata copied from a received CloudEvent is not re-sent as an extension
+		msg.Metadata.Set("ce-id", id)
+		msg.Metadata.Set(middleware.ReasonForPoisonedKey, "boom")
+		msg.Metadata.Set(middleware.PoisonedTopicKey, "test.topic")
+		msg.Metadata.Set(middleware.PoisonedHandlerKey, "handler")
+		require.NoError(t, pub.Publish(constants.DeadLetterQueueTopic, msg))
+	}
+
+	store, err := NewDeadLetterStore(ctx, cfg)
+	require.NoError(t, err)
+	defer store.Close()
+
+	// Publishing is asynchronous, so wait for the messages to land in the stream
+	var dls []*common.DeadLetter
+	require.Eventually(t, func() bool {
+		dls, err = store.List(ctx, 10)
+		return err == nil && len(dls) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, "123", dls[0].Message.UUID)
+	require.Equal(t, "456", dls[1].Message.UUID)
+	require.Equal(t, `{"msg":"hello"}`, string(dls[0].Message.Payload))
+	require.Equal(t, "boom", dls[0].Message.Metadata.Get(middleware.ReasonForPoisonedKey))
+	require.Equal(t, "test.topic", dls[0].Message.Metadata.Get(middleware.PoisonedTopicKey))
+	require.Equal(t, "handler", dls[0].Message.Metadata.Get(middleware.PoisonedHandlerKey))
+	require.False(t, dls[0].CreatedAt.IsZero())
+
+	limited, err := store.List(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	require.Equal(t, dls[0].ID, limited[0].ID)
+
+	got, err := store.Get(ctx, dls[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, "456", got.Message.UUID)
+
+	// The first message of the stream is not in the dead letter queue
+	_, err = store.Get(ctx, "1")
+	require.ErrorIs(t, err, common.ErrDeadLetterNotFound)
+	_, err = store.Get(ctx, "not-a-number")
+	require.ErrorIs(t, err, common.ErrDeadLetterNotFound)
+
+	require.NoError(t, store.Delete(ctx, dls[0].ID))
+	require.ErrorIs(t, store.Delete(ctx, dls[0].ID), common.ErrDeadLetterNotFound)
+
+	remaining, err := store.List(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	require.Equal(t, dls[1].ID, remaining[0].ID)
+}
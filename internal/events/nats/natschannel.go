@@ -31,6 +31,27 @@ func BuildNatsChannelDriver(cfg *serverconfig.EventConfig) (message.Publisher, m
 	return adapter, adapter, func() {}, nil
 }
 
+// NewCloudEventsClient creates a CloudEvents client which sends events to
+// the given topic, under the configured subject prefix.  It is meant for
+// publishing events in their own CloudEvents format, rather than
+// converting watermill messages.
+func NewCloudEventsClient(
+	ctx context.Context, cfg *serverconfig.NatsConfig, topic string,
+) (cloudevents.Client, common.DriverCloser, error) {
+	adapter := &cloudEventsNatsAdapter{cfg: cfg}
+	subject := fmt.Sprintf("%s.%s", cfg.Prefix, topic)
+
+	state, err := adapter.ensureTopic(ctx, subject, "sender")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating topic %q: %w", subject, err)
+	}
+	return state.ceClient, func() {
+		if err := adapter.Close(); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Str("topic", subject).Msg("Error closing NATS client")
+		}
+	}, nil
+}
+
 // CloudEventsNatsPublisher actually consumes a _set_ of NATS topics,
 // because CloudEvents-Jetstream has a separate Consumer for each topic
 type cloudEventsNatsAdapter struct {
@@ -156,31 +177,36 @@ func (c *cloudEventsNatsAdapter) Subscribe(ctx context.Context, topic string) (<
 
 func convertCloudEventToMessage(outChan chan *message.Message) func(ctx context.Context, event cloudevents.Event) error {
 	return func(ctx context.Context, event cloudevents.Event) error {
-		msg := message.NewMessage(event.ID(), event.Data())
+		msg := cloudEventToMessage(event)
 		msg.SetContext(ctx)
-		// Add some extra message metadata from the CloudEvent
-		msg.Metadata.Set("ce-id", event.ID())
-		msg.Metadata.Set("ce-source", event.Source())
-		msg.Metadata.Set("ce-type", event.Type())
-		msg.Metadata.Set("ce-subject", event.Subject())
-		msg.Metadata.Set("ce-time", event.Time().String())
-		msg.Metadata.Set("ce-datacontenttype", event.DataContentType())
-		msg.Metadata.Set("ce-schemaurl", event.DataSchema())
-
-		for k, v := range event.Extensions() {
-			// Strip "minder" prefix from metadata keys if present
-			// The prefix avoids collision on keys like "type"
-			k = strings.TrimPrefix(k, "minder")
-			// Undo the transformation from 228 in sendEvent
-			k = strings.ReplaceAll(k, "0", "_")
-			msg.Metadata.Set(k, fmt.Sprintf("%s", v))
-		}
 
 		outChan <- msg
 		return nil
 	}
 }
 
+func cloudEventToMessage(event cloudevents.Event) *message.Message {
+	msg := message.NewMessage(event.ID(), event.Data())
+	// Add some extra message metadata from the CloudEvent
+	msg.Metadata.Set("ce-id", event.ID())
+	msg.Metadata.Set("ce-source", event.Source())
+	msg.Metadata.Set("ce-type", event.Type())
+	msg.Metadata.Set("ce-subject", event.Subject())
+	msg.Metadata.Set("ce-time", event.Time().String())
+	msg.Metadata.Set("ce-datacontenttype", event.DataContentType())
+	msg.Metadata.Set("ce-schemaurl", event.DataSchema())
+
+	for k, v := range event.Extensions() {
+		// Strip "minder" prefix from metadata keys if present
+		// The prefix avoids collision on keys like "type"
+		k = strings.TrimPrefix(k, "minder")
+		// Undo the transformation from 228 in sendEvent
+		k = strings.ReplaceAll(k, "0", "_")
+		msg.Metadata.Set(k, fmt.Sprintf("%s", v))
+	}
+	return msg
+}
+
 // Publish implements message.Publisher.
 func (c *cloudEventsNatsAdapter) Publish(topic string, messages ...*message.Message) error {
 	ctx := context.Background()
@@ -219,6 +245,11 @@ func sendEvent(
 		return err
 	}
 	for k, v := range msg.Metadata {
+		// Attributes of a received CloudEvent are copied into the metadata
+		// by cloudEventToMessage, and are set again on the new event.
+		if strings.HasPrefix(k, "ce-") {
+			continue
+		}
 		// CloudEvents does not allow "_" or "-" in attribute keys, only A-Z, a-z, 0-9.
 		ceKey := strings.ReplaceAll(k, "_", "0")
 		event.SetExtension("minder"+ceKey, v)
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	natsserver "github.com/nats-io/nats-server/v2/test"
 
 	"github.com/mindersec/minder/internal/events/common"
@@ -120,6 +121,65 @@ loop:
 	expectMessageEqual(t, m4, results[3])
 }
 
+func TestNewCloudEventsClient(t *testing.T) {
+	t.Parallel()
+	server := natsserver.RunRandClientPortServer()
+	if err := server.EnableJetStream(nil); err != nil {
+		t.Fatalf("failed to enable JetStream: %v", err)
+	}
+	defer server.Shutdown()
+	cfg := serverconfig.EventConfig{
+		Nats: serverconfig.NatsConfig{
+			URL:    server.ClientURL(),
+			Prefix: "test",
+			Queue:  "minder",
+		},
+	}
+	ctx := context.Background()
+
+	_, sub, closer, err := BuildNatsChannelDriver(&cfg)
+	if err != nil {
+		t.Fatalf("failed to build nats channel driver: %v", err)
+	}
+	defer closer()
+	out, err := sub.Subscribe(ctx, "export")
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+
+	client, clientCloser, err := NewCloudEventsClient(ctx, &cfg.Nats, "export")
+	if err != nil {
+		t.Fatalf("failed to create CloudEvents client: %v", err)
+	}
+	defer clientCloser()
+
+	event := cloudevents.NewEvent()
+	event.SetID("123")
+	event.SetType("dev.minder.test")
+	event.SetSource("minder")
+	if err := event.SetData(cloudevents.ApplicationJSON, map[string]string{"msg": "hello"}); err != nil {
+		t.Fatalf("failed to set event data: %v", err)
+	}
+	if result := client.Send(ctx, event); !cloudevents.IsACK(result) {
+		t.Fatalf("failed to send event: %v", result)
+	}
+
+	select {
+	case m := <-out:
+		if m.UUID != "123" {
+			t.Errorf("expected message ID 123, got %s", m.UUID)
+		}
+		if m.Metadata["ce-type"] != "dev.minder.test" {
+			t.Errorf("expected ce-type dev.minder.test, got %v", m.Metadata)
+		}
+		if string(m.Payload) != `{"msg":"hello"}` {
+			t.Errorf("unexpected payload %s", m.Payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for event")
+	}
+}
+
 func buildDriverPair(ctx context.Context, cfg serverconfig.EventConfig) (message.Publisher, message.Subscriber, common.DriverCloser, <-chan *message.Message, error) {
 	pub, sub, closer, err := BuildNatsChannelDriver(&cfg)
 	if err != nil {
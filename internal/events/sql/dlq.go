@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	watermillsql "github.com/ThreeDotsLabs/watermill-sql/v3/pkg/sql"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/alexdrl/zerowater"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/events/common"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// DeadLetterStore gives access to the dead letter queue stored in the
+// watermill messages table of the dead letter queue topic.  Messages are
+// identified by their offset in the table.
+type DeadLetterStore struct {
+	db    *sql.DB
+	pub   message.Publisher
+	table string
+}
+
+var _ common.DeadLetterStore = (*DeadLetterStore)(nil)
+
+// NewDeadLetterStore creates a DeadLetterStore for the SQL driver
+func NewDeadLetterStore(ctx context.Context, cfg *serverconfig.EventConfig) (*DeadLetterStore, error) {
+	db, _, err := cfg.SQLPubSub.Connection.GetDBConnection(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to events database: %w", err)
+	}
+
+	schema := watermillsql.DefaultPostgreSQLSchema{}
+	// The table is only created when the first message is dead-lettered
+	for _, q := range schema.SchemaInitializingQueries(constants.DeadLetterQueueTopic) {
+		if _, err := db.ExecContext(ctx, q.Query, q.Args...); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("unable to initialize dead letter queue table: %w", err)
+		}
+	}
+
+	publisher, err := watermillsql.NewPublisher(
+		db,
+		watermillsql.PublisherConfig{
+			SchemaAdapter:        schema,
+			AutoInitializeSchema: true,
+		},
+		zerowater.NewZerologLoggerAdapter(zerolog.Ctx(ctx).With().Logger()),
+	)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create SQL publisher: %w", err)
+	}
+
+	return &DeadLetterStore{
+		db:    db,
+		pub:   publisher,
+		table: schema.MessagesTable(constants.DeadLetterQueueTopic),
+	}, nil
+}
+
+// List implements common.DeadLetterStore
+func (s *DeadLetterStore) List(ctx context.Context, limit int) ([]*common.DeadLetter, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT "offset", uuid, created_at, payload, metadata FROM `+s.table+` ORDER BY "offset" LIMIT $1`,
+		limit)
+	if err != nil {
+		return nil, fmt.Errorf("error listing dead letter queue: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*common.DeadLetter
+	for rows.Next() {
+		dl, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, dl)
+	}
+	return out, rows.Err()
+}
+
+// Get implements common.DeadLetterStore
+func (s *DeadLetterStore) Get(ctx context.Context, id string) (*common.DeadLetter, error) {
+	offset, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, common.ErrDeadLetterNotFound
+	}
+
+	row := s.db.QueryRowContext(ctx,
+		`SELECT "offset", uuid, created_at, payload, metadata FROM `+s.table+` WHERE "offset" = $1`,
+		offset)
+	dl, err := scanDeadLetter(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, common.ErrDeadLetterNotFound
+	}
+	return dl, err
+}
+
+// Delete implements common.DeadLetterStore
+func (s *DeadLetterStore) Delete(ctx context.Context, id string) error {
+	offset, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return common.ErrDeadLetterNotFound
+	}
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM `+s.table+` WHERE "offset" = $1`, offset)
+	if err != nil {
+		return fmt.Errorf("error deleting message %s: %w", id, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error deleting message %s: %w", id, err)
+	}
+	if n == 0 {
+		return common.ErrDeadLetterNotFound
+	}
+	return nil
+}
+
+// Publish implements message.Publisher
+func (s *DeadLetterStore) Publish(topic string, messages ...*message.Message) error {
+	return s.pub.Publish(topic, messages...)
+}
+
+// Close implements message.Publisher
+func (s *DeadLetterStore) Close() error {
+	return errors.Join(s.pub.Close(), s.db.Close())
+}
+
+func scanDeadLetter(row interface{ Scan(dest ...any) error }) (*common.DeadLetter, error) {
+	var (
+		offset    int64
+		uuid      string
+		createdAt time.Time
+		payload   []byte
+		metadata  []byte
+	)
+	if err := row.Scan(&offset, &uuid, &createdAt, &payload, &metadata); err != nil {
+		return nil, err
+	}
+
+	msg := message.NewMessage(uuid, payload)
+	if metadata != nil {
+		if err := json.Unmarshal(metadata, &msg.Metadata); err != nil {
+			return nil, fmt.Errorf("error unmarshalling metadata of message %d: %w", offset, err)
+		}
+	}
+
+	return &common.DeadLetter{
+		ID:        strconv.FormatInt(offset, 10),
+		CreatedAt: createdAt,
+		Message:   msg,
+	}, nil
+}
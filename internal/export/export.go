@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package export publishes the results of rule evaluations, remediations and
+// alerts as CloudEvents, so that external systems can react to them.
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/db"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+)
+
+const (
+	// TopicQueueExportEvent is the topic for events waiting to be exported
+	TopicQueueExportEvent = "export.status.event"
+
+	// EventTypeEvaluation is the CloudEvents type of evaluation status changes
+	EventTypeEvaluation = "dev.minder.evaluation.status.v1"
+	// EventTypeRemediation is the CloudEvents type of remediation status changes
+	EventTypeRemediation = "dev.minder.remediation.status.v1"
+	// EventTypeAlert is the CloudEvents type of alert status changes
+	EventTypeAlert = "dev.minder.alert.status.v1"
+)
+
+// Event is a status change waiting to be exported. It is the payload of
+// the messages on TopicQueueExportEvent.
+type Event struct {
+	// ID is the unique ID of the event, which consumers may use to drop
+	// duplicate deliveries
+	ID string `json:"id"`
+	// Type is the CloudEvents type of the event
+	Type string `json:"type"`
+	// Time is when the status changed
+	Time time.Time `json:"time"`
+	// Data is the body of the event
+	Data EventData `json:"data"`
+}
+
+// EventData is the body of an exported event
+type EventData struct {
+	ProjectID      uuid.UUID `json:"project_id"`
+	EntityType     string    `json:"entity_type"`
+	EntityID       uuid.UUID `json:"entity_id"`
+	ProfileID      uuid.UUID `json:"profile_id"`
+	ProfileName    string    `json:"profile_name"`
+	RuleID         uuid.UUID `json:"rule_id"`
+	RuleName       string    `json:"rule_name"`
+	RuleType       string    `json:"rule_type"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status,omitempty"`
+	Details        string    `json:"details,omitempty"`
+}
+
+// Subject returns the CloudEvents subject of the event, which identifies
+// the entity it is about
+func (e *Event) Subject() string {
+	return fmt.Sprintf("%s/%s", e.Data.EntityType, e.Data.EntityID)
+}
+
+// NewMessages creates a message for every status which changed in the given
+// evaluation: the evaluation status itself, and the status of the
+// remediation and alert actions.
+func NewMessages(params *engif.EvalStatusParams) ([]*message.Message, error) {
+	prev := params.EvalStatusFromDb
+	if prev == nil {
+		// This is the first evaluation of the rule for the entity
+		prev = &db.ListRuleEvaluationsByProfileIdRow{
+			RemStatus:   db.RemediationStatusTypesSkipped,
+			AlertStatus: db.AlertStatusTypesSkipped,
+		}
+	}
+
+	base := EventData{
+		ProjectID:  params.ProjectID,
+		EntityType: string(params.EntityType),
+		EntityID:   params.EntityID,
+		RuleType:   params.RuleTypeName,
+	}
+	if params.Profile != nil {
+		base.ProfileID = params.Profile.ID
+		base.ProfileName = params.Profile.Name
+	}
+	if params.Rule != nil {
+		base.RuleID = params.Rule.ID
+		base.RuleName = params.Rule.Name
+	}
+
+	now := time.Now().UTC()
+	var events []*Event
+	addEvent := func(eventType string, status, prevStatus string, details string) {
+		if status == prevStatus {
+			return
+		}
+		data := base
+		data.Status = status
+		data.PreviousStatus = prevStatus
+		data.Details = details
+		events = append(events, &Event{
+			ID:   uuid.New().String(),
+			Type: eventType,
+			Time: now,
+			Data: data,
+		})
+	}
+
+	evalErr := params.GetEvalErr()
+	actionsErr := params.GetActionsErr()
+	addEvent(EventTypeEvaluation,
+		string(evalerrors.ErrorAsEvalStatus(evalErr)), string(prev.EvalStatus),
+		evalerrors.ErrorAsEvalDetails(evalErr))
+	addEvent(EventTypeRemediation,
+		string(evalerrors.ErrorAsRemediationStatus(actionsErr.RemediateErr)), string(prev.RemStatus),
+		actionDetails(actionsErr.RemediateErr))
+	addEvent(EventTypeAlert,
+		string(evalerrors.ErrorAsAlertStatus(actionsErr.AlertErr)), string(prev.AlertStatus),
+		actionDetails(actionsErr.AlertErr))
+
+	msgs := make([]*message.Message, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling export event: %w", err)
+		}
+		msgs = append(msgs, message.NewMessage(e.ID, payload))
+	}
+	return msgs, nil
+}
+
+// actionDetails returns the details of a failed action. Other action
+// errors only carry the status, which is already part of the event.
+func actionDetails(err error) string {
+	if evalerrors.IsActionFatalError(err) {
+		return err.Error()
+	}
+	return ""
+}
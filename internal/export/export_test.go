@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+func TestNewMessages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		prev           *db.ListRuleEvaluationsByProfileIdRow
+		evalErr        error
+		actionsErr     evalerrors.ActionsError
+		expectedEvents []EventData
+	}{
+		{
+			name: "first evaluation",
+			actionsErr: evalerrors.ActionsError{
+				RemediateErr: evalerrors.ErrActionSkipped,
+				AlertErr:     evalerrors.ErrActionSkipped,
+			},
+			expectedEvents: []EventData{
+				{Status: "success"},
+			},
+		},
+		{
+			name: "unchanged",
+			prev: &db.ListRuleEvaluationsByProfileIdRow{
+				EvalStatus:  db.EvalStatusTypesSuccess,
+				RemStatus:   db.RemediationStatusTypesSkipped,
+				AlertStatus: db.AlertStatusTypesSkipped,
+			},
+			actionsErr: evalerrors.ActionsError{
+				RemediateErr: evalerrors.ErrActionSkipped,
+				AlertErr:     evalerrors.ErrActionSkipped,
+			},
+		},
+		{
+			name: "failure with alert and remediation",
+			prev: &db.ListRuleEvaluationsByProfileIdRow{
+				EvalStatus:  db.EvalStatusTypesSuccess,
+				RemStatus:   db.RemediationStatusTypesSkipped,
+				AlertStatus: db.AlertStatusTypesOff,
+			},
+			evalErr: evalerrors.NewErrEvaluationFailed("branch is not protected"),
+			actionsErr: evalerrors.ActionsError{
+				RemediateErr: evalerrors.ErrActionFailed,
+			},
+			expectedEvents: []EventData{
+				{Status: "failure", PreviousStatus: "success", Details: "branch is not protected"},
+				{Status: "failure", PreviousStatus: "skipped", Details: evalerrors.ErrActionFailed.Error()},
+				{Status: "on", PreviousStatus: "off"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := &engif.EvalStatusParams{
+				Profile:          &models.ProfileAggregate{ID: uuid.New(), Name: "profile"},
+				Rule:             &models.RuleInstance{ID: uuid.New(), Name: "rule"},
+				RuleTypeName:     "branch_protection",
+				ProjectID:        uuid.New(),
+				EntityType:       db.EntitiesRepository,
+				EntityID:         uuid.New(),
+				EvalStatusFromDb: tt.prev,
+			}
+			params.SetEvalErr(tt.evalErr)
+			params.SetActionsErr(t.Context(), tt.actionsErr)
+
+			msgs, err := NewMessages(params)
+			require.NoError(t, err)
+			require.Len(t, msgs, len(tt.expectedEvents))
+
+			for i, msg := range msgs {
+				var evt Event
+				require.NoError(t, json.Unmarshal(msg.Payload, &evt))
+				require.Equal(t, msg.UUID, evt.ID)
+				require.Equal(t, "repository/"+params.EntityID.String(), evt.Subject())
+
+				expected := tt.expectedEvents[i]
+				expected.ProjectID = params.ProjectID
+				expected.EntityType = "repository"
+				expected.EntityID = params.EntityID
+				expected.ProfileID = params.Profile.ID
+				expected.ProfileName = "profile"
+				expected.RuleID = params.Rule.ID
+				expected.RuleName = "rule"
+				expected.RuleType = "branch_protection"
+				require.Equal(t, expected, evt.Data)
+			}
+		})
+	}
+}
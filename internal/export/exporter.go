@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/cenkalti/backoff/v4"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/rs/zerolog"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// Exporter consumes the events queued on TopicQueueExportEvent and delivers
+// them to a sink as CloudEvents.
+//
+// Delivery is at-least-once: failed deliveries are retried with backoff,
+// and if they keep failing the message is handed back to the event router,
+// which retries it again and finally moves it to the dead letter queue.
+type Exporter struct {
+	sink       Sink
+	source     string
+	newBackOff func() backoff.BackOff
+}
+
+// NewExporter creates an exporter for the given configuration
+func NewExporter(ctx context.Context, cfg *serverconfig.ExportConfig) (*Exporter, error) {
+	sink, err := NewSink(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newExporter(sink, cfg), nil
+}
+
+func newExporter(sink Sink, cfg *serverconfig.ExportConfig) *Exporter {
+	return &Exporter{
+		sink:   sink,
+		source: cfg.Source,
+		newBackOff: func() backoff.BackOff {
+			b := backoff.NewExponentialBackOff()
+			b.InitialInterval = cfg.Retry.InitialInterval
+			b.MaxInterval = cfg.Retry.MaxInterval
+			b.MaxElapsedTime = 0
+			return backoff.WithMaxRetries(b, cfg.Retry.MaxRetries)
+		},
+	}
+}
+
+// Register implements the Consumer interface.
+func (e *Exporter) Register(reg interfaces.Registrar) {
+	reg.Register(TopicQueueExportEvent, e.handleExportEvent)
+}
+
+// Close closes the sink of the exporter
+func (e *Exporter) Close() error {
+	return e.sink.Close()
+}
+
+func (e *Exporter) handleExportEvent(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var evt Event
+	if err := json.Unmarshal(msg.Payload, &evt); err != nil {
+		// Retrying will not help, so drop the message
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error unmarshalling export event")
+		return nil
+	}
+
+	ce, err := e.toCloudEvent(&evt)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("event_id", evt.ID).Msg("error creating CloudEvent")
+		return nil
+	}
+
+	err = backoff.Retry(func() error {
+		return e.sink.Send(ctx, ce)
+	}, backoff.WithContext(e.newBackOff(), ctx))
+	if err != nil {
+		return fmt.Errorf("error exporting event %s: %w", evt.ID, err)
+	}
+
+	zerolog.Ctx(ctx).Debug().Str("event_id", evt.ID).Str("event_type", evt.Type).Msg("event exported")
+	return nil
+}
+
+func (e *Exporter) toCloudEvent(evt *Event) (cloudevents.Event, error) {
+	ce := cloudevents.NewEvent()
+	ce.SetID(evt.ID)
+	ce.SetType(evt.Type)
+	ce.SetSource(e.source)
+	ce.SetSubject(evt.Subject())
+	ce.SetTime(evt.Time)
+	if err := ce.SetData(cloudevents.ApplicationJSON, evt.Data); err != nil {
+		return ce, err
+	}
+	return ce, ce.Validate()
+}
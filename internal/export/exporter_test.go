@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/cenkalti/backoff/v4"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+type fakeSink struct {
+	errs []error
+	sent []cloudevents.Event
+}
+
+func (f *fakeSink) Send(_ context.Context, event cloudevents.Event) error {
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return err
+	}
+	f.sent = append(f.sent, event)
+	return nil
+}
+
+func (*fakeSink) Close() error {
+	return nil
+}
+
+func TestExporter(t *testing.T) {
+	t.Parallel()
+
+	evt := Event{
+		ID:   uuid.NewString(),
+		Type: EventTypeAlert,
+		Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Data: EventData{
+			EntityType: "repository",
+			EntityID:   uuid.New(),
+			RuleName:   "rule",
+			Status:     "on",
+		},
+	}
+	payload, err := json.Marshal(evt)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		payload     []byte
+		sinkErrs    []error
+		expectedErr bool
+		expectSent  bool
+	}{
+		{
+			name:       "delivered",
+			payload:    payload,
+			expectSent: true,
+		},
+		{
+			name:       "delivered after retries",
+			payload:    payload,
+			sinkErrs:   []error{errors.New("unavailable"), errors.New("unavailable")},
+			expectSent: true,
+		},
+		{
+			name:        "retries exhausted",
+			payload:     payload,
+			sinkErrs:    []error{errors.New("unavailable"), errors.New("unavailable"), errors.New("unavailable")},
+			expectedErr: true,
+		},
+		{
+			name:        "rejected",
+			payload:     payload,
+			sinkErrs:    []error{backoff.Permanent(errors.New("bad request"))},
+			expectedErr: true,
+		},
+		{
+			name:    "malformed payload is dropped",
+			payload: []byte("not json"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sink := &fakeSink{errs: tt.sinkErrs}
+			exp := newExporter(sink, &serverconfig.ExportConfig{
+				Source: "https://minder.example.com",
+				Retry: serverconfig.ExportRetryConfig{
+					MaxRetries:      2,
+					InitialInterval: time.Millisecond,
+					MaxInterval:     time.Millisecond,
+				},
+			})
+
+			err := exp.handleExportEvent(message.NewMessage(uuid.NewString(), tt.payload))
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if !tt.expectSent {
+				require.Empty(t, sink.sent)
+				return
+			}
+			require.Len(t, sink.sent, 1)
+			ce := sink.sent[0]
+			require.Equal(t, evt.ID, ce.ID())
+			require.Equal(t, EventTypeAlert, ce.Type())
+			require.Equal(t, "https://minder.example.com", ce.Source())
+			require.Equal(t, "repository/"+evt.Data.EntityID.String(), ce.Subject())
+			require.Equal(t, evt.Time, ce.Time())
+
+			var data EventData
+			require.NoError(t, ce.DataAs(&data))
+			require.Equal(t, evt.Data, data)
+		})
+	}
+}
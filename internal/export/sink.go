@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cenkalti/backoff/v4"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"github.com/mindersec/minder/internal/events/common"
+	"github.com/mindersec/minder/internal/events/nats"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+const (
+	// SinkHTTP posts events to an HTTP endpoint
+	SinkHTTP = "http"
+	// SinkNats publishes events to a NATS JetStream subject
+	SinkNats = "nats"
+	// SinkFile appends events to a local file
+	SinkFile = "file"
+)
+
+// Sink is the destination of exported events
+type Sink interface {
+	// Send delivers an event. Errors which will not go away by sending
+	// the event again are wrapped in backoff.Permanent.
+	Send(ctx context.Context, event cloudevents.Event) error
+	// Close releases the resources held by the sink
+	Close() error
+}
+
+// NewSink creates the sink selected in the configuration
+func NewSink(ctx context.Context, cfg *serverconfig.ExportConfig) (Sink, error) {
+	switch cfg.Sink {
+	case SinkHTTP:
+		return newHTTPSink(cfg.HTTP.URL)
+	case SinkNats:
+		return newNatsSink(ctx, &cfg.Nats)
+	case SinkFile:
+		return newFileSink(cfg.File.Path)
+	}
+	return nil, fmt.Errorf("unknown export sink: %q", cfg.Sink)
+}
+
+// ceClientSink sends events through a CloudEvents client
+type ceClientSink struct {
+	client cloudevents.Client
+	closer common.DriverCloser
+}
+
+func newHTTPSink(target string) (*ceClientSink, error) {
+	if target == "" {
+		return nil, errors.New("export HTTP URL cannot be empty")
+	}
+	client, err := cloudevents.NewClientHTTP(cloudevents.WithTarget(target))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CloudEvents HTTP client: %w", err)
+	}
+	return &ceClientSink{client: client, closer: func() {}}, nil
+}
+
+func newNatsSink(ctx context.Context, cfg *serverconfig.NatsExportConfig) (*ceClientSink, error) {
+	client, closer, err := nats.NewCloudEventsClient(ctx, &serverconfig.NatsConfig{
+		URL:    cfg.URL,
+		Prefix: cfg.Prefix,
+	}, cfg.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CloudEvents NATS client: %w", err)
+	}
+	return &ceClientSink{client: client, closer: closer}, nil
+}
+
+// Send implements Sink
+func (s *ceClientSink) Send(ctx context.Context, event cloudevents.Event) error {
+	result := s.client.Send(ctx, event)
+	if cloudevents.IsACK(result) {
+		return nil
+	}
+
+	var httpResult *cehttp.Result
+	if cloudevents.ResultAs(result, &httpResult) && isPermanentStatus(httpResult.StatusCode) {
+		return backoff.Permanent(fmt.Errorf("event rejected: %w", result))
+	}
+	return fmt.Errorf("error sending event: %w", result)
+}
+
+// Close implements Sink
+func (s *ceClientSink) Close() error {
+	s.closer()
+	return nil
+}
+
+// isPermanentStatus returns whether an HTTP status means that the endpoint
+// will keep rejecting the event
+func isPermanentStatus(code int) bool {
+	return code >= 400 && code < 500 && code != http.StatusTooManyRequests && code != http.StatusRequestTimeout
+}
+
+// fileSink appends events to a file in the JSON Lines format
+type fileSink struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	if path == "" {
+		return nil, errors.New("export file path cannot be empty")
+	}
+	file, err := os.OpenFile(filepath.Clean(path), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening export file: %w", err)
+	}
+	return &fileSink{file: file}, nil
+}
+
+// Send implements Sink
+func (s *fileSink) Send(_ context.Context, event cloudevents.Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return backoff.Permanent(fmt.Errorf("error marshalling event: %w", err))
+	}
+	line = append(line, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err := s.file.Write(line); err != nil {
+		return fmt.Errorf("error writing event: %w", err)
+	}
+	return s.file.Sync()
+}
+
+// Close implements Sink
+func (s *fileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.file.Close()
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cenkalti/backoff/v4"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func newTestCloudEvent(t *testing.T, id string) cloudevents.Event {
+	t.Helper()
+
+	ce := cloudevents.NewEvent()
+	ce.SetID(id)
+	ce.SetType(EventTypeEvaluation)
+	ce.SetSource("minder")
+	require.NoError(t, ce.SetData(cloudevents.ApplicationJSON, EventData{Status: "failure"}))
+	return ce
+}
+
+func TestHTTPSink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		statusCode    int
+		expectedErr   bool
+		expectedPerma bool
+	}{
+		{
+			name:       "accepted",
+			statusCode: http.StatusAccepted,
+		},
+		{
+			name:        "server error is retried",
+			statusCode:  http.StatusServiceUnavailable,
+			expectedErr: true,
+		},
+		{
+			name:        "rate limit is retried",
+			statusCode:  http.StatusTooManyRequests,
+			expectedErr: true,
+		},
+		{
+			name:          "bad request is permanent",
+			statusCode:    http.StatusBadRequest,
+			expectedErr:   true,
+			expectedPerma: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "abc", r.Header.Get("Ce-Id"))
+				assert.Equal(t, EventTypeEvaluation, r.Header.Get("Ce-Type"))
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer ts.Close()
+
+			sink, err := NewSink(context.Background(), &serverconfig.ExportConfig{
+				Sink: SinkHTTP,
+				HTTP: serverconfig.HTTPExportConfig{URL: ts.URL},
+			})
+			require.NoError(t, err)
+			defer sink.Close()
+
+			err = sink.Send(context.Background(), newTestCloudEvent(t, "abc"))
+			if !tt.expectedErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			var permanent *backoff.PermanentError
+			require.Equal(t, tt.expectedPerma, errors.As(err, &permanent))
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := NewSink(context.Background(), &serverconfig.ExportConfig{
+		Sink: SinkFile,
+		File: serverconfig.FileExportConfig{Path: path},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send(context.Background(), newTestCloudEvent(t, "1")))
+	require.NoError(t, sink.Send(context.Background(), newTestCloudEvent(t, "2")))
+	require.NoError(t, sink.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		ce := cloudevents.NewEvent()
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &ce))
+		require.Equal(t, EventTypeEvaluation, ce.Type())
+		ids = append(ids, ce.ID())
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []string{"1", "2"}, ids)
+}
+
+func TestNewSinkUnknown(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSink(context.Background(), &serverconfig.ExportConfig{Sink: "kafka"})
+	require.Error(t, err)
+}
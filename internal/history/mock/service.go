@@ -58,6 +58,21 @@ func (mr *MockEvaluationHistoryServiceMockRecorder) ListEvaluationHistory(ctx, q
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationHistory", reflect.TypeOf((*MockEvaluationHistoryService)(nil).ListEvaluationHistory), ctx, qtx, cursor, size, filter)
 }
 
+// ReportEvaluationHistory mocks base method.
+func (m *MockEvaluationHistoryService) ReportEvaluationHistory(ctx context.Context, qtx db.ExtendQuerier, filter history.ReportFilter) ([]*history.PostureSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportEvaluationHistory", ctx, qtx, filter)
+	ret0, _ := ret[0].([]*history.PostureSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportEvaluationHistory indicates an expected call of ReportEvaluationHistory.
+func (mr *MockEvaluationHistoryServiceMockRecorder) ReportEvaluationHistory(ctx, qtx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportEvaluationHistory", reflect.TypeOf((*MockEvaluationHistoryService)(nil).ReportEvaluationHistory), ctx, qtx, filter)
+}
+
 // StoreEvaluationStatus mocks base method.
 func (m *MockEvaluationHistoryService) StoreEvaluationStatus(ctx context.Context, qtx db.Querier, ruleID, profileID uuid.UUID, entityType db.Entities, entityID uuid.UUID, evalError error, marshaledCheckpoint []byte) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -680,6 +680,51 @@ func NewListEvaluationFilter(opts ...FilterOpt) (ListEvaluationFilter, error) {
 	return filter, nil
 }
 
+// MaxReportRange is the longest time range a posture report can
+// span.
+const MaxReportRange = 366 * 24 * time.Hour
+
+// ReportFilter is a filter to be used when aggregating historical
+// evaluations into a posture report.
+type ReportFilter interface {
+	ProjectFilter
+	EntityTypeFilter
+	EntityNameFilter
+	ProfileNameFilter
+	LabelFilter
+	TimeRangeFilter
+}
+
+var _ ReportFilter = (*listEvaluationFilter)(nil)
+
+// NewReportFilter is a constructor routine for ReportFilter objects.
+//
+// Contrary to ListEvaluationFilter, both ends of the time range are
+// mandatory and the range cannot exceed MaxReportRange.
+func NewReportFilter(opts ...FilterOpt) (ReportFilter, error) {
+	filter := &listEvaluationFilter{}
+	for _, opt := range opts {
+		if err := opt(filter); err != nil {
+			return nil, err
+		}
+	}
+
+	if filter.projectID == uuid.Nil {
+		return nil, fmt.Errorf("%w: missing", ErrInvalidProjectID)
+	}
+	if filter.from == nil || filter.to == nil {
+		return nil, fmt.Errorf("%w: from and to are required", ErrInvalidTimeRange)
+	}
+	if !filter.from.Before(*filter.to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidTimeRange)
+	}
+	if filter.to.Sub(*filter.from) > MaxReportRange {
+		return nil, fmt.Errorf("%w: range exceeds %d days", ErrInvalidTimeRange, MaxReportRange/(24*time.Hour))
+	}
+
+	return filter, nil
+}
+
 // OneEvalHistoryAndEntity is a struct representing a combination of an
 // evaluation and an entity.
 type OneEvalHistoryAndEntity struct {
@@ -699,3 +744,28 @@ type ListEvaluationHistoryResult struct {
 	// page. The page is absent if Prev is nil.
 	Prev []byte
 }
+
+// PostureSnapshot is the posture of the entities of a given type
+// evaluated by a rule of a profile, as of the end of a day.
+type PostureSnapshot struct {
+	// Day is the start of the day, in UTC.
+	Day         time.Time
+	ProfileName string
+	RuleName    string
+	RuleType    string
+	EntityType  db.Entities
+	// Passing, Failing, Errored and Skipped count the entities
+	// whose latest evaluation at the end of the day had the
+	// corresponding status.
+	Passing int
+	Failing int
+	Errored int
+	Skipped int
+	// Remediated is the number of failures which turned into a
+	// success during the day.
+	Remediated int
+	// MeanTimeToRemediation is the mean time elapsed between the
+	// first failing evaluation and the next successful one, for
+	// the failures remediated during the day.
+	MeanTimeToRemediation time.Duration
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+func (*evaluationHistoryService) ReportEvaluationHistory(
+	ctx context.Context,
+	qtx db.ExtendQuerier,
+	filter ReportFilter,
+) ([]*PostureSnapshot, error) {
+	params, err := toSQLReportParams(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// the evaluations are aggregated by the database, so that the
+	// size of the result depends on the number of days and rules,
+	// not on the number of evaluations.
+	rows, err := qtx.ListEvaluationHistoryForReport(ctx, *params)
+	if err != nil {
+		return nil, fmt.Errorf("error listing history for report: %w", err)
+	}
+
+	return toPostureSnapshots(rows), nil
+}
+
+// toSQLReportParams reuses the conversion routines of the list
+// filter, which share the same semantics.
+func toSQLReportParams(filter ReportFilter) (*db.ListEvaluationHistoryForReportParams, error) {
+	if filter == nil || filter.GetFrom() == nil || filter.GetTo() == nil {
+		return nil, fmt.Errorf("%w: from and to are required", ErrInvalidTimeRange)
+	}
+
+	var listParams db.ListEvaluationHistoryParams
+	if err := paramsFromProjectFilter(filter, &listParams); err != nil {
+		return nil, err
+	}
+	if err := paramsFromEntityTypeFilter(filter, &listParams); err != nil {
+		return nil, err
+	}
+	if err := paramsFromEntityNameFilter(filter, &listParams); err != nil {
+		return nil, err
+	}
+	if err := paramsFromProfileNameFilter(filter, &listParams); err != nil {
+		return nil, err
+	}
+	if err := paramsFromLabelFilter(filter, &listParams); err != nil {
+		return nil, err
+	}
+
+	return &db.ListEvaluationHistoryForReportParams{
+		Projectid:       listParams.Projectid,
+		Fromts:          filter.GetFrom().UTC(),
+		Tots:            filter.GetTo().UTC(),
+		Entitytypes:     listParams.Entitytypes,
+		Entitynames:     listParams.Entitynames,
+		Profilenames:    listParams.Profilenames,
+		Notentitytypes:  listParams.Notentitytypes,
+		Notentitynames:  listParams.Notentitynames,
+		Notprofilenames: listParams.Notprofilenames,
+		Labels:          listParams.Labels,
+		Notlabels:       listParams.Notlabels,
+	}, nil
+}
+
+// toPostureSnapshots converts the daily aggregates of the report query,
+// skipping the days where the evaluations of a rule were all pending.
+func toPostureSnapshots(rows []db.ListEvaluationHistoryForReportRow) []*PostureSnapshot {
+	var result []*PostureSnapshot
+	for _, row := range rows {
+		if row.Passing+row.Failing+row.Errored+row.Skipped+row.Remediated == 0 {
+			continue
+		}
+		result = append(result, &PostureSnapshot{
+			Day:                   row.Day.UTC(),
+			ProfileName:           row.ProfileName,
+			RuleName:              row.RuleName,
+			RuleType:              row.RuleType,
+			EntityType:            row.EntityType,
+			Passing:               int(row.Passing),
+			Failing:               int(row.Failing),
+			Errored:               int(row.Errored),
+			Skipped:               int(row.Skipped),
+			Remediated:            int(row.Remediated),
+			MeanTimeToRemediation: time.Duration(math.Round(row.MeanRemediationSeconds * float64(time.Second))),
+		})
+	}
+	return result
+}
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/db"
+	dbf "github.com/mindersec/minder/internal/db/fixtures"
+)
+
+const day = 24 * time.Hour
+
+func TestToPostureSnapshots(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		rows     []db.ListEvaluationHistoryForReportRow
+		expected []*PostureSnapshot
+	}{
+		{
+			name:     "no evaluations",
+			expected: nil,
+		},
+		{
+			name: "daily aggregates",
+			rows: []db.ListEvaluationHistoryForReportRow{
+				reportRow(from, "rule1", 0, 1, 0, 0),
+				reportRow(from, "rule2", 1, 0, 1, 1),
+				reportRow(from.Add(day), "rule1", 1, 0, 0, 0),
+			},
+			expected: []*PostureSnapshot{
+				snapshot(from, "rule1", 0, 1, 0, 0),
+				snapshot(from, "rule2", 1, 0, 1, 1),
+				snapshot(from.Add(day), "rule1", 1, 0, 0, 0),
+			},
+		},
+		{
+			name: "mean time to remediation",
+			rows: []db.ListEvaluationHistoryForReportRow{
+				withRemediationRow(reportRow(from, "rule1", 2, 0, 0, 0), 2, 3*60*60),
+			},
+			expected: []*PostureSnapshot{
+				withRemediation(snapshot(from, "rule1", 2, 0, 0, 0), 2, 3*time.Hour),
+			},
+		},
+		{
+			name: "only pending evaluations",
+			rows: []db.ListEvaluationHistoryForReportRow{
+				reportRow(from, "rule1", 0, 0, 0, 0),
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := toPostureSnapshots(tt.rows)
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestReportEvaluationHistory(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(day)
+
+	tests := []struct {
+		name     string
+		dbSetup  dbf.DBMockBuilder
+		opts     []FilterOpt
+		expected []*PostureSnapshot
+		err      bool
+	}{
+		{
+			name: "filters are passed down",
+			dbSetup: dbf.NewDBMock(
+				withListEvaluationHistoryForReport(
+					&db.ListEvaluationHistoryForReportParams{
+						Projectid:       projectID,
+						Fromts:          from,
+						Tots:            to,
+						Entitytypes:     []db.Entities{db.EntitiesRepository},
+						Notprofilenames: []string{"other"},
+						Labels:          []string{"*"},
+					},
+					nil,
+					reportRow(from, "rule1", 1, 0, 0, 0),
+				),
+			),
+			opts: []FilterOpt{
+				WithEntityType("repository"),
+				WithProfileName("!other"),
+				WithLabel("*"),
+			},
+			expected: []*PostureSnapshot{
+				snapshot(from, "rule1", 1, 0, 0, 0),
+			},
+		},
+		{
+			name: "database error",
+			dbSetup: dbf.NewDBMock(
+				withListEvaluationHistoryForReport(nil, errors.New("boom")),
+			),
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := tt.dbSetup(ctrl)
+			service := NewEvaluationHistoryService(nil)
+
+			opts := append([]FilterOpt{
+				WithProjectID(projectID),
+				WithFrom(from),
+				WithTo(to),
+			}, tt.opts...)
+			filter, err := NewReportFilter(opts...)
+			require.NoError(t, err)
+
+			res, err := service.ReportEvaluationHistory(context.Background(), store, filter)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestNewReportFilter(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		opts []FilterOpt
+		err  error
+	}{
+		{
+			name: "valid",
+			opts: []FilterOpt{WithProjectID(projectID), WithFrom(now.Add(-day)), WithTo(now)},
+		},
+		{
+			name: "missing project",
+			opts: []FilterOpt{WithFrom(now.Add(-day)), WithTo(now)},
+			err:  ErrInvalidProjectID,
+		},
+		{
+			name: "missing from",
+			opts: []FilterOpt{WithProjectID(projectID), WithTo(now)},
+			err:  ErrInvalidTimeRange,
+		},
+		{
+			name: "missing to",
+			opts: []FilterOpt{WithProjectID(projectID), WithFrom(now)},
+			err:  ErrInvalidTimeRange,
+		},
+		{
+			name: "empty range",
+			opts: []FilterOpt{WithProjectID(projectID), WithFrom(now), WithTo(now)},
+			err:  ErrInvalidTimeRange,
+		},
+		{
+			name: "range too long",
+			opts: []FilterOpt{WithProjectID(projectID), WithFrom(now.Add(-MaxReportRange - day)), WithTo(now)},
+			err:  ErrInvalidTimeRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewReportFilter(tt.opts...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func reportRow(
+	d time.Time,
+	ruleName string,
+	passing, failing, errored, skipped int64,
+) db.ListEvaluationHistoryForReportRow {
+	return db.ListEvaluationHistoryForReportRow{
+		Day:         d,
+		ProfileName: "profile",
+		RuleName:    ruleName,
+		RuleType:    "rule_type",
+		EntityType:  db.EntitiesRepository,
+		Passing:     passing,
+		Failing:     failing,
+		Errored:     errored,
+		Skipped:     skipped,
+	}
+}
+
+func withRemediationRow(
+	row db.ListEvaluationHistoryForReportRow,
+	remediated int64,
+	meanSeconds float64,
+) db.ListEvaluationHistoryForReportRow {
+	row.Remediated = remediated
+	row.MeanRemediationSeconds = meanSeconds
+	return row
+}
+
+func snapshot(
+	d time.Time,
+	ruleName string,
+	passing, failing, errored, skipped int,
+) *PostureSnapshot {
+	return &PostureSnapshot{
+		Day:         d,
+		ProfileName: "profile",
+		RuleName:    ruleName,
+		RuleType:    "rule_type",
+		EntityType:  db.EntitiesRepository,
+		Passing:     passing,
+		Failing:     failing,
+		Errored:     errored,
+		Skipped:     skipped,
+	}
+}
+
+func withRemediation(s *PostureSnapshot, remediated int, mttr time.Duration) *PostureSnapshot {
+	s.Remediated = remediated
+	s.MeanTimeToRemediation = mttr
+	return s
+}
+
+func withListEvaluationHistoryForReport(
+	params *db.ListEvaluationHistoryForReportParams,
+	err error,
+	records ...db.ListEvaluationHistoryForReportRow,
+) func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		if params != nil {
+			mock.EXPECT().
+				ListEvaluationHistoryForReport(gomock.Any(), *params).
+				Return(records, err)
+			return
+		}
+		mock.EXPECT().
+			ListEvaluationHistoryForReport(gomock.Any(), gomock.Any()).
+			Return(records, err)
+	}
+}
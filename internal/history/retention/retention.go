@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package retention purges evaluation history according to the retention
+// policy of each project.
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/projects"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// Policy is the evaluation history retention policy of a project
+type Policy struct {
+	// KeepDays is the number of days evaluation history is kept for
+	KeepDays int
+	// TransitionsOnly keeps only the evaluations which changed the status
+	// of a rule for an entity
+	TransitionsOnly bool
+}
+
+// ProjectResult is the outcome of purging the evaluation history of a project
+type ProjectResult struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+	Policy      Policy
+	// Records is the number of records purged, or the number of records
+	// which would be purged in a dry run
+	Records int64
+}
+
+// Purger purges evaluation history according to the retention policy of
+// each project.  The latest evaluation of each rule and entity pair is
+// always kept.
+type Purger struct {
+	store db.Store
+	cfg   *serverconfig.HistoryRetentionConfig
+	now   func() time.Time
+}
+
+// NewPurger creates a new Purger
+func NewPurger(store db.Store, cfg *serverconfig.HistoryRetentionConfig) *Purger {
+	return &Purger{
+		store: store,
+		cfg:   cfg,
+		now:   time.Now,
+	}
+}
+
+// Run purges the evaluation history of all the projects at the configured
+// interval, until the context is cancelled.
+func (p *Purger) Run(ctx context.Context) error {
+	if p.cfg.Interval <= 0 {
+		return fmt.Errorf("invalid history retention interval: %s", p.cfg.Interval)
+	}
+
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PurgeAll(ctx, p.cfg.DryRun); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error purging evaluation history")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeAll purges the evaluation history of all the projects.  When dryRun
+// is set, the records which would be purged are only counted.  A failure to
+// purge a project doesn't stop the other projects from being purged.
+func (p *Purger) PurgeAll(ctx context.Context, dryRun bool) ([]ProjectResult, error) {
+	logger := zerolog.Ctx(ctx)
+
+	allProjects, err := p.store.ListAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing projects: %w", err)
+	}
+
+	results := make([]ProjectResult, 0, len(allProjects))
+	var errs []error
+	for _, project := range allProjects {
+		res, err := p.PurgeProject(ctx, project, dryRun)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error purging project %s: %w", project.ID, err))
+			continue
+		}
+
+		logger.Info().
+			Str("project_id", project.ID.String()).
+			Int("keep_days", res.Policy.KeepDays).
+			Bool("transitions_only", res.Policy.TransitionsOnly).
+			Bool("dry_run", dryRun).
+			Int64("records", res.Records).
+			Msg("purged evaluation history")
+		results = append(results, res)
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// PurgeProject purges the evaluation history of a project
+func (p *Purger) PurgeProject(ctx context.Context, project db.Project, dryRun bool) (ProjectResult, error) {
+	policy := p.PolicyFor(ctx, &project)
+	res := ProjectResult{
+		ProjectID:   project.ID,
+		ProjectName: project.Name,
+		Policy:      policy,
+	}
+	threshold := p.now().UTC().AddDate(0, 0, -policy.KeepDays)
+
+	if dryRun {
+		count, err := p.store.CountEvaluationHistoryToPurge(ctx, db.CountEvaluationHistoryToPurgeParams{
+			ProjectID:       project.ID,
+			Threshold:       threshold,
+			TransitionsOnly: policy.TransitionsOnly,
+		})
+		if err != nil {
+			return res, fmt.Errorf("error counting evaluation history: %w", err)
+		}
+		res.Records = count
+		return res, nil
+	}
+
+	batchSize := p.cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	// The records are listed in batches from oldest to newest, resuming
+	// after the last record of the previous batch, whether it was purged
+	// or kept.
+	var afterTime sql.NullTime
+	var afterID uuid.NullUUID
+	for {
+		rows, err := p.store.ListEvaluationHistoryToPurge(ctx, db.ListEvaluationHistoryToPurgeParams{
+			ProjectID:       project.ID,
+			Threshold:       threshold,
+			TransitionsOnly: policy.TransitionsOnly,
+			AfterTime:       afterTime,
+			AfterID:         afterID,
+			Size:            int32(batchSize), //nolint:gosec // batch sizes are small
+		})
+		if err != nil {
+			return res, fmt.Errorf("error listing evaluation history: %w", err)
+		}
+		if len(rows) == 0 {
+			return res, nil
+		}
+
+		var ids []uuid.UUID
+		for _, row := range rows {
+			if row.Purge {
+				ids = append(ids, row.ID)
+			}
+		}
+
+		if len(ids) > 0 {
+			deleted, err := db.WithTransaction(p.store, func(qtx db.ExtendQuerier) (int64, error) {
+				return qtx.DeleteEvaluationHistoryByIDs(ctx, ids)
+			})
+			if err != nil {
+				return res, fmt.Errorf("error deleting evaluation history: %w", err)
+			}
+			res.Records += deleted
+		}
+
+		if len(rows) < batchSize {
+			return res, nil
+		}
+		last := rows[len(rows)-1]
+		afterTime = sql.NullTime{Time: last.EvaluationTime, Valid: true}
+		afterID = uuid.NullUUID{UUID: last.ID, Valid: true}
+	}
+}
+
+// PolicyFor returns the retention policy of a project, falling back to the
+// server defaults for the settings the project doesn't override.
+func (p *Purger) PolicyFor(ctx context.Context, project *db.Project) Policy {
+	policy := Policy{KeepDays: p.cfg.DefaultKeepDays}
+
+	meta, err := projects.ParseMetadata(project)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("project_id", project.ID.String()).
+			Msg("failed to parse project metadata, using the default retention policy")
+		return policy
+	}
+
+	if meta.HistoryRetention != nil {
+		if meta.HistoryRetention.KeepDays > 0 {
+			policy.KeepDays = meta.HistoryRetention.KeepDays
+		}
+		policy.TransitionsOnly = meta.HistoryRetention.TransitionsOnly
+	}
+
+	return policy
+}
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/db"
+	dbf "github.com/mindersec/minder/internal/db/fixtures"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+var (
+	now       = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	projectID = uuid.New()
+)
+
+func TestPolicyFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		metadata string
+		expected Policy
+	}{
+		{
+			name:     "no policy",
+			metadata: `{"version": "v1alpha1"}`,
+			expected: Policy{KeepDays: 30},
+		},
+		{
+			name:     "keep days",
+			metadata: `{"history_retention": {"keep_days": 365}}`,
+			expected: Policy{KeepDays: 365},
+		},
+		{
+			name:     "transitions only",
+			metadata: `{"history_retention": {"transitions_only": true}}`,
+			expected: Policy{KeepDays: 30, TransitionsOnly: true},
+		},
+		{
+			name:     "invalid metadata",
+			metadata: `not json`,
+			expected: Policy{KeepDays: 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := newTestPurger(nil, 0)
+			project := &db.Project{ID: projectID, Metadata: []byte(tt.metadata)}
+			require.Equal(t, tt.expected, p.PolicyFor(context.Background(), project))
+		})
+	}
+}
+
+func TestPurgeProject(t *testing.T) {
+	t.Parallel()
+
+	id1, id2, id3 := uuid.New(), uuid.New(), uuid.New()
+	project := db.Project{
+		ID:       projectID,
+		Name:     "test",
+		Metadata: []byte(`{"history_retention": {"keep_days": 7, "transitions_only": true}}`),
+	}
+	listParams := db.ListEvaluationHistoryToPurgeParams{
+		ProjectID:       projectID,
+		Threshold:       now.AddDate(0, 0, -7),
+		TransitionsOnly: true,
+		Size:            2,
+	}
+
+	t1, t2 := now.AddDate(0, 0, -9), now.AddDate(0, 0, -8)
+	afterParams := listParams
+	afterParams.AfterTime = sql.NullTime{Time: t2, Valid: true}
+	afterParams.AfterID = uuid.NullUUID{UUID: id2, Valid: true}
+
+	tests := []struct {
+		name     string
+		dbSetup  dbf.DBMockBuilder
+		dryRun   bool
+		expected int64
+		err      bool
+	}{
+		{
+			name: "deletes in batches",
+			dbSetup: dbf.NewDBMock(
+				withTransactionStuff(),
+				func(mock dbf.DBMock) {
+					gomock.InOrder(
+						mock.EXPECT().ListEvaluationHistoryToPurge(gomock.Any(), listParams).
+							Return([]db.ListEvaluationHistoryToPurgeRow{
+								{ID: id1, EvaluationTime: t1, Purge: true},
+								{ID: id2, EvaluationTime: t2, Purge: true},
+							}, nil),
+						mock.EXPECT().DeleteEvaluationHistoryByIDs(gomock.Any(), []uuid.UUID{id1, id2}).
+							Return(int64(2), nil),
+						mock.EXPECT().ListEvaluationHistoryToPurge(gomock.Any(), afterParams).
+							Return([]db.ListEvaluationHistoryToPurgeRow{
+								{ID: id3, EvaluationTime: now, Purge: true},
+							}, nil),
+						mock.EXPECT().DeleteEvaluationHistoryByIDs(gomock.Any(), []uuid.UUID{id3}).
+							Return(int64(1), nil),
+					)
+				},
+			),
+			expected: 3,
+		},
+		{
+			name: "nothing to delete",
+			dbSetup: dbf.NewDBMock(
+				func(mock dbf.DBMock) {
+					mock.EXPECT().ListEvaluationHistoryToPurge(gomock.Any(), listParams).
+						Return(nil, nil)
+				},
+			),
+		},
+		{
+			name: "continues past kept records",
+			dbSetup: dbf.NewDBMock(
+				withTransactionStuff(),
+				func(mock dbf.DBMock) {
+					gomock.InOrder(
+						mock.EXPECT().ListEvaluationHistoryToPurge(gomock.Any(), listParams).
+							Return([]db.ListEvaluationHistoryToPurgeRow{
+								{ID: id1, EvaluationTime: t1},
+								{ID: id2, EvaluationTime: t2},
+							}, nil),
+						mock.EXPECT().ListEvaluationHistoryToPurge(gomock.Any(), afterParams).
+							Return([]db.ListEvaluationHistoryToPurgeRow{
+								{ID: id3, EvaluationTime: now, Purge: true},
+							}, nil),
+						mock.EXPECT().DeleteEvaluationHistoryByIDs(gomock.Any(), []uuid.UUID{id3}).
+							Return(int64(1), nil),
+					)
+				},
+			),
+			expected: 1,
+		},
+		{
+			name:   "dry run counts",
+			dryRun: true,
+			dbSetup: dbf.NewDBMock(
+				func(mock dbf.DBMock) {
+					mock.EXPECT().CountEvaluationHistoryToPurge(gomock.Any(), db.CountEvaluationHistoryToPurgeParams{
+						ProjectID:       projectID,
+						Threshold:       now.AddDate(0, 0, -7),
+						TransitionsOnly: true,
+					}).Return(int64(42), nil)
+				},
+			),
+			expected: 42,
+		},
+		{
+			name: "delete error",
+			dbSetup: dbf.NewDBMock(
+				withTransactionStuff(),
+				func(mock dbf.DBMock) {
+					mock.EXPECT().ListEvaluationHistoryToPurge(gomock.Any(), listParams).
+						Return([]db.ListEvaluationHistoryToPurgeRow{{ID: id1, EvaluationTime: t1, Purge: true}}, nil)
+					mock.EXPECT().DeleteEvaluationHistoryByIDs(gomock.Any(), []uuid.UUID{id1}).
+						Return(int64(0), errors.New("boom"))
+				},
+			),
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			p := newTestPurger(tt.dbSetup(ctrl), 2)
+
+			res, err := p.PurgeProject(context.Background(), project, tt.dryRun)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res.Records)
+			require.Equal(t, Policy{KeepDays: 7, TransitionsOnly: true}, res.Policy)
+		})
+	}
+}
+
+func TestPurgeAll(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	failing := db.Project{ID: uuid.New(), Metadata: []byte(`{}`)}
+	working := db.Project{ID: projectID, Name: "working", Metadata: []byte(`{}`)}
+	store := dbf.NewDBMock(func(mock dbf.DBMock) {
+		mock.EXPECT().ListAllProjects(gomock.Any()).Return([]db.Project{failing, working}, nil)
+		mock.EXPECT().CountEvaluationHistoryToPurge(gomock.Any(), gomock.Any()).
+			Return(int64(0), errors.New("boom"))
+		mock.EXPECT().CountEvaluationHistoryToPurge(gomock.Any(), gomock.Any()).
+			Return(int64(5), nil)
+	})(ctrl)
+
+	results, err := newTestPurger(store, 2).PurgeAll(context.Background(), true)
+	require.Error(t, err)
+	require.Equal(t, []ProjectResult{{
+		ProjectID:   projectID,
+		ProjectName: "working",
+		Policy:      Policy{KeepDays: 30},
+		Records:     5,
+	}}, results)
+}
+
+func newTestPurger(store db.Store, batchSize int) *Purger {
+	p := NewPurger(store, &serverconfig.HistoryRetentionConfig{
+		DefaultKeepDays: 30,
+		BatchSize:       batchSize,
+	})
+	p.now = func() time.Time { return now }
+	return p
+}
+
+func withTransactionStuff() func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		mock.EXPECT().
+			BeginTransaction().
+			AnyTimes()
+		mock.EXPECT().
+			GetQuerierWithTransaction(gomock.Any()).
+			Return(mock).
+			AnyTimes()
+		mock.EXPECT().
+			Commit(gomock.Any()).
+			AnyTimes()
+		mock.EXPECT().
+			Rollback(gomock.Any()).
+			AnyTimes()
+	}
+}
@@ -44,6 +44,13 @@ type EvaluationHistoryService interface {
 		size uint32,
 		filter ListEvaluationFilter,
 	) (*ListEvaluationHistoryResult, error)
+	// ReportEvaluationHistory aggregates the evaluations stored
+	// in the history table into daily posture snapshots.
+	ReportEvaluationHistory(
+		ctx context.Context,
+		qtx db.ExtendQuerier,
+		filter ReportFilter,
+	) ([]*PostureSnapshot, error)
 }
 
 type options func(*evaluationHistoryService)
@@ -92,3 +92,20 @@ func WithFailedForEachDataSource(mock BundleMock) {
 		ForEachDataSource(gomock.Any()).
 		Return(errDefault)
 }
+
+var (
+	SignedManifest    = []byte(`{"metadata":{"name":"healthcheck"}}`)
+	ManifestSignature = []byte(`{"mediaType":"application/vnd.dev.sigstore.bundle.v0.3+json"}`)
+)
+
+func WithSignedManifest(mock BundleMock) {
+	mock.EXPECT().
+		GetSignedManifest().
+		Return(SignedManifest, ManifestSignature, nil)
+}
+
+func WithUnsignedManifest(mock BundleMock) {
+	mock.EXPECT().
+		GetSignedManifest().
+		Return(SignedManifest, nil, nil)
+}
@@ -55,6 +55,20 @@ func (mr *MockBundleReaderMockRecorder) ForEachDataSource(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForEachDataSource", reflect.TypeOf((*MockBundleReader)(nil).ForEachDataSource), arg0)
 }
 
+// ForEachProfile mocks base method.
+func (m *MockBundleReader) ForEachProfile(arg0 func(*v1.Profile) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForEachProfile", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForEachProfile indicates an expected call of ForEachProfile.
+func (mr *MockBundleReaderMockRecorder) ForEachProfile(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForEachProfile", reflect.TypeOf((*MockBundleReader)(nil).ForEachProfile), arg0)
+}
+
 // ForEachRuleType mocks base method.
 func (m *MockBundleReader) ForEachRuleType(arg0 func(*v1.RuleType) error) error {
 	m.ctrl.T.Helper()
@@ -97,3 +111,19 @@ func (mr *MockBundleReaderMockRecorder) GetProfile(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockBundleReader)(nil).GetProfile), arg0)
 }
+
+// GetSignedManifest mocks base method.
+func (m *MockBundleReader) GetSignedManifest() ([]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignedManifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSignedManifest indicates an expected call of GetSignedManifest.
+func (mr *MockBundleReaderMockRecorder) GetSignedManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignedManifest", reflect.TypeOf((*MockBundleReader)(nil).GetSignedManifest))
+}
@@ -6,10 +6,12 @@ package marketplaces
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
 	"github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/mindpak"
 	src "github.com/mindersec/minder/pkg/mindpak/sources"
@@ -41,22 +43,19 @@ func NewMarketplaceFromServiceConfig(
 
 	newSources := make([]src.BundleSource, len(cfgSources))
 	for i, cfgSource := range cfgSources {
-		// This is just used for validation
-		// TODO: support other sources
-		if t, err := cfgSource.GetType(); err != nil || t != server.TgzSource {
-			return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
-		}
-
-		tarPath := filepath.Clean(cfgSource.Location)
-		source, err := src.NewSourceFromTarGZ(tarPath)
+		source, err := newSourceFromConfig(cfgSource)
 		if err != nil {
-			return nil, fmt.Errorf("unable to load tar from path %s: %w", tarPath, err)
+			return nil, err
 		}
-
 		newSources[i] = source
 	}
 
-	subscription := sub.NewSubscriptionService(profile, ruleType, dataSource)
+	trustedSigners, err := newTrustedSignersFromConfig(config.TrustPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := sub.NewSubscriptionService(profile, ruleType, dataSource, trustedSigners...)
 	marketplace, err := NewMarketplace(newSources, subscription)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating marketplace: %w", err)
@@ -64,6 +63,61 @@ func NewMarketplaceFromServiceConfig(
 	return marketplace, nil
 }
 
+func newSourceFromConfig(cfgSource server.BundleSourceConfig) (src.BundleSource, error) {
+	t, err := cfgSource.GetType()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
+	}
+
+	switch t {
+	case server.TgzSource:
+		tarPath := filepath.Clean(cfgSource.Location)
+		source, err := src.NewSourceFromTarGZ(tarPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load tar from path %s: %w", tarPath, err)
+		}
+		return source, nil
+	case server.OCISource:
+		var opts []src.OCIOption
+		if cfgSource.Insecure {
+			opts = append(opts, src.WithInsecureRegistry())
+		}
+		source, err := src.NewOCISource(cfgSource.Location, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create OCI source for %s: %w", cfgSource.Location, err)
+		}
+		return source, nil
+	default:
+		return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
+	}
+}
+
+func newTrustedSignersFromConfig(policy server.BundleTrustPolicyConfig) ([]sub.ManifestVerifier, error) {
+	signers := make([]sub.ManifestVerifier, 0, len(policy.Keyless)+len(policy.PublicKeys))
+	for _, identity := range policy.Keyless {
+		if identity.Issuer == "" || identity.Subject == "" {
+			return nil, errors.New("keyless signers require an issuer and a subject")
+		}
+		signer, err := sigstore.NewKeylessBlobVerifier(identity.TUFRoot, identity.Issuer, identity.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create verifier for %s: %w", identity.Subject, err)
+		}
+		signers = append(signers, signer)
+	}
+	for _, keyPath := range policy.PublicKeys {
+		key, err := os.ReadFile(filepath.Clean(keyPath))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read public key from path %s: %w", keyPath, err)
+		}
+		signer, err := sigstore.NewPublicKeyBlobVerifier(key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create verifier for public key %s: %w", keyPath, err)
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
 // NewMarketplace creates an instance of Marketplace with a single source
 func NewMarketplace(sources []src.BundleSource, subscriptions sub.SubscriptionService) (Marketplace, error) {
 	sourceMapping := make(map[mindpak.BundleID]src.BundleSource)
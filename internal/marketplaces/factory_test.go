@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package marketplaces
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestNewTrustedSignersFromConfig(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "signing-key.pub")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	for _, tc := range []struct {
+		name    string
+		policy  server.BundleTrustPolicyConfig
+		signers int
+		mustErr bool
+	}{
+		{name: "empty policy"},
+		{
+			name:    "public keys",
+			policy:  server.BundleTrustPolicyConfig{PublicKeys: []string{keyPath, keyPath}},
+			signers: 2,
+		},
+		{
+			name:    "missing public key",
+			policy:  server.BundleTrustPolicyConfig{PublicKeys: []string{keyPath + ".missing"}},
+			mustErr: true,
+		},
+		{
+			name: "keyless signer without subject",
+			policy: server.BundleTrustPolicyConfig{Keyless: []server.KeylessSignerConfig{
+				{Issuer: "https://token.actions.githubusercontent.com"},
+			}},
+			mustErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			signers, err := newTrustedSignersFromConfig(tc.policy)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, signers, tc.signers)
+		})
+	}
+}
@@ -38,6 +38,22 @@ type Marketplace interface {
 		profileName string,
 		qtx db.Querier,
 	) error
+	// DiffUpgrade computes the changes upgrading the subscription of the
+	// project to the version of the bundle in the marketplace would make.
+	DiffUpgrade(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		qtx db.ExtendQuerier,
+	) (*sub.BundleDiff, error)
+	// Upgrade upgrades the subscription of the project to the version of
+	// the bundle in the marketplace.
+	Upgrade(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		qtx db.ExtendQuerier,
+	) (*sub.BundleDiff, error)
 }
 
 // trivial implementation of Marketplace with a single source
@@ -84,6 +100,42 @@ func (s *marketplace) AddProfile(
 	return nil
 }
 
+func (s *marketplace) DiffUpgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	qtx db.ExtendQuerier,
+) (*sub.BundleDiff, error) {
+	bundle, err := s.getBundle(bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := s.subscriptions.DiffUpgrade(ctx, projectID, bundle, qtx)
+	if err != nil {
+		return nil, fmt.Errorf("error while computing bundle upgrade: %w", err)
+	}
+	return diff, nil
+}
+
+func (s *marketplace) Upgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	qtx db.ExtendQuerier,
+) (*sub.BundleDiff, error) {
+	bundle, err := s.getBundle(bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := s.subscriptions.Upgrade(ctx, projectID, bundle, qtx)
+	if err != nil {
+		return diff, fmt.Errorf("error while upgrading bundle: %w", err)
+	}
+	return diff, nil
+}
+
 func (s *marketplace) getBundle(bundleID mindpak.BundleID) (reader.BundleReader, error) {
 	source, ok := s.sources[bundleID]
 	if !ok {
@@ -113,3 +165,21 @@ func (*noopMarketplace) AddProfile(
 ) error {
 	return nil
 }
+
+func (*noopMarketplace) DiffUpgrade(
+	_ context.Context,
+	_ uuid.UUID,
+	_ mindpak.BundleID,
+	_ db.ExtendQuerier,
+) (*sub.BundleDiff, error) {
+	return &sub.BundleDiff{}, nil
+}
+
+func (*noopMarketplace) Upgrade(
+	_ context.Context,
+	_ uuid.UUID,
+	_ mindpak.BundleID,
+	_ db.ExtendQuerier,
+) (*sub.BundleDiff, error) {
+	return &sub.BundleDiff{}, nil
+}
@@ -15,6 +15,7 @@ import (
 
 	uuid "github.com/google/uuid"
 	db "github.com/mindersec/minder/internal/db"
+	subscriptions "github.com/mindersec/minder/internal/marketplaces/subscriptions"
 	reader "github.com/mindersec/minder/pkg/mindpak/reader"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -57,6 +58,21 @@ func (mr *MockSubscriptionServiceMockRecorder) CreateProfile(ctx, projectID, bun
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfile", reflect.TypeOf((*MockSubscriptionService)(nil).CreateProfile), ctx, projectID, bundle, profileName, qtx)
 }
 
+// DiffUpgrade mocks base method.
+func (m *MockSubscriptionService) DiffUpgrade(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) (*subscriptions.BundleDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffUpgrade", ctx, projectID, bundle, qtx)
+	ret0, _ := ret[0].(*subscriptions.BundleDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffUpgrade indicates an expected call of DiffUpgrade.
+func (mr *MockSubscriptionServiceMockRecorder) DiffUpgrade(ctx, projectID, bundle, qtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffUpgrade", reflect.TypeOf((*MockSubscriptionService)(nil).DiffUpgrade), ctx, projectID, bundle, qtx)
+}
+
 // Subscribe mocks base method.
 func (m *MockSubscriptionService) Subscribe(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) error {
 	m.ctrl.T.Helper()
@@ -70,3 +86,18 @@ func (mr *MockSubscriptionServiceMockRecorder) Subscribe(ctx, projectID, bundle,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriptionService)(nil).Subscribe), ctx, projectID, bundle, qtx)
 }
+
+// Upgrade mocks base method.
+func (m *MockSubscriptionService) Upgrade(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) (*subscriptions.BundleDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", ctx, projectID, bundle, qtx)
+	ret0, _ := ret[0].(*subscriptions.BundleDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockSubscriptionServiceMockRecorder) Upgrade(ctx, projectID, bundle, qtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockSubscriptionService)(nil).Upgrade), ctx, projectID, bundle, qtx)
+}
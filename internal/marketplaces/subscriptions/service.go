@@ -32,6 +32,8 @@ import (
 type SubscriptionService interface {
 	// Subscribe creates a subscription record for the specified project
 	// and bundle. It is a no-op if the project is already subscribed.
+	// It returns ErrUntrustedBundle if the bundle is not signed by any of
+	// the trusted signers.
 	Subscribe(
 		ctx context.Context,
 		projectID uuid.UUID,
@@ -46,24 +48,49 @@ type SubscriptionService interface {
 		profileName string,
 		qtx db.Querier,
 	) error
+	// DiffUpgrade computes the changes which upgrading the subscription of
+	// the project to the specified version of the bundle would make. Changes
+	// which cannot be applied are flagged in the diff.
+	DiffUpgrade(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundle reader.BundleReader,
+		qtx db.ExtendQuerier,
+	) (*BundleDiff, error)
+	// Upgrade upgrades the subscription of the project to the specified
+	// version of the bundle, updating or removing the rule types, data
+	// sources and profiles installed from the bundle. It returns
+	// ErrInvalidUpgrade along with the diff if any change cannot be applied.
+	// The changes are only atomic if qtx is a transaction.
+	Upgrade(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundle reader.BundleReader,
+		qtx db.ExtendQuerier,
+	) (*BundleDiff, error)
 }
 
 type subscriptionService struct {
-	profiles    profsvc.ProfileService
-	rules       ruletypes.RuleTypeService
-	dataSources datasourceservice.DataSourcesService
+	profiles       profsvc.ProfileService
+	rules          ruletypes.RuleTypeService
+	dataSources    datasourceservice.DataSourcesService
+	trustedSigners []ManifestVerifier
 }
 
-// NewSubscriptionService creates an instance of the SubscriptionService interface
+// NewSubscriptionService creates an instance of the SubscriptionService interface.
+// If any trusted signers are given, only bundles signed by one of them can be
+// subscribed to.
 func NewSubscriptionService(
 	profiles profsvc.ProfileService,
 	rules ruletypes.RuleTypeService,
 	dataSources datasourceservice.DataSourcesService,
+	trustedSigners ...ManifestVerifier,
 ) SubscriptionService {
 	return &subscriptionService{
-		profiles:    profiles,
-		rules:       rules,
-		dataSources: dataSources,
+		profiles:       profiles,
+		rules:          rules,
+		dataSources:    dataSources,
+		trustedSigners: trustedSigners,
 	}
 }
 
@@ -73,6 +100,10 @@ func (s *subscriptionService) Subscribe(
 	bundle reader.BundleReader,
 	qtx db.ExtendQuerier,
 ) error {
+	if err := s.verifyBundle(bundle); err != nil {
+		return err
+	}
+
 	metadata := bundle.GetMetadata()
 	_, err := qtx.GetSubscriptionByProjectBundle(ctx, db.GetSubscriptionByProjectBundleParams{
 		Namespace: metadata.Namespace,
@@ -127,6 +158,10 @@ func (s *subscriptionService) CreateProfile(
 	profileName string,
 	qtx db.Querier,
 ) error {
+	if err := s.verifyBundle(bundle); err != nil {
+		return err
+	}
+
 	// ensure project is subscribed to this bundle
 	subscription, err := s.findSubscription(ctx, qtx, projectID, bundle.GetMetadata())
 	if err != nil {
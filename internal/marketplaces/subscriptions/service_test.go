@@ -241,6 +241,7 @@ func createService(
 	profileSetup psf.ProfileSvcMockBuilder,
 	ruleTypeSetup rsf.RuleTypeSvcMockBuilder,
 	dataSourceSetup dsf.DataSourcesSvcMockBuilder,
+	trustedSigners ...subscriptions.ManifestVerifier,
 ) subscriptions.SubscriptionService {
 	var rules ruletypes.RuleTypeService
 	if ruleTypeSetup != nil {
@@ -257,7 +258,7 @@ func createService(
 		dataSources = dataSourceSetup(ctrl)
 	}
 
-	return subscriptions.NewSubscriptionService(profSvc, rules, dataSources)
+	return subscriptions.NewSubscriptionService(profSvc, rules, dataSources, trustedSigners...)
 }
 
 func getQuerier(ctrl *gomock.Controller, dbSetup dbf.DBMockBuilder) db.ExtendQuerier {
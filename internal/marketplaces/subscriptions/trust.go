@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package subscriptions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+)
+
+// ErrUntrustedBundle is returned when a bundle is not signed by any of the
+// trusted signers
+var ErrUntrustedBundle = errors.New("bundle is not signed by a trusted signer")
+
+// ManifestVerifier checks the signature of a bundle manifest against a
+// trusted signer, e.g. a sigstore.BlobVerifier
+type ManifestVerifier interface {
+	// VerifyBlob returns an error unless signature is a valid signature of
+	// the manifest by the trusted signer
+	VerifyBlob(signature []byte, manifest []byte) error
+}
+
+// verifyBundle checks that the bundle is signed by one of the trusted
+// signers. Any bundle is accepted if no signers are configured.
+func (s *subscriptionService) verifyBundle(bundle reader.BundleReader) error {
+	if len(s.trustedSigners) == 0 {
+		return nil
+	}
+
+	manifest, signature, err := bundle.GetSignedManifest()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUntrustedBundle, err)
+	}
+	if signature == nil {
+		metadata := bundle.GetMetadata()
+		return fmt.Errorf("%w: bundle %s/%s is not signed", ErrUntrustedBundle, metadata.Namespace, metadata.Name)
+	}
+
+	errs := make([]error, 0, len(s.trustedSigners))
+	for _, signer := range s.trustedSigners {
+		err := signer.VerifyBlob(signature, manifest)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	metadata := bundle.GetMetadata()
+	return fmt.Errorf("%w: bundle %s/%s: %w", ErrUntrustedBundle, metadata.Namespace, metadata.Name, errors.Join(errs...))
+}
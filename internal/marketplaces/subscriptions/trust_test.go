@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package subscriptions_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	dsf "github.com/mindersec/minder/internal/datasources/service/mock/fixtures"
+	dbf "github.com/mindersec/minder/internal/db/fixtures"
+	brf "github.com/mindersec/minder/internal/marketplaces/bundles/mock/fixtures"
+	"github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	rsf "github.com/mindersec/minder/pkg/ruletypes/mock/fixtures"
+)
+
+// fakeSigner accepts the manifest signed by the bundle fixtures if trusted
+type fakeSigner struct {
+	trusted bool
+}
+
+func (f *fakeSigner) VerifyBlob(signature []byte, manifest []byte) error {
+	if !f.trusted || !bytes.Equal(signature, brf.ManifestSignature) || !bytes.Equal(manifest, brf.SignedManifest) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func TestSubscriptionService_SubscribeTrustPolicy(t *testing.T) {
+	t.Parallel()
+	scenarios := []struct {
+		Name            string
+		Signers         []subscriptions.ManifestVerifier
+		DBSetup         dbf.DBMockBuilder
+		BundleSetup     brf.BundleMockBuilder
+		RuleTypeSetup   rsf.RuleTypeSvcMockBuilder
+		DataSourceSetup dsf.DataSourcesSvcMockBuilder
+		ExpectUntrusted bool
+	}{
+		{
+			Name:            "Subscribe refuses unsigned bundles",
+			Signers:         []subscriptions.ManifestVerifier{&fakeSigner{trusted: true}},
+			BundleSetup:     brf.NewBundleReaderMock(brf.WithMetadata, brf.WithUnsignedManifest),
+			ExpectUntrusted: true,
+		},
+		{
+			Name:            "Subscribe refuses bundles signed by an untrusted signer",
+			Signers:         []subscriptions.ManifestVerifier{&fakeSigner{}, &fakeSigner{}},
+			BundleSetup:     brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSignedManifest),
+			ExpectUntrusted: true,
+		},
+		{
+			Name:    "Subscribe accepts bundles signed by any trusted signer",
+			Signers: []subscriptions.ManifestVerifier{&fakeSigner{}, &fakeSigner{trusted: true}},
+			DBSetup: dbf.NewDBMock(withNotFoundFindSubscription, withSuccessfulCreateSubscription, withBundleUpsert),
+			BundleSetup: brf.NewBundleReaderMock(brf.WithSignedManifest, brf.WithMetadata,
+				brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := createService(ctrl, nil, scenario.RuleTypeSetup, scenario.DataSourceSetup, scenario.Signers...)
+			err := svc.Subscribe(context.Background(), projectID, scenario.BundleSetup(ctrl), getQuerier(ctrl, scenario.DBSetup))
+			if scenario.ExpectUntrusted {
+				require.ErrorIs(t, err, subscriptions.ErrUntrustedBundle)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
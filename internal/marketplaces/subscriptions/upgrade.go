@@ -0,0 +1,581 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+	profsvc "github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// ErrInvalidUpgrade is returned when some of the changes of a bundle upgrade
+// cannot be applied to the project
+var ErrInvalidUpgrade = errors.New("bundle upgrade is invalid")
+
+// ChangeKind describes what happens to a resource of the bundle when the
+// subscription is upgraded
+type ChangeKind string
+
+const (
+	// ChangeAdded is a resource which is only in the new version of the bundle
+	ChangeAdded ChangeKind = "added"
+	// ChangeModified is a resource which differs between the installed and
+	// the new version of the bundle
+	ChangeModified ChangeKind = "modified"
+	// ChangeRemoved is a resource which is not in the new version of the bundle
+	ChangeRemoved ChangeKind = "removed"
+)
+
+// Change is a change to a single resource of the bundle
+type Change struct {
+	Kind ChangeKind
+	Name string
+	// Error is set when the change cannot be applied, e.g. because a rule
+	// type update is not backwards compatible
+	Error error
+}
+
+// BundleDiff holds the changes between the version of a bundle installed in
+// a project and a new version of the bundle
+type BundleDiff struct {
+	Namespace   string
+	Name        string
+	FromVersion string
+	ToVersion   string
+	RuleTypes   []Change
+	DataSources []Change
+	// Profiles lists the changes to the profiles created from the bundle.
+	// Profiles which are new in the bundle are listed as well, but they are
+	// not created by the upgrade.
+	Profiles []Change
+}
+
+// IsEmpty returns true when the upgrade does not change any resource
+func (d *BundleDiff) IsEmpty() bool {
+	return len(d.RuleTypes) == 0 && len(d.DataSources) == 0 && len(d.Profiles) == 0
+}
+
+// Err returns the errors of all the changes which cannot be applied, or nil
+// if the upgrade is valid
+func (d *BundleDiff) Err() error {
+	var errs []error
+	for _, group := range []struct {
+		kind    string
+		changes []Change
+	}{
+		{"rule type", d.RuleTypes},
+		{"data source", d.DataSources},
+		{"profile", d.Profiles},
+	} {
+		for _, change := range group.changes {
+			if change.Error != nil {
+				errs = append(errs, fmt.Errorf("%s %s %s: %w", change.Kind, group.kind, change.Name, change.Error))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidUpgrade, errors.Join(errs...))
+}
+
+// upgradePlan holds the diff of an upgrade along with the resources which
+// need to be written to apply it
+type upgradePlan struct {
+	diff              *BundleDiff
+	subscriptionID    uuid.UUID
+	dataSources       []*minderv1.DataSource
+	ruleTypes         []*minderv1.RuleType
+	profiles          []*minderv1.Profile
+	removeProfiles    []db.Profile
+	removeRuleTypes   []uuid.UUID
+	removeDataSources []uuid.UUID
+}
+
+func (s *subscriptionService) DiffUpgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	qtx db.ExtendQuerier,
+) (*BundleDiff, error) {
+	plan, err := s.planUpgrade(ctx, projectID, bundle, qtx)
+	if err != nil {
+		return nil, err
+	}
+	return plan.diff, nil
+}
+
+func (s *subscriptionService) Upgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	qtx db.ExtendQuerier,
+) (*BundleDiff, error) {
+	plan, err := s.planUpgrade(ctx, projectID, bundle, qtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := plan.diff.Err(); err != nil {
+		return plan.diff, err
+	}
+
+	// data sources go first, as the rule types may depend on them
+	for _, ds := range plan.dataSources {
+		err := s.dataSources.Upsert(ctx, projectID, plan.subscriptionID, ds,
+			datasourceservice.OptionsBuilder().WithTransaction(qtx))
+		if err != nil {
+			return nil, fmt.Errorf("error while upgrading data source %s: %w", ds.GetName(), err)
+		}
+	}
+
+	for _, rt := range plan.ruleTypes {
+		if err := s.rules.UpsertRuleType(ctx, projectID, plan.subscriptionID, rt, qtx); err != nil {
+			return nil, fmt.Errorf("error while upgrading rule type %s: %w", rt.GetName(), err)
+		}
+	}
+
+	for _, profile := range plan.profiles {
+		if _, err := s.profiles.UpdateProfile(ctx, projectID, plan.subscriptionID, profile, qtx); err != nil {
+			return nil, fmt.Errorf("error while upgrading profile %s: %w", profile.GetName(), err)
+		}
+	}
+
+	// removals go last and in reverse order, as profiles depend on rule
+	// types which depend on data sources
+	for _, profile := range plan.removeProfiles {
+		if err := qtx.DeleteProfile(ctx, db.DeleteProfileParams{ID: profile.ID, ProjectID: projectID}); err != nil {
+			return nil, fmt.Errorf("error while removing profile %s: %w", profile.Name, err)
+		}
+	}
+
+	for _, id := range plan.removeRuleTypes {
+		if err := qtx.DeleteRuleType(ctx, id); err != nil {
+			return nil, fmt.Errorf("error while removing rule type %s: %w", id, err)
+		}
+	}
+
+	for _, id := range plan.removeDataSources {
+		if _, err := qtx.DeleteDataSource(ctx, db.DeleteDataSourceParams{ID: id, ProjectID: projectID}); err != nil {
+			return nil, fmt.Errorf("error while removing data source %s: %w", id, err)
+		}
+	}
+
+	err = qtx.UpdateSubscriptionVersion(ctx, db.UpdateSubscriptionVersionParams{
+		ID:             plan.subscriptionID,
+		CurrentVersion: plan.diff.ToVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while updating subscription version: %w", err)
+	}
+
+	return plan.diff, nil
+}
+
+func (s *subscriptionService) planUpgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	qtx db.ExtendQuerier,
+) (*upgradePlan, error) {
+	if err := s.verifyBundle(bundle); err != nil {
+		return nil, err
+	}
+
+	metadata := bundle.GetMetadata()
+	subscription, err := s.findSubscription(ctx, qtx, projectID, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &upgradePlan{
+		diff: &BundleDiff{
+			Namespace:   metadata.Namespace,
+			Name:        metadata.Name,
+			FromVersion: subscription.CurrentVersion,
+			ToVersion:   metadata.Version,
+		},
+		subscriptionID: subscription.ID,
+	}
+
+	installedRuleTypes, err := s.installedRuleTypes(ctx, qtx, projectID, subscription.ID)
+	if err != nil {
+		return nil, err
+	}
+	newRuleTypes := map[string]*minderv1.RuleType{}
+	if err := bundle.ForEachRuleType(func(rt *minderv1.RuleType) error {
+		newRuleTypes[rt.GetName()] = rt
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("error while reading rule types from bundle: %w", err)
+	}
+
+	installedDataSources, err := s.installedDataSources(ctx, qtx, projectID, subscription.ID)
+	if err != nil {
+		return nil, err
+	}
+	newDataSources := map[string]*minderv1.DataSource{}
+	if err := bundle.ForEachDataSource(func(ds *minderv1.DataSource) error {
+		newDataSources[ds.GetName()] = ds
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("error while reading data sources from bundle: %w", err)
+	}
+
+	installedProfiles, err := s.installedProfiles(ctx, qtx, projectID, subscription.ID)
+	if err != nil {
+		return nil, err
+	}
+	newProfiles := map[string]*minderv1.Profile{}
+	if err := bundle.ForEachProfile(func(profile *minderv1.Profile) error {
+		newProfiles[profile.GetName()] = profile
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("error while reading profiles from bundle: %w", err)
+	}
+
+	// profiles are planned first, as removing a rule type is only valid
+	// when no remaining profile uses it
+	plan.planProfiles(installedProfiles, newProfiles, newRuleTypes)
+	if err := plan.planRuleTypes(ctx, qtx, installedRuleTypes, newRuleTypes, newProfiles); err != nil {
+		return nil, err
+	}
+	if err := plan.planDataSources(ctx, qtx, installedDataSources, newDataSources, installedRuleTypes, newRuleTypes); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (p *upgradePlan) planProfiles(
+	installed map[string]*installedProfile,
+	updated map[string]*minderv1.Profile,
+	ruleTypes map[string]*minderv1.RuleType,
+) {
+	for _, name := range sortedKeys(updated) {
+		profile := updated[name]
+		old, ok := installed[name]
+		if !ok {
+			p.diff.Profiles = append(p.diff.Profiles, Change{Kind: ChangeAdded, Name: name})
+			continue
+		}
+		if proto.Equal(normalizeProfile(old.pb, ruleTypes), normalizeProfile(profile, ruleTypes)) {
+			continue
+		}
+		p.diff.Profiles = append(p.diff.Profiles, Change{Kind: ChangeModified, Name: name})
+		p.profiles = append(p.profiles, profile)
+	}
+
+	for _, name := range sortedKeys(installed) {
+		if _, ok := updated[name]; ok {
+			continue
+		}
+		p.diff.Profiles = append(p.diff.Profiles, Change{Kind: ChangeRemoved, Name: name})
+		p.removeProfiles = append(p.removeProfiles, installed[name].db)
+	}
+}
+
+func (p *upgradePlan) planRuleTypes(
+	ctx context.Context,
+	qtx db.Querier,
+	installed map[string]*installedRuleType,
+	updated map[string]*minderv1.RuleType,
+	profiles map[string]*minderv1.Profile,
+) error {
+	for _, name := range sortedKeys(updated) {
+		rt := updated[name]
+		old, ok := installed[name]
+		if !ok {
+			var err error
+			if verr := rt.Validate(); verr != nil {
+				err = errors.Join(ruletypes.ErrRuleTypeInvalid, verr)
+			}
+			p.diff.RuleTypes = append(p.diff.RuleTypes, Change{Kind: ChangeAdded, Name: name, Error: err})
+			p.ruleTypes = append(p.ruleTypes, rt)
+			continue
+		}
+		if proto.Equal(normalizeRuleType(old.pb), normalizeRuleType(rt)) {
+			continue
+		}
+		p.diff.RuleTypes = append(p.diff.RuleTypes, Change{
+			Kind:  ChangeModified,
+			Name:  name,
+			Error: ruletypes.ValidateRuleTypeUpdate(old.pb, rt),
+		})
+		p.ruleTypes = append(p.ruleTypes, rt)
+	}
+
+	for _, name := range sortedKeys(installed) {
+		if _, ok := updated[name]; ok {
+			continue
+		}
+		old := installed[name]
+		users, err := qtx.ListProfilesInstantiatingRuleType(ctx, old.db.ID)
+		if err != nil {
+			return fmt.Errorf("error while listing profiles using rule type %s: %w", name, err)
+		}
+		// profiles removed by the upgrade, or updated to stop using the
+		// rule type, do not block its removal
+		users = slices.DeleteFunc(users, func(profile string) bool {
+			if slices.ContainsFunc(p.removeProfiles, func(removed db.Profile) bool { return removed.Name == profile }) {
+				return true
+			}
+			newProfile, ok := profiles[profile]
+			return ok && !profileUsesRuleType(newProfile, name)
+		})
+		var changeErr error
+		if len(users) > 0 {
+			changeErr = fmt.Errorf("rule type is still used by profiles: %v", users)
+		}
+		p.diff.RuleTypes = append(p.diff.RuleTypes, Change{Kind: ChangeRemoved, Name: name, Error: changeErr})
+		p.removeRuleTypes = append(p.removeRuleTypes, old.db.ID)
+	}
+
+	return nil
+}
+
+func (p *upgradePlan) planDataSources(
+	ctx context.Context,
+	qtx db.Querier,
+	installed map[string]*installedDataSource,
+	updated map[string]*minderv1.DataSource,
+	installedRuleTypes map[string]*installedRuleType,
+	ruleTypes map[string]*minderv1.RuleType,
+) error {
+	for _, name := range sortedKeys(updated) {
+		ds := updated[name]
+		old, ok := installed[name]
+		if ok && proto.Equal(normalizeDataSource(old.pb), normalizeDataSource(ds)) {
+			continue
+		}
+		kind := ChangeModified
+		if !ok {
+			kind = ChangeAdded
+		}
+		p.diff.DataSources = append(p.diff.DataSources, Change{Kind: kind, Name: name})
+		p.dataSources = append(p.dataSources, ds)
+	}
+
+	for _, name := range sortedKeys(installed) {
+		if _, ok := updated[name]; ok {
+			continue
+		}
+		id := installed[name].db.ID
+		refs, err := qtx.ListRuleTypesReferencesByDataSource(ctx, id)
+		if err != nil {
+			return fmt.Errorf("error while listing rule types using data source %s: %w", name, err)
+		}
+
+		var users []string
+		for _, ref := range refs {
+			rtName, fromBundle := ruleTypeNameByID(installedRuleTypes, ref.RuleTypeID)
+			if !fromBundle {
+				users = append(users, ref.RuleTypeID.String())
+				continue
+			}
+			// rule types removed by the upgrade, or updated to stop using
+			// the data source, do not block its removal
+			if rt, ok := ruleTypes[rtName]; ok && ruleTypeUsesDataSource(rt, name) {
+				users = append(users, rtName)
+			}
+		}
+		var changeErr error
+		if len(users) > 0 {
+			changeErr = fmt.Errorf("data source is still used by rule types: %v", users)
+		}
+		p.diff.DataSources = append(p.diff.DataSources, Change{Kind: ChangeRemoved, Name: name, Error: changeErr})
+		p.removeDataSources = append(p.removeDataSources, id)
+	}
+
+	return nil
+}
+
+type installedRuleType struct {
+	db db.RuleType
+	pb *minderv1.RuleType
+}
+
+type installedDataSource struct {
+	db db.DataSource
+	pb *minderv1.DataSource
+}
+
+type installedProfile struct {
+	db db.Profile
+	pb *minderv1.Profile
+}
+
+func (*subscriptionService) installedRuleTypes(
+	ctx context.Context,
+	qtx db.Querier,
+	projectID uuid.UUID,
+	subscriptionID uuid.UUID,
+) (map[string]*installedRuleType, error) {
+	dbRuleTypes, err := qtx.ListRuleTypesByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing rule types: %w", err)
+	}
+
+	result := map[string]*installedRuleType{}
+	for _, rt := range dbRuleTypes {
+		if !rt.SubscriptionID.Valid || rt.SubscriptionID.UUID != subscriptionID {
+			continue
+		}
+		pbRuleType, err := ruletypes.RuleTypePBFromDB(&rt)
+		if err != nil {
+			return nil, fmt.Errorf("error while reading rule type %s: %w", rt.Name, err)
+		}
+		result[rt.Name] = &installedRuleType{db: rt, pb: pbRuleType}
+	}
+	return result, nil
+}
+
+func (s *subscriptionService) installedDataSources(
+	ctx context.Context,
+	qtx db.ExtendQuerier,
+	projectID uuid.UUID,
+	subscriptionID uuid.UUID,
+) (map[string]*installedDataSource, error) {
+	dbDataSources, err := qtx.ListDataSources(ctx, []uuid.UUID{projectID})
+	if err != nil {
+		return nil, fmt.Errorf("error while listing data sources: %w", err)
+	}
+
+	result := map[string]*installedDataSource{}
+	for _, ds := range dbDataSources {
+		if !ds.SubscriptionID.Valid || ds.SubscriptionID.UUID != subscriptionID {
+			continue
+		}
+		pbDataSource, err := s.dataSources.GetByID(ctx, ds.ID, projectID,
+			datasourceservice.ReadBuilder().WithTransaction(qtx))
+		if err != nil {
+			return nil, fmt.Errorf("error while reading data source %s: %w", ds.Name, err)
+		}
+		result[ds.Name] = &installedDataSource{db: ds, pb: pbDataSource}
+	}
+	return result, nil
+}
+
+func (*subscriptionService) installedProfiles(
+	ctx context.Context,
+	qtx db.Querier,
+	projectID uuid.UUID,
+	subscriptionID uuid.UUID,
+) (map[string]*installedProfile, error) {
+	rows, err := qtx.ListProfilesByProjectIDAndLabel(ctx, db.ListProfilesByProjectIDAndLabelParams{
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while listing profiles: %w", err)
+	}
+
+	pbProfiles := profsvc.MergeDatabaseListIntoProfiles(rows)
+	result := map[string]*installedProfile{}
+	for _, row := range rows {
+		profile := row.Profile
+		if !profile.SubscriptionID.Valid || profile.SubscriptionID.UUID != subscriptionID {
+			continue
+		}
+		pbProfile, ok := pbProfiles[profile.Name]
+		if !ok {
+			continue
+		}
+		// labels are not part of the merged profile
+		pbProfile.Labels = profile.Labels
+		result[profile.Name] = &installedProfile{db: profile, pb: pbProfile}
+	}
+	return result, nil
+}
+
+// normalizeRuleType returns a copy of the rule type holding only the fields
+// which are stored, with the defaults applied when storing it
+func normalizeRuleType(rt *minderv1.RuleType) *minderv1.RuleType {
+	rt = proto.Clone(rt).(*minderv1.RuleType)
+	rt.Id = nil
+	rt.Context = nil
+	rt.Version = ""
+	rt.Type = ""
+	rt.Severity = rt.GetSeverity().EnsureDefault()
+	rt.ReleasePhase.EnsureDefault()
+	return rt.WithDefaultDisplayName().WithDefaultShortFailureMessage()
+}
+
+// normalizeDataSource returns a copy of the data source without the fields
+// which depend on the project it is installed in
+func normalizeDataSource(ds *minderv1.DataSource) *minderv1.DataSource {
+	ds = proto.Clone(ds).(*minderv1.DataSource)
+	ds.Id = ""
+	ds.Context = nil
+	ds.Version = ""
+	ds.Type = ""
+	return ds
+}
+
+// normalizeProfile returns a copy of the profile holding only the fields
+// which are stored, with the defaults applied when storing it
+func normalizeProfile(profile *minderv1.Profile, ruleTypes map[string]*minderv1.RuleType) *minderv1.Profile {
+	profile = proto.Clone(profile).(*minderv1.Profile)
+	profile.Id = nil
+	profile.Context = nil
+	profile.Version = ""
+	profile.Type = ""
+	if profile.GetDisplayName() == "" {
+		profile.DisplayName = profile.GetName()
+	}
+	profile.Alert = proto.String(string(db.ValidateAlertType(profile.GetAlert()).ActionType))
+	profile.Remediate = proto.String(string(db.ValidateRemediateType(profile.GetRemediate()).ActionType))
+	_ = profsvc.TraverseAllRulesForPipeline(profile, func(rule *minderv1.Profile_Rule) error {
+		displayName := rule.GetType()
+		if rt, ok := ruleTypes[rule.GetType()]; ok && rt.GetDisplayName() != "" {
+			displayName = rt.GetDisplayName()
+		}
+		rule.Name = profsvc.ComputeRuleName(rule, displayName)
+		return nil
+	})
+	return profile
+}
+
+func profileUsesRuleType(profile *minderv1.Profile, ruleTypeName string) bool {
+	found := false
+	_ = profsvc.TraverseAllRulesForPipeline(profile, func(rule *minderv1.Profile_Rule) error {
+		found = found || rule.GetType() == ruleTypeName
+		return nil
+	})
+	return found
+}
+
+func ruleTypeUsesDataSource(rt *minderv1.RuleType, dataSourceName string) bool {
+	return slices.ContainsFunc(rt.GetDef().GetEval().GetDataSources(), func(ref *minderv1.DataSourceReference) bool {
+		return ref.GetName() == dataSourceName
+	})
+}
+
+func ruleTypeNameByID(ruleTypes map[string]*installedRuleType, id uuid.UUID) (string, bool) {
+	for name, rt := range ruleTypes {
+		if rt.db.ID == id {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
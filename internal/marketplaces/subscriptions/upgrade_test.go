@@ -0,0 +1,476 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package subscriptions_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	mockdssvc "github.com/mindersec/minder/internal/datasources/service/mock"
+	"github.com/mindersec/minder/internal/db"
+	mockbundle "github.com/mindersec/minder/internal/marketplaces/bundles/mock"
+	"github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak"
+	mockprofsvc "github.com/mindersec/minder/pkg/profiles/mock"
+	mockrulesvc "github.com/mindersec/minder/pkg/ruletypes/mock"
+)
+
+const (
+	ruleTypeKept    = "stacklok/kept"
+	ruleTypeRemoved = "stacklok/removed"
+	ruleTypeAdded   = "stacklok/added"
+	upgradeProfile  = "stacklok/profile"
+	upgradeDS       = "stacklok/ds"
+	fromVersion     = "1.0.0"
+	toVersion       = "2.0.0"
+)
+
+// upgradeScenario describes the resources installed from the bundle and the
+// resources in the new version of the bundle
+type upgradeScenario struct {
+	installedRuleTypes   []*minderv1.RuleType
+	bundleRuleTypes      []*minderv1.RuleType
+	installedProfiles    []*minderv1.Profile
+	bundleProfiles       []*minderv1.Profile
+	installedDataSources []*minderv1.DataSource
+	bundleDataSources    []*minderv1.DataSource
+	// profiles using each rule type, by rule type name
+	ruleTypeUsers map[string][]string
+	// rule types outside the bundle using each data source, by data source name
+	dataSourceUsers map[string][]uuid.UUID
+}
+
+func TestSubscriptionService_DiffUpgrade(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		Name        string
+		Setup       upgradeScenario
+		RuleTypes   []subscriptions.Change
+		DataSources []subscriptions.Change
+		Profiles    []subscriptions.Change
+		Errors      []string
+	}{
+		{
+			Name: "DiffUpgrade returns empty diff when nothing changed",
+			Setup: upgradeScenario{
+				installedRuleTypes: []*minderv1.RuleType{upgradeRuleType(ruleTypeKept)},
+				bundleRuleTypes:    []*minderv1.RuleType{upgradeRuleType(ruleTypeKept)},
+				installedProfiles:  []*minderv1.Profile{installedUpgradeProfile(ruleTypeKept)},
+				bundleProfiles:     []*minderv1.Profile{bundleUpgradeProfile(ruleTypeKept)},
+			},
+		},
+		{
+			Name: "DiffUpgrade lists added, modified and removed resources",
+			Setup: upgradeScenario{
+				installedRuleTypes: []*minderv1.RuleType{
+					upgradeRuleType(ruleTypeKept),
+					upgradeRuleType(ruleTypeRemoved),
+				},
+				bundleRuleTypes: []*minderv1.RuleType{
+					upgradeRuleType(ruleTypeKept, withUpgradeDescription("new description")),
+					upgradeRuleType(ruleTypeAdded),
+				},
+				installedProfiles:    []*minderv1.Profile{installedUpgradeProfile(ruleTypeKept, ruleTypeRemoved)},
+				bundleProfiles:       []*minderv1.Profile{bundleUpgradeProfile(ruleTypeKept)},
+				installedDataSources: []*minderv1.DataSource{upgradeDataSource()},
+				ruleTypeUsers:        map[string][]string{ruleTypeRemoved: {upgradeProfile}},
+			},
+			RuleTypes: []subscriptions.Change{
+				{Kind: subscriptions.ChangeAdded, Name: ruleTypeAdded},
+				{Kind: subscriptions.ChangeModified, Name: ruleTypeKept},
+				{Kind: subscriptions.ChangeRemoved, Name: ruleTypeRemoved},
+			},
+			DataSources: []subscriptions.Change{
+				{Kind: subscriptions.ChangeRemoved, Name: upgradeDS},
+			},
+			Profiles: []subscriptions.Change{
+				{Kind: subscriptions.ChangeModified, Name: upgradeProfile},
+			},
+		},
+		{
+			Name: "DiffUpgrade flags incompatible rule type updates",
+			Setup: upgradeScenario{
+				installedRuleTypes: []*minderv1.RuleType{upgradeRuleType(ruleTypeKept)},
+				bundleRuleTypes:    []*minderv1.RuleType{upgradeRuleType(ruleTypeKept, withUpgradeIncompatibleSchema)},
+			},
+			RuleTypes: []subscriptions.Change{
+				{Kind: subscriptions.ChangeModified, Name: ruleTypeKept},
+			},
+			Errors: []string{"rule schema update is invalid"},
+		},
+		{
+			Name: "DiffUpgrade flags removed rule types still used by profiles",
+			Setup: upgradeScenario{
+				installedRuleTypes: []*minderv1.RuleType{upgradeRuleType(ruleTypeRemoved)},
+				ruleTypeUsers:      map[string][]string{ruleTypeRemoved: {"my-own-profile"}},
+			},
+			RuleTypes: []subscriptions.Change{
+				{Kind: subscriptions.ChangeRemoved, Name: ruleTypeRemoved},
+			},
+			Errors: []string{"rule type is still used by profiles: [my-own-profile]"},
+		},
+		{
+			Name: "DiffUpgrade flags removed data sources still used by rule types",
+			Setup: upgradeScenario{
+				installedDataSources: []*minderv1.DataSource{upgradeDataSource()},
+				dataSourceUsers:      map[string][]uuid.UUID{upgradeDS: {uuid.Nil}},
+			},
+			DataSources: []subscriptions.Change{
+				{Kind: subscriptions.ChangeRemoved, Name: upgradeDS},
+			},
+			Errors: []string{"data source is still used by rule types"},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := scenario.Setup.newStore(ctrl)
+			dataSources := scenario.Setup.newDataSourcesService(ctrl)
+			svc := subscriptions.NewSubscriptionService(nil, nil, dataSources)
+
+			diff, err := svc.DiffUpgrade(context.Background(), projectID, scenario.Setup.newBundle(ctrl), store)
+			require.NoError(t, err)
+			require.Equal(t, fromVersion, diff.FromVersion)
+			require.Equal(t, toVersion, diff.ToVersion)
+			require.Equal(t, scenario.RuleTypes, stripErrors(diff.RuleTypes))
+			require.Equal(t, scenario.DataSources, stripErrors(diff.DataSources))
+			require.Equal(t, scenario.Profiles, stripErrors(diff.Profiles))
+
+			if len(scenario.Errors) == 0 {
+				require.NoError(t, diff.Err())
+				return
+			}
+			require.ErrorIs(t, diff.Err(), subscriptions.ErrInvalidUpgrade)
+			for _, expected := range scenario.Errors {
+				require.ErrorContains(t, diff.Err(), expected)
+			}
+		})
+	}
+}
+
+func TestSubscriptionService_Upgrade(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	setup := upgradeScenario{
+		installedRuleTypes: []*minderv1.RuleType{
+			upgradeRuleType(ruleTypeKept),
+			upgradeRuleType(ruleTypeRemoved),
+		},
+		bundleRuleTypes: []*minderv1.RuleType{
+			upgradeRuleType(ruleTypeKept, withUpgradeDescription("new description")),
+		},
+		installedProfiles: []*minderv1.Profile{installedUpgradeProfile(ruleTypeRemoved)},
+		bundleDataSources: []*minderv1.DataSource{upgradeDataSource()},
+		ruleTypeUsers:     map[string][]string{ruleTypeRemoved: {upgradeProfile}},
+	}
+
+	store := setup.newStore(ctrl)
+	dataSources := setup.newDataSourcesService(ctrl)
+	rules := mockrulesvc.NewMockRuleTypeService(ctrl)
+	profiles := mockprofsvc.NewMockProfileService(ctrl)
+
+	// writes happen in dependency order: data sources, rule types, then the
+	// removals from profiles down to data sources
+	gomock.InOrder(
+		dataSources.EXPECT().
+			Upsert(gomock.Any(), projectID, subscriptionID, gomock.Any(), gomock.Any()).
+			Return(nil),
+		rules.EXPECT().
+			UpsertRuleType(gomock.Any(), projectID, subscriptionID, gomock.Any(), gomock.Any()).
+			Return(nil),
+		store.EXPECT().
+			DeleteProfile(gomock.Any(), gomock.Any()).
+			Return(nil),
+		store.EXPECT().
+			DeleteRuleType(gomock.Any(), ruleTypeIDs[ruleTypeRemoved]).
+			Return(nil),
+		store.EXPECT().
+			UpdateSubscriptionVersion(gomock.Any(), db.UpdateSubscriptionVersionParams{
+				ID:             subscriptionID,
+				CurrentVersion: toVersion,
+			}).
+			Return(nil),
+	)
+
+	svc := subscriptions.NewSubscriptionService(profiles, rules, dataSources)
+	diff, err := svc.Upgrade(context.Background(), projectID, setup.newBundle(ctrl), store)
+	require.NoError(t, err)
+	require.Equal(t, []subscriptions.Change{
+		{Kind: subscriptions.ChangeModified, Name: ruleTypeKept},
+		{Kind: subscriptions.ChangeRemoved, Name: ruleTypeRemoved},
+	}, diff.RuleTypes)
+	require.Equal(t, []subscriptions.Change{
+		{Kind: subscriptions.ChangeRemoved, Name: upgradeProfile},
+	}, diff.Profiles)
+}
+
+func TestSubscriptionService_UpgradeInvalid(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	setup := upgradeScenario{
+		installedRuleTypes: []*minderv1.RuleType{upgradeRuleType(ruleTypeKept)},
+		bundleRuleTypes:    []*minderv1.RuleType{upgradeRuleType(ruleTypeKept, withUpgradeIncompatibleSchema)},
+	}
+
+	// no writes are expected by the mocks
+	svc := subscriptions.NewSubscriptionService(
+		mockprofsvc.NewMockProfileService(ctrl),
+		mockrulesvc.NewMockRuleTypeService(ctrl),
+		setup.newDataSourcesService(ctrl),
+	)
+	diff, err := svc.Upgrade(context.Background(), projectID, setup.newBundle(ctrl), setup.newStore(ctrl))
+	require.ErrorIs(t, err, subscriptions.ErrInvalidUpgrade)
+	require.NotNil(t, diff)
+	require.Len(t, diff.RuleTypes, 1)
+}
+
+func TestSubscriptionService_UpgradeNotSubscribed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	withNotFoundFindSubscription(store)
+	bundle := mockbundle.NewMockBundleReader(ctrl)
+	bundle.EXPECT().GetMetadata().Return(&mindpak.Metadata{Namespace: "stacklok", Name: "healthcheck"})
+
+	svc := subscriptions.NewSubscriptionService(nil, nil, nil)
+	_, err := svc.Upgrade(context.Background(), projectID, bundle, store)
+	require.ErrorContains(t, err, "not subscribed to bundle")
+}
+
+var ruleTypeIDs = map[string]uuid.UUID{
+	ruleTypeKept:    uuid.New(),
+	ruleTypeRemoved: uuid.New(),
+	ruleTypeAdded:   uuid.New(),
+}
+
+var dataSourceID = uuid.New()
+
+func (s *upgradeScenario) newStore(ctrl *gomock.Controller) *mockdb.MockStore {
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetSubscriptionByProjectBundle(gomock.Any(), gomock.Any()).
+		Return(db.Subscription{ID: subscriptionID, CurrentVersion: fromVersion}, nil)
+
+	ruleTypeRows := make([]db.RuleType, 0, len(s.installedRuleTypes))
+	for _, rt := range s.installedRuleTypes {
+		ruleTypeRows = append(ruleTypeRows, dbUpgradeRuleType(rt))
+		store.EXPECT().
+			ListProfilesInstantiatingRuleType(gomock.Any(), ruleTypeIDs[rt.GetName()]).
+			Return(s.ruleTypeUsers[rt.GetName()], nil).
+			AnyTimes()
+	}
+	// a rule type which is not part of the bundle
+	ruleTypeRows = append(ruleTypeRows, db.RuleType{ID: uuid.New(), Name: "my-own-rule-type"})
+	store.EXPECT().
+		ListRuleTypesByProject(gomock.Any(), projectID).
+		Return(ruleTypeRows, nil)
+
+	dataSourceRows := make([]db.DataSource, 0, len(s.installedDataSources))
+	for _, ds := range s.installedDataSources {
+		dataSourceRows = append(dataSourceRows, db.DataSource{
+			ID:             dataSourceID,
+			Name:           ds.GetName(),
+			ProjectID:      projectID,
+			SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: true},
+		})
+		refs := make([]db.RuleTypeDataSource, 0, len(s.dataSourceUsers[ds.GetName()]))
+		for _, id := range s.dataSourceUsers[ds.GetName()] {
+			refs = append(refs, db.RuleTypeDataSource{RuleTypeID: id, DataSourcesID: dataSourceID})
+		}
+		store.EXPECT().
+			ListRuleTypesReferencesByDataSource(gomock.Any(), dataSourceID).
+			Return(refs, nil).
+			AnyTimes()
+	}
+	store.EXPECT().
+		ListDataSources(gomock.Any(), []uuid.UUID{projectID}).
+		Return(dataSourceRows, nil)
+
+	profileRows := make([]db.ListProfilesByProjectIDAndLabelRow, 0, len(s.installedProfiles))
+	for _, profile := range s.installedProfiles {
+		profileRows = append(profileRows, dbUpgradeProfile(profile))
+	}
+	store.EXPECT().
+		ListProfilesByProjectIDAndLabel(gomock.Any(), gomock.Any()).
+		Return(profileRows, nil)
+
+	return store
+}
+
+func (s *upgradeScenario) newDataSourcesService(ctrl *gomock.Controller) *mockdssvc.MockDataSourcesService {
+	dataSources := mockdssvc.NewMockDataSourcesService(ctrl)
+	for _, ds := range s.installedDataSources {
+		dataSources.EXPECT().
+			GetByID(gomock.Any(), dataSourceID, projectID, gomock.Any()).
+			Return(ds, nil)
+	}
+	return dataSources
+}
+
+func (s *upgradeScenario) newBundle(ctrl *gomock.Controller) *mockbundle.MockBundleReader {
+	bundle := mockbundle.NewMockBundleReader(ctrl)
+	bundle.EXPECT().
+		GetMetadata().
+		Return(&mindpak.Metadata{Namespace: "stacklok", Name: "healthcheck", Version: toVersion})
+	bundle.EXPECT().
+		ForEachRuleType(gomock.Any()).
+		DoAndReturn(func(fn func(*minderv1.RuleType) error) error {
+			return forEach(s.bundleRuleTypes, fn)
+		})
+	bundle.EXPECT().
+		ForEachDataSource(gomock.Any()).
+		DoAndReturn(func(fn func(*minderv1.DataSource) error) error {
+			return forEach(s.bundleDataSources, fn)
+		})
+	bundle.EXPECT().
+		ForEachProfile(gomock.Any()).
+		DoAndReturn(func(fn func(*minderv1.Profile) error) error {
+			return forEach(s.bundleProfiles, fn)
+		})
+	return bundle
+}
+
+func forEach[T any](items []T, fn func(T) error) error {
+	for _, item := range items {
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func stripErrors(changes []subscriptions.Change) []subscriptions.Change {
+	var result []subscriptions.Change
+	for _, change := range changes {
+		change.Error = nil
+		result = append(result, change)
+	}
+	return result
+}
+
+func upgradeRuleType(name string, opts ...func(*minderv1.RuleType)) *minderv1.RuleType {
+	rt := &minderv1.RuleType{
+		Name:        name,
+		Description: "description",
+		Def: &minderv1.RuleType_Definition{
+			InEntity:   string(minderv1.RepositoryEntity),
+			RuleSchema: &structpb.Struct{},
+			Ingest:     &minderv1.RuleType_Definition_Ingest{},
+			Eval:       &minderv1.RuleType_Definition_Eval{},
+		},
+		Severity: &minderv1.Severity{Value: minderv1.Severity_VALUE_MEDIUM},
+	}
+	for _, opt := range opts {
+		opt(rt)
+	}
+	return rt
+}
+
+func withUpgradeDescription(description string) func(*minderv1.RuleType) {
+	return func(rt *minderv1.RuleType) {
+		rt.Description = description
+	}
+}
+
+func withUpgradeIncompatibleSchema(rt *minderv1.RuleType) {
+	rt.Def.RuleSchema = &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"required": structpb.NewStringValue("foobar"),
+		},
+	}
+}
+
+func dbUpgradeRuleType(rt *minderv1.RuleType) db.RuleType {
+	def, err := protojson.Marshal(rt.GetDef())
+	if err != nil {
+		panic(err)
+	}
+	return db.RuleType{
+		ID:                  ruleTypeIDs[rt.GetName()],
+		Name:                rt.GetName(),
+		ProjectID:           projectID,
+		Description:         rt.GetDescription(),
+		Definition:          def,
+		SeverityValue:       db.Severity(rt.GetSeverity().InitializedStringValue()),
+		SubscriptionID:      uuid.NullUUID{UUID: subscriptionID, Valid: true},
+		DisplayName:         rt.GetName(),
+		ReleasePhase:        db.ReleaseStatusGa,
+		ShortFailureMessage: "Rule " + rt.GetName() + " evaluation failed",
+	}
+}
+
+func upgradeDataSource() *minderv1.DataSource {
+	return &minderv1.DataSource{
+		Name: upgradeDS,
+		Driver: &minderv1.DataSource_Rest{
+			Rest: &minderv1.RestDataSource{},
+		},
+	}
+}
+
+func bundleUpgradeProfile(ruleTypes ...string) *minderv1.Profile {
+	profile := &minderv1.Profile{Name: upgradeProfile}
+	for _, rt := range ruleTypes {
+		profile.Repository = append(profile.Repository, &minderv1.Profile_Rule{
+			Type: rt,
+			Def:  &structpb.Struct{Fields: map[string]*structpb.Value{"enabled": structpb.NewBoolValue(true)}},
+		})
+	}
+	return profile
+}
+
+// installedUpgradeProfile returns the profile as it is stored, with the rule
+// names populated
+func installedUpgradeProfile(ruleTypes ...string) *minderv1.Profile {
+	profile := bundleUpgradeProfile(ruleTypes...)
+	for _, rule := range profile.Repository {
+		rule.Name = rule.Type
+	}
+	return profile
+}
+
+func dbUpgradeProfile(profile *minderv1.Profile) db.ListProfilesByProjectIDAndLabelRow {
+	rules, err := json.Marshal(profile.GetRepository())
+	if err != nil {
+		panic(err)
+	}
+	id := uuid.New()
+	return db.ListProfilesByProjectIDAndLabelRow{
+		Profile: db.Profile{
+			ID:             id,
+			Name:           profile.GetName(),
+			ProjectID:      projectID,
+			SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: true},
+		},
+		ProfilesWithEntityProfile: db.ProfilesWithEntityProfile{
+			Entity:          db.NullEntities{Entities: db.EntitiesRepository, Valid: true},
+			ProfileID:       uuid.NullUUID{UUID: id, Valid: true},
+			ContextualRules: pqtype.NullRawMessage{RawMessage: rules, Valid: true},
+		},
+	}
+}
@@ -37,6 +37,20 @@ type Metadata struct {
 	// Public is a field that is meant to be read by other systems.
 	// It will be exposed to the public, e.g. via a UI.
 	Public PublicMetadataV1 `json:"public"`
+
+	// HistoryRetention overrides the server's evaluation history
+	// retention policy for the project.
+	HistoryRetention *HistoryRetentionV1 `json:"history_retention,omitempty"`
+}
+
+// HistoryRetentionV1 contains the evaluation history retention policy of a project.
+type HistoryRetentionV1 struct {
+	// KeepDays is the number of days the evaluation history is kept for.
+	// Zero uses the server default.
+	KeepDays int `json:"keep_days,omitempty"`
+	// TransitionsOnly keeps only the evaluations which changed the status
+	// of a rule for an entity.
+	TransitionsOnly bool `json:"transitions_only,omitempty"`
 }
 
 // PublicMetadataV1 contains public metadata relevant for a project.
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"net/http"
+
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// GiteaTokenCredential is a credential that uses a Gitea or Forgejo access token
+type GiteaTokenCredential struct {
+	token string
+}
+
+// Ensure that the GiteaTokenCredential implements the GiteaCredential interface
+var _ provifv1.GiteaCredential = (*GiteaTokenCredential)(nil)
+
+// NewGiteaTokenCredential creates a new GiteaTokenCredential from the token
+func NewGiteaTokenCredential(token string) *GiteaTokenCredential {
+	return &GiteaTokenCredential{
+		token: token,
+	}
+}
+
+// SetAuthorizationHeader sets the authorization header on the request.
+// Gitea expects access tokens to use the "token" scheme.
+func (t *GiteaTokenCredential) SetAuthorizationHeader(req *http.Request) {
+	req.Header.Set("Authorization", "token "+t.token)
+}
+
+// AddToPushOptions adds the credential to the git push options
+func (t *GiteaTokenCredential) AddToPushOptions(options *git.PushOptions, owner string) {
+	options.Auth = &githttp.BasicAuth{
+		Username: owner,
+		Password: t.token,
+	}
+}
+
+// AddToCloneOptions adds the credential to the git clone options
+func (t *GiteaTokenCredential) AddToCloneOptions(options *git.CloneOptions) {
+	options.Auth = &githttp.BasicAuth{
+		// the username can be anything, but it can't be empty
+		Username: "minder-user",
+		Password: t.token,
+	}
+}
+
+// GetCacheKey returns the cache key used to look up the REST client
+func (t *GiteaTokenCredential) GetCacheKey() string {
+	return t.token
+}
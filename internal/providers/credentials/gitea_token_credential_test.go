@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGiteaTokenCredentialSetAuthorizationHeader(t *testing.T) {
+	t.Parallel()
+
+	req := &http.Request{
+		Header: http.Header{},
+	}
+	NewGiteaTokenCredential(token).SetAuthorizationHeader(req)
+	require.Equal(t, "token test_token", req.Header.Get("Authorization"))
+}
+
+func TestGiteaTokenCredentialAddToPushOptions(t *testing.T) {
+	t.Parallel()
+
+	expected := &githttp.BasicAuth{
+		Username: "test_user",
+		Password: token,
+	}
+	pushOptions := &git.PushOptions{}
+	NewGiteaTokenCredential(token).AddToPushOptions(pushOptions, "test_user")
+	require.Equal(t, expected, pushOptions.Auth)
+}
+
+func TestGiteaTokenCredentialAddToClone(t *testing.T) {
+	t.Parallel()
+
+	expected := &githttp.BasicAuth{
+		Username: "minder-user",
+		Password: token,
+	}
+	cloneOptions := &git.CloneOptions{}
+	NewGiteaTokenCredential(token).AddToCloneOptions(cloneOptions)
+	require.Equal(t, expected, cloneOptions.Auth)
+}
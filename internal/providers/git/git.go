@@ -9,14 +9,18 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/go-git/go-billy/v5"
 	"github.com/go-git/go-billy/v5/memfs"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/cache"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/go-git/go-git/v5/storage/transactional"
 
 	"github.com/mindersec/minder/internal/providers/git/memboxfs"
+	"github.com/mindersec/minder/internal/providers/git/mirror"
 	"github.com/mindersec/minder/pkg/config/server"
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
@@ -28,6 +32,7 @@ type Git struct {
 	credential provifv1.GitCredential
 	maxFiles   int64
 	maxBytes   int64
+	mirrors    *mirror.Store
 }
 
 const maxCachedObjectSize = 100 * 1024 // 100KiB
@@ -51,17 +56,24 @@ func WithConfig(cfg server.GitConfig) Options {
 	return func(g *Git) {
 		g.maxFiles = cfg.MaxFiles
 		g.maxBytes = cfg.MaxBytes
+		if cfg.Mirror.Enabled {
+			g.mirrors = mirror.StoreForDir(cfg.Mirror.Dir)
+		}
 	}
 }
 
 // Clone clones a git repository
-func (g *Git) Clone(ctx context.Context, url, branch string) (*git.Repository, error) {
+func (g *Git) Clone(ctx context.Context, url, branch string, cloneOpts ...provifv1.CloneOption) (*git.Repository, error) {
+	co := provifv1.NewCloneOptions(cloneOpts...)
+
 	opts := &git.CloneOptions{
 		URL:           url,
 		SingleBranch:  true,
 		Depth:         1,
 		Tags:          git.NoTags,
 		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		// A sparse checkout is done after cloning
+		NoCheckout: len(co.SparsePaths) > 0,
 	}
 
 	g.credential.AddToCloneOptions(opts)
@@ -71,23 +83,14 @@ func (g *Git) Clone(ctx context.Context, url, branch string) (*git.Repository, e
 	}
 
 	// TODO(#3582): Switch this to use a tmpfs backed clone
-	memFS := memfs.New()
-	if g.maxFiles != 0 && g.maxBytes != 0 {
-		memFS = &memboxfs.LimitedFs{
-			Fs:            memFS,
-			MaxFiles:      g.maxFiles,
-			TotalFileSize: g.maxBytes,
-		}
+	memFS := g.newLimitedFs()
+
+	if g.mirrors != nil {
+		return g.checkoutFromMirror(ctx, opts, memFS, co.SparsePaths)
 	}
+
 	// go-git seems to want separate filesystems for the storer and the checked out files
-	storerFs := memfs.New()
-	if g.maxFiles != 0 && g.maxBytes != 0 {
-		storerFs = &memboxfs.LimitedFs{
-			Fs:            storerFs,
-			MaxFiles:      g.maxFiles,
-			TotalFileSize: g.maxBytes,
-		}
-	}
+	storerFs := g.newLimitedFs()
 	storerCache := cache.NewObjectLRU(maxCachedObjectSize)
 	storer := filesystem.NewStorage(storerFs, storerCache)
 
@@ -97,18 +100,85 @@ func (g *Git) Clone(ctx context.Context, url, branch string) (*git.Repository, e
 	// where we don't have access to the underlying filesystem.
 	r, err := git.CloneContext(ctx, storer, memFS, opts)
 	if err != nil {
-		var refspecerr git.NoMatchingRefSpecError
-		if errors.Is(err, git.ErrBranchNotFound) || refspecerr.Is(err) {
+		return nil, mapCloneError(err)
+	}
+
+	if len(co.SparsePaths) > 0 {
+		if err := sparseCheckout(r, opts.ReferenceName, co.SparsePaths); err != nil {
+			return nil, mapCloneError(err)
+		}
+	}
+
+	return r, nil
+}
+
+// checkoutFromMirror brings the local mirror of the repository up to date
+// and checks out the branch into an in-memory worktree. Objects are read
+// from the mirror, while anything written to the repository (e.g. commits
+// created for remediations) is kept in memory and never reaches the mirror.
+func (g *Git) checkoutFromMirror(
+	ctx context.Context, opts *git.CloneOptions, memFS billy.Filesystem, sparsePaths []string,
+) (*git.Repository, error) {
+	base, _, err := g.mirrors.Fetch(ctx, opts.URL, opts.ReferenceName.Short(), opts.Auth)
+	if err != nil {
+		if errors.Is(err, mirror.ErrBranchNotFound) {
 			return nil, provifv1.ErrProviderGitBranchNotFound
-		} else if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		} else if errors.Is(err, mirror.ErrEmptyRepository) {
 			return nil, provifv1.ErrRepositoryEmpty
-		} else if errors.Is(err, memboxfs.ErrTooManyFiles) {
-			return nil, fmt.Errorf("%w: %w", provifv1.ErrRepositoryTooLarge, err)
-		} else if errors.Is(err, memboxfs.ErrTooBig) {
-			return nil, fmt.Errorf("%w: %w", provifv1.ErrRepositoryTooLarge, err)
 		}
-		return nil, fmt.Errorf("could not clone repo: %w", err)
+		return nil, fmt.Errorf("could not fetch repo: %w", err)
+	}
+
+	storer := transactional.NewStorage(base, memory.NewStorage())
+	r, err := git.Open(storer, memFS)
+	if err != nil {
+		return nil, fmt.Errorf("could not open mirrored repo: %w", err)
+	}
+
+	if err := sparseCheckout(r, opts.ReferenceName, sparsePaths); err != nil {
+		return nil, mapCloneError(err)
 	}
 
 	return r, nil
 }
+
+// sparseCheckout checks out the given branch, restricting the worktree to
+// the given directories if any are specified.
+func sparseCheckout(r *git.Repository, branch plumbing.ReferenceName, paths []string) error {
+	wt, err := r.Worktree()
+	if err != nil {
+		return fmt.Errorf("could not get worktree: %w", err)
+	}
+
+	return wt.Checkout(&git.CheckoutOptions{
+		Branch:                    branch,
+		Force:                     true,
+		SparseCheckoutDirectories: paths,
+	})
+}
+
+func (g *Git) newLimitedFs() billy.Filesystem {
+	memFS := memfs.New()
+	if g.maxFiles != 0 && g.maxBytes != 0 {
+		return &memboxfs.LimitedFs{
+			Fs:            memFS,
+			MaxFiles:      g.maxFiles,
+			TotalFileSize: g.maxBytes,
+		}
+	}
+	return memFS
+}
+
+func mapCloneError(err error) error {
+	var refspecerr git.NoMatchingRefSpecError
+	if errors.Is(err, git.ErrBranchNotFound) || refspecerr.Is(err) {
+		return provifv1.ErrProviderGitBranchNotFound
+	} else if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return provifv1.ErrRepositoryEmpty
+	} else if errors.Is(err, memboxfs.ErrTooManyFiles) {
+		return fmt.Errorf("%w: %w", provifv1.ErrRepositoryTooLarge, err)
+	} else if errors.Is(err, memboxfs.ErrTooBig) {
+		return fmt.Errorf("%w: %w", provifv1.ErrRepositoryTooLarge, err)
+	}
+	return fmt.Errorf("could not clone repo: %w", err)
+}
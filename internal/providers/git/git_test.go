@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	"github.com/mindersec/minder/pkg/config/server"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func newUpstream(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	r, err := git.PlainInitWithOptions(dir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+	wt, err := r.Worktree()
+	require.NoError(t, err)
+
+	for name, contents := range files {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0750))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0600))
+		_, err = wt.Add(name)
+		require.NoError(t, err)
+	}
+
+	_, err = wt.Commit("initial", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return dir
+}
+
+func TestClone(t *testing.T) {
+	t.Parallel()
+
+	upstream := newUpstream(t, map[string]string{
+		"README.md":                  "hello",
+		".github/workflows/test.yml": "on: push",
+		"src/main.go":                "package main",
+	})
+
+	tests := []struct {
+		name        string
+		mirror      bool
+		sparsePaths []string
+		present     []string
+		absent      []string
+	}{
+		{
+			name:    "clone",
+			present: []string{"README.md", ".github/workflows/test.yml", "src/main.go"},
+		},
+		{
+			name:        "sparse clone",
+			sparsePaths: []string{".github"},
+			present:     []string{".github/workflows/test.yml"},
+			absent:      []string{"README.md", "src/main.go"},
+		},
+		{
+			name:    "mirror",
+			mirror:  true,
+			present: []string{"README.md", ".github/workflows/test.yml", "src/main.go"},
+		},
+		{
+			name:        "sparse mirror",
+			mirror:      true,
+			sparsePaths: []string{"src"},
+			present:     []string{"src/main.go"},
+			absent:      []string{"README.md", ".github/workflows/test.yml"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := server.GitConfig{
+				MaxFiles: 100,
+				MaxBytes: 1_000_000,
+				Mirror: server.GitMirrorConfig{
+					Enabled: tt.mirror,
+					Dir:     t.TempDir(),
+				},
+			}
+			g := NewGit(credentials.NewEmptyCredential(), WithConfig(cfg))
+
+			r, err := g.Clone(context.Background(), upstream, "main", provifv1.WithSparsePaths(tt.sparsePaths...))
+			require.NoError(t, err)
+
+			wt, err := r.Worktree()
+			require.NoError(t, err)
+			for _, f := range tt.present {
+				_, err := wt.Filesystem.Stat(f)
+				require.NoError(t, err, "expected %s to be checked out", f)
+			}
+			for _, f := range tt.absent {
+				_, err := wt.Filesystem.Stat(f)
+				require.ErrorIs(t, err, os.ErrNotExist, "expected %s not to be checked out", f)
+			}
+
+			head, err := r.Head()
+			require.NoError(t, err)
+			require.Equal(t, plumbing.NewBranchReferenceName("main"), head.Name())
+		})
+	}
+}
+
+func TestCloneMissingBranch(t *testing.T) {
+	t.Parallel()
+
+	upstream := newUpstream(t, map[string]string{"README.md": "hello"})
+
+	for _, useMirror := range []bool{false, true} {
+		g := NewGit(credentials.NewEmptyCredential(), WithConfig(server.GitConfig{
+			Mirror: server.GitMirrorConfig{Enabled: useMirror, Dir: t.TempDir()},
+		}))
+		_, err := g.Clone(context.Background(), upstream, "nope")
+		require.ErrorIs(t, err, provifv1.ErrProviderGitBranchNotFound)
+	}
+}
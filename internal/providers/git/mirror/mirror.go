@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mirror provides a store of bare git repository mirrors on the
+// local filesystem. Rather than cloning a repository for every evaluation,
+// the mirror is fetched incrementally, so that only the objects which are
+// new since the last fetch are transferred.
+package mirror
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+const remoteName = "origin"
+
+var (
+	// ErrBranchNotFound is returned when the requested branch does not
+	// exist in the remote repository
+	ErrBranchNotFound = errors.New("branch not found")
+	// ErrEmptyRepository is returned when the remote repository has no commits
+	ErrEmptyRepository = errors.New("repository is empty")
+
+	stores sync.Map // map[string]*Store
+)
+
+// Store is a directory of bare repository mirrors, one per remote URL.
+// A Store is safe for concurrent use; fetches of the same mirror are
+// serialized.
+type Store struct {
+	dir   string
+	locks sync.Map // map[string]*sync.Mutex
+}
+
+// NewStore returns a store of mirrors in the given directory. The
+// directory is created on first use.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// StoreForDir returns the process-wide store for the given directory, so
+// that all the users of a directory share the same locks.
+func StoreForDir(dir string) *Store {
+	st, _ := stores.LoadOrStore(filepath.Clean(dir), NewStore(dir))
+	return st.(*Store)
+}
+
+// Fetch brings the mirror of url up to date with the remote branch,
+// creating the mirror if needed. It returns a storer for the mirror's
+// objects and the reference the branch points to.
+//
+// The returned storer is not shared with other callers and must only be
+// read from; writes should go to a separate layer, such as a
+// transactional storage.
+func (s *Store) Fetch(
+	ctx context.Context, url, branch string, auth transport.AuthMethod,
+) (storage.Storer, *plumbing.Reference, error) {
+	path := s.mirrorPath(url)
+
+	lock := s.lockFor(path)
+	lock.Lock()
+	defer lock.Unlock()
+
+	r, err := s.openOrInit(path, url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refName := plumbing.NewBranchReferenceName(branch)
+	err = r.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: remoteName,
+		RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%s", refName, refName))},
+		Depth:      1,
+		Tags:       git.NoTags,
+		Auth:       auth,
+		Force:      true,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		var refspecerr git.NoMatchingRefSpecError
+		if errors.Is(err, git.ErrBranchNotFound) || refspecerr.Is(err) {
+			return nil, nil, ErrBranchNotFound
+		} else if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			return nil, nil, ErrEmptyRepository
+		}
+		return nil, nil, fmt.Errorf("could not fetch into mirror: %w", err)
+	}
+
+	ref, err := r.Reference(refName, true)
+	if err != nil {
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return nil, nil, ErrBranchNotFound
+		}
+		return nil, nil, fmt.Errorf("could not resolve %s: %w", refName, err)
+	}
+
+	// Hand out a fresh storage instance, as the go-git filesystem storage
+	// keeps unsynchronized in-memory indexes. Packfiles are never modified
+	// once written, so reading them while another fetch runs is safe.
+	return filesystem.NewStorage(osfs.New(path), cache.NewObjectLRUDefault()), ref, nil
+}
+
+func (s *Store) openOrInit(path, url string) (*git.Repository, error) {
+	r, err := git.PlainOpen(path)
+	if err == nil {
+		return r, nil
+	}
+	if !errors.Is(err, git.ErrRepositoryNotExists) {
+		return nil, fmt.Errorf("could not open mirror: %w", err)
+	}
+
+	if err := os.MkdirAll(s.dir, 0750); err != nil {
+		return nil, fmt.Errorf("could not create mirror directory: %w", err)
+	}
+
+	r, err = git.PlainInit(path, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not create mirror: %w", err)
+	}
+
+	if _, err := r.CreateRemote(&config.RemoteConfig{
+		Name: remoteName,
+		URLs: []string{url},
+	}); err != nil {
+		// don't leave a half-initialized mirror behind
+		_ = os.RemoveAll(path)
+		return nil, fmt.Errorf("could not configure mirror remote: %w", err)
+	}
+
+	return r, nil
+}
+
+func (s *Store) mirrorPath(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".git")
+}
+
+func (s *Store) lockFor(path string) *sync.Mutex {
+	l, _ := s.locks.LoadOrStore(path, &sync.Mutex{})
+	return l.(*sync.Mutex)
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+func commitFile(t *testing.T, r *git.Repository, dir, name, contents string) plumbing.Hash {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0600))
+	wt, err := r.Worktree()
+	require.NoError(t, err)
+	_, err = wt.Add(name)
+	require.NoError(t, err)
+	hash, err := wt.Commit("update "+name, &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return hash
+}
+
+func TestStoreFetch(t *testing.T) {
+	t.Parallel()
+
+	upstreamDir := t.TempDir()
+	upstream, err := git.PlainInitWithOptions(upstreamDir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+	first := commitFile(t, upstream, upstreamDir, "README.md", "hello")
+
+	store := NewStore(t.TempDir())
+
+	storer, ref, err := store.Fetch(context.Background(), upstreamDir, "main", nil)
+	require.NoError(t, err)
+	require.Equal(t, first, ref.Hash())
+	_, err = storer.EncodedObject(plumbing.CommitObject, first)
+	require.NoError(t, err)
+
+	// A new commit upstream is picked up by the existing mirror
+	second := commitFile(t, upstream, upstreamDir, "docs/guide.md", "guide")
+	storer, ref, err = store.Fetch(context.Background(), upstreamDir, "main", nil)
+	require.NoError(t, err)
+	require.Equal(t, second, ref.Hash())
+	_, err = storer.EncodedObject(plumbing.CommitObject, second)
+	require.NoError(t, err)
+
+	// Fetching again without changes is not an error
+	_, ref, err = store.Fetch(context.Background(), upstreamDir, "main", nil)
+	require.NoError(t, err)
+	require.Equal(t, second, ref.Hash())
+
+	entries, err := os.ReadDir(store.dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "there should be a single mirror for the repository")
+}
+
+func TestStoreFetchMissingBranch(t *testing.T) {
+	t.Parallel()
+
+	upstreamDir := t.TempDir()
+	upstream, err := git.PlainInitWithOptions(upstreamDir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+	commitFile(t, upstream, upstreamDir, "README.md", "hello")
+
+	store := NewStore(t.TempDir())
+	_, _, err = store.Fetch(context.Background(), upstreamDir, "does-not-exist", nil)
+	require.ErrorIs(t, err, ErrBranchNotFound)
+}
+
+func TestStoreForDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.Same(t, StoreForDir(dir), StoreForDir(dir+"/"))
+}
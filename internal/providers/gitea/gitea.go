@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gitea provides the Gitea provider implementation. The provider
+// also works against Forgejo instances, whose API is compatible with Gitea's.
+package gitea
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Class is the string that represents the Gitea provider class
+const Class = "gitea"
+
+// Implements is the list of provider types that the Gitea provider implements
+var Implements = []db.ProviderType{
+	db.ProviderTypeGit,
+	db.ProviderTypeRest,
+	db.ProviderTypeRepoLister,
+}
+
+// AuthorizationFlows is the list of authorization flows that the Gitea provider supports
+var AuthorizationFlows = []db.AuthorizationFlow{
+	db.AuthorizationFlowUserInput,
+}
+
+// Ensure that the Gitea provider implements the right interfaces
+var _ provifv1.Git = (*giteaClient)(nil)
+var _ provifv1.REST = (*giteaClient)(nil)
+var _ provifv1.RepoLister = (*giteaClient)(nil)
+
+type giteaClient struct {
+	cred       provifv1.GiteaCredential
+	cli        *http.Client
+	gtcfg      *minderv1.GiteaProviderConfig
+	webhookURL string
+	gitConfig  config.GitConfig
+
+	// secret for the webhook. This is stored in the
+	// structure to allow efficient fetching.
+	currentWebhookSecret string
+}
+
+// New creates a new Gitea provider
+// Note that the webhook URL should already contain the provider class in the path
+func New(
+	cred provifv1.GiteaCredential,
+	cfg *minderv1.GiteaProviderConfig,
+	webhookURL string,
+	currentWebhookSecret string,
+	gitConfig config.GitConfig,
+) (*giteaClient, error) {
+	if err := validateConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	if webhookURL == "" {
+		return nil, errors.New("webhook URL is required")
+	}
+
+	return &giteaClient{
+		cred:                 cred,
+		cli:                  &http.Client{},
+		gtcfg:                cfg,
+		webhookURL:           webhookURL,
+		gitConfig:            gitConfig,
+		currentWebhookSecret: currentWebhookSecret,
+	}, nil
+}
+
+type gtConfigWrapper struct {
+	Gitea *minderv1.GiteaProviderConfig `json:"gitea" yaml:"gitea" mapstructure:"gitea" validate:"required"`
+}
+
+// ParseV1Config parses the raw configuration into a GiteaProviderConfig
+func ParseV1Config(rawCfg json.RawMessage) (*minderv1.GiteaProviderConfig, error) {
+	var w gtConfigWrapper
+	if err := json.Unmarshal(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	if err := validateConfig(w.Gitea); err != nil {
+		return nil, err
+	}
+
+	return w.Gitea, nil
+}
+
+// MarshalV1Config marshals and validates the given config
+// so it can safely be stored in the database
+func MarshalV1Config(rawCfg json.RawMessage) (json.RawMessage, error) {
+	var w gtConfigWrapper
+	if err := json.Unmarshal(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	if err := validateConfig(w.Gitea); err != nil {
+		return nil, fmt.Errorf("error validating gitea config: %w", err)
+	}
+
+	return json.Marshal(w)
+}
+
+// validateConfig checks that the config points to a Gitea instance. Unlike
+// GitLab, there is no public instance we could default to.
+func validateConfig(cfg *minderv1.GiteaProviderConfig) error {
+	if cfg.GetEndpoint() == "" {
+		return errors.New("gitea endpoint is required")
+	}
+
+	u, err := url.Parse(cfg.GetEndpoint())
+	if err != nil {
+		return fmt.Errorf("invalid gitea endpoint: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid gitea endpoint scheme: %q", u.Scheme)
+	}
+
+	return nil
+}
+
+// CanImplement returns true if the provider can implement the given trait
+func (*giteaClient) CanImplement(trait minderv1.ProviderType) bool {
+	return trait == minderv1.ProviderType_PROVIDER_TYPE_GIT ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REST ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REPO_LISTER
+}
+
+func (c *giteaClient) GetCredential() provifv1.GiteaCredential {
+	return c.cred
+}
+
+// SupportsEntity implements the Provider interface
+func (*giteaClient) SupportsEntity(entType minderv1.Entity) bool {
+	return entType == minderv1.Entity_ENTITY_REPOSITORIES ||
+		entType == minderv1.Entity_ENTITY_PULL_REQUESTS
+}
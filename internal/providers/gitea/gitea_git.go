@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+
+	"github.com/go-git/go-git/v5"
+
+	gitclient "github.com/mindersec/minder/internal/providers/git"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Implements the Git interface
+func (c *giteaClient) Clone(
+	ctx context.Context, cloneUrl string, branch string, opts ...provifv1.CloneOption,
+) (*git.Repository, error) {
+	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return g.Clone(ctx, cloneUrl, branch, opts...)
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestParseV1Config(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		rawCfg   string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "valid config",
+			rawCfg:   `{"gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`,
+			expected: "https://gitea.example.com/api/v1/",
+		},
+		{
+			name:    "missing gitea config",
+			rawCfg:  `{}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing endpoint",
+			rawCfg:  `{"gitea": {}}`,
+			wantErr: true,
+		},
+		{
+			name:    "endpoint is not an HTTP URL",
+			rawCfg:  `{"gitea": {"endpoint": "ssh://gitea.example.com"}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := ParseV1Config(json.RawMessage(tt.rawCfg))
+			if tt.wantErr {
+				require.Error(t, err)
+
+				_, err = MarshalV1Config(json.RawMessage(tt.rawCfg))
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.GetEndpoint())
+
+			marshalled, err := MarshalV1Config(json.RawMessage(tt.rawCfg))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.rawCfg, string(marshalled))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	cfg := &minderv1.GiteaProviderConfig{Endpoint: "https://gitea.example.com/api/v1/"}
+	cred := credentials.NewGiteaTokenCredential("token")
+
+	_, err := New(cred, cfg, "", "secret", config.GitConfig{})
+	require.Error(t, err, "the webhook URL is required")
+
+	_, err = New(cred, &minderv1.GiteaProviderConfig{}, "https://minder.example.com/webhook", "secret", config.GitConfig{})
+	require.Error(t, err, "the endpoint is required")
+
+	cli, err := New(cred, cfg, "https://minder.example.com/webhook", "secret", config.GitConfig{})
+	require.NoError(t, err)
+	assert.True(t, cli.SupportsEntity(minderv1.Entity_ENTITY_PULL_REQUESTS))
+	assert.False(t, cli.SupportsEntity(minderv1.Entity_ENTITY_ARTIFACTS))
+
+	req, err := cli.NewRequest(http.MethodGet, "repos/org/repo?page=2", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitea.example.com/api/v1/repos/org/repo?page=2", req.URL.String())
+	assert.Equal(t, "token token", req.Header.Get("Authorization"))
+}
+
+func newTestGiteaProvider(endpoint string) *giteaClient {
+	return &giteaClient{
+		cred: credentials.NewGiteaTokenCredential("token"),
+		gtcfg: &minderv1.GiteaProviderConfig{
+			Endpoint: endpoint,
+		},
+		cli:                  &http.Client{},
+		webhookURL:           "https://minder.example.com/api/v1/webhook/gitea",
+		currentWebhookSecret: "secret",
+	}
+}
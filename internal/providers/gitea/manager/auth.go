@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/oauth2"
+
+	"github.com/mindersec/minder/internal/db"
+	m "github.com/mindersec/minder/internal/providers/manager"
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// NewOAuthConfig implements the providerClassOAuthManager interface.
+// Gitea providers are enrolled with an access token, so there is no
+// OAuth configuration.
+func (*providerClassManager) NewOAuthConfig(_ db.ProviderClass, _ bool) (*oauth2.Config, error) {
+	return nil, errors.New("gitea provider does not support the OAuth flow")
+}
+
+// ValidateCredentials implements the providerClassOAuthManager interface
+func (*providerClassManager) ValidateCredentials(
+	_ context.Context, cred provv1.Credential, _ *m.CredentialVerifyParams,
+) error {
+	switch c := cred.(type) {
+	case string:
+		// Tokens enrolled via StoreProviderToken are passed as-is
+		if c == "" {
+			return errors.New("empty access token")
+		}
+		return nil
+	case provv1.GiteaCredential:
+		return nil
+	default:
+		return fmt.Errorf("invalid credential type: %T", cred)
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cred    provv1.Credential
+		wantErr bool
+	}{
+		{
+			name: "token string",
+			cred: "a-token",
+		},
+		{
+			name:    "empty token string",
+			cred:    "",
+			wantErr: true,
+		},
+		{
+			name: "gitea credential",
+			cred: credentials.NewGiteaTokenCredential("a-token"),
+		},
+		{
+			name:    "other credential",
+			cred:    credentials.NewOAuth2TokenCredential("a-token"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := (&providerClassManager{}).ValidateCredentials(context.Background(), tt.cred, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manager contains the GiteaProviderClassManager
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/credentials"
+	"github.com/mindersec/minder/internal/providers/gitea"
+	"github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+type providerClassManager struct {
+	store    db.Store
+	crypteng crypto.Engine
+	// git configuration used when cloning repositories
+	gitConfig     server.GitConfig
+	webhookURL    string
+	parentContext context.Context
+	pub           interfaces.Publisher
+
+	// secrets for the webhook. These are stored in the
+	// structure to allow efficient fetching. Rotation
+	// requires a process restart.
+	currentWebhookSecret   string
+	previousWebhookSecrets []string
+}
+
+// NewGiteaProviderClassManager creates a new provider class manager for the gitea provider
+func NewGiteaProviderClassManager(
+	ctx context.Context, crypteng crypto.Engine, store db.Store, pub interfaces.Publisher,
+	cfg *server.GiteaConfig, gitCfg server.GitConfig, wgCfg server.WebhookConfig,
+) (*providerClassManager, error) {
+	webhookURLBase := wgCfg.ExternalWebhookURL
+	if webhookURLBase == "" {
+		return nil, errors.New("webhook URL is required")
+	}
+
+	if cfg == nil {
+		return nil, errors.New("gitea config is required")
+	}
+
+	webhookURL, err := url.JoinPath(webhookURLBase, url.PathEscape(string(db.ProviderClassGitea)))
+	if err != nil {
+		return nil, fmt.Errorf("error joining webhook URL: %w", err)
+	}
+
+	whSecret, err := cfg.GetWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook secret: %w", err)
+	}
+
+	previousSecrets, err := cfg.GetPreviousWebhookSecrets()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("previous secrets not loaded")
+	}
+
+	return &providerClassManager{
+		store:                  store,
+		crypteng:               crypteng,
+		pub:                    pub,
+		gitConfig:              gitCfg,
+		webhookURL:             webhookURL,
+		parentContext:          ctx,
+		currentWebhookSecret:   whSecret,
+		previousWebhookSecrets: previousSecrets,
+	}, nil
+}
+
+// GetSupportedClasses implements the ProviderClassManager interface
+func (*providerClassManager) GetSupportedClasses() []db.ProviderClass {
+	return []db.ProviderClass{db.ProviderClassGitea}
+}
+
+// Build implements the ProviderClassManager interface
+func (g *providerClassManager) Build(ctx context.Context, config *db.Provider) (v1.Provider, error) {
+	class := config.Class
+	// This should be validated by the caller, but let's check anyway
+	if !slices.Contains(g.GetSupportedClasses(), class) {
+		return nil, fmt.Errorf("provider does not implement gitea")
+	}
+
+	if config.Version != v1.V1 {
+		return nil, fmt.Errorf("provider version not supported")
+	}
+
+	creds, err := g.getProviderCredentials(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch credentials: %w", err)
+	}
+
+	cfg, err := gitea.ParseV1Config(config.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing gitea config: %w", err)
+	}
+
+	cli, err := gitea.New(creds, cfg, g.webhookURL, g.currentWebhookSecret, g.gitConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating gitea client: %w", err)
+	}
+	return cli, nil
+}
+
+// Delete implements the ProviderClassManager interface
+// TODO: Implement this
+func (*providerClassManager) Delete(_ context.Context, _ *db.Provider) error {
+	return nil
+}
+
+func (m *providerClassManager) getProviderCredentials(
+	ctx context.Context,
+	prov *db.Provider,
+) (v1.GiteaCredential, error) {
+	encToken, err := m.store.GetAccessTokenByProjectID(ctx,
+		db.GetAccessTokenByProjectIDParams{Provider: prov.Name, ProjectID: prov.ProjectID})
+	if err != nil {
+		return nil, fmt.Errorf("error getting credential: %w", err)
+	}
+
+	if !encToken.EncryptedAccessToken.Valid {
+		return nil, fmt.Errorf("no secret found for provider %s", encToken.Provider)
+	}
+
+	encryptedData, err := crypto.DeserializeEncryptedData(encToken.EncryptedAccessToken.RawMessage)
+	if err != nil {
+		return nil, err
+	}
+	decryptedToken, err := m.crypteng.DecryptOAuthToken(encryptedData)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting access token: %w", err)
+	}
+
+	return credentials.NewGiteaTokenCredential(decryptedToken.AccessToken), nil
+}
+
+func (m *providerClassManager) MarshallConfig(
+	_ context.Context, class db.ProviderClass, config json.RawMessage,
+) (json.RawMessage, error) {
+	if !slices.Contains(m.GetSupportedClasses(), class) {
+		return nil, fmt.Errorf("provider does not implement %s", string(class))
+	}
+
+	return gitea.MarshalV1Config(config)
+}
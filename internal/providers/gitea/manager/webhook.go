@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+)
+
+const (
+	// MaxBytesLimit is the maximum number of bytes to read from the request body
+	// We limit to 1MB to prevent abuse
+	MaxBytesLimit int64 = 1 << 20
+)
+
+// Forgejo sends both its own headers and the Gitea ones, but we accept
+// either in case the Gitea ones are dropped in the future.
+var (
+	eventHeaders     = []string{"X-Gitea-Event", "X-Forgejo-Event"}
+	signatureHeaders = []string{"X-Gitea-Signature", "X-Forgejo-Signature"}
+)
+
+// GetWebhookHandler implements the ProviderManager interface
+// Note that this is where the whole webhook handler is defined and
+// will live.
+func (m *providerClassManager) GetWebhookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := zerolog.Ctx(m.parentContext).With().
+			Str("webhook", "gitea").
+			Str("method", r.Method).
+			Str("path", r.URL.Path).
+			Str("remote", r.RemoteAddr).
+			Str("user-agent", r.UserAgent()).
+			Str("content-type", r.Header.Get("Content-Type")).
+			Logger()
+
+		// The signature covers the body, so we need to read it
+		// before validating the request.
+		body, err := io.ReadAll(wrapSafe(r.Body))
+		if err != nil {
+			l.Error().Err(err).Msg("error reading webhook body")
+			http.Error(w, "error reading webhook body", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err := m.validateRequest(r, body); err != nil {
+			l.Error().Err(err).Msg("invalid webhook request")
+			http.Error(w, "invalid webhook request", http.StatusUnauthorized)
+			return
+		}
+
+		eventType := firstHeader(r, eventHeaders)
+		if eventType == "" {
+			l.Error().Msg("missing X-Gitea-Event header")
+			http.Error(w, "missing X-Gitea-Event header", http.StatusBadRequest)
+			return
+		}
+
+		l = l.With().Str("event", eventType).Logger()
+
+		disp := m.getWebhookEventDispatcher(eventType)
+
+		if err := disp(l, r); err != nil {
+			l.Error().Err(err).Msg("error handling webhook event")
+			http.Error(w, "error handling webhook event", http.StatusInternalServerError)
+			return
+		}
+
+		l.Debug().Msg("processed webhook event successfully")
+	})
+}
+
+// getWebhookEventDispatcher returns the appropriate webhook event dispatcher for the given event type
+// It returns a function that is meant to do the actual handling of the event.
+func (m *providerClassManager) getWebhookEventDispatcher(
+	eventType string,
+) func(l zerolog.Logger, r *http.Request) error {
+	switch eventType {
+	case "push", "create":
+		return m.handleRepoPush
+	case "pull_request":
+		return m.handlePullRequest
+	default:
+		return m.handleNoop
+	}
+}
+
+// handleNoop is a no-op handler for unhandled webhook events
+func (*providerClassManager) handleNoop(l zerolog.Logger, _ *http.Request) error {
+	l.Debug().Msg("unhandled webhook event")
+	return nil
+}
+
+func (m *providerClassManager) validateRequest(r *http.Request, body []byte) error {
+	sig := firstHeader(r, signatureHeaders)
+	if sig == "" {
+		return errors.New("missing X-Gitea-Signature header")
+	}
+
+	if err := m.validateSignature(sig, body, r); err != nil {
+		return fmt.Errorf("invalid X-Gitea-Signature header: %w", err)
+	}
+
+	return nil
+}
+
+// validateSignature validates the HMAC-SHA256 signature of the body.
+// The key is the secret the webhook was registered with, which is derived
+// from the configured secret and the last element of the path (which is
+// unique per entity).
+func (m *providerClassManager) validateSignature(sig string, body []byte, req *http.Request) error {
+	// Extract the unique ID from the URL path
+	path := req.URL.Path
+	uniq := path[strings.LastIndex(path, "/")+1:]
+
+	// uniq must be a valid UUID
+	if _, err := uuid.Parse(uniq); err != nil {
+		return errors.New("invalid unique ID")
+	}
+
+	expected, err := hex.DecodeString(sig)
+	if err != nil {
+		return errors.New("malformed signature")
+	}
+
+	for _, base := range append([]string{m.currentWebhookSecret}, m.previousWebhookSecrets...) {
+		secret, err := webhooksecret.New(base, uniq)
+		if err != nil {
+			continue
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		if hmac.Equal(mac.Sum(nil), expected) {
+			return nil
+		}
+	}
+
+	return errors.New("invalid webhook signature")
+}
+
+func firstHeader(r *http.Request, names []string) string {
+	for _, name := range names {
+		if v := r.Header.Get(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func decodeJSONSafe[T any](r io.ReadCloser, v *T) error {
+	rs := wrapSafe(r)
+	defer r.Close()
+
+	dec := json.NewDecoder(rs)
+	return dec.Decode(v)
+}
+
+// wrapSafe wraps the io.Reader in a LimitReader to prevent abuse
+func wrapSafe(r io.Reader) io.Reader {
+	return io.LimitReader(r, MaxBytesLimit)
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/gitea"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func (m *providerClassManager) handlePullRequest(l zerolog.Logger, r *http.Request) error {
+	l.Debug().Msg("handling pull request event")
+
+	prEvent := gitea.PullRequestPayload{}
+	if err := decodeJSONSafe(r.Body, &prEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding pull request event")
+		return fmt.Errorf("error decoding pull request event: %w", err)
+	}
+
+	pr := prEvent.PullRequest
+	if pr == nil || pr.ID == 0 {
+		return fmt.Errorf("pull request event missing ID")
+	}
+
+	if pr.Number == 0 {
+		return fmt.Errorf("pull request event missing number")
+	}
+
+	repo := prEvent.Repository
+	if repo == nil || repo.ID == 0 || repo.Owner == nil {
+		return fmt.Errorf("pull request event missing repository")
+	}
+
+	switch prEvent.Action {
+	case "opened", "reopened":
+		return m.publishPullRequestMessage(pr, repo, constants.TopicQueueOriginatingEntityAdd)
+	case "closed":
+		return m.publishPullRequestMessage(pr, repo, constants.TopicQueueOriginatingEntityDelete)
+	case "synchronized", "edited":
+		return m.publishPullRequestMessage(pr, repo, constants.TopicQueueRefreshEntityAndEvaluate)
+	default:
+		return nil
+	}
+}
+
+func (m *providerClassManager) publishPullRequestMessage(
+	pr *gitea.PullRequest, repo *gitea.Repository, queueTopic string) error {
+	// Form identifying properties
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: gitea.FormatPullRequestUpstreamID(pr.ID),
+		gitea.PullRequestNumber:       strconv.FormatInt(pr.Number, 10),
+		gitea.RepoPropertyOwner:       repo.Owner.Login,
+		gitea.RepoPropertyName:        repo.Name,
+	})
+
+	repoIdentifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: gitea.FormatRepositoryUpstreamID(repo.ID),
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_PULL_REQUESTS, identifyingProps)
+	outm.WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, repoIdentifyingProps)
+	outm.WithProviderClassHint(gitea.Class)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	if err := m.pub.Publish(queueTopic, msg); err != nil {
+		return fmt.Errorf("error publishing pull request message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/gitea"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// handleRepoPush handles both the push and the create (branch or tag)
+// events, which carry the same repository information
+func (m *providerClassManager) handleRepoPush(l zerolog.Logger, r *http.Request) error {
+	l.Debug().Msg("handling push event")
+
+	pushEvent := gitea.PushPayload{}
+	if err := decodeJSONSafe(r.Body, &pushEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding push event")
+		return fmt.Errorf("error decoding push event: %w", err)
+	}
+
+	if pushEvent.Repository == nil || pushEvent.Repository.ID == 0 {
+		l.Error().Msg("push event missing repository ID")
+		return fmt.Errorf("push event missing repository ID")
+	}
+
+	return m.publishRefreshAndEvalForGiteaRepo(l, pushEvent.Repository.ID)
+}
+
+func (m *providerClassManager) publishRefreshAndEvalForGiteaRepo(
+	l zerolog.Logger, rawRepoID int64) error {
+	upstreamID := gitea.FormatRepositoryUpstreamID(rawRepoID)
+
+	// Form identifying properties
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: upstreamID,
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, identifyingProps)
+	outm.WithProviderClassHint(gitea.Class)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		l.Error().Err(err).Msg("error converting message to protobuf")
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	l.Debug().Str("msg_id", msgID).Msg("publishing refresh and eval message")
+	if err := m.pub.Publish(constants.TopicQueueRefreshEntityAndEvaluate, msg); err != nil {
+		l.Error().Err(err).Msg("error publishing refresh and eval message")
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/providers/gitea"
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+const (
+	currentSecret  = "current-secret"
+	previousSecret = "previous-secret"
+
+	pushPayload  = `{"ref": "refs/heads/main", "repository": {"id": 42, "name": "repo", "owner": {"login": "org"}}}`
+	prPayloadFmt = `{"action": "%s", "number": 3, "pull_request": {"id": 1001, "number": 3},` +
+		` "repository": {"id": 42, "name": "repo", "owner": {"login": "org"}}}`
+)
+
+func sign(t *testing.T, base, hookID string, body []byte) string {
+	t.Helper()
+
+	secret, err := webhooksecret.New(base, hookID)
+	require.NoError(t, err)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestGetWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	hookID := uuid.New().String()
+
+	tests := []struct {
+		name           string
+		eventHeader    string
+		event          string
+		payload        string
+		signWith       string
+		sigHeader      string
+		path           string
+		expectedStatus int
+		expectedTopic  string
+		expectedEntity minderv1.Entity
+		expectedProps  map[string]any
+	}{
+		{
+			name:           "push is signed with the current secret",
+			eventHeader:    "X-Gitea-Event",
+			event:          "push",
+			payload:        pushPayload,
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusOK,
+			expectedTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			expectedEntity: minderv1.Entity_ENTITY_REPOSITORIES,
+			expectedProps:  map[string]any{properties.PropertyUpstreamID: "42"},
+		},
+		{
+			name:           "forgejo headers and a previous secret",
+			eventHeader:    "X-Forgejo-Event",
+			event:          "create",
+			payload:        pushPayload,
+			signWith:       previousSecret,
+			sigHeader:      "X-Forgejo-Signature",
+			expectedStatus: http.StatusOK,
+			expectedTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			expectedEntity: minderv1.Entity_ENTITY_REPOSITORIES,
+			expectedProps:  map[string]any{properties.PropertyUpstreamID: "42"},
+		},
+		{
+			name:           "opened pull request",
+			eventHeader:    "X-Gitea-Event",
+			event:          "pull_request",
+			payload:        fmtPR("opened"),
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusOK,
+			expectedTopic:  constants.TopicQueueOriginatingEntityAdd,
+			expectedEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			expectedProps: map[string]any{
+				properties.PropertyUpstreamID: "1001",
+				gitea.PullRequestNumber:       "3",
+				gitea.RepoPropertyOwner:       "org",
+				gitea.RepoPropertyName:        "repo",
+			},
+		},
+		{
+			name:           "closed pull request",
+			eventHeader:    "X-Gitea-Event",
+			event:          "pull_request",
+			payload:        fmtPR("closed"),
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusOK,
+			expectedTopic:  constants.TopicQueueOriginatingEntityDelete,
+			expectedEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			expectedProps: map[string]any{
+				properties.PropertyUpstreamID: "1001",
+				gitea.PullRequestNumber:       "3",
+				gitea.RepoPropertyOwner:       "org",
+				gitea.RepoPropertyName:        "repo",
+			},
+		},
+		{
+			name:           "synchronized pull request",
+			eventHeader:    "X-Gitea-Event",
+			event:          "pull_request",
+			payload:        fmtPR("synchronized"),
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusOK,
+			expectedTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			expectedEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			expectedProps: map[string]any{
+				properties.PropertyUpstreamID: "1001",
+				gitea.PullRequestNumber:       "3",
+				gitea.RepoPropertyOwner:       "org",
+				gitea.RepoPropertyName:        "repo",
+			},
+		},
+		{
+			name:           "unhandled pull request action",
+			eventHeader:    "X-Gitea-Event",
+			event:          "pull_request",
+			payload:        fmtPR("label_updated"),
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unhandled event",
+			eventHeader:    "X-Gitea-Event",
+			event:          "issues",
+			payload:        `{}`,
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "wrong secret",
+			eventHeader:    "X-Gitea-Event",
+			event:          "push",
+			payload:        pushPayload,
+			signWith:       "not-the-secret",
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing signature",
+			eventHeader:    "X-Gitea-Event",
+			event:          "push",
+			payload:        pushPayload,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "path is not a hook ID",
+			eventHeader:    "X-Gitea-Event",
+			event:          "push",
+			payload:        pushPayload,
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			path:           "/api/v1/webhook/gitea/not-a-uuid",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing event",
+			payload:        pushPayload,
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "push without repository",
+			eventHeader:    "X-Gitea-Event",
+			event:          "push",
+			payload:        `{"ref": "refs/heads/main"}`,
+			signWith:       currentSecret,
+			sigHeader:      "X-Gitea-Signature",
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pub := &stubs.StubEventer{}
+			m := &providerClassManager{
+				parentContext:          context.Background(),
+				pub:                    pub,
+				currentWebhookSecret:   currentSecret,
+				previousWebhookSecrets: []string{previousSecret},
+			}
+
+			path := tt.path
+			if path == "" {
+				path = "/api/v1/webhook/gitea/" + hookID
+			}
+
+			body := []byte(tt.payload)
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+			if tt.eventHeader != "" {
+				req.Header.Set(tt.eventHeader, tt.event)
+			}
+			if tt.sigHeader != "" {
+				req.Header.Set(tt.sigHeader, sign(t, tt.signWith, hookID, body))
+			}
+
+			rec := httptest.NewRecorder()
+			m.GetWebhookHandler().ServeHTTP(rec, req)
+			require.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedTopic == "" {
+				assert.Empty(t, pub.Sent)
+				return
+			}
+
+			require.Equal(t, []string{tt.expectedTopic}, pub.Topics)
+			require.Len(t, pub.Sent, 1)
+
+			msg, err := entmsg.ToEntityRefreshAndDo(pub.Sent[0])
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEntity, msg.Entity.Type)
+			assert.Equal(t, tt.expectedProps, msg.Entity.GetByProps)
+			assert.Equal(t, gitea.Class, msg.Hint.ProviderClassHint)
+			if tt.expectedEntity == minderv1.Entity_ENTITY_PULL_REQUESTS {
+				assert.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, msg.Originator.Type)
+				assert.Equal(t, map[string]any{properties.PropertyUpstreamID: "42"}, msg.Originator.GetByProps)
+			}
+		})
+	}
+}
+
+func fmtPR(action string) string {
+	return fmt.Sprintf(prPayloadFmt, action)
+}
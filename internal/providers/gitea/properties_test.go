@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func testRepository() *Repository {
+	return &Repository{
+		ID:            42,
+		Owner:         &User{ID: 1, Login: "org"},
+		Name:          "repo",
+		FullName:      "org/repo",
+		Private:       true,
+		DefaultBranch: "main",
+		CloneURL:      "https://gitea.example.com/org/repo.git",
+		Licenses:      []string{"Apache-2.0"},
+	}
+}
+
+func testPullRequest() *PullRequest {
+	fork := testRepository()
+	fork.ID = 43
+	fork.Owner = &User{ID: 2, Login: "contributor"}
+	fork.CloneURL = "https://gitea.example.com/contributor/repo.git"
+
+	return &PullRequest{
+		ID:      1001,
+		Number:  3,
+		User:    &User{ID: 2, Login: "contributor"},
+		HTMLURL: "https://gitea.example.com/org/repo/pulls/3",
+		Head:    &PRBranch{Ref: "feature", Sha: "abc123", Repo: fork},
+		Base:    &PRBranch{Ref: "main", Sha: "def456", Repo: testRepository()},
+	}
+}
+
+func TestGiteaClient_FetchAllProperties(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/repositories/42":
+			_ = json.NewEncoder(w).Encode(testRepository())
+		case "/repos/org/repo/pulls/3":
+			_ = json.NewEncoder(w).Encode(testPullRequest())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	// the subtests run in parallel, after this function returns
+	t.Cleanup(ts.Close)
+
+	tests := []struct {
+		name       string
+		entityType minderv1.Entity
+		getByProps map[string]any
+		expected   map[string]any
+		wantErr    bool
+		errIs      error
+	}{
+		{
+			name:       "repository",
+			entityType: minderv1.Entity_ENTITY_REPOSITORIES,
+			getByProps: map[string]any{properties.PropertyUpstreamID: "42"},
+			expected: map[string]any{
+				properties.PropertyUpstreamID:     "42",
+				properties.PropertyName:           "org/repo",
+				properties.RepoPropertyIsPrivate:  true,
+				properties.RepoPropertyIsArchived: false,
+				properties.RepoPropertyIsFork:     false,
+				RepoPropertyOwner:                 "org",
+				RepoPropertyName:                  "repo",
+				RepoPropertyDefaultBranch:         "main",
+				RepoPropertyLicense:               "Apache-2.0",
+				RepoPropertyCloneURL:              "https://gitea.example.com/org/repo.git",
+			},
+		},
+		{
+			name:       "missing repository",
+			entityType: minderv1.Entity_ENTITY_REPOSITORIES,
+			getByProps: map[string]any{properties.PropertyUpstreamID: "7"},
+			wantErr:    true,
+			errIs:      provifv1.ErrEntityNotFound,
+		},
+		{
+			name:       "pull request",
+			entityType: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: "1001",
+				PullRequestNumber:             "3",
+				RepoPropertyOwner:             "org",
+				RepoPropertyName:              "repo",
+			},
+			expected: map[string]any{
+				properties.PropertyUpstreamID:           "1001",
+				properties.PropertyName:                 "org/repo/3",
+				properties.PullRequestCommitSHA:         "abc123",
+				properties.PullRequestBaseCloneURL:      "https://gitea.example.com/org/repo.git",
+				properties.PullRequestBaseDefaultBranch: "main",
+				properties.PullRequestTargetCloneURL:    "https://gitea.example.com/contributor/repo.git",
+				properties.PullRequestTargetBranch:      "feature",
+				properties.PullRequestUpstreamURL:       "https://gitea.example.com/org/repo/pulls/3",
+				RepoPropertyOwner:                       "org",
+				RepoPropertyName:                        "repo",
+				PullRequestNumber:                       "3",
+				PullRequestAuthor:                       int64(2),
+			},
+		},
+		{
+			name:       "pull request ID mismatch",
+			entityType: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: "999",
+				PullRequestNumber:             "3",
+				RepoPropertyOwner:             "org",
+				RepoPropertyName:              "repo",
+			},
+			wantErr: true,
+		},
+		{
+			name:       "unsupported entity",
+			entityType: minderv1.Entity_ENTITY_ARTIFACTS,
+			getByProps: map[string]any{properties.PropertyUpstreamID: "42"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			props, err := newTestGiteaProvider(ts.URL).FetchAllProperties(
+				context.Background(), properties.NewProperties(tt.getByProps), tt.entityType, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errIs != nil {
+					require.ErrorIs(t, err, tt.errIs)
+				}
+				return
+			}
+			require.NoError(t, err)
+			expected := properties.NewProperties(tt.expected)
+			assert.Equal(t, expected.Len(), props.Len())
+			for key, prop := range expected.Iterate() {
+				assert.True(t, prop.Equal(props.GetProperty(key)), "property %s: %v", key, props.GetProperty(key))
+			}
+		})
+	}
+}
+
+func TestGiteaClient_PropertiesToProtoMessage(t *testing.T) {
+	t.Parallel()
+
+	c := newTestGiteaProvider("https://gitea.example.com/api/v1/")
+
+	repoProps, err := giteaRepositoryToProperties(testRepository())
+	require.NoError(t, err)
+
+	msg, err := c.PropertiesToProtoMessage(minderv1.Entity_ENTITY_REPOSITORIES, repoProps)
+	require.NoError(t, err)
+	repo, ok := msg.(*minderv1.Repository)
+	require.True(t, ok)
+	assert.Equal(t, "org", repo.GetOwner())
+	assert.Equal(t, "repo", repo.GetName())
+	assert.Equal(t, int64(42), repo.GetRepoId())
+	assert.Equal(t, "https://gitea.example.com/org/repo.git", repo.GetCloneUrl())
+	assert.True(t, repo.GetIsPrivate())
+
+	name, err := c.GetEntityName(minderv1.Entity_ENTITY_REPOSITORIES, repoProps)
+	require.NoError(t, err)
+	assert.Equal(t, "org/repo", name)
+
+	prProps, err := giteaPullRequestToProperties(testPullRequest())
+	require.NoError(t, err)
+
+	msg, err = c.PropertiesToProtoMessage(minderv1.Entity_ENTITY_PULL_REQUESTS, prProps)
+	require.NoError(t, err)
+	pr, ok := msg.(*pbinternal.PullRequest)
+	require.True(t, ok)
+	assert.Equal(t, int64(3), pr.GetNumber())
+	assert.Equal(t, "org", pr.GetRepoOwner())
+	assert.Equal(t, "repo", pr.GetRepoName())
+	assert.Equal(t, "abc123", pr.GetCommitSha())
+	assert.Equal(t, int64(2), pr.GetAuthorId())
+	assert.Equal(t, "main", pr.GetBaseRef())
+	assert.Equal(t, "feature", pr.GetTargetRef())
+
+	name, err = c.GetEntityName(minderv1.Entity_ENTITY_PULL_REQUESTS, prProps)
+	require.NoError(t, err)
+	assert.Equal(t, "org/repo/3", name)
+
+	_, err = c.PropertiesToProtoMessage(minderv1.Entity_ENTITY_REPOSITORIES, properties.NewProperties(nil))
+	require.Error(t, err)
+}
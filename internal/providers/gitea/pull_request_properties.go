@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// FormatPullRequestUpstreamID returns the upstream ID for a gitea pull request
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatPullRequestUpstreamID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func (c *giteaClient) getPropertiesForPullRequest(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	uid, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	number, err := getStringProp(getByProps, PullRequestNumber)
+	if err != nil {
+		return nil, fmt.Errorf("pull request number not found or invalid: %w", err)
+	}
+
+	owner, err := getStringProp(getByProps, RepoPropertyOwner)
+	if err != nil {
+		return nil, fmt.Errorf("repository owner not found or invalid: %w", err)
+	}
+
+	name, err := getStringProp(getByProps, RepoPropertyName)
+	if err != nil {
+		return nil, fmt.Errorf("repository name not found or invalid: %w", err)
+	}
+
+	prURLPath, err := url.JoinPath("repos", owner, name, "pulls", number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for pull request: %w", err)
+	}
+
+	pr := &PullRequest{}
+	if err := gtRESTGet(ctx, c, prURLPath, pr); err != nil {
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	// Validate - pull request upstream ID must match the one we requested
+	if res := FormatPullRequestUpstreamID(pr.ID); res != uid {
+		return nil, fmt.Errorf("pull request ID mismatch: %s != %s", res, uid)
+	}
+
+	outProps, err := giteaPullRequestToProperties(pr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert pull request to properties: %w", err)
+	}
+
+	return outProps, nil
+}
+
+func giteaPullRequestToProperties(pr *PullRequest) (*properties.Properties, error) {
+	if pr.Base == nil || pr.Base.Repo == nil || pr.Base.Repo.Owner == nil {
+		return nil, fmt.Errorf("pull request %d has no base repository", pr.ID)
+	}
+	if pr.Head == nil {
+		return nil, fmt.Errorf("pull request %d has no head", pr.ID)
+	}
+
+	owner := pr.Base.Repo.Owner.Login
+	name := pr.Base.Repo.Name
+	number := strconv.FormatInt(pr.Number, 10)
+
+	// The head repository is missing if the fork was deleted
+	headCloneURL := pr.Base.Repo.CloneURL
+	if pr.Head.Repo != nil {
+		headCloneURL = pr.Head.Repo.CloneURL
+	}
+
+	var authorID int64
+	if pr.User != nil {
+		authorID = pr.User.ID
+	}
+
+	outProps := properties.NewProperties(map[string]any{
+		// Unique upstream ID for the pull request
+		properties.PropertyUpstreamID:           FormatPullRequestUpstreamID(pr.ID),
+		properties.PropertyName:                 formatPullRequestName(owner, name, number),
+		properties.PullRequestCommitSHA:         pr.Head.Sha,
+		properties.PullRequestBaseCloneURL:      pr.Base.Repo.CloneURL,
+		properties.PullRequestBaseDefaultBranch: pr.Base.Ref,
+		properties.PullRequestTargetCloneURL:    headCloneURL,
+		properties.PullRequestTargetBranch:      pr.Head.Ref,
+		properties.PullRequestUpstreamURL:       pr.HTMLURL,
+		RepoPropertyOwner:                       owner,
+		RepoPropertyName:                        name,
+		// number of the pull request within the repository
+		PullRequestNumber: number,
+		PullRequestAuthor: authorID,
+	})
+
+	return outProps, nil
+}
+
+func pullRequestV1FromProperties(prProps *properties.Properties) (*pbinternal.PullRequest, error) {
+	_, err := prProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream ID: %w", err)
+	}
+
+	number, err := getStringProp(prProps, PullRequestNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request number: %w", err)
+	}
+
+	owner, err := getStringProp(prProps, RepoPropertyOwner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+
+	name, err := getStringProp(prProps, RepoPropertyName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository name: %w", err)
+	}
+
+	commitSha, err := getStringProp(prProps, properties.PullRequestCommitSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	prURL, err := getStringProp(prProps, properties.PullRequestUpstreamURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request URL: %w", err)
+	}
+
+	authorID, err := prProps.GetProperty(PullRequestAuthor).AsInt64()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author ID: %w", err)
+	}
+
+	id, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pull request number: %w", err)
+	}
+
+	pbPR := &pbinternal.PullRequest{
+		Number:         id,
+		RepoOwner:      owner,
+		RepoName:       name,
+		CommitSha:      commitSha,
+		AuthorId:       authorID,
+		Url:            prURL,
+		BaseCloneUrl:   prProps.GetProperty(properties.PullRequestBaseCloneURL).GetString(),
+		TargetCloneUrl: prProps.GetProperty(properties.PullRequestTargetCloneURL).GetString(),
+		BaseRef:        prProps.GetProperty(properties.PullRequestBaseDefaultBranch).GetString(),
+		TargetRef:      prProps.GetProperty(properties.PullRequestTargetBranch).GetString(),
+		Properties:     prProps.ToProtoStruct(),
+	}
+
+	return pbPR, nil
+}
+
+func getPullRequestNameFromProperties(props *properties.Properties) (string, error) {
+	owner, err := getStringProp(props, RepoPropertyOwner)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := getStringProp(props, RepoPropertyName)
+	if err != nil {
+		return "", err
+	}
+
+	number, err := getStringProp(props, PullRequestNumber)
+	if err != nil {
+		return "", err
+	}
+
+	return formatPullRequestName(owner, name, number), nil
+}
+
+func formatPullRequestName(owner, name, number string) string {
+	return fmt.Sprintf("%s/%s/%s", owner, name, number)
+}
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// webhookEvents are the events Minder subscribes to. Subscribing to
+// "pull_request" also delivers pull request synchronization events.
+var webhookEvents = []string{"push", "create", "pull_request"}
+
+// RegisterEntity implements the Provider interface
+func (c *giteaClient) RegisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, errors.New("unsupported entity type")
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		// We only explicitly register repositories
+		// Pull requests are handled via origination
+		return props, nil
+	}
+
+	hooksPath, err := repoHooksPath(props)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cleanUpStaleWebhooks(ctx, hooksPath); err != nil {
+		// This is a non-fatal error and may be transient. We log it and
+		// continue with the registration.
+		zerolog.Ctx(ctx).Error().
+			Str("hooksPath", hooksPath).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to clean up stale webhooks")
+	}
+
+	whprops, err := c.createWebhook(ctx, hooksPath)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("hooksPath", hooksPath).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to create webhook")
+		return nil, errors.New("failed to create webhook")
+	}
+
+	return props.Merge(whprops), nil
+}
+
+// DeregisterEntity implements the Provider interface
+func (c *giteaClient) DeregisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) error {
+	if !c.SupportsEntity(entType) {
+		return errors.New("unsupported entity type")
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		return nil
+	}
+
+	hooksPath, err := repoHooksPath(props)
+	if err != nil {
+		return err
+	}
+
+	hookID := props.GetProperty(RepoPropertyHookID).GetString()
+	if hookID == "" {
+		return errors.New("missing hook ID")
+	}
+
+	err = c.deleteWebhook(ctx, hooksPath, hookID)
+	if errors.Is(err, provifv1.ErrEntityNotFound) {
+		// The webhook is already gone
+		return nil
+	}
+	return err
+}
+
+// ReregisterEntity implements the Provider interface
+func (c *giteaClient) ReregisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) error {
+	if !c.SupportsEntity(entType) {
+		return errors.New("unsupported entity type")
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		return nil
+	}
+
+	hooksPath, err := repoHooksPath(props)
+	if err != nil {
+		return err
+	}
+
+	hookID := props.GetProperty(RepoPropertyHookID).GetString()
+	if hookID == "" {
+		return errors.New("missing hook ID")
+	}
+
+	hookURL := props.GetProperty(RepoPropertyHookURL).GetString()
+	if hookURL == "" {
+		return errors.New("missing hook URL")
+	}
+
+	return c.updateWebhook(ctx, hooksPath, hookID, hookURL)
+}
+
+func (c *giteaClient) createWebhook(ctx context.Context, hooksPath string) (*properties.Properties, error) {
+	hookUUID := uuid.New()
+	webhookUniqueURL, err := url.JoinPath(c.webhookURL, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for webhook: %w", err)
+	}
+
+	sec, err := webhooksecret.New(c.currentWebhookSecret, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook secret: %w", err)
+	}
+
+	hreq := &CreateHookOption{
+		Type:   "gitea",
+		Config: hookConfig(webhookUniqueURL, sec),
+		Events: webhookEvents,
+		Active: true,
+	}
+
+	hook := &Hook{}
+	if err := gtRESTWrite(ctx, c, http.MethodPost, hooksPath, hreq, hook, http.StatusCreated); err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	outProps := properties.NewProperties(map[string]interface{}{
+		// we store as string to avoid any type issues. Note that we
+		// need to retrieve it as a string as well.
+		RepoPropertyHookID:  strconv.FormatInt(hook.ID, 10),
+		RepoPropertyHookURL: webhookUniqueURL,
+	})
+
+	return outProps, nil
+}
+
+func (c *giteaClient) deleteWebhook(ctx context.Context, hooksPath, hookID string) error {
+	hookPath, err := url.JoinPath(hooksPath, hookID)
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for hook: %w", err)
+	}
+
+	if err := gtRESTWrite(ctx, c, http.MethodDelete, hookPath, nil, nil, http.StatusNoContent); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (c *giteaClient) cleanUpStaleWebhooks(ctx context.Context, hooksPath string) error {
+	hooks := []*Hook{}
+	if err := gtRESTGet(ctx, c, hooksPath, &hooks); err != nil {
+		return fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	for _, hook := range hooks {
+		if strings.HasPrefix(hook.Config["url"], c.webhookURL) {
+			if err := c.deleteWebhook(ctx, hooksPath, strconv.FormatInt(hook.ID, 10)); err != nil {
+				return fmt.Errorf("failed to delete webhook: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *giteaClient) updateWebhook(ctx context.Context, hooksPath, hookID, hookURL string) error {
+	// We don't need to update the webhook URL, as it's unique for each
+	// registration. We only need to update the secret.
+	hookPath, err := url.JoinPath(hooksPath, hookID)
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for hook: %w", err)
+	}
+
+	hookURLParsed, err := url.Parse(hookURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse hook URL: %w", err)
+	}
+
+	// We need to extract the UUID from the webhook URL. The UUID is
+	// the last part of the path.
+	hookMinderUUID := hookURLParsed.Path[strings.LastIndex(hookURLParsed.Path, "/")+1:]
+
+	sec, err := webhooksecret.New(c.currentWebhookSecret, hookMinderUUID)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook secret: %w", err)
+	}
+
+	hreq := &EditHookOption{
+		Config: hookConfig(hookURL, sec),
+		Events: webhookEvents,
+		Active: true,
+	}
+
+	if err := gtRESTWrite(ctx, c, http.MethodPatch, hookPath, hreq, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return nil
+}
+
+func hookConfig(hookURL, secret string) map[string]string {
+	return map[string]string{
+		"url":          hookURL,
+		"content_type": "json",
+		"secret":       secret,
+	}
+}
+
+func repoHooksPath(props *properties.Properties) (string, error) {
+	owner, err := getStringProp(props, RepoPropertyOwner)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := getStringProp(props, RepoPropertyName)
+	if err != nil {
+		return "", err
+	}
+
+	hooksPath, err := url.JoinPath("repos", owner, name, "hooks")
+	if err != nil {
+		return "", fmt.Errorf("failed to join URL path for hooks: %w", err)
+	}
+	return hooksPath, nil
+}
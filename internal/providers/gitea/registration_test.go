@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+func repoProps(extra map[string]any) *properties.Properties {
+	props := map[string]any{
+		properties.PropertyUpstreamID: "42",
+		RepoPropertyOwner:             "org",
+		RepoPropertyName:              "repo",
+	}
+	for k, v := range extra {
+		props[k] = v
+	}
+	return properties.NewProperties(props)
+}
+
+func TestGiteaClient_RegisterEntity(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var deleted []string
+	var created *CreateHookOption
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/org/repo/hooks":
+			_ = json.NewEncoder(w).Encode([]*Hook{
+				{ID: 1, Config: map[string]string{"url": "https://minder.example.com/api/v1/webhook/gitea/old"}},
+				{ID: 2, Config: map[string]string{"url": "https://ci.example.com/hook"}},
+			})
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/repos/org/repo/hooks/"):
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/repos/org/repo/hooks/"))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/org/repo/hooks":
+			created = &CreateHookOption{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(&Hook{ID: 5, Config: created.Config})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c := newTestGiteaProvider(ts.URL)
+	props, err := c.RegisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES, repoProps(nil))
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// only the stale Minder webhook is removed
+	assert.Equal(t, []string{"1"}, deleted)
+
+	require.NotNil(t, created)
+	assert.Equal(t, "gitea", created.Type)
+	assert.True(t, created.Active)
+	assert.ElementsMatch(t, []string{"push", "create", "pull_request"}, created.Events)
+	assert.Equal(t, "json", created.Config["content_type"])
+
+	hookURL := created.Config["url"]
+	require.True(t, strings.HasPrefix(hookURL, c.webhookURL+"/"))
+	hookUUID := strings.TrimPrefix(hookURL, c.webhookURL+"/")
+	_, err = uuid.Parse(hookUUID)
+	require.NoError(t, err)
+	assert.True(t, webhooksecret.Verify(c.currentWebhookSecret, hookUUID, created.Config["secret"]))
+
+	assert.Equal(t, "5", props.GetProperty(RepoPropertyHookID).GetString())
+	assert.Equal(t, hookURL, props.GetProperty(RepoPropertyHookURL).GetString())
+	assert.Equal(t, "42", props.GetProperty(properties.PropertyUpstreamID).GetString())
+}
+
+func TestGiteaClient_RegisterEntityFails(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	c := newTestGiteaProvider(ts.URL)
+	_, err := c.RegisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES, repoProps(nil))
+	require.Error(t, err)
+
+	// pull requests are not registered
+	props := properties.NewProperties(map[string]any{properties.PropertyUpstreamID: "1001"})
+	out, err := c.RegisterEntity(context.Background(), minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+	require.NoError(t, err)
+	assert.Equal(t, props, out)
+}
+
+func TestGiteaClient_DeregisterEntity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		props      *properties.Properties
+		wantErr    bool
+	}{
+		{
+			name:       "webhook deleted",
+			statusCode: http.StatusNoContent,
+			props:      repoProps(map[string]any{RepoPropertyHookID: "5"}),
+		},
+		{
+			name:       "webhook already gone",
+			statusCode: http.StatusNotFound,
+			props:      repoProps(map[string]any{RepoPropertyHookID: "5"}),
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusInternalServerError,
+			props:      repoProps(map[string]any{RepoPropertyHookID: "5"}),
+			wantErr:    true,
+		},
+		{
+			name:    "missing hook ID",
+			props:   repoProps(nil),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "/repos/org/repo/hooks/5", r.URL.Path)
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer ts.Close()
+
+			err := newTestGiteaProvider(ts.URL).DeregisterEntity(
+				context.Background(), minderv1.Entity_ENTITY_REPOSITORIES, tt.props)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGiteaClient_ReregisterEntity(t *testing.T) {
+	t.Parallel()
+
+	hookUUID := uuid.New().String()
+
+	var edited *EditHookOption
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/repos/org/repo/hooks/5", r.URL.Path)
+		edited = &EditHookOption{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(edited))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	c := newTestGiteaProvider(ts.URL)
+	hookURL := c.webhookURL + "/" + hookUUID
+	err := c.ReregisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES, repoProps(map[string]any{
+		RepoPropertyHookID:  "5",
+		RepoPropertyHookURL: hookURL,
+	}))
+	require.NoError(t, err)
+
+	require.NotNil(t, edited)
+	assert.Equal(t, hookURL, edited.Config["url"])
+	assert.True(t, webhooksecret.Verify(c.currentWebhookSecret, hookUUID, edited.Config["secret"]))
+}
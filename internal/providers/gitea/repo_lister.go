@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// repoListPageSize is the number of repositories requested per page. Gitea
+// caps the page size at a server-configured maximum, 50 by default.
+const repoListPageSize = 50
+
+// ListAllRepositories lists the repositories the authenticated user can access
+func (c *giteaClient) ListAllRepositories(ctx context.Context) ([]*minderv1.Repository, error) {
+	var repos []*minderv1.Repository
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(repoListPageSize))
+
+		pageRepos := []*Repository{}
+		if err := gtRESTGet(ctx, c, "user/repos?"+query.Encode(), &pageRepos); err != nil {
+			return nil, fmt.Errorf("failed to list repositories: %w", err)
+		}
+
+		for _, r := range pageRepos {
+			props, err := giteaRepositoryToProperties(r)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert repository to properties: %w", err)
+			}
+
+			outRep, err := repoV1FromProperties(props)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert properties to repository: %w", err)
+			}
+
+			repos = append(repos, outRep)
+		}
+
+		if len(pageRepos) < repoListPageSize {
+			break
+		}
+	}
+
+	zerolog.Ctx(ctx).Debug().Int("num_repos", len(repos)).Msg("found repositories in gitea provider")
+
+	return repos, nil
+}
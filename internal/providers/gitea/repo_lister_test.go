@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGiteaClient_ListAllRepositories(t *testing.T) {
+	t.Parallel()
+
+	const total = repoListPageSize + 3
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/user/repos", r.URL.Path)
+		assert.Equal(t, strconv.Itoa(repoListPageSize), r.URL.Query().Get("limit"))
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		assert.NoError(t, err)
+
+		repos := []*Repository{}
+		for i := (page - 1) * repoListPageSize; i < min(page*repoListPageSize, total); i++ {
+			repo := testRepository()
+			repo.ID = int64(i + 1)
+			repo.Name = "repo-" + strconv.Itoa(i+1)
+			repos = append(repos, repo)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(repos)
+	}))
+	defer ts.Close()
+
+	repos, err := newTestGiteaProvider(ts.URL).ListAllRepositories(context.Background())
+	require.NoError(t, err)
+	require.Len(t, repos, total)
+	assert.Equal(t, "repo-1", repos[0].GetName())
+	assert.Equal(t, "org", repos[0].GetOwner())
+	assert.Equal(t, int64(total), repos[total-1].GetRepoId())
+}
+
+func TestGiteaClient_ListAllRepositoriesError(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	_, err := newTestGiteaProvider(ts.URL).ListAllRepositories(context.Background())
+	require.Error(t, err)
+}
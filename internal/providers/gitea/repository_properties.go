@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// FormatRepositoryUpstreamID returns the upstream ID for a gitea repository
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatRepositoryUpstreamID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func (c *giteaClient) getPropertiesForRepo(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	uid, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	repo, err := c.getGiteaRepository(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	outProps, err := giteaRepositoryToProperties(repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert repository to properties: %w", err)
+	}
+
+	return getByProps.Merge(outProps), nil
+}
+
+func (c *giteaClient) getGiteaRepository(ctx context.Context, upstreamID string) (*Repository, error) {
+	repoURLPath, err := url.JoinPath("repositories", url.PathEscape(upstreamID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for repository using upstream ID: %w", err)
+	}
+
+	repo := &Repository{}
+	if err := gtRESTGet(ctx, c, repoURLPath, repo); err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+func giteaRepositoryToProperties(repo *Repository) (*properties.Properties, error) {
+	if repo.Owner == nil {
+		return nil, fmt.Errorf("gitea repository %d has no owner", repo.ID)
+	}
+
+	var license string
+	if len(repo.Licenses) > 0 {
+		license = repo.Licenses[0]
+	}
+
+	outProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:     FormatRepositoryUpstreamID(repo.ID),
+		properties.PropertyName:           formatRepoName(repo.Owner.Login, repo.Name),
+		properties.RepoPropertyIsPrivate:  repo.Private,
+		properties.RepoPropertyIsArchived: repo.Archived,
+		properties.RepoPropertyIsFork:     repo.Fork,
+		RepoPropertyDefaultBranch:         repo.DefaultBranch,
+		RepoPropertyOwner:                 repo.Owner.Login,
+		RepoPropertyName:                  repo.Name,
+		RepoPropertyLicense:               license,
+		RepoPropertyCloneURL:              repo.CloneURL,
+	})
+
+	return outProps, nil
+}
+
+func repoV1FromProperties(repoProperties *properties.Properties) (*minderv1.Repository, error) {
+	upstreamID, err := repoProperties.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching upstream ID property: %w", err)
+	}
+
+	// convert the upstream ID to an int64
+	repoId, err := strconv.ParseInt(upstreamID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error converting upstream ID to int64: %w", err)
+	}
+
+	name, err := repoProperties.GetProperty(RepoPropertyName).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching name property: %w", err)
+	}
+
+	owner, err := repoProperties.GetProperty(RepoPropertyOwner).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching owner property: %w", err)
+	}
+
+	isPrivate, err := repoProperties.GetProperty(properties.RepoPropertyIsPrivate).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_private property: %w", err)
+	}
+
+	isFork, err := repoProperties.GetProperty(properties.RepoPropertyIsFork).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_fork property: %w", err)
+	}
+
+	pbRepo := &minderv1.Repository{
+		Name:          name,
+		Owner:         owner,
+		RepoId:        repoId,
+		CloneUrl:      repoProperties.GetProperty(RepoPropertyCloneURL).GetString(),
+		IsPrivate:     isPrivate,
+		IsFork:        isFork,
+		DefaultBranch: repoProperties.GetProperty(RepoPropertyDefaultBranch).GetString(),
+		License:       repoProperties.GetProperty(RepoPropertyLicense).GetString(),
+		Properties:    repoProperties.ToProtoStruct(),
+	}
+
+	return pbRepo, nil
+}
+
+func getRepoNameFromProperties(props *properties.Properties) (string, error) {
+	owner, err := getStringProp(props, RepoPropertyOwner)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := getStringProp(props, RepoPropertyName)
+	if err != nil {
+		return "", err
+	}
+
+	return formatRepoName(owner, name), nil
+}
+
+func formatRepoName(owner, name string) string {
+	return owner + "/" + name
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+// The types below are the subset of the Gitea API that the provider
+// needs. Forgejo serves the same payloads.
+
+// User is a Gitea user or organization
+type User struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+// Repository is a Gitea repository
+type Repository struct {
+	ID            int64    `json:"id"`
+	Owner         *User    `json:"owner"`
+	Name          string   `json:"name"`
+	FullName      string   `json:"full_name"`
+	Private       bool     `json:"private"`
+	Fork          bool     `json:"fork"`
+	Archived      bool     `json:"archived"`
+	DefaultBranch string   `json:"default_branch"`
+	CloneURL      string   `json:"clone_url"`
+	HTMLURL       string   `json:"html_url"`
+	Licenses      []string `json:"licenses"`
+}
+
+// PRBranch is the head or base branch of a pull request
+type PRBranch struct {
+	Ref  string      `json:"ref"`
+	Sha  string      `json:"sha"`
+	Repo *Repository `json:"repo"`
+}
+
+// PullRequest is a Gitea pull request
+type PullRequest struct {
+	ID      int64     `json:"id"`
+	Number  int64     `json:"number"`
+	User    *User     `json:"user"`
+	State   string    `json:"state"`
+	HTMLURL string    `json:"html_url"`
+	Head    *PRBranch `json:"head"`
+	Base    *PRBranch `json:"base"`
+}
+
+// Hook is a Gitea repository webhook
+type Hook struct {
+	ID     int64             `json:"id"`
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+// CreateHookOption is the request body used to create a webhook
+type CreateHookOption struct {
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+// EditHookOption is the request body used to update a webhook
+type EditHookOption struct {
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+// PushPayload is the payload of the push and create webhook events
+type PushPayload struct {
+	Ref        string      `json:"ref"`
+	Repository *Repository `json:"repository"`
+}
+
+// PullRequestPayload is the payload of the pull_request webhook event
+type PullRequestPayload struct {
+	Action      string       `json:"action"`
+	Number      int64        `json:"number"`
+	PullRequest *PullRequest `json:"pull_request"`
+	Repository  *Repository  `json:"repository"`
+}
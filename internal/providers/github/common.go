@@ -717,6 +717,54 @@ func (c *GitHub) ListPullRequests(
 	return prs, nil
 }
 
+// FindOpenPullRequest returns the number of the open pull request whose
+// head is the given branch, or 0 if there is none.
+func (c *GitHub) FindOpenPullRequest(ctx context.Context, repo *minderv1.Repository, head string) (int, error) {
+	opts := &github.PullRequestListOptions{
+		// TODO: filtering by head does not work as expected, so we
+		// match the head ref ourselves
+		// Head: fmt.Sprintf("%s:%s", repo.GetOwner(), head),
+		State: "open",
+	}
+	prs, err := c.ListPullRequests(ctx, repo.GetOwner(), repo.GetName(), opts)
+	if err != nil {
+		return 0, err
+	}
+	for _, pr := range prs {
+		if pr.GetHead().GetRef() == head {
+			return pr.GetNumber(), nil
+		}
+	}
+	return 0, nil
+}
+
+// OpenPullRequest opens a pull request merging head into base and returns
+// its number.
+func (c *GitHub) OpenPullRequest(
+	ctx context.Context, repo *minderv1.Repository, title, body, head, base string,
+) (int, error) {
+	pr, err := c.CreatePullRequest(ctx, repo.GetOwner(), repo.GetName(), title, body, head, base)
+	if err != nil {
+		return 0, err
+	}
+	return pr.GetNumber(), nil
+}
+
+// EditPullRequest updates the title and body of a pull request.
+func (c *GitHub) EditPullRequest(ctx context.Context, repo *minderv1.Repository, number int, title, body string) error {
+	_, _, err := c.client.PullRequests.Edit(ctx, repo.GetOwner(), repo.GetName(), number, &github.PullRequest{
+		Title: github.String(title),
+		Body:  github.String(body),
+	})
+	return err
+}
+
+// WithdrawPullRequest closes a pull request without merging it.
+func (c *GitHub) WithdrawPullRequest(ctx context.Context, repo *minderv1.Repository, number int) error {
+	_, err := c.ClosePullRequest(ctx, repo.GetOwner(), repo.GetName(), number)
+	return err
+}
+
 // CreateIssueComment creates a comment on a pull request or an issue
 func (c *GitHub) CreateIssueComment(
 	ctx context.Context, owner, repo string, number int, comment string,
@@ -753,9 +801,11 @@ func (c *GitHub) UpdateIssueComment(ctx context.Context, owner, repo string, num
 }
 
 // Clone clones a GitHub repository
-func (c *GitHub) Clone(ctx context.Context, cloneUrl string, branch string) (*git.Repository, error) {
+func (c *GitHub) Clone(
+	ctx context.Context, cloneUrl string, branch string, opts ...provifv1.CloneOption,
+) (*git.Repository, error) {
 	delegator := gitclient.NewGit(c.delegate.GetCredential(), gitclient.WithConfig(c.gitConfig))
-	return delegator.Clone(ctx, cloneUrl, branch)
+	return delegator.Clone(ctx, cloneUrl, branch, opts...)
 }
 
 // AddAuthToPushOptions adds authorization to the push options
@@ -768,6 +818,22 @@ func (c *GitHub) AddAuthToPushOptions(ctx context.Context, pushOptions *git.Push
 	return nil
 }
 
+// GetCommitAuthor returns the name and email of the acting user. The login
+// is used as the name if the user has not set one.
+func (c *GitHub) GetCommitAuthor(ctx context.Context) (string, string, error) {
+	email, err := c.delegate.GetPrimaryEmail(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("cannot get primary email: %w", err)
+	}
+
+	// we ignore errors here, as we can still create a commit without a name
+	name, _ := c.delegate.GetName(ctx)
+	if name == "" {
+		name, _ = c.delegate.GetLogin(ctx)
+	}
+	return name, email, nil
+}
+
 // ListAllRepositories lists all repositories the credential has access to
 func (c *GitHub) ListAllRepositories(ctx context.Context) ([]*minderv1.Repository, error) {
 	return c.delegate.ListAllRepositories(ctx)
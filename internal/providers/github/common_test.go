@@ -1722,3 +1722,117 @@ func TestCloseSecurityAdvisory(t *testing.T) {
 		})
 	}
 }
+
+func TestFindOpenPullRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		statusCode     int
+		body           string
+		expectedNumber int
+		wantErr        bool
+	}{
+		{
+			name:           "matching head branch",
+			statusCode:     http.StatusOK,
+			body:           `[{"number": 1, "head": {"ref": "other"}}, {"number": 2, "head": {"ref": "minder_fix"}}]`,
+			expectedNumber: 2,
+		},
+		{
+			name:           "no matching head branch",
+			statusCode:     http.StatusOK,
+			body:           `[{"number": 1, "head": {"ref": "other"}}]`,
+			expectedNumber: 0,
+		},
+		{
+			name:       "error listing pull requests",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"message": "Internal Server Error"}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			th := setupTest(t)
+			th.gh.client = github.NewClient(&http.Client{
+				Transport: &mockTransport{
+					response: &http.Response{
+						StatusCode: tt.statusCode,
+						Body:       io.NopCloser(strings.NewReader(tt.body)),
+						Header:     make(http.Header),
+					},
+				},
+			})
+
+			repo := &minderv1.Repository{Owner: "test-owner", Name: "test-repo"}
+			number, err := th.gh.FindOpenPullRequest(context.Background(), repo, "minder_fix")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNumber, number)
+		})
+	}
+}
+
+func TestGetCommitAuthor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*testGitHub)
+		expectedName  string
+		expectedEmail string
+		wantErr       bool
+	}{
+		{
+			name: "name and email",
+			setupMocks: func(th *testGitHub) {
+				th.delegate.EXPECT().GetPrimaryEmail(gomock.Any()).Return("user@example.com", nil)
+				th.delegate.EXPECT().GetName(gomock.Any()).Return("Test User", nil)
+			},
+			expectedName:  "Test User",
+			expectedEmail: "user@example.com",
+		},
+		{
+			name: "falls back to login",
+			setupMocks: func(th *testGitHub) {
+				th.delegate.EXPECT().GetPrimaryEmail(gomock.Any()).Return("user@example.com", nil)
+				th.delegate.EXPECT().GetName(gomock.Any()).Return("", nil)
+				th.delegate.EXPECT().GetLogin(gomock.Any()).Return("test-user", nil)
+			},
+			expectedName:  "test-user",
+			expectedEmail: "user@example.com",
+		},
+		{
+			name: "no email",
+			setupMocks: func(th *testGitHub) {
+				th.delegate.EXPECT().GetPrimaryEmail(gomock.Any()).Return("", errors.New("no email"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			th := setupTest(t)
+			tt.setupMocks(th)
+
+			name, email, err := th.gh.GetCommitAuthor(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, name)
+			assert.Equal(t, tt.expectedEmail, email)
+		})
+	}
+}
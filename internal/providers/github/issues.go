@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v63/github"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var _ provifv1.IssueTracker = (*GitHub)(nil)
+
+// CreateIssue opens an issue on a repository
+func (c *GitHub) CreateIssue(
+	ctx context.Context, repo *minderv1.Repository, opts *provifv1.IssueOptions,
+) (*provifv1.Issue, error) {
+	req := &github.IssueRequest{
+		Title:  github.String(opts.Title),
+		Body:   github.String(opts.Body),
+		Labels: &opts.Labels,
+	}
+	if len(opts.Assignees) > 0 {
+		req.Assignees = &opts.Assignees
+	}
+
+	issue, _, err := c.client.Issues.Create(ctx, repo.GetOwner(), repo.GetName(), req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating issue: %w", err)
+	}
+
+	return &provifv1.Issue{
+		Number: issue.GetNumber(),
+		URL:    issue.GetHTMLURL(),
+	}, nil
+}
+
+// UpdateIssue replaces the contents of an issue and reopens it
+func (c *GitHub) UpdateIssue(
+	ctx context.Context, repo *minderv1.Repository, number int, opts *provifv1.IssueOptions,
+) error {
+	req := &github.IssueRequest{
+		Title:  github.String(opts.Title),
+		Body:   github.String(opts.Body),
+		Labels: &opts.Labels,
+		State:  github.String("open"),
+	}
+	if len(opts.Assignees) > 0 {
+		req.Assignees = &opts.Assignees
+	}
+
+	_, resp, err := c.client.Issues.Edit(ctx, repo.GetOwner(), repo.GetName(), number, req)
+	if isIssueGone(resp) {
+		return provifv1.ErrEntityNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("error updating issue: %w", err)
+	}
+	return nil
+}
+
+// CloseIssue comments on an issue and closes it as completed
+func (c *GitHub) CloseIssue(ctx context.Context, repo *minderv1.Repository, number int, comment string) error {
+	_, resp, err := c.client.Issues.CreateComment(ctx, repo.GetOwner(), repo.GetName(), number, &github.IssueComment{
+		Body: github.String(comment),
+	})
+	if isIssueGone(resp) {
+		return provifv1.ErrEntityNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("error commenting on issue: %w", err)
+	}
+
+	_, resp, err = c.client.Issues.Edit(ctx, repo.GetOwner(), repo.GetName(), number, &github.IssueRequest{
+		State:       github.String("closed"),
+		StateReason: github.String("completed"),
+	})
+	if isIssueGone(resp) {
+		return provifv1.ErrEntityNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("error closing issue: %w", err)
+	}
+	return nil
+}
+
+// isIssueGone returns whether the response says that the issue does not
+// exist, or was deleted
+func isIssueGone(resp *github.Response) bool {
+	return resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone)
+}
@@ -58,6 +58,7 @@ var prPropertyDefinitions = []propertyOrigin{
 			properties.PullRequestTargetCloneURL,
 			properties.PullRequestTargetBranch,
 			properties.PullRequestUpstreamURL,
+			properties.PullRequestRepoUpstreamID,
 			// github-specific
 			PullPropertyURL,
 			PullPropertyNumber,
@@ -164,6 +165,7 @@ func getPrWrapper(
 		properties.PullRequestTargetCloneURL:    prReply.GetHead().GetRepo().GetCloneURL(),
 		properties.PullRequestTargetBranch:      prReply.GetHead().GetRef(),
 		properties.PullRequestUpstreamURL:       prReply.GetHTMLURL(),
+		properties.PullRequestRepoUpstreamID:    properties.NumericalValueToUpstreamID(prReply.GetBase().GetRepo().GetID()),
 		// github-specific
 		PullPropertyURL: prReply.GetHTMLURL(),
 		// our proto representation uses int64 for the number but GH uses int
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	go_github "github.com/google/go-github/v63/github"
 	"github.com/rs/zerolog"
@@ -63,6 +64,7 @@ var repoPropertyDefinitions = []propertyOrigin{
 			properties.RepoPropertyIsPrivate,
 			properties.RepoPropertyIsArchived,
 			properties.RepoPropertyIsFork,
+			properties.RepoPropertyPushedAt,
 			// github-specific
 			RepoPropertyId,
 			RepoPropertyName,
@@ -86,6 +88,7 @@ func GitHubRepoToMap(repo *go_github.Repository) map[string]any {
 		properties.RepoPropertyIsPrivate:  repo.GetPrivate(),
 		properties.RepoPropertyIsArchived: repo.GetArchived(),
 		properties.RepoPropertyIsFork:     repo.GetFork(),
+		properties.RepoPropertyPushedAt:   pushedAt(repo),
 		// github-specific
 		RepoPropertyId:              repo.GetID(),
 		RepoPropertyName:            repo.GetName(),
@@ -102,6 +105,15 @@ func GitHubRepoToMap(repo *go_github.Repository) map[string]any {
 	return repoProps
 }
 
+// pushedAt returns the time of the last push to the repository, or an empty
+// string if it was never pushed to
+func pushedAt(repo *go_github.Repository) string {
+	if repo.PushedAt == nil {
+		return ""
+	}
+	return repo.GetPushedAt().UTC().Format(time.RFC3339)
+}
+
 func getRepoWrapper(
 	ctx context.Context, ghCli *go_github.Client, isOrg bool, getByProps *properties.Properties,
 ) (map[string]any, error) {
@@ -9,10 +9,13 @@ import (
 	"github.com/go-git/go-git/v5"
 
 	gitclient "github.com/mindersec/minder/internal/providers/git"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
 // Implements the Git interface
-func (c *gitlabClient) Clone(ctx context.Context, cloneUrl string, branch string) (*git.Repository, error) {
+func (c *gitlabClient) Clone(
+	ctx context.Context, cloneUrl string, branch string, opts ...provifv1.CloneOption,
+) (*git.Repository, error) {
 	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
-	return g.Clone(ctx, cloneUrl, branch)
+	return g.Clone(ctx, cloneUrl, branch, opts...)
 }
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var _ provifv1.IssueTracker = (*gitlabClient)(nil)
+
+// CreateIssue opens an issue on a project
+func (c *gitlabClient) CreateIssue(
+	ctx context.Context, repo *minderv1.Repository, opts *provifv1.IssueOptions,
+) (*provifv1.Issue, error) {
+	issuesPath, err := projectIssuesPath(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeIDs, err := c.lookupUserIDs(ctx, opts.Assignees)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := gitlab.LabelOptions(opts.Labels)
+	issue := &gitlab.Issue{}
+	err = c.doRESTWrite(ctx, http.MethodPost, issuesPath, &gitlab.CreateIssueOptions{
+		Title:       ptr.Ptr(opts.Title),
+		Description: ptr.Ptr(opts.Body),
+		Labels:      &labels,
+		AssigneeIDs: assigneeIDs,
+	}, issue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	return &provifv1.Issue{
+		Number: issue.IID,
+		URL:    issue.WebURL,
+	}, nil
+}
+
+// UpdateIssue replaces the contents of an issue and reopens it
+func (c *gitlabClient) UpdateIssue(
+	ctx context.Context, repo *minderv1.Repository, number int, opts *provifv1.IssueOptions,
+) error {
+	issuePath, err := projectIssuePath(repo, number)
+	if err != nil {
+		return err
+	}
+
+	assigneeIDs, err := c.lookupUserIDs(ctx, opts.Assignees)
+	if err != nil {
+		return err
+	}
+
+	labels := gitlab.LabelOptions(opts.Labels)
+	err = c.doRESTWrite(ctx, http.MethodPut, issuePath, &gitlab.UpdateIssueOptions{
+		Title:       ptr.Ptr(opts.Title),
+		Description: ptr.Ptr(opts.Body),
+		Labels:      &labels,
+		AssigneeIDs: assigneeIDs,
+		StateEvent:  ptr.Ptr("reopen"),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update issue: %w", err)
+	}
+	return nil
+}
+
+// CloseIssue leaves a note on an issue and closes it
+func (c *gitlabClient) CloseIssue(ctx context.Context, repo *minderv1.Repository, number int, comment string) error {
+	issuePath, err := projectIssuePath(repo, number)
+	if err != nil {
+		return err
+	}
+
+	notesPath, err := url.JoinPath(issuePath, "notes")
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for issue notes: %w", err)
+	}
+
+	err = c.doRESTWrite(ctx, http.MethodPost, notesPath, &gitlab.CreateIssueNoteOptions{
+		Body: ptr.Ptr(comment),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to comment on issue: %w", err)
+	}
+
+	err = c.doRESTWrite(ctx, http.MethodPut, issuePath, &gitlab.UpdateIssueOptions{
+		StateEvent: ptr.Ptr("close"),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to close issue: %w", err)
+	}
+	return nil
+}
+
+// lookupUserIDs returns the IDs of the users with the given usernames, as
+// GitLab assigns issues by user ID
+func (c *gitlabClient) lookupUserIDs(ctx context.Context, usernames []string) (*[]int, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int, 0, len(usernames))
+	for _, username := range usernames {
+		query := url.Values{}
+		query.Set("username", username)
+
+		users := []*gitlab.User{}
+		if err := glRESTGet(ctx, c, "users?"+query.Encode(), &users); err != nil {
+			return nil, fmt.Errorf("failed to look up user %s: %w", username, err)
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("user %s not found", username)
+		}
+		ids = append(ids, users[0].ID)
+	}
+	return &ids, nil
+}
+
+func projectIssuesPath(repo *minderv1.Repository) (string, error) {
+	if repo.GetRepoId() == 0 {
+		return "", errors.New("repository has no upstream ID")
+	}
+
+	issuesPath, err := url.JoinPath("projects", strconv.FormatInt(repo.GetRepoId(), 10), "issues")
+	if err != nil {
+		return "", fmt.Errorf("failed to join URL path for issues: %w", err)
+	}
+	return issuesPath, nil
+}
+
+func projectIssuePath(repo *minderv1.Repository, iid int) (string, error) {
+	issuesPath, err := projectIssuesPath(repo)
+	if err != nil {
+		return "", err
+	}
+	return url.JoinPath(issuesPath, strconv.Itoa(iid))
+}
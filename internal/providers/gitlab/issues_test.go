@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func TestGitlabClient_CreateIssue(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users":
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "octocat", r.URL.Query().Get("username"))
+			_, _ = w.Write([]byte(`[{"id": 5, "username": "octocat"}]`))
+		case "/projects/42/issues":
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			body := map[string]any{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "rule failed", body["title"])
+			assert.Equal(t, "details", body["description"])
+			assert.Equal(t, "security", body["labels"])
+			assert.Equal(t, []any{float64(5)}, body["assignee_ids"])
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 1001, "iid": 3, "web_url": "https://gitlab.com/group/project/-/issues/3"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	issue, err := newTestGitlabProvider(ts.URL).CreateIssue(context.Background(), &minderv1.Repository{RepoId: 42},
+		&provifv1.IssueOptions{
+			Title:     "rule failed",
+			Body:      "details",
+			Labels:    []string{"security"},
+			Assignees: []string{"octocat"},
+		})
+	require.NoError(t, err)
+	assert.Equal(t, &provifv1.Issue{Number: 3, URL: "https://gitlab.com/group/project/-/issues/3"}, issue)
+
+	// The repository must carry its upstream ID
+	_, err = newTestGitlabProvider(ts.URL).CreateIssue(context.Background(), &minderv1.Repository{},
+		&provifv1.IssueOptions{Title: "rule failed"})
+	require.Error(t, err)
+}
+
+func TestGitlabClient_UpdateIssue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		statusCode  int
+		expectedErr error
+	}{
+		{
+			name:       "update",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:        "deleted issue",
+			statusCode:  http.StatusNotFound,
+			expectedErr: provifv1.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/projects/42/issues/3", r.URL.Path)
+
+				body := map[string]any{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "still failing", body["title"])
+				assert.Equal(t, "reopen", body["state_event"])
+
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer ts.Close()
+
+			err := newTestGitlabProvider(ts.URL).UpdateIssue(context.Background(), &minderv1.Repository{RepoId: 42}, 3,
+				&provifv1.IssueOptions{Title: "still failing"})
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGitlabClient_CloseIssue(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		body := map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		switch r.URL.Path {
+		case "/projects/42/issues/3/notes":
+			assert.Equal(t, map[string]any{"body": "fixed"}, body)
+			w.WriteHeader(http.StatusCreated)
+		case "/projects/42/issues/3":
+			assert.Equal(t, map[string]any{"state_event": "close"}, body)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	err := newTestGitlabProvider(ts.URL).CloseIssue(context.Background(), &minderv1.Repository{RepoId: 42}, 3, "fixed")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"POST /projects/42/issues/3/notes",
+		"PUT /projects/42/issues/3",
+	}, requests)
+}
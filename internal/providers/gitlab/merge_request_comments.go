@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/mindersec/minder/internal/util/ptr"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var _ provifv1.PullRequestCommenter = (*gitlabClient)(nil)
+
+// CreatePullRequestComment starts a new discussion on a merge request. The
+// comment ID is the ID of the discussion's note and the thread ID the ID of
+// the discussion, so that the comment can later be resolved.
+func (c *gitlabClient) CreatePullRequestComment(
+	ctx context.Context, pr *properties.Properties, body string,
+) (*provifv1.PullRequestComment, error) {
+	discussionsPath, err := mergeRequestDiscussionsPath(pr)
+	if err != nil {
+		return nil, err
+	}
+
+	discussion := &gitlab.Discussion{}
+	err = c.doRESTWrite(ctx, http.MethodPost, discussionsPath, &gitlab.CreateMergeRequestDiscussionOptions{
+		Body: ptr.Ptr(body),
+	}, discussion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merge request discussion: %w", err)
+	}
+
+	if len(discussion.Notes) == 0 {
+		return nil, errors.New("merge request discussion has no notes")
+	}
+
+	return &provifv1.PullRequestComment{
+		ID:       strconv.Itoa(discussion.Notes[0].ID),
+		ThreadID: discussion.ID,
+	}, nil
+}
+
+// UpdatePullRequestComment replaces the body of a merge request note
+func (c *gitlabClient) UpdatePullRequestComment(
+	ctx context.Context, pr *properties.Properties, comment *provifv1.PullRequestComment, body string,
+) error {
+	discussionsPath, err := mergeRequestDiscussionsPath(pr)
+	if err != nil {
+		return err
+	}
+
+	notePath, err := url.JoinPath(discussionsPath, comment.ThreadID, "notes", comment.ID)
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for merge request note: %w", err)
+	}
+
+	err = c.doRESTWrite(ctx, http.MethodPut, notePath, &gitlab.UpdateMergeRequestDiscussionNoteOptions{
+		Body: ptr.Ptr(body),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update merge request note: %w", err)
+	}
+	return nil
+}
+
+// ResolvePullRequestComment resolves the discussion holding a merge
+// request note
+func (c *gitlabClient) ResolvePullRequestComment(
+	ctx context.Context, pr *properties.Properties, comment *provifv1.PullRequestComment,
+) error {
+	discussionsPath, err := mergeRequestDiscussionsPath(pr)
+	if err != nil {
+		return err
+	}
+
+	discussionPath, err := url.JoinPath(discussionsPath, comment.ThreadID)
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for merge request discussion: %w", err)
+	}
+
+	err = c.doRESTWrite(ctx, http.MethodPut, discussionPath, &gitlab.ResolveMergeRequestDiscussionOptions{
+		Resolved: ptr.Ptr(true),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to resolve merge request discussion: %w", err)
+	}
+	return nil
+}
+
+func mergeRequestDiscussionsPath(pr *properties.Properties) (string, error) {
+	pid, err := getStringProp(pr, PullRequestProjectID)
+	if err != nil {
+		return "", err
+	}
+
+	iid, err := getStringProp(pr, PullRequestNumber)
+	if err != nil {
+		return "", err
+	}
+
+	discussionsPath, err := url.JoinPath("projects", pid, "merge_requests", iid, "discussions")
+	if err != nil {
+		return "", fmt.Errorf("failed to join URL path for merge request discussions: %w", err)
+	}
+	return discussionsPath, nil
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func newTestMergeRequest() *properties.Properties {
+	return properties.NewProperties(map[string]any{
+		PullRequestProjectID: "42",
+		PullRequestNumber:    "7",
+	})
+}
+
+func TestGitlabClient_CreatePullRequestComment(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/projects/42/merge_requests/7/discussions", r.URL.Path)
+
+		body := map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"body": "policy violated"}, body)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "abc123", "notes": [{"id": 99}]}`))
+	}))
+	defer ts.Close()
+
+	comment, err := newTestGitlabProvider(ts.URL).CreatePullRequestComment(
+		context.Background(), newTestMergeRequest(), "policy violated")
+	require.NoError(t, err)
+	assert.Equal(t, &provifv1.PullRequestComment{ID: "99", ThreadID: "abc123"}, comment)
+
+	// The merge request must carry the GitLab properties
+	_, err = newTestGitlabProvider(ts.URL).CreatePullRequestComment(
+		context.Background(), properties.NewProperties(map[string]any{}), "policy violated")
+	require.Error(t, err)
+}
+
+func TestGitlabClient_UpdateAndResolvePullRequestComment(t *testing.T) {
+	t.Parallel()
+
+	comment := &provifv1.PullRequestComment{ID: "99", ThreadID: "abc123"}
+
+	tests := []struct {
+		name         string
+		statusCode   int
+		call         func(*gitlabClient, *properties.Properties) error
+		expectedPath string
+		expectedBody map[string]any
+		expectedErr  error
+	}{
+		{
+			name:       "update",
+			statusCode: http.StatusOK,
+			call: func(c *gitlabClient, pr *properties.Properties) error {
+				return c.UpdatePullRequestComment(context.Background(), pr, comment, "still violated")
+			},
+			expectedPath: "/projects/42/merge_requests/7/discussions/abc123/notes/99",
+			expectedBody: map[string]any{"body": "still violated"},
+		},
+		{
+			name:       "resolve",
+			statusCode: http.StatusOK,
+			call: func(c *gitlabClient, pr *properties.Properties) error {
+				return c.ResolvePullRequestComment(context.Background(), pr, comment)
+			},
+			expectedPath: "/projects/42/merge_requests/7/discussions/abc123",
+			expectedBody: map[string]any{"resolved": true},
+		},
+		{
+			name:       "resolve deleted discussion",
+			statusCode: http.StatusNotFound,
+			call: func(c *gitlabClient, pr *properties.Properties) error {
+				return c.ResolvePullRequestComment(context.Background(), pr, comment)
+			},
+			expectedPath: "/projects/42/merge_requests/7/discussions/abc123",
+			expectedBody: map[string]any{"resolved": true},
+			expectedErr:  provifv1.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+
+				body := map[string]any{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tt.expectedBody, body)
+
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer ts.Close()
+
+			err := tt.call(newTestGitlabProvider(ts.URL), newTestMergeRequest())
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-git/go-git/v5"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var _ provifv1.PullRequestProvider = (*gitlabClient)(nil)
+
+// AddAuthToPushOptions adds the credentials needed to push a branch to
+// the repository
+func (c *gitlabClient) AddAuthToPushOptions(_ context.Context, options *git.PushOptions) error {
+	// the username can be anything, but it can't be empty
+	c.cred.AddToPushOptions(options, "minder-user")
+	return nil
+}
+
+// GetCommitAuthor returns the name and email of the authenticated user
+func (c *gitlabClient) GetCommitAuthor(ctx context.Context) (string, string, error) {
+	user := &gitlab.User{}
+	if err := glRESTGet(ctx, c, "user", user); err != nil {
+		return "", "", fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+
+	email := user.Email
+	if email == "" {
+		email = user.PublicEmail
+	}
+	if email == "" {
+		return "", "", errors.New("authenticated user has no email address")
+	}
+
+	name := user.Name
+	if name == "" {
+		name = user.Username
+	}
+	return name, email, nil
+}
+
+// FindOpenPullRequest returns the IID of the open merge request whose
+// source branch is the given branch, or 0 if there is none
+func (c *gitlabClient) FindOpenPullRequest(ctx context.Context, repo *minderv1.Repository, head string) (int, error) {
+	mrsPath, err := mergeRequestsPath(repo)
+	if err != nil {
+		return 0, err
+	}
+
+	query := url.Values{}
+	query.Set("state", "opened")
+	query.Set("source_branch", head)
+
+	mrs := []*gitlab.BasicMergeRequest{}
+	if err := glRESTGet(ctx, c, mrsPath+"?"+query.Encode(), &mrs); err != nil {
+		return 0, fmt.Errorf("failed to list merge requests: %w", err)
+	}
+
+	for _, mr := range mrs {
+		if mr.SourceBranch == head {
+			return mr.IID, nil
+		}
+	}
+	return 0, nil
+}
+
+// OpenPullRequest opens a merge request from head into base and returns
+// its IID
+func (c *gitlabClient) OpenPullRequest(
+	ctx context.Context, repo *minderv1.Repository, title, body, head, base string,
+) (int, error) {
+	mrsPath, err := mergeRequestsPath(repo)
+	if err != nil {
+		return 0, err
+	}
+
+	mr := &gitlab.BasicMergeRequest{}
+	err = c.doRESTWrite(ctx, http.MethodPost, mrsPath, &gitlab.CreateMergeRequestOptions{
+		Title:              ptr.Ptr(title),
+		Description:        ptr.Ptr(body),
+		SourceBranch:       ptr.Ptr(head),
+		TargetBranch:       ptr.Ptr(base),
+		RemoveSourceBranch: ptr.Ptr(true),
+	}, mr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create merge request: %w", err)
+	}
+
+	return mr.IID, nil
+}
+
+// EditPullRequest updates the title and description of a merge request
+func (c *gitlabClient) EditPullRequest(
+	ctx context.Context, repo *minderv1.Repository, number int, title, body string,
+) error {
+	mrPath, err := mergeRequestPath(repo, number)
+	if err != nil {
+		return err
+	}
+
+	err = c.doRESTWrite(ctx, http.MethodPut, mrPath, &gitlab.UpdateMergeRequestOptions{
+		Title:       ptr.Ptr(title),
+		Description: ptr.Ptr(body),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update merge request: %w", err)
+	}
+	return nil
+}
+
+// WithdrawPullRequest closes a merge request without merging it
+func (c *gitlabClient) WithdrawPullRequest(ctx context.Context, repo *minderv1.Repository, number int) error {
+	mrPath, err := mergeRequestPath(repo, number)
+	if err != nil {
+		return err
+	}
+
+	err = c.doRESTWrite(ctx, http.MethodPut, mrPath, &gitlab.UpdateMergeRequestOptions{
+		StateEvent: ptr.Ptr("close"),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to close merge request: %w", err)
+	}
+	return nil
+}
+
+func (c *gitlabClient) doRESTWrite(ctx context.Context, method, path string, body any, out any) error {
+	req, err := c.NewRequest(method, path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return provifv1.ErrEntityNotFound
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func mergeRequestsPath(repo *minderv1.Repository) (string, error) {
+	if repo.GetRepoId() == 0 {
+		return "", errors.New("repository has no upstream ID")
+	}
+
+	mrsPath, err := url.JoinPath("projects", strconv.FormatInt(repo.GetRepoId(), 10), "merge_requests")
+	if err != nil {
+		return "", fmt.Errorf("failed to join URL path for merge requests: %w", err)
+	}
+	return mrsPath, nil
+}
+
+func mergeRequestPath(repo *minderv1.Repository, iid int) (string, error) {
+	mrsPath, err := mergeRequestsPath(repo)
+	if err != nil {
+		return "", err
+	}
+	return url.JoinPath(mrsPath, strconv.Itoa(iid))
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func TestGitlabClient_GetCommitAuthor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		user          map[string]any
+		expectedName  string
+		expectedEmail string
+		wantErr       bool
+	}{
+		{
+			name:          "name and email",
+			user:          map[string]any{"name": "Test User", "username": "test-user", "email": "user@example.com"},
+			expectedName:  "Test User",
+			expectedEmail: "user@example.com",
+		},
+		{
+			name:          "falls back to username and public email",
+			user:          map[string]any{"username": "test-user", "public_email": "public@example.com"},
+			expectedName:  "test-user",
+			expectedEmail: "public@example.com",
+		},
+		{
+			name:    "no email",
+			user:    map[string]any{"username": "test-user"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/user", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(tt.user)
+			}))
+			defer ts.Close()
+
+			name, email, err := newTestGitlabProvider(ts.URL).GetCommitAuthor(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, name)
+			assert.Equal(t, tt.expectedEmail, email)
+		})
+	}
+}
+
+func TestGitlabClient_FindOpenPullRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		statusCode     int
+		mrs            []map[string]any
+		expectedNumber int
+		wantErr        bool
+	}{
+		{
+			name:       "matching merge request",
+			statusCode: http.StatusOK,
+			mrs: []map[string]any{
+				{"iid": 3, "source_branch": "minder_fix"},
+			},
+			expectedNumber: 3,
+		},
+		{
+			name:           "no merge request",
+			statusCode:     http.StatusOK,
+			mrs:            []map[string]any{},
+			expectedNumber: 0,
+		},
+		{
+			name:       "project not found",
+			statusCode: http.StatusNotFound,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/projects/42/merge_requests", r.URL.Path)
+				assert.Equal(t, "opened", r.URL.Query().Get("state"))
+				assert.Equal(t, "minder_fix", r.URL.Query().Get("source_branch"))
+				w.WriteHeader(tt.statusCode)
+				_ = json.NewEncoder(w).Encode(tt.mrs)
+			}))
+			defer ts.Close()
+
+			repo := &minderv1.Repository{RepoId: 42}
+			number, err := newTestGitlabProvider(ts.URL).FindOpenPullRequest(context.Background(), repo, "minder_fix")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNumber, number)
+		})
+	}
+}
+
+func TestGitlabClient_OpenPullRequest(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/projects/42/merge_requests", r.URL.Path)
+
+		body := map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "title", body["title"])
+		assert.Equal(t, "body", body["description"])
+		assert.Equal(t, "minder_fix", body["source_branch"])
+		assert.Equal(t, "main", body["target_branch"])
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{"iid": 7})
+	}))
+	defer ts.Close()
+
+	repo := &minderv1.Repository{RepoId: 42}
+	number, err := newTestGitlabProvider(ts.URL).OpenPullRequest(
+		context.Background(), repo, "title", "body", "minder_fix", "main")
+	require.NoError(t, err)
+	assert.Equal(t, 7, number)
+
+	_, err = newTestGitlabProvider(ts.URL).OpenPullRequest(
+		context.Background(), &minderv1.Repository{}, "title", "body", "minder_fix", "main")
+	require.Error(t, err)
+}
+
+func TestGitlabClient_EditAndWithdrawPullRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		statusCode   int
+		call         func(*gitlabClient, *minderv1.Repository) error
+		expectedBody map[string]any
+		expectedErr  error
+	}{
+		{
+			name:       "edit",
+			statusCode: http.StatusOK,
+			call: func(c *gitlabClient, repo *minderv1.Repository) error {
+				return c.EditPullRequest(context.Background(), repo, 7, "new title", "new body")
+			},
+			expectedBody: map[string]any{"title": "new title", "description": "new body"},
+		},
+		{
+			name:       "withdraw",
+			statusCode: http.StatusOK,
+			call: func(c *gitlabClient, repo *minderv1.Repository) error {
+				return c.WithdrawPullRequest(context.Background(), repo, 7)
+			},
+			expectedBody: map[string]any{"state_event": "close"},
+		},
+		{
+			name:       "withdraw missing merge request",
+			statusCode: http.StatusNotFound,
+			call: func(c *gitlabClient, repo *minderv1.Repository) error {
+				return c.WithdrawPullRequest(context.Background(), repo, 7)
+			},
+			expectedBody: map[string]any{"state_event": "close"},
+			expectedErr:  provifv1.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/projects/42/merge_requests/7", r.URL.Path)
+
+				body := map[string]any{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tt.expectedBody, body)
+
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(`{"iid": 7}`))
+			}))
+			defer ts.Close()
+
+			err := tt.call(newTestGitlabProvider(ts.URL), &minderv1.Repository{RepoId: 42})
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -123,6 +123,7 @@ func gitlabMergeRequestToProperties(
 		properties.PullRequestTargetCloneURL:    targetproj.HTTPURLToRepo,
 		properties.PullRequestTargetBranch:      mr.SourceBranch,
 		properties.PullRequestUpstreamURL:       mr.WebURL,
+		properties.PullRequestRepoUpstreamID:    FormatRepositoryUpstreamID(proj.ID),
 		RepoPropertyNamespace:                   ns,
 		RepoPropertyProjectName:                 projName,
 		// internal ID of the merge request
@@ -8,6 +8,7 @@ import (
 
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
+	"github.com/mindersec/minder/internal/providers/gitea"
 	ghclient "github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/gitlab"
 )
@@ -35,6 +36,10 @@ var supportedProviderClassDefinitions = map[string]ProviderClassDefinition{
 		Traits:             gitlab.Implements,
 		AuthorizationFlows: gitlab.AuthorizationFlows,
 	},
+	gitea.Class: {
+		Traits:             gitea.Implements,
+		AuthorizationFlows: gitea.AuthorizationFlows,
+	},
 }
 
 // GetProviderClassDefinition returns the provider definition for the given provider class
@@ -20,14 +20,20 @@ type EntityReminderEvent struct {
 	ProviderID uuid.UUID `json:"provider"`
 	// EntityID is the entity id of the repository to be reconciled
 	EntityID uuid.UUID `json:"entity_id"`
+	// ProfileIDs are the profiles whose evaluation of the entity is due.
+	// All the profiles are evaluated when empty.
+	ProfileIDs []uuid.UUID `json:"profile_ids,omitempty"`
 }
 
 // NewEntityReminderMessage creates a new repo reminder message
-func NewEntityReminderMessage(providerId uuid.UUID, entityID uuid.UUID, projectID uuid.UUID) (*message.Message, error) {
+func NewEntityReminderMessage(
+	providerId uuid.UUID, entityID uuid.UUID, projectID uuid.UUID, profileIDs []uuid.UUID,
+) (*message.Message, error) {
 	evt := &EntityReminderEvent{
 		Project:    projectID,
 		ProviderID: providerId,
 		EntityID:   entityID,
+		ProfileIDs: profileIDs,
 	}
 
 	evtStr, err := json.Marshal(evt)
@@ -30,6 +30,10 @@ type Metrics struct {
 	// Time between when a reminder became eligible and when it was sent for the first time
 	NewSendDelay metric.Float64Histogram
 
+	// Time between when a profile evaluation of an entity became due, according
+	// to the profile's evaluation schedule or the default, and when it was sent
+	ScheduleLag metric.Float64Histogram
+
 	// Current number of reminders in the batch
 	BatchSize metric.Int64Histogram
 }
@@ -56,6 +60,16 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, err
 	}
 
+	scheduleLag, err := meter.Float64Histogram(
+		"schedule_lag",
+		metric.WithDescription("Time between a profile evaluation becoming due and the reminder being sent (seconds)"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(delayBuckets...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	batchSize, err := meter.Int64Histogram(
 		"batch_size",
 		metric.WithDescription("Current number of reminders in the batch"),
@@ -67,6 +81,7 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 	return &Metrics{
 		SendDelay:    sendDelay,
 		NewSendDelay: newSendDelay,
+		ScheduleLag:  scheduleLag,
 		BatchSize:    batchSize,
 	}, nil
 }
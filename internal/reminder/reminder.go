@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/mindersec/minder/internal/db"
 	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
@@ -23,6 +26,19 @@ import (
 	"github.com/mindersec/minder/pkg/eventer/constants"
 )
 
+// entityTypes are the entity types reminders are sent for, in the order in
+// which they are processed
+var entityTypes = []db.Entities{
+	db.EntitiesRepository,
+	db.EntitiesArtifact,
+	db.EntitiesPullRequest,
+	db.EntitiesRelease,
+	db.EntitiesBuildEnvironment,
+	db.EntitiesPipelineRun,
+	db.EntitiesTaskRun,
+	db.EntitiesBuild,
+}
+
 // Interface is an interface over the reminder service
 type Interface interface {
 	// Start starts the reminder by sending reminders at regular intervals
@@ -39,7 +55,9 @@ type reminder struct {
 	stop     chan struct{}
 	stopOnce sync.Once
 
-	repositoryCursor uuid.UUID
+	// entityCursors holds, for each entity type, the ID of the last entity
+	// processed, as entities are iterated in ID order
+	entityCursors map[db.Entities]uuid.UUID
 
 	ticker *time.Ticker
 
@@ -59,9 +77,12 @@ func NewReminder(ctx context.Context, store db.Store, config *reminderconfig.Con
 	}
 
 	// Set to a random UUID to start
-	r.repositoryCursor = uuid.New()
+	r.entityCursors = make(map[db.Entities]uuid.UUID, len(entityTypes))
 	logger := zerolog.Ctx(ctx)
-	logger.Info().Msgf("initial repository cursor: %s", r.repositoryCursor)
+	for _, entityType := range entityTypes {
+		r.entityCursors[entityType] = uuid.New()
+		logger.Info().Msgf("initial %s cursor: %s", entityType, r.entityCursors[entityType])
+	}
 
 	pub, err := r.getMessagePublisher(ctx)
 	if err != nil {
@@ -150,50 +171,55 @@ func (r *reminder) Stop() {
 }
 
 func (r *reminder) sendReminders(ctx context.Context) error {
-	logger := zerolog.Ctx(ctx)
+	var errs []error
+	for _, entityType := range entityTypes {
+		if err := r.sendRemindersForEntityType(ctx, entityType); err != nil {
+			errs = append(errs, fmt.Errorf("error sending reminders for %s entities: %w", entityType, err))
+		}
+	}
+	return errors.Join(errs...)
+}
 
-	// Fetch a batch of repositories
-	repos, repoToLastUpdated, err := r.getRepositoryBatch(ctx)
+func (r *reminder) sendRemindersForEntityType(ctx context.Context, entityType db.Entities) error {
+	logger := zerolog.Ctx(ctx).With().Str("entity_type", string(entityType)).Logger()
+
+	// Fetch a batch of entities
+	entities, entityToDueEvals, err := r.getEntityBatch(ctx, entityType)
 	if err != nil {
-		return fmt.Errorf("error fetching repository batch: %w", err)
+		return fmt.Errorf("error fetching entity batch: %w", err)
 	}
 
-	if len(repos) == 0 {
-		logger.Debug().Msg("no repositories to send reminders for")
+	if len(entities) == 0 {
+		logger.Debug().Msg("no entities to send reminders for")
 		return nil
 	}
 
-	logger.Info().Msgf("created repository batch of size: %d", len(repos))
+	logger.Info().Msgf("created entity batch of size: %d", len(entities))
 
-	messages, err := createReminderMessages(ctx, repos)
+	messages, err := createReminderMessages(ctx, entities, entityToDueEvals)
 	if err != nil {
 		return fmt.Errorf("error creating reminder messages: %w", err)
 	}
 
+	attrs := metric.WithAttributes(attribute.String("entity_type", string(entityType)))
 	if r.metrics != nil {
-		r.metrics.BatchSize.Record(ctx, int64(len(repos)))
+		r.metrics.BatchSize.Record(ctx, int64(len(entities)), attrs)
 	}
 
-	err = r.eventPublisher.Publish(constants.TopicQueueRepoReminder, messages...)
+	err = r.eventPublisher.Publish(constants.TopicQueueEntityReminder, messages...)
 	if err != nil {
 		return fmt.Errorf("error publishing messages: %w", err)
 	}
 
-	repoIds := make([]uuid.UUID, len(repos))
-	for _, repo := range repos {
-		repoIds = append(repoIds, repo.ID)
+	entityIds := make([]uuid.UUID, 0, len(entities))
+	for _, entity := range entities {
+		entityIds = append(entityIds, entity.ID)
 		if r.metrics != nil {
-			sendDelay := time.Since(repoToLastUpdated[repo.ID]) - r.cfg.RecurrenceConfig.MinElapsed
-
-			recorder := r.metrics.SendDelay
-			if !repo.ReminderLastSent.Valid {
-				recorder = r.metrics.NewSendDelay
-			}
-			recorder.Record(ctx, sendDelay.Seconds())
+			r.recordDelays(ctx, entityType, entity, entityToDueEvals[entity.ID])
 		}
 	}
 
-	err = r.store.UpdateReminderLastSentForRepositories(ctx, repoIds)
+	err = r.store.UpdateReminderLastSentForEntities(ctx, entityIds)
 	if err != nil {
 		return fmt.Errorf("reminders published but error updating last sent time: %w", err)
 	}
@@ -201,108 +227,198 @@ func (r *reminder) sendReminders(ctx context.Context) error {
 	return nil
 }
 
-func (r *reminder) getRepositoryBatch(ctx context.Context) ([]db.Repository, map[uuid.UUID]time.Time, error) {
+// recordDelays records the time between the evaluations of an entity
+// becoming due and the reminder being sent
+func (r *reminder) recordDelays(
+	ctx context.Context, entityType db.Entities, entity db.ListEntitiesAfterIDRow, dueEvals []dueEvaluation,
+) {
+	now := time.Now()
+	attrs := metric.WithAttributes(attribute.String("entity_type", string(entityType)))
+
+	recorder := r.metrics.SendDelay
+	if !entity.ReminderLastSent.Valid {
+		recorder = r.metrics.NewSendDelay
+	}
+	recorder.Record(ctx, now.Sub(earliestDueAt(dueEvals)).Seconds(), attrs)
+
+	for _, dueEval := range dueEvals {
+		r.metrics.ScheduleLag.Record(ctx, now.Sub(dueEval.dueAt).Seconds(), metric.WithAttributes(
+			attribute.String("entity_type", string(entityType)),
+			attribute.Bool("profile_schedule", dueEval.scheduled),
+		))
+	}
+}
+
+func (r *reminder) getEntityBatch(ctx context.Context, entityType db.Entities) (
+	[]db.ListEntitiesAfterIDRow, map[uuid.UUID][]dueEvaluation, error,
+) {
 	logger := zerolog.Ctx(ctx)
 
-	logger.Debug().Msgf("fetching repositories after cursor: %s", r.repositoryCursor)
-	repos, err := r.store.ListRepositoriesAfterID(ctx, db.ListRepositoriesAfterIDParams{
-		ID:    r.repositoryCursor,
-		Limit: int64(r.cfg.RecurrenceConfig.BatchSize),
+	logger.Debug().Msgf("fetching %s entities after cursor: %s", entityType, r.entityCursors[entityType])
+	entities, err := r.store.ListEntitiesAfterID(ctx, db.ListEntitiesAfterIDParams{
+		EntityType: entityType,
+		ID:         r.entityCursors[entityType],
+		Limit:      int64(r.cfg.RecurrenceConfig.BatchSizeFor(string(entityType))),
 	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	eligibleRepos, eligibleReposLastUpdated, err := r.getEligibleRepositories(ctx, repos)
+	eligibleEntities, eligibleEntitiesDueEvals, err := r.getEligibleEntities(ctx, entityType, entities)
 	if err != nil {
 		return nil, nil, err
 	}
-	logger.Debug().Msgf("%d/%d repositories are eligible for reminders", len(eligibleRepos), len(repos))
+	logger.Debug().Msgf("%d/%d %s entities are eligible for reminders",
+		len(eligibleEntities), len(entities), entityType)
 
-	r.updateRepositoryCursor(ctx, repos)
+	r.updateEntityCursor(ctx, entityType, entities)
 
-	return eligibleRepos, eligibleReposLastUpdated, nil
+	return eligibleEntities, eligibleEntitiesDueEvals, nil
 }
 
-func (r *reminder) getEligibleRepositories(ctx context.Context, repos []db.Repository) (
-	[]db.Repository, map[uuid.UUID]time.Time, error,
+// getEligibleEntities returns the entities which have at least one profile
+// evaluation due for a refresh, and the due evaluations of each entity.  An
+// entity is not reminded again until a new evaluation becomes due, unless
+// the last reminder is older than the minimum elapsed time, in case it was lost.
+func (r *reminder) getEligibleEntities(ctx context.Context, entityType db.Entities, entities []db.ListEntitiesAfterIDRow) (
+	[]db.ListEntitiesAfterIDRow, map[uuid.UUID][]dueEvaluation, error,
 ) {
-	eligibleRepos := make([]db.Repository, 0, len(repos))
+	eligibleEntities := make([]db.ListEntitiesAfterIDRow, 0, len(entities))
 
-	// We have a slice of repositories, but the sqlc-generated code wants a slice of UUIDs,
+	// We have a slice of entities, but the sqlc-generated code wants a slice of UUIDs,
 	// and similarly returns slices of ID -> date (in possibly different order), so we need
 	// to do a bunch of mapping here.
-	repoIds := make([]uuid.UUID, 0, len(repos))
-	for _, repo := range repos {
-		repoIds = append(repoIds, repo.ID)
+	entityIds := make([]uuid.UUID, 0, len(entities))
+	for _, entity := range entities {
+		entityIds = append(entityIds, entity.ID)
 	}
-	oldestRuleEvals, err := r.store.ListOldestRuleEvaluationsByRepositoryId(ctx, repoIds)
+	oldestRuleEvals, err := r.store.ListOldestRuleEvaluationsByEntityId(ctx, entityIds)
 	if err != nil {
 		return nil, nil, err
 	}
-	idToLastUpdate := make(map[uuid.UUID]time.Time, len(oldestRuleEvals))
+
+	now := time.Now()
+	minElapsed := r.cfg.RecurrenceConfig.MinElapsedFor(string(entityType))
+	schedules := newScheduleParser()
+	idToDueEvals := make(map[uuid.UUID][]dueEvaluation, len(entities))
 	for _, ruleEval := range oldestRuleEvals {
-		idToLastUpdate[ruleEval.RepositoryID] = ruleEval.OldestLastUpdated
+		dueAt, scheduled := schedules.dueAt(ctx, ruleEval, minElapsed)
+		if dueAt.After(now) {
+			continue
+		}
+		idToDueEvals[ruleEval.EntityID] = append(idToDueEvals[ruleEval.EntityID], dueEvaluation{
+			profileID: ruleEval.ProfileID,
+			dueAt:     dueAt,
+			scheduled: scheduled,
+		})
 	}
 
-	cutoff := time.Now().Add(-1 * r.cfg.RecurrenceConfig.MinElapsed)
-	for _, repo := range repos {
-		if t, ok := idToLastUpdate[repo.ID]; ok && t.Before(cutoff) {
-			eligibleRepos = append(eligibleRepos, repo)
+	retryCutoff := now.Add(-1 * minElapsed)
+	for _, entity := range entities {
+		dueEvals, ok := idToDueEvals[entity.ID]
+		if !ok {
+			continue
 		}
+		if entity.ReminderLastSent.Valid && !entity.ReminderLastSent.Time.Before(retryCutoff) &&
+			!entity.ReminderLastSent.Time.Before(latestDueAt(dueEvals)) {
+			// already reminded since the evaluations became due
+			delete(idToDueEvals, entity.ID)
+			continue
+		}
+		eligibleEntities = append(eligibleEntities, entity)
 	}
 
-	return eligibleRepos, idToLastUpdate, nil
+	return eligibleEntities, idToDueEvals, nil
 }
 
-func (r *reminder) updateRepositoryCursor(ctx context.Context, repos []db.Repository) {
+func latestDueAt(dueEvals []dueEvaluation) time.Time {
+	var latest time.Time
+	for _, dueEval := range dueEvals {
+		if dueEval.dueAt.After(latest) {
+			latest = dueEval.dueAt
+		}
+	}
+	return latest
+}
+
+// dueProfileIDs returns the distinct profiles of the due evaluations
+func dueProfileIDs(dueEvals []dueEvaluation) []uuid.UUID {
+	profileIDs := make([]uuid.UUID, 0, len(dueEvals))
+	for _, dueEval := range dueEvals {
+		if !slices.Contains(profileIDs, dueEval.profileID) {
+			profileIDs = append(profileIDs, dueEval.profileID)
+		}
+	}
+	return profileIDs
+}
+
+func earliestDueAt(dueEvals []dueEvaluation) time.Time {
+	var earliest time.Time
+	for _, dueEval := range dueEvals {
+		if earliest.IsZero() || dueEval.dueAt.Before(earliest) {
+			earliest = dueEval.dueAt
+		}
+	}
+	return earliest
+}
+
+func (r *reminder) updateEntityCursor(ctx context.Context, entityType db.Entities, entities []db.ListEntitiesAfterIDRow) {
 	logger := zerolog.Ctx(ctx)
 
-	if len(repos) == 0 {
-		r.repositoryCursor = uuid.Nil
+	if len(entities) == 0 {
+		r.entityCursors[entityType] = uuid.Nil
 	} else {
-		r.repositoryCursor = repos[len(repos)-1].ID
-		r.adjustCursorForEndOfList(ctx)
+		r.entityCursors[entityType] = entities[len(entities)-1].ID
+		r.adjustCursorForEndOfList(ctx, entityType)
 	}
 
-	logger.Debug().Msgf("updated repository cursor to: %s", r.repositoryCursor)
+	logger.Debug().Msgf("updated %s cursor to: %s", entityType, r.entityCursors[entityType])
 }
 
-func (r *reminder) adjustCursorForEndOfList(ctx context.Context) {
+func (r *reminder) adjustCursorForEndOfList(ctx context.Context, entityType db.Entities) {
 	logger := zerolog.Ctx(ctx)
+	cursor := r.entityCursors[entityType]
 	// Check if the cursor is the last element in the db
-	exists, err := r.store.RepositoryExistsAfterID(ctx, r.repositoryCursor)
+	exists, err := r.store.EntityExistsAfterID(ctx, db.EntityExistsAfterIDParams{
+		EntityType: entityType,
+		ID:         cursor,
+	})
 	if err != nil {
-		logger.Error().Err(err).Msgf("unable to check if repository exists after cursor: %s"+
-			", resetting cursor to zero uuid", r.repositoryCursor)
-		r.repositoryCursor = uuid.Nil
+		logger.Error().Err(err).Msgf("unable to check if %s entity exists after cursor: %s"+
+			", resetting cursor to zero uuid", entityType, cursor)
+		r.entityCursors[entityType] = uuid.Nil
 		return
 	}
 
 	if !exists {
-		logger.Info().Msgf("cursor %s is at the end of the list, resetting cursor to zero uuid",
-			r.repositoryCursor)
-		r.repositoryCursor = uuid.Nil
+		logger.Info().Msgf("%s cursor %s is at the end of the list, resetting cursor to zero uuid",
+			entityType, cursor)
+		r.entityCursors[entityType] = uuid.Nil
 	}
 }
 
-func createReminderMessages(ctx context.Context, repos []db.Repository) ([]*message.Message, error) {
+// createReminderMessages creates the reminder messages of the given
+// entities, so that only the profiles whose evaluation is due are evaluated
+func createReminderMessages(
+	ctx context.Context, entities []db.ListEntitiesAfterIDRow, entityToDueEvals map[uuid.UUID][]dueEvaluation,
+) ([]*message.Message, error) {
 	logger := zerolog.Ctx(ctx)
 
-	messages := make([]*message.Message, 0, len(repos))
-	for _, repo := range repos {
-		repoReconcileMessage, err := remindermessages.NewEntityReminderMessage(
-			repo.ProviderID, repo.ID, repo.ProjectID,
+	messages := make([]*message.Message, 0, len(entities))
+	for _, entity := range entities {
+		reminderMessage, err := remindermessages.NewEntityReminderMessage(
+			entity.ProviderID, entity.ID, entity.ProjectID, dueProfileIDs(entityToDueEvals[entity.ID]),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error creating reminder message: %w", err)
 		}
 
 		logger.Debug().
-			Str("repo", repo.ID.String()).
+			Str("entity_type", string(entity.EntityType)).
+			Str("entity", entity.ID.String()).
 			Msg("created reminder message")
 
-		messages = append(messages, repoReconcileMessage)
+		messages = append(messages, reminderMessage)
 	}
 
 	return messages, nil
@@ -16,22 +16,27 @@ import (
 
 	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
+	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
 	reminderconfig "github.com/mindersec/minder/pkg/config/reminder"
 )
 
-func Test_getRepositoryBatch(t *testing.T) {
+func Test_getEntityBatch(t *testing.T) {
 	t.Parallel()
 
 	type expectedOutput struct {
-		repos      []db.Repository
-		repoCursor uuid.UUID
+		entities     []db.ListEntitiesAfterIDRow
+		entityCursor uuid.UUID
 	}
 
 	type input struct {
-		repos []db.Repository
-		cfg   reminderconfig.RecurrenceConfig
+		entities []db.ListEntitiesAfterIDRow
+		cfg      reminderconfig.RecurrenceConfig
 	}
 
+	remindedEntities := getEntitiesTillId(t, 3)
+	remindedEntities[0].ReminderLastSent = sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true}
+	remindedEntities[2].ReminderLastSent = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+
 	tests := []struct {
 		name           string
 		input          input
@@ -40,7 +45,7 @@ func Test_getRepositoryBatch(t *testing.T) {
 		err            string
 	}{
 		{
-			name: "no repos",
+			name: "no entities",
 			input: input{
 				cfg: reminderconfig.RecurrenceConfig{
 					BatchSize:  5,
@@ -48,12 +53,12 @@ func Test_getRepositoryBatch(t *testing.T) {
 				},
 			},
 			setup: func(store *mockdb.MockStore, _ input) {
-				store.EXPECT().ListRepositoriesAfterID(gomock.Any(), gomock.Any()).Return(nil, nil)
-				store.EXPECT().ListOldestRuleEvaluationsByRepositoryId(gomock.Any(), []uuid.UUID{}).Return(nil, nil)
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(nil, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), []uuid.UUID{}).Return(nil, nil)
 			},
 		},
 		{
-			name: "error listing repos",
+			name: "error listing entities",
 			input: input{
 				cfg: reminderconfig.RecurrenceConfig{
 					BatchSize:  5,
@@ -61,84 +66,176 @@ func Test_getRepositoryBatch(t *testing.T) {
 				},
 			},
 			setup: func(store *mockdb.MockStore, _ input) {
-				store.EXPECT().ListRepositoriesAfterID(gomock.Any(), gomock.Any()).Return(nil, sql.ErrConnDone)
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(nil, sql.ErrConnDone)
 			},
 			err: sql.ErrConnDone.Error(),
 		},
 		{
-			name: "repo exists after ID",
+			name: "entity exists after ID",
 			input: input{
-				repos: getReposTillId(t, 2),
+				entities: getEntitiesTillId(t, 2),
 				cfg: reminderconfig.RecurrenceConfig{
 					BatchSize:  5,
 					MinElapsed: time.Minute,
 				},
 			},
 			expectedOutput: expectedOutput{
-				repos:      getReposTillId(t, 2),
-				repoCursor: generateUUIDFromNum(t, 2),
+				entities:     getEntitiesTillId(t, 2),
+				entityCursor: generateUUIDFromNum(t, 2),
 			},
 			setup: func(store *mockdb.MockStore, in input) {
-				store.EXPECT().ListRepositoriesAfterID(gomock.Any(), gomock.Any()).Return(in.repos, nil)
-				store.EXPECT().ListOldestRuleEvaluationsByRepositoryId(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
-				store.EXPECT().RepositoryExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.entities, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.entities), nil)
+				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
 			},
 		},
 		{
-			name: "repo does not exist after ID",
+			name: "entity does not exist after ID",
 			input: input{
-				repos: getReposTillId(t, 2),
+				entities: getEntitiesTillId(t, 2),
 				cfg: reminderconfig.RecurrenceConfig{
 					BatchSize:  5,
 					MinElapsed: time.Minute,
 				},
 			},
 			expectedOutput: expectedOutput{
-				repos: getReposTillId(t, 2),
+				entities: getEntitiesTillId(t, 2),
 			},
 			setup: func(store *mockdb.MockStore, in input) {
-				store.EXPECT().ListRepositoriesAfterID(gomock.Any(), gomock.Any()).Return(in.repos, nil)
-				store.EXPECT().ListOldestRuleEvaluationsByRepositoryId(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
-				store.EXPECT().RepositoryExistsAfterID(gomock.Any(), gomock.Any()).Return(false, nil)
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.entities, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.entities), nil)
+				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(false, nil)
 			},
 		},
 		{
-			name: "error checking if repo exists after ID",
+			name: "error checking if entity exists after ID",
 			input: input{
-				repos: getReposTillId(t, 3),
+				entities: getEntitiesTillId(t, 3),
 				cfg: reminderconfig.RecurrenceConfig{
 					BatchSize:  5,
 					MinElapsed: time.Minute,
 				},
 			},
 			expectedOutput: expectedOutput{
-				repos: getReposTillId(t, 3),
+				entities: getEntitiesTillId(t, 3),
 			},
 			setup: func(store *mockdb.MockStore, in input) {
-				store.EXPECT().ListRepositoriesAfterID(gomock.Any(), gomock.Any()).Return(in.repos, nil)
-				store.EXPECT().ListOldestRuleEvaluationsByRepositoryId(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
-				store.EXPECT().RepositoryExistsAfterID(gomock.Any(), gomock.Any()).Return(false, sql.ErrConnDone)
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.entities, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.entities), nil)
+				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(false, sql.ErrConnDone)
 			},
 		},
 		{
-			name: "some repos are eligible",
+			name: "some entities are eligible",
 			input: input{
-				repos: getReposTillId(t, 3),
+				entities: getEntitiesTillId(t, 3),
 				cfg: reminderconfig.RecurrenceConfig{
 					BatchSize:  5,
 					MinElapsed: 10 * time.Minute,
 				},
 			},
 			expectedOutput: expectedOutput{
-				repos:      getReposTillId(t, 2),
-				repoCursor: generateUUIDFromNum(t, 3),
+				entities:     getEntitiesTillId(t, 2),
+				entityCursor: generateUUIDFromNum(t, 3),
 			},
 			setup: func(store *mockdb.MockStore, in input) {
-				store.EXPECT().ListRepositoriesAfterID(gomock.Any(), gomock.Any()).Return(in.repos, nil)
-				oldestRuleEvals := getStandardOldestRuleEvals(t, in.repos)
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.entities, nil)
+				oldestRuleEvals := getStandardOldestRuleEvals(t, in.entities)
 				oldestRuleEvals[2].OldestLastUpdated = time.Now().Add(-time.Second)
-				store.EXPECT().ListOldestRuleEvaluationsByRepositoryId(gomock.Any(), gomock.Any()).Return(oldestRuleEvals, nil)
-				store.EXPECT().RepositoryExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), gomock.Any()).Return(oldestRuleEvals, nil)
+				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name: "recently reminded entities are not eligible",
+			input: input{
+				entities: remindedEntities,
+				cfg: reminderconfig.RecurrenceConfig{
+					BatchSize:  5,
+					MinElapsed: 10 * time.Minute,
+				},
+			},
+			expectedOutput: expectedOutput{
+				entities:     remindedEntities[:2],
+				entityCursor: generateUUIDFromNum(t, 3),
+			},
+			setup: func(store *mockdb.MockStore, in input) {
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.entities, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.entities), nil)
+				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name: "profile schedules override the default",
+			input: input{
+				entities: getEntitiesTillId(t, 3),
+				cfg: reminderconfig.RecurrenceConfig{
+					BatchSize:  5,
+					MinElapsed: 24 * time.Hour,
+				},
+			},
+			expectedOutput: expectedOutput{
+				entities:     getEntitiesTillId(t, 3)[:2],
+				entityCursor: generateUUIDFromNum(t, 3),
+			},
+			setup: func(store *mockdb.MockStore, in input) {
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.entities, nil)
+				oldestRuleEvals := getStandardOldestRuleEvals(t, in.entities)
+				// hourly profile evaluated an hour ago
+				oldestRuleEvals[0].EvaluationSchedule = sql.NullString{String: "@every 30m", Valid: true}
+				// weekly profile on another entity, evaluated over a week ago
+				oldestRuleEvals[1].EvaluationSchedule = sql.NullString{String: "0 0 * * 0", Valid: true}
+				oldestRuleEvals[1].OldestLastUpdated = time.Now().Add(-8 * 24 * time.Hour)
+				// the third entity uses the default schedule, and is not yet due
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), gomock.Any()).Return(oldestRuleEvals, nil)
+				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name: "reminded since the profile evaluation became due",
+			input: input{
+				entities: remindedEntities,
+				cfg: reminderconfig.RecurrenceConfig{
+					BatchSize:  5,
+					MinElapsed: 24 * time.Hour,
+				},
+			},
+			expectedOutput: expectedOutput{
+				entities:     remindedEntities[:2],
+				entityCursor: generateUUIDFromNum(t, 3),
+			},
+			setup: func(store *mockdb.MockStore, in input) {
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.entities, nil)
+				oldestRuleEvals := getStandardOldestRuleEvals(t, in.entities)
+				for i := range oldestRuleEvals {
+					oldestRuleEvals[i].EvaluationSchedule = sql.NullString{String: "@every 10m", Valid: true}
+				}
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), gomock.Any()).Return(oldestRuleEvals, nil)
+				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name: "entity type overrides",
+			input: input{
+				entities: getEntitiesTillId(t, 2),
+				cfg: reminderconfig.RecurrenceConfig{
+					BatchSize:  5,
+					MinElapsed: time.Minute,
+					EntityTypes: map[string]reminderconfig.EntityRecurrenceConfig{
+						"repository": {BatchSize: 2, MinElapsed: 2 * time.Hour},
+					},
+				},
+			},
+			expectedOutput: expectedOutput{
+				entityCursor: generateUUIDFromNum(t, 2),
+			},
+			setup: func(store *mockdb.MockStore, in input) {
+				store.EXPECT().ListEntitiesAfterID(gomock.Any(), db.ListEntitiesAfterIDParams{
+					EntityType: db.EntitiesRepository,
+					Limit:      2,
+				}).Return(in.entities, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityId(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.entities), nil)
+				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
 			},
 		},
 	}
@@ -159,18 +256,50 @@ func Test_getRepositoryBatch(t *testing.T) {
 
 			r := createTestReminder(t, store, cfg)
 
-			got, _, err := r.getRepositoryBatch(context.Background())
+			got, _, err := r.getEntityBatch(context.Background(), db.EntitiesRepository)
 			if test.err != "" {
 				require.ErrorContains(t, err, test.err)
 				return
 			}
 			require.NoError(t, err)
-			require.ElementsMatch(t, got, test.expectedOutput.repos)
-			require.Equal(t, test.expectedOutput.repoCursor, r.repositoryCursor)
+			require.ElementsMatch(t, test.expectedOutput.entities, got)
+			require.Equal(t, test.expectedOutput.entityCursor, r.entityCursors[db.EntitiesRepository])
 		})
 	}
 }
 
+func Test_createReminderMessages(t *testing.T) {
+	t.Parallel()
+
+	entities := getEntitiesTillId(t, 2)
+	profileID1 := uuid.New()
+	profileID2 := uuid.New()
+	dueEvals := map[uuid.UUID][]dueEvaluation{
+		entities[0].ID: {
+			{profileID: profileID1},
+			{profileID: profileID2},
+			{profileID: profileID1},
+		},
+		entities[1].ID: {
+			{profileID: profileID2},
+		},
+	}
+
+	messages, err := createReminderMessages(context.Background(), entities, dueEvals)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+
+	evt, err := remindermessages.EntityReminderEventFromMessage(messages[0])
+	require.NoError(t, err)
+	require.Equal(t, entities[0].ID, evt.EntityID)
+	require.Equal(t, []uuid.UUID{profileID1, profileID2}, evt.ProfileIDs)
+
+	evt, err = remindermessages.EntityReminderEventFromMessage(messages[1])
+	require.NoError(t, err)
+	require.Equal(t, entities[1].ID, evt.EntityID)
+	require.Equal(t, []uuid.UUID{profileID2}, evt.ProfileIDs)
+}
+
 func generateUUIDFromNum(t *testing.T, num int) uuid.UUID {
 	t.Helper()
 
@@ -186,33 +315,37 @@ func generateUUIDFromNum(t *testing.T, num int) uuid.UUID {
 	return u
 }
 
-func getReposTillId(t *testing.T, id int) []db.Repository {
+func getEntitiesTillId(t *testing.T, id int) []db.ListEntitiesAfterIDRow {
 	t.Helper()
 
-	repos := make([]db.Repository, 0, id)
+	entities := make([]db.ListEntitiesAfterIDRow, 0, id)
 	for i := 1; i <= id; i++ {
-		repos = append(repos, db.Repository{ID: generateUUIDFromNum(t, i)})
+		entities = append(entities, db.ListEntitiesAfterIDRow{
+			ID:         generateUUIDFromNum(t, i),
+			EntityType: db.EntitiesRepository,
+		})
 	}
 
-	return repos
+	return entities
 }
 
 func createTestReminder(t *testing.T, store db.Store, config *reminderconfig.Config) *reminder {
 	t.Helper()
 
 	return &reminder{
-		store: store,
-		cfg:   config,
+		store:         store,
+		cfg:           config,
+		entityCursors: make(map[db.Entities]uuid.UUID),
 	}
 }
 
-func getStandardOldestRuleEvals(t *testing.T, repos []db.Repository) []db.ListOldestRuleEvaluationsByRepositoryIdRow {
+func getStandardOldestRuleEvals(t *testing.T, entities []db.ListEntitiesAfterIDRow) []db.ListOldestRuleEvaluationsByEntityIdRow {
 	t.Helper()
 
-	oldestRuleEvals := make([]db.ListOldestRuleEvaluationsByRepositoryIdRow, 0, len(repos))
-	for _, repo := range repos {
-		oldestRuleEvals = append(oldestRuleEvals, db.ListOldestRuleEvaluationsByRepositoryIdRow{
-			RepositoryID:      repo.ID,
+	oldestRuleEvals := make([]db.ListOldestRuleEvaluationsByEntityIdRow, 0, len(entities))
+	for _, entity := range entities {
+		oldestRuleEvals = append(oldestRuleEvals, db.ListOldestRuleEvaluationsByEntityIdRow{
+			EntityID:          entity.ID,
 			OldestLastUpdated: time.Now().Add(-time.Hour),
 		})
 	}
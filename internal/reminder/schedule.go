@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+// dueEvaluation is the evaluation of a profile against an entity which is
+// due for a refresh
+type dueEvaluation struct {
+	profileID uuid.UUID
+	// dueAt is the time at which the evaluation became due
+	dueAt time.Time
+	// scheduled is true when the profile sets its own evaluation schedule
+	scheduled bool
+}
+
+// scheduleParser parses and caches the evaluation schedules of profiles,
+// which are stored as cron specs
+type scheduleParser struct {
+	schedules map[string]cron.Schedule
+}
+
+func newScheduleParser() *scheduleParser {
+	return &scheduleParser{schedules: make(map[string]cron.Schedule)}
+}
+
+// dueAt returns the time at which the profile evaluation becomes due again,
+// and whether the profile's own schedule was used. Profiles without a
+// schedule, or with a schedule which can't be parsed, are due once
+// minElapsed has passed since the last evaluation.
+func (sp *scheduleParser) dueAt(
+	ctx context.Context,
+	eval db.ListOldestRuleEvaluationsByEntityIdRow,
+	minElapsed time.Duration,
+) (time.Time, bool) {
+	defaultDue := eval.OldestLastUpdated.Add(minElapsed)
+	if !eval.EvaluationSchedule.Valid || eval.EvaluationSchedule.String == "" {
+		return defaultDue, false
+	}
+
+	spec := eval.EvaluationSchedule.String
+	sched, ok := sp.schedules[spec]
+	if !ok {
+		var err error
+		sched, err = cron.ParseStandard(spec)
+		if err != nil {
+			// Schedules are validated when the profile is created, so this
+			// should not happen.
+			zerolog.Ctx(ctx).Error().Err(err).
+				Str("profile_id", eval.ProfileID.String()).
+				Msg("unable to parse profile evaluation schedule, using default")
+			return defaultDue, false
+		}
+		sp.schedules[spec] = sched
+	}
+
+	return sched.Next(eval.OldestLastUpdated), true
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reminder
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+func Test_scheduleParser_dueAt(t *testing.T) {
+	t.Parallel()
+
+	lastUpdated := time.Date(2025, 3, 5, 10, 30, 0, 0, time.UTC) // a Wednesday
+
+	tests := []struct {
+		name          string
+		schedule      sql.NullString
+		expectedDue   time.Time
+		expectedSched bool
+	}{
+		{
+			name:        "no schedule uses min elapsed",
+			expectedDue: lastUpdated.Add(time.Hour),
+		},
+		{
+			name:          "interval",
+			schedule:      sql.NullString{String: "@every 15m", Valid: true},
+			expectedDue:   lastUpdated.Add(15 * time.Minute),
+			expectedSched: true,
+		},
+		{
+			name:          "cron",
+			schedule:      sql.NullString{String: "0 6 * * 1", Valid: true},
+			expectedDue:   time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC),
+			expectedSched: true,
+		},
+		{
+			name:        "invalid schedule uses min elapsed",
+			schedule:    sql.NullString{String: "every monday", Valid: true},
+			expectedDue: lastUpdated.Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := newScheduleParser()
+			due, scheduled := sp.dueAt(context.Background(), db.ListOldestRuleEvaluationsByEntityIdRow{
+				EvaluationSchedule: tt.schedule,
+				OldestLastUpdated:  lastUpdated,
+			}, time.Hour)
+			require.Equal(t, tt.expectedDue, due)
+			require.Equal(t, tt.expectedSched, scheduled)
+		})
+	}
+}
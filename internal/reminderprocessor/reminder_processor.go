@@ -8,9 +8,10 @@ import (
 	"fmt"
 
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 
-	reconcilermessages "github.com/mindersec/minder/internal/reconcilers/messages"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
 	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
 	"github.com/mindersec/minder/pkg/eventer/constants"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
@@ -28,6 +29,8 @@ func NewReminderProcessor(evt interfaces.Interface) *ReminderProcessor {
 
 // Register implements the Consumer interface.
 func (rp *ReminderProcessor) Register(r interfaces.Registrar) {
+	r.Register(constants.TopicQueueEntityReminder, rp.reminderMessageHandler)
+	// Drain reminders published by older versions of the reminder service
 	r.Register(constants.TopicQueueRepoReminder, rp.reminderMessageHandler)
 }
 
@@ -39,14 +42,24 @@ func (rp *ReminderProcessor) reminderMessageHandler(msg *message.Message) error
 
 	log.Info().Msgf("Received reminder event: %v", evt)
 
-	repoReconcileMsg, err := reconcilermessages.NewRepoReconcilerMessage(evt.ProviderID, evt.EntityID, evt.Project)
-	if err != nil {
-		return fmt.Errorf("error creating repo reconcile event: %w", err)
+	if evt.EntityID == uuid.Nil {
+		// no point in retrying
+		log.Error().Msg("reminder event has no entity ID")
+		return nil
+	}
+
+	entRefresh := entityMessage.NewEntityRefreshAndDoMessage().
+		WithEntityID(evt.EntityID).
+		WithProfileIDs(evt.ProfileIDs)
+
+	m := message.NewMessage(uuid.New().String(), nil)
+	if err := entRefresh.ToMessage(m); err != nil {
+		return fmt.Errorf("error creating entity refresh event: %w", err)
 	}
 
 	// This is a non-fatal error, so we'll just log it and continue with the next ones
-	if err := rp.evt.Publish(constants.TopicQueueReconcileRepoInit, repoReconcileMsg); err != nil {
-		log.Printf("error publishing reconciler event: %v", err)
+	if err := rp.evt.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, m); err != nil {
+		log.Printf("error publishing entity refresh event: %v", err)
 	}
 	return nil
 }
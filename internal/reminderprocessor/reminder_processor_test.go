@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reminderprocessor
+
+import (
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestReminderMessageHandler(t *testing.T) {
+	t.Parallel()
+
+	entityID := uuid.New()
+	profileIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name       string
+		msg        func(t *testing.T) *message.Message
+		expectSent bool
+		err        bool
+	}{
+		{
+			name: "publishes entity refresh",
+			msg: func(t *testing.T) *message.Message {
+				t.Helper()
+				msg, err := remindermessages.NewEntityReminderMessage(uuid.New(), entityID, uuid.New(), profileIDs)
+				require.NoError(t, err)
+				return msg
+			},
+			expectSent: true,
+		},
+		{
+			name: "nil entity ID is dropped",
+			msg: func(t *testing.T) *message.Message {
+				t.Helper()
+				msg, err := remindermessages.NewEntityReminderMessage(uuid.New(), uuid.Nil, uuid.New(), nil)
+				require.NoError(t, err)
+				return msg
+			},
+		},
+		{
+			name: "invalid payload",
+			msg: func(_ *testing.T) *message.Message {
+				return message.NewMessage(uuid.New().String(), []byte("not json"))
+			},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			evt := &stubs.StubEventer{}
+			rp := NewReminderProcessor(evt)
+
+			err := rp.reminderMessageHandler(tt.msg(t))
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if !tt.expectSent {
+				require.Empty(t, evt.Sent)
+				return
+			}
+			require.Equal(t, []string{constants.TopicQueueRefreshEntityByIDAndEvaluate}, evt.Topics)
+			require.Len(t, evt.Sent, 1)
+
+			refresh, err := entityMessage.ToEntityRefreshAndDo(evt.Sent[0])
+			require.NoError(t, err)
+			require.Equal(t, entityID, refresh.Entity.EntityID)
+			require.Equal(t, profileIDs, refresh.ProfileIDs)
+		})
+	}
+}
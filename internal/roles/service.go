@@ -113,7 +113,7 @@ func (*roleService) UpdateRoleAssignment(ctx context.Context, qtx db.Querier, au
 
 	for _, a := range as {
 		if a.Subject == identity.String() {
-			roleToDelete, err := authz.ParseRole(a.Role)
+			roleToDelete, err := authz.ParseRoleName(a.Role)
 			if err != nil {
 				return nil, util.UserVisibleError(codes.Internal, "%s", err.Error())
 			}
@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sarif
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// Finding is a failed evaluation of a rule against an entity.
+type Finding struct {
+	// RuleType is the name of the rule type, used as SARIF rule id.
+	RuleType string
+	// RuleTypeDisplayName is the human readable name of the rule type.
+	RuleTypeDisplayName string
+	// Guidance is the rule type guidance, in markdown.
+	Guidance string
+	// Severity is the severity of the rule type.
+	Severity minderv1.Severity_Value
+	// RuleName is the name of the rule within the profile.
+	RuleName string
+	// Profile is the name of the profile the rule belongs to.
+	Profile string
+	// EntityType is the type of the evaluated entity.
+	EntityType string
+	// EntityName is the name of the evaluated entity.
+	EntityName string
+	// EntityID is the ID of the evaluated entity.
+	EntityID string
+	// Details are the evaluation details.
+	Details string
+}
+
+// Builder accumulates findings into a SARIF log.
+type Builder struct {
+	version   string
+	rules     []*ReportingDescriptor
+	ruleIndex map[string]int
+	results   []*Result
+}
+
+// NewBuilder creates a Builder for a log produced by the given
+// version of Minder, which may be empty.
+func NewBuilder(version string) *Builder {
+	return &Builder{
+		version:   version,
+		ruleIndex: make(map[string]int),
+	}
+}
+
+// Add records a finding. Rule types are added as SARIF rules the first
+// time they are seen, and findings whose details are a JSON list of
+// violations, as produced by rego rules with `violation_format: json`,
+// are reported as one result per violation.
+func (b *Builder) Add(f Finding) {
+	idx, ok := b.ruleIndex[f.RuleType]
+	if !ok {
+		idx = len(b.rules)
+		b.ruleIndex[f.RuleType] = idx
+		b.rules = append(b.rules, ruleFromFinding(f))
+	}
+
+	for _, msg := range messagesFromDetails(f) {
+		b.results = append(b.results, &Result{
+			RuleID:    f.RuleType,
+			RuleIndex: idx,
+			Level:     levelFromSeverity(f.Severity),
+			Message:   msg,
+			Locations: []*Location{locationFromFinding(f)},
+			Properties: map[string]any{
+				"profile":     f.Profile,
+				"rule_name":   f.RuleName,
+				"entity_id":   f.EntityID,
+				"entity_type": f.EntityType,
+			},
+		})
+	}
+}
+
+// Log returns the SARIF log of the findings added so far.
+func (b *Builder) Log() *Log {
+	rules := b.rules
+	if rules == nil {
+		rules = []*ReportingDescriptor{}
+	}
+	results := b.results
+	if results == nil {
+		results = []*Result{}
+	}
+
+	return &Log{
+		Schema:  Schema,
+		Version: Version,
+		Runs: []*Run{
+			{
+				Tool: Tool{
+					Driver: Driver{
+						Name:           toolName,
+						Version:        b.version,
+						InformationURI: toolInformationURI,
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+func ruleFromFinding(f Finding) *ReportingDescriptor {
+	name := f.RuleTypeDisplayName
+	if name == "" {
+		name = f.RuleType
+	}
+
+	rule := &ReportingDescriptor{
+		ID:               f.RuleType,
+		Name:             name,
+		ShortDescription: &Message{Text: name},
+		DefaultConfiguration: &ReportingConfiguration{
+			Level: levelFromSeverity(f.Severity),
+		},
+		Properties: map[string]any{
+			"tags": []string{"minder", f.EntityType},
+		},
+	}
+	if f.Guidance != "" {
+		rule.Help = &Message{Text: f.Guidance, Markdown: f.Guidance}
+	}
+	if score, ok := securitySeverity(f.Severity); ok {
+		rule.Properties["security-severity"] = score
+	}
+	return rule
+}
+
+// levelFromSeverity maps rule type severities to SARIF levels.
+func levelFromSeverity(sev minderv1.Severity_Value) Level {
+	//nolint:exhaustive // everything else is a warning
+	switch sev {
+	case minderv1.Severity_VALUE_CRITICAL, minderv1.Severity_VALUE_HIGH:
+		return LevelError
+	case minderv1.Severity_VALUE_LOW, minderv1.Severity_VALUE_INFO:
+		return LevelNote
+	default:
+		return LevelWarning
+	}
+}
+
+// securitySeverity maps rule type severities to the CVSS-like scores
+// code scanning dashboards use to rank security findings.
+func securitySeverity(sev minderv1.Severity_Value) (string, bool) {
+	//nolint:exhaustive // unknown severities have no score
+	switch sev {
+	case minderv1.Severity_VALUE_CRITICAL:
+		return "9.5", true
+	case minderv1.Severity_VALUE_HIGH:
+		return "8.0", true
+	case minderv1.Severity_VALUE_MEDIUM:
+		return "5.5", true
+	case minderv1.Severity_VALUE_LOW:
+		return "2.0", true
+	case minderv1.Severity_VALUE_INFO:
+		return "0.0", true
+	default:
+		return "", false
+	}
+}
+
+func locationFromFinding(f Finding) *Location {
+	name := f.EntityName
+	if name == "" {
+		name = f.EntityID
+	}
+	return &Location{
+		PhysicalLocation: &PhysicalLocation{
+			ArtifactLocation: ArtifactLocation{URI: name},
+		},
+		LogicalLocations: []*LogicalLocation{
+			{
+				Name:               name,
+				FullyQualifiedName: fmt.Sprintf("%s/%s", f.EntityType, name),
+				Kind:               f.EntityType,
+			},
+		},
+	}
+}
+
+// messagesFromDetails returns one message per violation when the
+// details are a JSON list of objects, and a single message otherwise.
+func messagesFromDetails(f Finding) []Message {
+	details := strings.TrimSpace(f.Details)
+
+	var violations []map[string]any
+	if strings.HasPrefix(details, "[") &&
+		json.Unmarshal([]byte(details), &violations) == nil &&
+		len(violations) > 0 {
+		msgs := make([]Message, 0, len(violations))
+		for _, v := range violations {
+			msgs = append(msgs, messageFromViolation(v))
+		}
+		return msgs
+	}
+
+	if details == "" {
+		details = fmt.Sprintf("Rule %s failed", f.RuleName)
+	}
+	return []Message{{Text: details}}
+}
+
+func messageFromViolation(v map[string]any) Message {
+	if msg, ok := v["msg"].(string); ok && msg != "" {
+		return Message{Text: msg}
+	}
+	// keep the whole violation when there is no explicit message
+	out, err := json.Marshal(v)
+	if err != nil {
+		return Message{Text: fmt.Sprint(v)}
+	}
+	return Message{Text: string(out)}
+}
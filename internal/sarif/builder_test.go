@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sarif
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestBuilderAdd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		findings        []Finding
+		expectRules     []string
+		expectMessages  []string
+		expectLevels    []Level
+		expectLocations []string
+	}{
+		{
+			name: "plain details",
+			findings: []Finding{
+				{
+					RuleType:   "secret_scanning",
+					Severity:   minderv1.Severity_VALUE_HIGH,
+					RuleName:   "secret_scanning",
+					EntityType: "repository",
+					EntityName: "owner/repo",
+					Details:    "secret scanning is disabled",
+				},
+			},
+			expectRules:     []string{"secret_scanning"},
+			expectMessages:  []string{"secret scanning is disabled"},
+			expectLevels:    []Level{LevelError},
+			expectLocations: []string{"owner/repo"},
+		},
+		{
+			name: "json violations are split",
+			findings: []Finding{
+				{
+					RuleType:   "actions_check",
+					Severity:   minderv1.Severity_VALUE_LOW,
+					EntityType: "repository",
+					EntityName: "owner/repo",
+					Details:    `[{"msg": "action foo is not allowed"}, {"action": "bar"}]`,
+				},
+			},
+			expectRules:     []string{"actions_check"},
+			expectMessages:  []string{"action foo is not allowed", `{"action":"bar"}`},
+			expectLevels:    []Level{LevelNote, LevelNote},
+			expectLocations: []string{"owner/repo", "owner/repo"},
+		},
+		{
+			name: "rules are deduplicated",
+			findings: []Finding{
+				{RuleType: "rule_a", RuleName: "a1", EntityType: "repository", EntityName: "owner/one"},
+				{RuleType: "rule_b", RuleName: "b1", EntityType: "artifact", EntityID: "some-id"},
+				{RuleType: "rule_a", RuleName: "a2", EntityType: "repository", EntityName: "owner/two"},
+			},
+			expectRules:     []string{"rule_a", "rule_b"},
+			expectMessages:  []string{"Rule a1 failed", "Rule b1 failed", "Rule a2 failed"},
+			expectLevels:    []Level{LevelWarning, LevelWarning, LevelWarning},
+			expectLocations: []string{"owner/one", "some-id", "owner/two"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := NewBuilder("v1.0.0")
+			for _, f := range tt.findings {
+				b.Add(f)
+			}
+			log := b.Log()
+
+			require.Equal(t, Version, log.Version)
+			require.Len(t, log.Runs, 1)
+			run := log.Runs[0]
+			require.Equal(t, "v1.0.0", run.Tool.Driver.Version)
+
+			rules := make([]string, 0, len(run.Tool.Driver.Rules))
+			for _, r := range run.Tool.Driver.Rules {
+				rules = append(rules, r.ID)
+			}
+			require.Equal(t, tt.expectRules, rules)
+
+			require.Len(t, run.Results, len(tt.expectMessages))
+			for i, res := range run.Results {
+				require.Equal(t, tt.expectMessages[i], res.Message.Text)
+				require.Equal(t, tt.expectLevels[i], res.Level)
+				require.Equal(t, tt.expectLocations[i], res.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+				require.Equal(t, res.RuleID, run.Tool.Driver.Rules[res.RuleIndex].ID)
+			}
+		})
+	}
+}
+
+func TestRuleFromFinding(t *testing.T) {
+	t.Parallel()
+
+	rule := ruleFromFinding(Finding{
+		RuleType:            "secret_scanning",
+		RuleTypeDisplayName: "Secret scanning is enabled",
+		Guidance:            "Enable **secret scanning**",
+		Severity:            minderv1.Severity_VALUE_CRITICAL,
+		EntityType:          "repository",
+	})
+
+	require.Equal(t, "secret_scanning", rule.ID)
+	require.Equal(t, "Secret scanning is enabled", rule.Name)
+	require.Equal(t, "Enable **secret scanning**", rule.Help.Markdown)
+	require.Equal(t, LevelError, rule.DefaultConfiguration.Level)
+	require.Equal(t, "9.5", rule.Properties["security-severity"])
+
+	rule = ruleFromFinding(Finding{RuleType: "other", EntityType: "repository"})
+	require.Equal(t, "other", rule.Name)
+	require.Nil(t, rule.Help)
+	require.NotContains(t, rule.Properties, "security-severity")
+}
+
+func TestEmptyLogMarshalling(t *testing.T) {
+	t.Parallel()
+
+	out, err := json.Marshal(NewBuilder("").Log())
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": [{
+			"tool": {"driver": {"name": "minder", "informationUri": "https://github.com/mindersec/minder", "rules": []}},
+			"results": []
+		}]
+	}`, string(out))
+}
+
+func TestAddRuleEvaluationStatuses(t *testing.T) {
+	t.Parallel()
+
+	b := NewBuilder("")
+	b.AddRuleEvaluationStatuses("profile", []*minderv1.RuleEvaluationStatus{
+		{
+			Status:              "failure",
+			RuleTypeName:        "secret_scanning",
+			RuleDescriptionName: "secret_scanning_rule",
+			Entity:              "repository",
+			EntityInfo: map[string]string{
+				"repo_owner": "owner",
+				"repo_name":  "repo",
+				"entity_id":  "id",
+			},
+			Severity: &minderv1.Severity{Value: minderv1.Severity_VALUE_MEDIUM},
+			Details:  "disabled",
+		},
+		{
+			Status:       "success",
+			RuleTypeName: "branch_protection",
+			Entity:       "repository",
+		},
+	})
+
+	run := b.Log().Runs[0]
+	require.Len(t, run.Tool.Driver.Rules, 1)
+	require.Len(t, run.Results, 1)
+	require.Equal(t, "owner/repo", run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.Equal(t, "profile", run.Results[0].Properties["profile"])
+	require.Equal(t, "secret_scanning_rule", run.Results[0].Properties["rule_name"])
+	require.Equal(t, LevelWarning, run.Results[0].Level)
+}
+
+func TestAddEvaluationHistory(t *testing.T) {
+	t.Parallel()
+
+	b := NewBuilder("")
+	b.AddEvaluationHistory([]*minderv1.EvaluationHistory{
+		{
+			Entity: &minderv1.EvaluationHistoryEntity{
+				Id:   "id",
+				Type: minderv1.Entity_ENTITY_REPOSITORIES,
+				Name: "owner/repo",
+			},
+			Rule: &minderv1.EvaluationHistoryRule{
+				Name:     "rule",
+				RuleType: "rule_type",
+				Profile:  "profile",
+				Severity: &minderv1.Severity{Value: minderv1.Severity_VALUE_HIGH},
+			},
+			Status: &minderv1.EvaluationHistoryStatus{Status: "failure", Details: "oops"},
+		},
+		{
+			Entity: &minderv1.EvaluationHistoryEntity{Type: minderv1.Entity_ENTITY_REPOSITORIES},
+			Rule:   &minderv1.EvaluationHistoryRule{RuleType: "rule_type"},
+			Status: &minderv1.EvaluationHistoryStatus{Status: "error"},
+		},
+	})
+
+	run := b.Log().Runs[0]
+	require.Len(t, run.Results, 1)
+	require.Equal(t, "rule_type", run.Results[0].RuleID)
+	require.Equal(t, "oops", run.Results[0].Message.Text)
+	require.Equal(t, "repository", run.Results[0].Locations[0].LogicalLocations[0].Kind)
+	require.Equal(t, LevelError, run.Results[0].Level)
+}
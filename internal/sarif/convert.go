@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sarif
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// AddRuleEvaluationStatuses adds the failed rule evaluations of a
+// profile to the log.
+func (b *Builder) AddRuleEvaluationStatuses(profile string, statuses []*minderv1.RuleEvaluationStatus) {
+	for _, st := range statuses {
+		if st.GetStatus() != string(db.EvalStatusTypesFailure) {
+			continue
+		}
+		b.Add(Finding{
+			RuleType:            st.GetRuleTypeName(),
+			RuleTypeDisplayName: st.GetRuleDisplayName(),
+			Guidance:            st.GetGuidance(),
+			Severity:            st.GetSeverity().GetValue(),
+			//nolint:staticcheck // rule_name is still set by some endpoints
+			RuleName:   cmp.Or(st.GetRuleDescriptionName(), st.GetRuleName()),
+			Profile:    profile,
+			EntityType: st.GetEntity(),
+			EntityName: entityNameFromInfo(st.GetEntityInfo()),
+			EntityID:   st.GetEntityInfo()["entity_id"],
+			Details:    st.GetDetails(),
+		})
+	}
+}
+
+// AddEvaluationHistory adds the failed evaluations of a history
+// listing to the log.
+func (b *Builder) AddEvaluationHistory(evals []*minderv1.EvaluationHistory) {
+	for _, eval := range evals {
+		if eval.GetStatus().GetStatus() != string(db.EvalStatusTypesFailure) {
+			continue
+		}
+		b.Add(Finding{
+			RuleType:   eval.GetRule().GetRuleType(),
+			Severity:   eval.GetRule().GetSeverity().GetValue(),
+			RuleName:   eval.GetRule().GetName(),
+			Profile:    eval.GetRule().GetProfile(),
+			EntityType: eval.GetEntity().GetType().ToString(),
+			EntityName: eval.GetEntity().GetName(),
+			EntityID:   eval.GetEntity().GetId(),
+			Details:    eval.GetStatus().GetDetails(),
+		})
+	}
+}
+
+func entityNameFromInfo(info map[string]string) string {
+	if name := info["name"]; name != "" {
+		return name
+	}
+	if owner, name := info["repo_owner"], info["repo_name"]; owner != "" && name != "" {
+		return fmt.Sprintf("%s/%s", owner, name)
+	}
+	return info["artifact_name"]
+}
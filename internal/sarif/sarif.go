@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sarif converts evaluation results into SARIF 2.1.0 logs, so
+// that they can be uploaded to code scanning dashboards.
+package sarif
+
+const (
+	// Version is the version of the SARIF specification implemented.
+	Version = "2.1.0"
+	// Schema is the JSON schema of the SARIF specification implemented.
+	Schema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	toolName           = "minder"
+	toolInformationURI = "https://github.com/mindersec/minder"
+)
+
+// Level is the level of a SARIF result.
+type Level string
+
+const (
+	// LevelError is used for results of high and critical severity.
+	LevelError Level = "error"
+	// LevelWarning is used for results of medium or unknown severity.
+	LevelWarning Level = "warning"
+	// LevelNote is used for results of low and informational severity.
+	LevelNote Level = "note"
+)
+
+// Log is the top-level object of a SARIF file.
+type Log struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []*Run `json:"runs"`
+}
+
+// Run is a single invocation of an analysis tool.
+type Run struct {
+	Tool    Tool      `json:"tool"`
+	Results []*Result `json:"results"`
+}
+
+// Tool describes the analysis tool which produced a run.
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+// Driver describes the main component of the analysis tool.
+type Driver struct {
+	Name           string                 `json:"name"`
+	Version        string                 `json:"version,omitempty"`
+	InformationURI string                 `json:"informationUri"`
+	Rules          []*ReportingDescriptor `json:"rules"`
+}
+
+// ReportingDescriptor describes a rule, i.e. a Minder rule type.
+type ReportingDescriptor struct {
+	ID                   string                  `json:"id"`
+	Name                 string                  `json:"name,omitempty"`
+	ShortDescription     *Message                `json:"shortDescription,omitempty"`
+	FullDescription      *Message                `json:"fullDescription,omitempty"`
+	Help                 *Message                `json:"help,omitempty"`
+	DefaultConfiguration *ReportingConfiguration `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]any          `json:"properties,omitempty"`
+}
+
+// ReportingConfiguration is the default configuration of a rule.
+type ReportingConfiguration struct {
+	Level Level `json:"level"`
+}
+
+// Message is a plain text and optionally markdown message.
+type Message struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+// Result is a single finding.
+type Result struct {
+	RuleID     string         `json:"ruleId"`
+	RuleIndex  int            `json:"ruleIndex"`
+	Level      Level          `json:"level"`
+	Message    Message        `json:"message"`
+	Locations  []*Location    `json:"locations,omitempty"`
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+// Location is where a result was detected.
+type Location struct {
+	PhysicalLocation *PhysicalLocation  `json:"physicalLocation,omitempty"`
+	LogicalLocations []*LogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+// PhysicalLocation is the artifact a result was detected in.
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+}
+
+// ArtifactLocation identifies an artifact by URI.
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// LogicalLocation identifies an entity by name and kind.
+type LogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind"`
+}
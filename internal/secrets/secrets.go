@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secrets stores and resolves the secrets of projects, which
+// REST data sources use to authenticate their requests.
+package secrets
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+)
+
+// ErrSecretNotFound is returned when the project has no secret with the
+// requested name
+var ErrSecretNotFound = errors.New("secret not found")
+
+// Resolver looks up the secrets of projects
+type Resolver interface {
+	// GetSecret returns the value of the named secret of the project.
+	// Secrets are not inherited: callers pass the project owning the data
+	// source using the secret, so that the data sources of child projects
+	// cannot send the secrets of their parents to other hosts.
+	GetSecret(ctx context.Context, project uuid.UUID, name string) (string, error)
+}
+
+type dbResolver struct {
+	store        db.Querier
+	cryptoEngine crypto.Engine
+}
+
+// NewResolver creates a Resolver which reads the encrypted secrets stored
+// in the database
+func NewResolver(store db.Querier, cryptoEngine crypto.Engine) Resolver {
+	return &dbResolver{
+		store:        store,
+		cryptoEngine: cryptoEngine,
+	}
+}
+
+func (r *dbResolver) GetSecret(ctx context.Context, project uuid.UUID, name string) (string, error) {
+	found, err := r.store.GetProjectSecretByName(ctx, db.GetProjectSecretByNameParams{
+		ProjectID: project,
+		Name:      name,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: %s", ErrSecretNotFound, name)
+	} else if err != nil {
+		return "", fmt.Errorf("error getting secret: %w", err)
+	}
+
+	value, err := crypto.DeserializeAndDecrypt(r.cryptoEngine, found.EncryptedValue)
+	if err != nil {
+		return "", fmt.Errorf("error decrypting secret: %w", err)
+	}
+	return value, nil
+}
@@ -6,10 +6,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/open-feature/go-sdk/openfeature"
+	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/mindersec/minder/internal/auth"
@@ -25,16 +27,22 @@ import (
 	"github.com/mindersec/minder/internal/email/noop"
 	"github.com/mindersec/minder/internal/email/sendgrid"
 	"github.com/mindersec/minder/internal/engine"
+	alertwebhook "github.com/mindersec/minder/internal/engine/actions/alert/webhook"
+	"github.com/mindersec/minder/internal/engine/ingestcache"
 	"github.com/mindersec/minder/internal/entities/handlers"
 	propService "github.com/mindersec/minder/internal/entities/properties/service"
 	entityService "github.com/mindersec/minder/internal/entities/service"
+	"github.com/mindersec/minder/internal/events/dlq"
+	"github.com/mindersec/minder/internal/export"
 	"github.com/mindersec/minder/internal/history"
+	"github.com/mindersec/minder/internal/history/retention"
 	"github.com/mindersec/minder/internal/invites"
 	"github.com/mindersec/minder/internal/marketplaces"
 	"github.com/mindersec/minder/internal/metrics/meters"
 	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/providers"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
+	giteamanager "github.com/mindersec/minder/internal/providers/gitea/manager"
 	ghprov "github.com/mindersec/minder/internal/providers/github"
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/github/installations"
@@ -49,6 +57,7 @@ import (
 	"github.com/mindersec/minder/internal/reminderprocessor"
 	"github.com/mindersec/minder/internal/repositories"
 	"github.com/mindersec/minder/internal/roles"
+	"github.com/mindersec/minder/internal/secrets"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/engine/selectors"
 	"github.com/mindersec/minder/pkg/eventer"
@@ -159,6 +168,23 @@ func AllInOneServerService(
 		provmans = append(provmans, gitlabProviderManager)
 	}
 
+	if flags.Bool(ctx, featureFlagClient, flags.GiteaProvider) {
+		giteaProviderManager, err := giteamanager.NewGiteaProviderClassManager(
+			ctx,
+			cryptoEngine,
+			store,
+			evt,
+			cfg.Provider.Gitea,
+			cfg.Provider.Git,
+			cfg.WebhookConfig,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create gitea provider manager: %w", err)
+		}
+
+		provmans = append(provmans, giteaProviderManager)
+	}
+
 	providerManager, closer, err := manager.NewProviderManager(ctx, providerStore,
 		provmans...)
 	if err != nil {
@@ -176,6 +202,63 @@ func AllInOneServerService(
 	sessionsService := session.NewProviderSessionService(providerManager, providerStore, store)
 	entSvc := entityService.NewEntityService(store, propSvc, providerManager)
 
+	executorMetrics, err := engine.NewExecutorMetrics(meterFactory)
+	if err != nil {
+		return fmt.Errorf("unable to create metrics for executor: %w", err)
+	}
+
+	profileStore := profiles.NewProfileStore(store)
+	selEnv := selectors.NewEnv()
+
+	sharedIngestCache, err := ingestcache.NewSharedCacheFromConfig(&cfg.IngestCache)
+	if err != nil {
+		return fmt.Errorf("unable to create shared ingest cache: %w", err)
+	}
+
+	// Export evaluation status changes to an external sink, if configured
+	var exportPub interfaces.Publisher
+	if cfg.Export.Sink != "" {
+		exporter, err := export.NewExporter(ctx, &cfg.Export)
+		if err != nil {
+			return fmt.Errorf("unable to create status exporter: %w", err)
+		}
+		defer func() {
+			if err := exporter.Close(); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error closing status exporter")
+			}
+		}()
+		evt.ConsumeEvents(exporter)
+		exportPub = evt
+	}
+
+	// The executor is used both to handle entity evaluations and by the
+	// control plane to dry run profiles
+	exec := engine.NewExecutor(
+		store,
+		providerManager,
+		executorMetrics,
+		historySvc,
+		featureFlagClient,
+		profileStore,
+		selEnv,
+		propSvc,
+		sharedIngestCache,
+		alertwebhook.NewDestinationResolver(store, cryptoEngine),
+		secrets.NewResolver(store, cryptoEngine),
+		exportPub,
+	)
+
+	// The dead letter queue is only available with persistent event drivers
+	deadLetterQueue, dlqCloser, err := dlq.NewQueue(ctx, &cfg.Events)
+	switch {
+	case errors.Is(err, dlq.ErrUnsupportedDriver):
+		zerolog.Ctx(ctx).Info().Str("driver", cfg.Events.Driver).Msg("dead letter queue management is disabled")
+	case err != nil:
+		return fmt.Errorf("unable to create dead letter queue: %w", err)
+	default:
+		defer dlqCloser()
+	}
+
 	s := controlplane.NewServer(
 		store,
 		evt,
@@ -202,6 +285,8 @@ func AllInOneServerService(
 		projectCreator,
 		entSvc,
 		featureFlagClient,
+		exec,
+		deadLetterQueue,
 	)
 
 	// Subscribe to events from the identity server
@@ -221,26 +306,7 @@ func AllInOneServerService(
 
 	// prepend the aggregator to the executor options
 	executorMiddleware = append([]message.HandlerMiddleware{aggr.AggregateMiddleware}, executorMiddleware...)
-	executorMetrics, err := engine.NewExecutorMetrics(meterFactory)
-	if err != nil {
-		return fmt.Errorf("unable to create metrics for executor: %w", err)
-	}
-
-	profileStore := profiles.NewProfileStore(store)
-	selEnv := selectors.NewEnv()
-
 	// Register the executor to handle entity evaluations
-	exec := engine.NewExecutor(
-		store,
-		providerManager,
-		executorMetrics,
-		historySvc,
-		featureFlagClient,
-		profileStore,
-		selEnv,
-		propSvc,
-	)
-
 	handler := engine.NewExecutorEventHandler(
 		ctx,
 		evt,
@@ -313,6 +379,13 @@ func AllInOneServerService(
 		return evt.Run(ctx)
 	})
 
+	if cfg.HistoryRetention.Enabled {
+		purger := retention.NewPurger(store, &cfg.HistoryRetention)
+		errg.Go(func() error {
+			return purger.Run(ctx)
+		})
+	}
+
 	// Wait for event handlers to start running
 	<-evt.Running()
 
@@ -74,6 +74,35 @@ func JQEvalBoolExpression(ctx context.Context, path string, obj any) (bool, erro
 	return v.(bool), nil
 }
 
+// JQSetPath sets the value at the given path on the object and returns the updated object.
+// the path is a path expression in jq format, e.g. ".a.b[0]", and the object is not modified.
+func JQSetPath(ctx context.Context, path string, obj any, value any) (any, error) {
+	// make sure the path is a whole expression before wrapping it
+	if _, err := gojq.Parse(path); err != nil {
+		return nil, fmt.Errorf("data parse: cannot parse key: %w", err)
+	}
+
+	query, err := gojq.Parse(fmt.Sprintf("(%s) = $value", path))
+	if err != nil {
+		return nil, fmt.Errorf("data parse: cannot parse key: %w", err)
+	}
+	code, err := gojq.Compile(query, gojq.WithVariables([]string{"$value"}))
+	if err != nil {
+		return nil, fmt.Errorf("data parse: cannot compile key: %w", err)
+	}
+
+	iter := code.RunWithContext(ctx, obj, value)
+	v, ok := iter.Next()
+	if !ok {
+		return nil, newErrNoValueFound("no value found for path %s", path)
+	}
+	if err, ok := v.(error); ok {
+		return nil, fmt.Errorf("error processing JQ statement: %w", err)
+	}
+
+	return v, nil
+}
+
 // ErrNoValueFound is an error that is returned when the accessor doesn't find anything
 var ErrNoValueFound = errors.New("evaluation error")
 
@@ -315,3 +315,58 @@ func TestJQExists_InvalidJQPath(t *testing.T) {
 	assert.Error(t, err, "Expected an error due to invalid JQ path")
 	assert.False(t, found, "Expected result to be false due to error")
 }
+
+func TestJQSetPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		path    string
+		obj     any
+		want    any
+		wantErr bool
+	}{
+		{
+			name: "nested member",
+			path: ".org.teams.nodes",
+			obj: map[string]any{
+				"org": map[string]any{"teams": map[string]any{"nodes": []any{"a"}, "total": 2}},
+			},
+			want: map[string]any{
+				"org": map[string]any{"teams": map[string]any{"nodes": []any{"a", "b"}, "total": 2}},
+			},
+		},
+		{
+			name: "array index",
+			path: ".items[1]",
+			obj:  map[string]any{"items": []any{"x", "y"}},
+			want: map[string]any{"items": []any{"x", []any{"a", "b"}}},
+		},
+		{
+			name:    "not a path",
+			path:    ".items | length",
+			obj:     map[string]any{"items": []any{"x"}},
+			wantErr: true,
+		},
+		{
+			name:    "unbalanced expression",
+			path:    ".a) | (.b",
+			obj:     map[string]any{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := util.JQSetPath(context.Background(), tt.path, tt.obj, []any{"a", "b"})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
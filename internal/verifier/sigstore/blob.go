@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sigstore
+
+import (
+	"bytes"
+	"crypto"
+	"fmt"
+	"time"
+
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+// BlobVerifier verifies sigstore bundles holding the signature of a blob,
+// such as the manifest of a mindpak bundle, against a single trusted
+// identity or public key.
+type BlobVerifier struct {
+	verifier *verify.SignedEntityVerifier
+	policy   verify.PolicyOption
+}
+
+// NewKeylessBlobVerifier creates a verifier which accepts blobs signed with
+// a certificate issued by the sigstore instance of the given TUF repository
+// to the given identity. The issuer is matched exactly, while the subject
+// (the SAN of the certificate) is a regular expression.
+func NewKeylessBlobVerifier(sigstoreTUFRepoURL, issuer, subjectRegex string) (*BlobVerifier, error) {
+	sev, err := newSignedEntityVerifier(sigstoreTUFRepoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := verify.NewShortCertificateIdentity(issuer, "", "", subjectRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error creating certificate identity: %w", err)
+	}
+
+	return &BlobVerifier{
+		verifier: sev,
+		policy:   verify.WithCertificateIdentity(identity),
+	}, nil
+}
+
+// NewPublicKeyBlobVerifier creates a verifier which accepts blobs signed
+// with the private key matching the given PEM encoded public key.
+func NewPublicKeyBlobVerifier(publicKeyPEM []byte) (*BlobVerifier, error) {
+	pub, err := cryptoutils.UnmarshalPEMToPublicKey(publicKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+
+	sv, err := signature.LoadVerifier(pub, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("error loading public key: %w", err)
+	}
+
+	// The verifier holds a single key, so the key hint of the bundle is not
+	// needed to look it up.
+	key := root.NewExpiringKey(sv, time.Time{}, time.Time{})
+	trustedMaterial := root.NewTrustedPublicKeyMaterial(func(string) (root.TimeConstrainedVerifier, error) {
+		return key, nil
+	})
+
+	// Bundles signed with a long-lived key carry no timestamps
+	sev, err := verify.NewSignedEntityVerifier(trustedMaterial, verify.WithCurrentTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlobVerifier{
+		verifier: sev,
+		policy:   verify.WithKey(),
+	}, nil
+}
+
+// VerifyBlob checks that the sigstore bundle in sigBundle, serialized as
+// JSON, holds a valid signature of blob.
+func (v *BlobVerifier) VerifyBlob(sigBundle []byte, blob []byte) error {
+	b := &bundle.Bundle{}
+	if err := b.UnmarshalJSON(sigBundle); err != nil {
+		return fmt.Errorf("error parsing signature bundle: %w", err)
+	}
+
+	_, err := v.verifier.Verify(b, verify.NewPolicy(verify.WithArtifact(bytes.NewReader(blob)), v.policy))
+	if err != nil {
+		return fmt.Errorf("error verifying signature: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sigstore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/build"
+)
+
+func TestPublicKeyBlobVerifier(t *testing.T) {
+	t.Parallel()
+
+	privPEM, pubPEM := generateKey(t)
+	_, otherPubPEM := generateKey(t)
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, mindpak.PathRuleTypes), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, mindpak.PathRuleTypes, "rule.yaml"), []byte("rule"), 0600))
+
+	packer := build.NewPacker()
+	_, err := packer.InitBundle(&build.InitOptions{
+		Metadata: &mindpak.Metadata{Name: "test", Namespace: "ns"},
+		Path:     dir,
+	})
+	require.NoError(t, err)
+	require.NoError(t, packer.SignBundle(context.Background(), &build.SignOptions{Path: dir, Key: privPEM}))
+
+	manifest, err := os.ReadFile(filepath.Join(dir, mindpak.ManifestFileName))
+	require.NoError(t, err)
+	signature, err := os.ReadFile(filepath.Join(dir, mindpak.SignatureFileName))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		key      []byte
+		manifest []byte
+		mustErr  bool
+	}{
+		{name: "valid signature", key: pubPEM, manifest: manifest},
+		{name: "tampered manifest", key: pubPEM, manifest: append([]byte(" "), manifest...), mustErr: true},
+		{name: "untrusted key", key: otherPubPEM, manifest: manifest, mustErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			v, err := NewPublicKeyBlobVerifier(tc.key)
+			require.NoError(t, err)
+
+			err = v.VerifyBlob(signature, tc.manifest)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPublicKeyBlobVerifierInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPublicKeyBlobVerifier([]byte("not a key"))
+	require.Error(t, err)
+
+	_, pubPEM := generateKey(t)
+	v, err := NewPublicKeyBlobVerifier(pubPEM)
+	require.NoError(t, err)
+	require.ErrorContains(t, v.VerifyBlob([]byte("{}"), []byte("blob")), "error parsing signature bundle")
+}
+
+func generateKey(t *testing.T) (privPEM []byte, pubPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	privPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	pubPEM, err = cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+	return privPEM, pubPEM
+}
@@ -44,28 +44,34 @@ var _ verifyif.ArtifactVerifier = (*Sigstore)(nil)
 
 // New creates a new Sigstore verifier
 func New(sigstoreTUFRepoURL string, authOpts ...container.AuthMethod) (*Sigstore, error) {
-	// Get the sigstore options for the TUF client and the verifier
-	tufOpts, opts, err := getSigstoreOptions(sigstoreTUFRepoURL)
+	sev, err := newSignedEntityVerifier(sigstoreTUFRepoURL)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the trusted material - sigstore's trusted_root.json
-	trustedMaterial, err := root.FetchTrustedRootWithOptions(tufOpts)
+	// return the verifier
+	return &Sigstore{
+		verifier: sev,
+		authOpts: authOpts,
+	}, nil
+}
+
+// newSignedEntityVerifier creates a verifier trusting the sigstore instance
+// of the given TUF repository
+func newSignedEntityVerifier(sigstoreTUFRepoURL string) (*verify.SignedEntityVerifier, error) {
+	// Get the sigstore options for the TUF client and the verifier
+	tufOpts, opts, err := getSigstoreOptions(sigstoreTUFRepoURL)
 	if err != nil {
 		return nil, err
 	}
 
-	sev, err := verify.NewSignedEntityVerifier(trustedMaterial, opts...)
+	// Get the trusted material - sigstore's trusted_root.json
+	trustedMaterial, err := root.FetchTrustedRootWithOptions(tufOpts)
 	if err != nil {
 		return nil, err
 	}
 
-	// return the verifier
-	return &Sigstore{
-		verifier: sev,
-		authOpts: authOpts,
-	}, nil
+	return verify.NewSignedEntityVerifier(trustedMaterial, opts...)
 }
 
 func getSigstoreOptions(sigstoreTUFRepoURL string) (*tuf.Options, []verify.VerifierOption, error) {
@@ -1163,6 +1163,7 @@ const (
 	ProfileService_GetProfileStatusByName_FullMethodName    = "/minder.v1.ProfileService/GetProfileStatusByName"
 	ProfileService_GetProfileStatusById_FullMethodName      = "/minder.v1.ProfileService/GetProfileStatusById"
 	ProfileService_GetProfileStatusByProject_FullMethodName = "/minder.v1.ProfileService/GetProfileStatusByProject"
+	ProfileService_TestProfile_FullMethodName               = "/minder.v1.ProfileService/TestProfile"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -1179,6 +1180,12 @@ type ProfileServiceClient interface {
 	GetProfileStatusByName(ctx context.Context, in *GetProfileStatusByNameRequest, opts ...grpc.CallOption) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusById(ctx context.Context, in *GetProfileStatusByIdRequest, opts ...grpc.CallOption) (*GetProfileStatusByIdResponse, error)
 	GetProfileStatusByProject(ctx context.Context, in *GetProfileStatusByProjectRequest, opts ...grpc.CallOption) (*GetProfileStatusByProjectResponse, error)
+	// TestProfile evaluates a profile, which does not need to be stored,
+	// against a set of registered entities. The results of each rule
+	// evaluation are streamed back as they are produced. Nothing is
+	// recorded in the evaluation history and no alerts or remediations
+	// are performed.
+	TestProfile(ctx context.Context, in *TestProfileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TestProfileResponse], error)
 }
 
 type profileServiceClient struct {
@@ -1289,6 +1296,25 @@ func (c *profileServiceClient) GetProfileStatusByProject(ctx context.Context, in
 	return out, nil
 }
 
+func (c *profileServiceClient) TestProfile(ctx context.Context, in *TestProfileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TestProfileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProfileService_ServiceDesc.Streams[0], ProfileService_TestProfile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TestProfileRequest, TestProfileResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProfileService_TestProfileClient = grpc.ServerStreamingClient[TestProfileResponse]
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility.
@@ -1303,6 +1329,12 @@ type ProfileServiceServer interface {
 	GetProfileStatusByName(context.Context, *GetProfileStatusByNameRequest) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusById(context.Context, *GetProfileStatusByIdRequest) (*GetProfileStatusByIdResponse, error)
 	GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error)
+	// TestProfile evaluates a profile, which does not need to be stored,
+	// against a set of registered entities. The results of each rule
+	// evaluation are streamed back as they are produced. Nothing is
+	// recorded in the evaluation history and no alerts or remediations
+	// are performed.
+	TestProfile(*TestProfileRequest, grpc.ServerStreamingServer[TestProfileResponse]) error
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -1343,6 +1375,9 @@ func (UnimplementedProfileServiceServer) GetProfileStatusById(context.Context, *
 func (UnimplementedProfileServiceServer) GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfileStatusByProject not implemented")
 }
+func (UnimplementedProfileServiceServer) TestProfile(*TestProfileRequest, grpc.ServerStreamingServer[TestProfileResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method TestProfile not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
 
@@ -1544,6 +1579,17 @@ func _ProfileService_GetProfileStatusByProject_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_TestProfile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TestProfileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfileServiceServer).TestProfile(m, &grpc.GenericServerStream[TestProfileRequest, TestProfileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProfileService_TestProfileServer = grpc.ServerStreamingServer[TestProfileResponse]
+
 // ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1592,7 +1638,13 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ProfileService_GetProfileStatusByProject_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TestProfile",
+			Handler:       _ProfileService_TestProfile_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "minder/v1/minder.proto",
 }
 
@@ -2219,9 +2271,11 @@ var RuleTypeService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EvalResultsService_ListEvaluationResults_FullMethodName = "/minder.v1.EvalResultsService/ListEvaluationResults"
-	EvalResultsService_ListEvaluationHistory_FullMethodName = "/minder.v1.EvalResultsService/ListEvaluationHistory"
-	EvalResultsService_GetEvaluationHistory_FullMethodName  = "/minder.v1.EvalResultsService/GetEvaluationHistory"
+	EvalResultsService_ListEvaluationResults_FullMethodName     = "/minder.v1.EvalResultsService/ListEvaluationResults"
+	EvalResultsService_ListEvaluationHistory_FullMethodName     = "/minder.v1.EvalResultsService/ListEvaluationHistory"
+	EvalResultsService_GetEvaluationHistory_FullMethodName      = "/minder.v1.EvalResultsService/GetEvaluationHistory"
+	EvalResultsService_GetEvaluationResultsSarif_FullMethodName = "/minder.v1.EvalResultsService/GetEvaluationResultsSarif"
+	EvalResultsService_GetPostureReport_FullMethodName          = "/minder.v1.EvalResultsService/GetPostureReport"
 )
 
 // EvalResultsServiceClient is the client API for EvalResultsService service.
@@ -2231,6 +2285,8 @@ type EvalResultsServiceClient interface {
 	ListEvaluationResults(ctx context.Context, in *ListEvaluationResultsRequest, opts ...grpc.CallOption) (*ListEvaluationResultsResponse, error)
 	ListEvaluationHistory(ctx context.Context, in *ListEvaluationHistoryRequest, opts ...grpc.CallOption) (*ListEvaluationHistoryResponse, error)
 	GetEvaluationHistory(ctx context.Context, in *GetEvaluationHistoryRequest, opts ...grpc.CallOption) (*GetEvaluationHistoryResponse, error)
+	GetEvaluationResultsSarif(ctx context.Context, in *GetEvaluationResultsSarifRequest, opts ...grpc.CallOption) (*GetEvaluationResultsSarifResponse, error)
+	GetPostureReport(ctx context.Context, in *GetPostureReportRequest, opts ...grpc.CallOption) (*GetPostureReportResponse, error)
 }
 
 type evalResultsServiceClient struct {
@@ -2271,6 +2327,26 @@ func (c *evalResultsServiceClient) GetEvaluationHistory(ctx context.Context, in
 	return out, nil
 }
 
+func (c *evalResultsServiceClient) GetEvaluationResultsSarif(ctx context.Context, in *GetEvaluationResultsSarifRequest, opts ...grpc.CallOption) (*GetEvaluationResultsSarifResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEvaluationResultsSarifResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_GetEvaluationResultsSarif_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evalResultsServiceClient) GetPostureReport(ctx context.Context, in *GetPostureReportRequest, opts ...grpc.CallOption) (*GetPostureReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostureReportResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_GetPostureReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EvalResultsServiceServer is the server API for EvalResultsService service.
 // All implementations must embed UnimplementedEvalResultsServiceServer
 // for forward compatibility.
@@ -2278,6 +2354,8 @@ type EvalResultsServiceServer interface {
 	ListEvaluationResults(context.Context, *ListEvaluationResultsRequest) (*ListEvaluationResultsResponse, error)
 	ListEvaluationHistory(context.Context, *ListEvaluationHistoryRequest) (*ListEvaluationHistoryResponse, error)
 	GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error)
+	GetEvaluationResultsSarif(context.Context, *GetEvaluationResultsSarifRequest) (*GetEvaluationResultsSarifResponse, error)
+	GetPostureReport(context.Context, *GetPostureReportRequest) (*GetPostureReportResponse, error)
 	mustEmbedUnimplementedEvalResultsServiceServer()
 }
 
@@ -2297,6 +2375,12 @@ func (UnimplementedEvalResultsServiceServer) ListEvaluationHistory(context.Conte
 func (UnimplementedEvalResultsServiceServer) GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvaluationHistory not implemented")
 }
+func (UnimplementedEvalResultsServiceServer) GetEvaluationResultsSarif(context.Context, *GetEvaluationResultsSarifRequest) (*GetEvaluationResultsSarifResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvaluationResultsSarif not implemented")
+}
+func (UnimplementedEvalResultsServiceServer) GetPostureReport(context.Context, *GetPostureReportRequest) (*GetPostureReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostureReport not implemented")
+}
 func (UnimplementedEvalResultsServiceServer) mustEmbedUnimplementedEvalResultsServiceServer() {}
 func (UnimplementedEvalResultsServiceServer) testEmbeddedByValue()                            {}
 
@@ -2372,6 +2456,42 @@ func _EvalResultsService_GetEvaluationHistory_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EvalResultsService_GetEvaluationResultsSarif_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEvaluationResultsSarifRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).GetEvaluationResultsSarif(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_GetEvaluationResultsSarif_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).GetEvaluationResultsSarif(ctx, req.(*GetEvaluationResultsSarifRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvalResultsService_GetPostureReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostureReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).GetPostureReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_GetPostureReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).GetPostureReport(ctx, req.(*GetPostureReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EvalResultsService_ServiceDesc is the grpc.ServiceDesc for EvalResultsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2391,17 +2511,28 @@ var EvalResultsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvaluationHistory",
 			Handler:    _EvalResultsService_GetEvaluationHistory_Handler,
 		},
+		{
+			MethodName: "GetEvaluationResultsSarif",
+			Handler:    _EvalResultsService_GetEvaluationResultsSarif_Handler,
+		},
+		{
+			MethodName: "GetPostureReport",
+			Handler:    _EvalResultsService_GetPostureReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
 }
 
 const (
-	PermissionsService_ListRoles_FullMethodName           = "/minder.v1.PermissionsService/ListRoles"
-	PermissionsService_ListRoleAssignments_FullMethodName = "/minder.v1.PermissionsService/ListRoleAssignments"
-	PermissionsService_AssignRole_FullMethodName          = "/minder.v1.PermissionsService/AssignRole"
-	PermissionsService_UpdateRole_FullMethodName          = "/minder.v1.PermissionsService/UpdateRole"
-	PermissionsService_RemoveRole_FullMethodName          = "/minder.v1.PermissionsService/RemoveRole"
+	PermissionsService_ListRoles_FullMethodName            = "/minder.v1.PermissionsService/ListRoles"
+	PermissionsService_ListRoleAssignments_FullMethodName  = "/minder.v1.PermissionsService/ListRoleAssignments"
+	PermissionsService_AssignRole_FullMethodName           = "/minder.v1.PermissionsService/AssignRole"
+	PermissionsService_UpdateRole_FullMethodName           = "/minder.v1.PermissionsService/UpdateRole"
+	PermissionsService_RemoveRole_FullMethodName           = "/minder.v1.PermissionsService/RemoveRole"
+	PermissionsService_CreateRoleDefinition_FullMethodName = "/minder.v1.PermissionsService/CreateRoleDefinition"
+	PermissionsService_ListRoleDefinitions_FullMethodName  = "/minder.v1.PermissionsService/ListRoleDefinitions"
+	PermissionsService_DeleteRoleDefinition_FullMethodName = "/minder.v1.PermissionsService/DeleteRoleDefinition"
 )
 
 // PermissionsServiceClient is the client API for PermissionsService service.
@@ -2413,6 +2544,9 @@ type PermissionsServiceClient interface {
 	AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error)
 	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
 	RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*RemoveRoleResponse, error)
+	CreateRoleDefinition(ctx context.Context, in *CreateRoleDefinitionRequest, opts ...grpc.CallOption) (*CreateRoleDefinitionResponse, error)
+	ListRoleDefinitions(ctx context.Context, in *ListRoleDefinitionsRequest, opts ...grpc.CallOption) (*ListRoleDefinitionsResponse, error)
+	DeleteRoleDefinition(ctx context.Context, in *DeleteRoleDefinitionRequest, opts ...grpc.CallOption) (*DeleteRoleDefinitionResponse, error)
 }
 
 type permissionsServiceClient struct {
@@ -2473,6 +2607,36 @@ func (c *permissionsServiceClient) RemoveRole(ctx context.Context, in *RemoveRol
 	return out, nil
 }
 
+func (c *permissionsServiceClient) CreateRoleDefinition(ctx context.Context, in *CreateRoleDefinitionRequest, opts ...grpc.CallOption) (*CreateRoleDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoleDefinitionResponse)
+	err := c.cc.Invoke(ctx, PermissionsService_CreateRoleDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionsServiceClient) ListRoleDefinitions(ctx context.Context, in *ListRoleDefinitionsRequest, opts ...grpc.CallOption) (*ListRoleDefinitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoleDefinitionsResponse)
+	err := c.cc.Invoke(ctx, PermissionsService_ListRoleDefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionsServiceClient) DeleteRoleDefinition(ctx context.Context, in *DeleteRoleDefinitionRequest, opts ...grpc.CallOption) (*DeleteRoleDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleDefinitionResponse)
+	err := c.cc.Invoke(ctx, PermissionsService_DeleteRoleDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PermissionsServiceServer is the server API for PermissionsService service.
 // All implementations must embed UnimplementedPermissionsServiceServer
 // for forward compatibility.
@@ -2482,6 +2646,9 @@ type PermissionsServiceServer interface {
 	AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error)
 	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
 	RemoveRole(context.Context, *RemoveRoleRequest) (*RemoveRoleResponse, error)
+	CreateRoleDefinition(context.Context, *CreateRoleDefinitionRequest) (*CreateRoleDefinitionResponse, error)
+	ListRoleDefinitions(context.Context, *ListRoleDefinitionsRequest) (*ListRoleDefinitionsResponse, error)
+	DeleteRoleDefinition(context.Context, *DeleteRoleDefinitionRequest) (*DeleteRoleDefinitionResponse, error)
 	mustEmbedUnimplementedPermissionsServiceServer()
 }
 
@@ -2507,6 +2674,15 @@ func (UnimplementedPermissionsServiceServer) UpdateRole(context.Context, *Update
 func (UnimplementedPermissionsServiceServer) RemoveRole(context.Context, *RemoveRoleRequest) (*RemoveRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRole not implemented")
 }
+func (UnimplementedPermissionsServiceServer) CreateRoleDefinition(context.Context, *CreateRoleDefinitionRequest) (*CreateRoleDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleDefinition not implemented")
+}
+func (UnimplementedPermissionsServiceServer) ListRoleDefinitions(context.Context, *ListRoleDefinitionsRequest) (*ListRoleDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleDefinitions not implemented")
+}
+func (UnimplementedPermissionsServiceServer) DeleteRoleDefinition(context.Context, *DeleteRoleDefinitionRequest) (*DeleteRoleDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleDefinition not implemented")
+}
 func (UnimplementedPermissionsServiceServer) mustEmbedUnimplementedPermissionsServiceServer() {}
 func (UnimplementedPermissionsServiceServer) testEmbeddedByValue()                            {}
 
@@ -2618,6 +2794,60 @@ func _PermissionsService_RemoveRole_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PermissionsService_CreateRoleDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionsServiceServer).CreateRoleDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionsService_CreateRoleDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionsServiceServer).CreateRoleDefinition(ctx, req.(*CreateRoleDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionsService_ListRoleDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionsServiceServer).ListRoleDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionsService_ListRoleDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionsServiceServer).ListRoleDefinitions(ctx, req.(*ListRoleDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionsService_DeleteRoleDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionsServiceServer).DeleteRoleDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionsService_DeleteRoleDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionsServiceServer).DeleteRoleDefinition(ctx, req.(*DeleteRoleDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PermissionsService_ServiceDesc is the grpc.ServiceDesc for PermissionsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2645,6 +2875,18 @@ var PermissionsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRole",
 			Handler:    _PermissionsService_RemoveRole_Handler,
 		},
+		{
+			MethodName: "CreateRoleDefinition",
+			Handler:    _PermissionsService_CreateRoleDefinition_Handler,
+		},
+		{
+			MethodName: "ListRoleDefinitions",
+			Handler:    _PermissionsService_ListRoleDefinitions_Handler,
+		},
+		{
+			MethodName: "DeleteRoleDefinition",
+			Handler:    _PermissionsService_DeleteRoleDefinition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -2658,6 +2900,12 @@ const (
 	ProjectsService_UpdateProject_FullMethodName                  = "/minder.v1.ProjectsService/UpdateProject"
 	ProjectsService_PatchProject_FullMethodName                   = "/minder.v1.ProjectsService/PatchProject"
 	ProjectsService_CreateEntityReconciliationTask_FullMethodName = "/minder.v1.ProjectsService/CreateEntityReconciliationTask"
+	ProjectsService_SetAlertWebhook_FullMethodName                = "/minder.v1.ProjectsService/SetAlertWebhook"
+	ProjectsService_ListAlertWebhooks_FullMethodName              = "/minder.v1.ProjectsService/ListAlertWebhooks"
+	ProjectsService_DeleteAlertWebhook_FullMethodName             = "/minder.v1.ProjectsService/DeleteAlertWebhook"
+	ProjectsService_SetProjectSecret_FullMethodName               = "/minder.v1.ProjectsService/SetProjectSecret"
+	ProjectsService_ListProjectSecrets_FullMethodName             = "/minder.v1.ProjectsService/ListProjectSecrets"
+	ProjectsService_DeleteProjectSecret_FullMethodName            = "/minder.v1.ProjectsService/DeleteProjectSecret"
 )
 
 // ProjectsServiceClient is the client API for ProjectsService service.
@@ -2671,6 +2919,12 @@ type ProjectsServiceClient interface {
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	PatchProject(ctx context.Context, in *PatchProjectRequest, opts ...grpc.CallOption) (*PatchProjectResponse, error)
 	CreateEntityReconciliationTask(ctx context.Context, in *CreateEntityReconciliationTaskRequest, opts ...grpc.CallOption) (*CreateEntityReconciliationTaskResponse, error)
+	SetAlertWebhook(ctx context.Context, in *SetAlertWebhookRequest, opts ...grpc.CallOption) (*SetAlertWebhookResponse, error)
+	ListAlertWebhooks(ctx context.Context, in *ListAlertWebhooksRequest, opts ...grpc.CallOption) (*ListAlertWebhooksResponse, error)
+	DeleteAlertWebhook(ctx context.Context, in *DeleteAlertWebhookRequest, opts ...grpc.CallOption) (*DeleteAlertWebhookResponse, error)
+	SetProjectSecret(ctx context.Context, in *SetProjectSecretRequest, opts ...grpc.CallOption) (*SetProjectSecretResponse, error)
+	ListProjectSecrets(ctx context.Context, in *ListProjectSecretsRequest, opts ...grpc.CallOption) (*ListProjectSecretsResponse, error)
+	DeleteProjectSecret(ctx context.Context, in *DeleteProjectSecretRequest, opts ...grpc.CallOption) (*DeleteProjectSecretResponse, error)
 }
 
 type projectsServiceClient struct {
@@ -2751,6 +3005,66 @@ func (c *projectsServiceClient) CreateEntityReconciliationTask(ctx context.Conte
 	return out, nil
 }
 
+func (c *projectsServiceClient) SetAlertWebhook(ctx context.Context, in *SetAlertWebhookRequest, opts ...grpc.CallOption) (*SetAlertWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAlertWebhookResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_SetAlertWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) ListAlertWebhooks(ctx context.Context, in *ListAlertWebhooksRequest, opts ...grpc.CallOption) (*ListAlertWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertWebhooksResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_ListAlertWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) DeleteAlertWebhook(ctx context.Context, in *DeleteAlertWebhookRequest, opts ...grpc.CallOption) (*DeleteAlertWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertWebhookResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_DeleteAlertWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) SetProjectSecret(ctx context.Context, in *SetProjectSecretRequest, opts ...grpc.CallOption) (*SetProjectSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProjectSecretResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_SetProjectSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) ListProjectSecrets(ctx context.Context, in *ListProjectSecretsRequest, opts ...grpc.CallOption) (*ListProjectSecretsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectSecretsResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_ListProjectSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) DeleteProjectSecret(ctx context.Context, in *DeleteProjectSecretRequest, opts ...grpc.CallOption) (*DeleteProjectSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProjectSecretResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_DeleteProjectSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectsServiceServer is the server API for ProjectsService service.
 // All implementations must embed UnimplementedProjectsServiceServer
 // for forward compatibility.
@@ -2762,6 +3076,12 @@ type ProjectsServiceServer interface {
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	PatchProject(context.Context, *PatchProjectRequest) (*PatchProjectResponse, error)
 	CreateEntityReconciliationTask(context.Context, *CreateEntityReconciliationTaskRequest) (*CreateEntityReconciliationTaskResponse, error)
+	SetAlertWebhook(context.Context, *SetAlertWebhookRequest) (*SetAlertWebhookResponse, error)
+	ListAlertWebhooks(context.Context, *ListAlertWebhooksRequest) (*ListAlertWebhooksResponse, error)
+	DeleteAlertWebhook(context.Context, *DeleteAlertWebhookRequest) (*DeleteAlertWebhookResponse, error)
+	SetProjectSecret(context.Context, *SetProjectSecretRequest) (*SetProjectSecretResponse, error)
+	ListProjectSecrets(context.Context, *ListProjectSecretsRequest) (*ListProjectSecretsResponse, error)
+	DeleteProjectSecret(context.Context, *DeleteProjectSecretRequest) (*DeleteProjectSecretResponse, error)
 	mustEmbedUnimplementedProjectsServiceServer()
 }
 
@@ -2793,6 +3113,24 @@ func (UnimplementedProjectsServiceServer) PatchProject(context.Context, *PatchPr
 func (UnimplementedProjectsServiceServer) CreateEntityReconciliationTask(context.Context, *CreateEntityReconciliationTaskRequest) (*CreateEntityReconciliationTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEntityReconciliationTask not implemented")
 }
+func (UnimplementedProjectsServiceServer) SetAlertWebhook(context.Context, *SetAlertWebhookRequest) (*SetAlertWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAlertWebhook not implemented")
+}
+func (UnimplementedProjectsServiceServer) ListAlertWebhooks(context.Context, *ListAlertWebhooksRequest) (*ListAlertWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertWebhooks not implemented")
+}
+func (UnimplementedProjectsServiceServer) DeleteAlertWebhook(context.Context, *DeleteAlertWebhookRequest) (*DeleteAlertWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertWebhook not implemented")
+}
+func (UnimplementedProjectsServiceServer) SetProjectSecret(context.Context, *SetProjectSecretRequest) (*SetProjectSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectSecret not implemented")
+}
+func (UnimplementedProjectsServiceServer) ListProjectSecrets(context.Context, *ListProjectSecretsRequest) (*ListProjectSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectSecrets not implemented")
+}
+func (UnimplementedProjectsServiceServer) DeleteProjectSecret(context.Context, *DeleteProjectSecretRequest) (*DeleteProjectSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectSecret not implemented")
+}
 func (UnimplementedProjectsServiceServer) mustEmbedUnimplementedProjectsServiceServer() {}
 func (UnimplementedProjectsServiceServer) testEmbeddedByValue()                         {}
 
@@ -2940,6 +3278,114 @@ func _ProjectsService_CreateEntityReconciliationTask_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectsService_SetAlertWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlertWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).SetAlertWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_SetAlertWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).SetAlertWebhook(ctx, req.(*SetAlertWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_ListAlertWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).ListAlertWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_ListAlertWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).ListAlertWebhooks(ctx, req.(*ListAlertWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_DeleteAlertWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).DeleteAlertWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_DeleteAlertWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).DeleteAlertWebhook(ctx, req.(*DeleteAlertWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_SetProjectSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).SetProjectSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_SetProjectSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).SetProjectSecret(ctx, req.(*SetProjectSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_ListProjectSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).ListProjectSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_ListProjectSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).ListProjectSecrets(ctx, req.(*ListProjectSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_DeleteProjectSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).DeleteProjectSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_DeleteProjectSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).DeleteProjectSecret(ctx, req.(*DeleteProjectSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectsService_ServiceDesc is the grpc.ServiceDesc for ProjectsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2975,6 +3421,30 @@ var ProjectsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateEntityReconciliationTask",
 			Handler:    _ProjectsService_CreateEntityReconciliationTask_Handler,
 		},
+		{
+			MethodName: "SetAlertWebhook",
+			Handler:    _ProjectsService_SetAlertWebhook_Handler,
+		},
+		{
+			MethodName: "ListAlertWebhooks",
+			Handler:    _ProjectsService_ListAlertWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteAlertWebhook",
+			Handler:    _ProjectsService_DeleteAlertWebhook_Handler,
+		},
+		{
+			MethodName: "SetProjectSecret",
+			Handler:    _ProjectsService_SetProjectSecret_Handler,
+		},
+		{
+			MethodName: "ListProjectSecrets",
+			Handler:    _ProjectsService_ListProjectSecrets_Handler,
+		},
+		{
+			MethodName: "DeleteProjectSecret",
+			Handler:    _ProjectsService_DeleteProjectSecret_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -3450,6 +3920,190 @@ var InviteService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "minder/v1/minder.proto",
 }
 
+const (
+	AdminService_ListDeadLetterMessages_FullMethodName    = "/minder.v1.AdminService/ListDeadLetterMessages"
+	AdminService_ReplayDeadLetterMessages_FullMethodName  = "/minder.v1.AdminService/ReplayDeadLetterMessages"
+	AdminService_DiscardDeadLetterMessages_FullMethodName = "/minder.v1.AdminService/DiscardDeadLetterMessages"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService provides server administration operations.  These are only
+// available to the identities configured as server admins.
+type AdminServiceClient interface {
+	ListDeadLetterMessages(ctx context.Context, in *ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ListDeadLetterMessagesResponse, error)
+	ReplayDeadLetterMessages(ctx context.Context, in *ReplayDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessagesResponse, error)
+	DiscardDeadLetterMessages(ctx context.Context, in *DiscardDeadLetterMessagesRequest, opts ...grpc.CallOption) (*DiscardDeadLetterMessagesResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListDeadLetterMessages(ctx context.Context, in *ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ListDeadLetterMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDeadLetterMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReplayDeadLetterMessages(ctx context.Context, in *ReplayDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReplayDeadLetterMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DiscardDeadLetterMessages(ctx context.Context, in *DiscardDeadLetterMessagesRequest, opts ...grpc.CallOption) (*DiscardDeadLetterMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, AdminService_DiscardDeadLetterMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService provides server administration operations.  These are only
+// available to the identities configured as server admins.
+type AdminServiceServer interface {
+	ListDeadLetterMessages(context.Context, *ListDeadLetterMessagesRequest) (*ListDeadLetterMessagesResponse, error)
+	ReplayDeadLetterMessages(context.Context, *ReplayDeadLetterMessagesRequest) (*ReplayDeadLetterMessagesResponse, error)
+	DiscardDeadLetterMessages(context.Context, *DiscardDeadLetterMessagesRequest) (*DiscardDeadLetterMessagesResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListDeadLetterMessages(context.Context, *ListDeadLetterMessagesRequest) (*ListDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetterMessages not implemented")
+}
+func (UnimplementedAdminServiceServer) ReplayDeadLetterMessages(context.Context, *ReplayDeadLetterMessagesRequest) (*ReplayDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetterMessages not implemented")
+}
+func (UnimplementedAdminServiceServer) DiscardDeadLetterMessages(context.Context, *DiscardDeadLetterMessagesRequest) (*DiscardDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardDeadLetterMessages not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDeadLetterMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDeadLetterMessages(ctx, req.(*ListDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReplayDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReplayDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReplayDeadLetterMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReplayDeadLetterMessages(ctx, req.(*ReplayDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DiscardDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DiscardDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DiscardDeadLetterMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DiscardDeadLetterMessages(ctx, req.(*DiscardDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "minder.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDeadLetterMessages",
+			Handler:    _AdminService_ListDeadLetterMessages_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetterMessages",
+			Handler:    _AdminService_ReplayDeadLetterMessages_Handler,
+		},
+		{
+			MethodName: "DiscardDeadLetterMessages",
+			Handler:    _AdminService_DiscardDeadLetterMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "minder/v1/minder.proto",
+}
+
 const (
 	EntityInstanceService_ListEntities_FullMethodName     = "/minder.v1.EntityInstanceService/ListEntities"
 	EntityInstanceService_GetEntityById_FullMethodName    = "/minder.v1.EntityInstanceService/GetEntityById"
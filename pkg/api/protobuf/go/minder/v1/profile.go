@@ -3,11 +3,22 @@
 
 package v1
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
 const (
 	// ProfileType is the type of the profile resource.
 	ProfileType = "profile"
 	// ProfileTypeVersion is the version of the profile resource.
 	ProfileTypeVersion = "v1"
+
+	// MinEvaluationInterval is the shortest interval a profile evaluation
+	// schedule can set.
+	MinEvaluationInterval = time.Minute
 )
 
 // GetContext returns the context from the nested Profile
@@ -25,3 +36,38 @@ func (r *UpdateProfileRequest) GetContext() *Context {
 	}
 	return nil
 }
+
+// GetContext returns the context from the nested Profile
+func (r *TestProfileRequest) GetContext() *Context {
+	if r != nil && r.Profile != nil {
+		return r.Profile.Context
+	}
+	return nil
+}
+
+// Validate validates the evaluation schedule of a profile.  A nil schedule
+// is valid, and means that the reminder service defaults apply.
+func (s *Profile_EvaluationSchedule) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	switch sched := s.GetSchedule().(type) {
+	case *Profile_EvaluationSchedule_Interval:
+		interval, err := time.ParseDuration(sched.Interval)
+		if err != nil {
+			return fmt.Errorf("%w: invalid interval %q: %w", ErrValidationFailed, sched.Interval, err)
+		}
+		if interval < MinEvaluationInterval {
+			return fmt.Errorf("%w: interval %s is shorter than %s", ErrValidationFailed, interval, MinEvaluationInterval)
+		}
+	case *Profile_EvaluationSchedule_Cron:
+		if _, err := cron.ParseStandard(sched.Cron); err != nil {
+			return fmt.Errorf("%w: invalid cron expression %q: %w", ErrValidationFailed, sched.Cron, err)
+		}
+	default:
+		return fmt.Errorf("%w: schedule must set either an interval or a cron expression", ErrValidationFailed)
+	}
+
+	return nil
+}
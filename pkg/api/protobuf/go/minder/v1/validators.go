@@ -6,8 +6,10 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/itchyny/gojq"
@@ -301,6 +303,16 @@ func (op *RuleType_Definition_Eval_JQComparison_Operator) Validate() error {
 	return nil
 }
 
+// compileJQ checks that expr is a valid jq expression
+func compileJQ(expr string) error {
+	q, err := gojq.Parse(expr)
+	if err != nil {
+		return err
+	}
+	_, err = gojq.Compile(q)
+	return err
+}
+
 // Validate validates a data source reference
 func (dsr *DataSourceReference) Validate() interface{} {
 	if dsr == nil {
@@ -366,6 +378,14 @@ func (alert *RuleType_Definition_Alert) Validate() error {
 		if err := alert.GetPullRequestComment().Validate(); err != nil {
 			return err
 		}
+	case "webhook":
+		if err := alert.GetWebhook().Validate(); err != nil {
+			return err
+		}
+	case "issue":
+		if err := alert.GetIssue().Validate(); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("%w: alert type cannot be empty", ErrInvalidRuleTypeDefinition)
 	}
@@ -399,6 +419,45 @@ func (comment *RuleType_Definition_Alert_AlertTypePRComment) Validate() error {
 	return nil
 }
 
+// Validate validates a rule type alert webhook
+func (wh *RuleType_Definition_Alert_AlertTypeWebhook) Validate() error {
+	if wh == nil {
+		return fmt.Errorf("%w: webhook is nil", ErrInvalidRuleTypeDefinition)
+	}
+
+	if wh.GetMessage() == "" {
+		return nil
+	}
+
+	_, err := util.NewSafeTextTemplate(&wh.Message, "message")
+	if err != nil {
+		return fmt.Errorf("%w: webhook message is not parsable: %w", ErrInvalidRuleTypeDefinition, err)
+	}
+
+	return nil
+}
+
+// Validate validates a rule type alert issue
+func (issue *RuleType_Definition_Alert_AlertTypeIssue) Validate() error {
+	if issue == nil {
+		return fmt.Errorf("%w: issue is nil", ErrInvalidRuleTypeDefinition)
+	}
+
+	if issue.GetTitle() != "" {
+		if _, err := util.NewSafeTextTemplate(&issue.Title, "title"); err != nil {
+			return fmt.Errorf("%w: issue title is not parsable: %w", ErrInvalidRuleTypeDefinition, err)
+		}
+	}
+
+	if issue.GetBody() != "" {
+		if _, err := util.NewSafeTextTemplate(&issue.Body, "body"); err != nil {
+			return fmt.Errorf("%w: issue body is not parsable: %w", ErrInvalidRuleTypeDefinition, err)
+		}
+	}
+
+	return nil
+}
+
 // Validate validates a rule type definition remediate
 func (rem *RuleType_Definition_Remediate) Validate() error {
 	if rem == nil {
@@ -552,6 +611,10 @@ func (p *Profile) Validate() error {
 		}
 	}
 
+	if err := p.GetEvaluationSchedule().Validate(); err != nil {
+		return fmt.Errorf("evaluation schedule is invalid: %w", err)
+	}
+
 	return nil
 }
 
@@ -632,6 +695,15 @@ func (dsRestDriver *DataSource_Rest) Validate() error {
 	return dsRestDriver.Rest.Validate()
 }
 
+// Validate is the entrypoint for the actual driver's validation
+func (dsGraphQLDriver *DataSource_Graphql) Validate() error {
+	if dsGraphQLDriver == nil || dsGraphQLDriver.Graphql == nil {
+		return fmt.Errorf("%w: graphql driver is nil", ErrValidationFailed)
+	}
+
+	return dsGraphQLDriver.Graphql.Validate()
+}
+
 // Validate validates a rest data source
 func (rest *RestDataSource) Validate() error {
 	if rest == nil {
@@ -655,6 +727,14 @@ func (rest *RestDataSource) Validate() error {
 		}
 	}
 
+	if err := rest.GetCache().Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := rest.GetLimits().Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -662,6 +742,43 @@ func (rest *RestDataSource) Validate() error {
 	return nil
 }
 
+// maxRestCacheTTL is the longest time a REST data source may cache responses
+const maxRestCacheTTL = 24 * time.Hour
+
+// Validate validates the cache policy of a REST data source. A nil value
+// is valid, and means responses are not cached.
+func (c *RestDataSource_Cache) Validate() error {
+	if c == nil || c.GetTtl() == nil {
+		return nil
+	}
+
+	if ttl := c.GetTtl().AsDuration(); ttl <= 0 || ttl > maxRestCacheTTL {
+		return fmt.Errorf("%w: rest cache ttl must be positive and at most %s", ErrValidationFailed, maxRestCacheTTL)
+	}
+
+	return nil
+}
+
+// Validate validates the request limits of a REST data source. A nil value
+// is valid, and means requests are not limited.
+func (l *RestDataSource_Limits) Validate() error {
+	if l == nil {
+		return nil
+	}
+
+	if rps := l.GetRequestsPerSecond(); math.IsNaN(rps) || rps < 0 || rps > 10000 {
+		return fmt.Errorf("%w: rest requests_per_second must be between 0 and 10000", ErrValidationFailed)
+	}
+	if l.GetBurst() > 10000 {
+		return fmt.Errorf("%w: rest burst must be at most 10000", ErrValidationFailed)
+	}
+	if l.GetMaxConcurrent() > 1000 {
+		return fmt.Errorf("%w: rest max_concurrent must be at most 1000", ErrValidationFailed)
+	}
+
+	return nil
+}
+
 // Validate validates a rest function
 func (rest *RestDataSource_Def) Validate() error {
 	if rest == nil {
@@ -696,12 +813,203 @@ func (rest *RestDataSource_Def) Validate() error {
 		}
 	}
 
+	if err := rest.GetAuth().Validate(); err != nil {
+		return err
+	}
+
+	if err := rest.GetTls().Validate(); err != nil {
+		return err
+	}
+
+	if rest.GetAuth().GetProvider() != nil && rest.GetTls() != nil {
+		return fmt.Errorf("%w: rest function provider auth cannot be combined with tls", ErrValidationFailed)
+	}
+
+	if rest.GetTimeout() != nil {
+		if timeout := rest.GetTimeout().AsDuration(); timeout <= 0 || timeout > maxRestTimeout {
+			return fmt.Errorf("%w: rest function timeout must be positive and at most %s", ErrValidationFailed, maxRestTimeout)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates a graphql data source
+func (gql *GraphQLDataSource) Validate() error {
+	if gql == nil {
+		return fmt.Errorf("%w: graphql data source is nil", ErrValidationFailed)
+	}
+
+	if len(gql.GetDef()) == 0 {
+		return fmt.Errorf("%w: graphql definition is empty", ErrValidationFailed)
+	}
+
+	var errs []error
+	for i, def := range gql.GetDef() {
+		if i == "" {
+			errs = append(errs, fmt.Errorf("graphql function name %s is empty", i))
+		}
+
+		if err := def.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("graphql function %s is invalid: %w", i, err))
+		}
+	}
+
+	if err := gql.GetCache().Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := gql.GetLimits().Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+// Validate validates a graphql function
+func (gql *GraphQLDataSource_Def) Validate() error {
+	if gql == nil {
+		return fmt.Errorf("%w: graphql function is nil", ErrValidationFailed)
+	}
+
+	if gql.GetEndpoint() == "" {
+		return fmt.Errorf("%w: graphql function endpoint is empty", ErrValidationFailed)
+	}
+
+	if gql.GetQuery() == "" {
+		return fmt.Errorf("%w: graphql function query is empty", ErrValidationFailed)
+	}
+
+	if gql.GetInputSchema() == nil {
+		return fmt.Errorf("%w: graphql function input schema is nil", ErrValidationFailed)
+	}
+
+	for variable, arg := range gql.GetVariables() {
+		if err := keyIsProperty(arg, gql.GetInputSchema().AsMap()); err != nil {
+			return fmt.Errorf("%w: graphql variable %s: %v", ErrValidationFailed, variable, err)
+		}
+	}
+
+	if gql.GetResult() != "" {
+		if err := compileJQ(gql.GetResult()); err != nil {
+			return fmt.Errorf("%w: graphql function result: %w", ErrValidationFailed, err)
+		}
+	}
+
+	if err := gql.GetPagination().Validate(); err != nil {
+		return err
+	}
+
+	if err := gql.GetAuth().Validate(); err != nil {
+		return err
+	}
+
+	if gql.GetTimeout() != nil {
+		if timeout := gql.GetTimeout().AsDuration(); timeout <= 0 || timeout > maxRestTimeout {
+			return fmt.Errorf("%w: graphql function timeout must be positive and at most %s", ErrValidationFailed, maxRestTimeout)
+		}
+	}
+
+	return nil
+}
+
+// maxGraphQLPages is the most pages a graphql function may fetch per call
+const maxGraphQLPages = 100
+
+// Validate validates the pagination of a graphql function. A nil value is
+// valid, and means only the first page is fetched.
+func (p *GraphQLDataSource_Def_Pagination) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if err := compileJQ(p.GetNodes()); err != nil {
+		return fmt.Errorf("%w: graphql pagination nodes: %w", ErrValidationFailed, err)
+	}
+	if err := compileJQ(p.GetPageInfo()); err != nil {
+		return fmt.Errorf("%w: graphql pagination page_info: %w", ErrValidationFailed, err)
+	}
+	if p.GetCursorVariable() == "" {
+		return fmt.Errorf("%w: graphql pagination cursor_variable is empty", ErrValidationFailed)
+	}
+	if p.GetMaxPages() > maxGraphQLPages {
+		return fmt.Errorf("%w: graphql pagination max_pages must be at most %d", ErrValidationFailed, maxGraphQLPages)
+	}
+
+	return nil
+}
+
+// maxRestTimeout is the longest timeout a REST data source function may set
+const maxRestTimeout = time.Minute
+
+// Validate validates the authentication settings of a REST data source
+// function. A nil value is valid, and means requests are not authenticated.
+func (auth *RestDataSource_Def_Auth) Validate() error {
+	if auth == nil {
+		return nil
+	}
+
+	switch auth.GetMethod().(type) {
+	case *RestDataSource_Def_Auth_Bearer_:
+		if auth.GetBearer().GetSecret() == "" {
+			return fmt.Errorf("%w: rest function bearer auth secret is empty", ErrValidationFailed)
+		}
+	case *RestDataSource_Def_Auth_Basic_:
+		if auth.GetBasic().GetPasswordSecret() == "" {
+			return fmt.Errorf("%w: rest function basic auth password secret is empty", ErrValidationFailed)
+		}
+	case *RestDataSource_Def_Auth_ApiKey:
+		apiKey := auth.GetApiKey()
+		if apiKey.GetSecret() == "" {
+			return fmt.Errorf("%w: rest function api key secret is empty", ErrValidationFailed)
+		}
+		if (apiKey.GetHeader() == "") == (apiKey.GetQueryParam() == "") {
+			return fmt.Errorf("%w: rest function api key must set exactly one of header and query_param", ErrValidationFailed)
+		}
+	case *RestDataSource_Def_Auth_Provider_:
+	default:
+		return fmt.Errorf("%w: rest function auth method is missing", ErrValidationFailed)
+	}
+
+	return nil
+}
+
+// Validate validates the TLS settings of a REST data source function.
+// A nil value is valid, and means the default TLS settings are used.
+func (t *RestDataSource_Def_TLS) Validate() error {
+	if t == nil {
+		return nil
+	}
+
+	if (t.GetClientCertSecret() == "") != (t.GetClientKeySecret() == "") {
+		return fmt.Errorf("%w: rest function tls client certificate and key must be set together", ErrValidationFailed)
+	}
+
 	return nil
 }
 
 // validate that the given key exists in the given properties.
 // they key must exist in the top level properties. It must contain a default
 // or be marked as required.
+// keyIsProperty checks that key is a property of the schema, which may be
+// optional
+func keyIsProperty(key string, schema map[string]any) error {
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("properties are missing")
+	}
+
+	if _, ok := properties[key]; !ok {
+		return fmt.Errorf("key %q is missing", key)
+	}
+
+	return nil
+}
+
 func keyInProperties(key string, schema map[string]any) error {
 	if schema == nil {
 		return fmt.Errorf("properties are missing")
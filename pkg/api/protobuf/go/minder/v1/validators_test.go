@@ -479,6 +479,106 @@ func TestRuleType_Definition_Alert_AlertTypePRComment_Validate(t *testing.T) {
 	}
 }
 
+func TestRuleType_Definition_Alert_AlertTypeWebhook_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		whAlert *RuleType_Definition_Alert_AlertTypeWebhook
+		wantErr bool
+	}{
+		{
+			name:    "valid webhook alert with default message",
+			whAlert: &RuleType_Definition_Alert_AlertTypeWebhook{},
+			wantErr: false,
+		},
+		{
+			name: "valid webhook alert template",
+			whAlert: &RuleType_Definition_Alert_AlertTypeWebhook{
+				Preset:  "slack",
+				Message: "Rule {{ .RuleName }} is {{ .Status }}",
+			},
+			wantErr: false,
+		},
+		{
+			name: "unparsable webhook alert template",
+			whAlert: &RuleType_Definition_Alert_AlertTypeWebhook{
+				Message: "{{ ",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "nil webhook alert is invalid",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.whAlert.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRuleType_Definition_Alert_AlertTypeIssue_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		issueAlert *RuleType_Definition_Alert_AlertTypeIssue
+		wantErr    bool
+	}{
+		{
+			name:       "valid issue alert with default title and body",
+			issueAlert: &RuleType_Definition_Alert_AlertTypeIssue{},
+			wantErr:    false,
+		},
+		{
+			name: "valid issue alert templates",
+			issueAlert: &RuleType_Definition_Alert_AlertTypeIssue{
+				Title:  "{{ .RuleName }} failed",
+				Body:   "{{ .EvalErrorDetails }}",
+				Labels: []string{"security"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unparsable issue title",
+			issueAlert: &RuleType_Definition_Alert_AlertTypeIssue{
+				Title: "{{ ",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unparsable issue body",
+			issueAlert: &RuleType_Definition_Alert_AlertTypeIssue{
+				Body: "{{ end }}",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "nil issue alert is invalid",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.issueAlert.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestRuleType_Definition_Remediate_Validate(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -580,6 +680,220 @@ func TestRestType_Validate(t *testing.T) {
 	}
 }
 
+func TestRestDataSource_Def_Auth_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		auth    *RestDataSource_Def_Auth
+		wantErr bool
+	}{
+		{
+			name:    "nil auth is valid",
+			wantErr: false,
+		},
+		{
+			name: "valid bearer auth",
+			auth: &RestDataSource_Def_Auth{
+				Method: &RestDataSource_Def_Auth_Bearer_{
+					Bearer: &RestDataSource_Def_Auth_Bearer{Secret: "token"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "bearer auth without secret",
+			auth: &RestDataSource_Def_Auth{
+				Method: &RestDataSource_Def_Auth_Bearer_{
+					Bearer: &RestDataSource_Def_Auth_Bearer{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "api key with header and query parameter",
+			auth: &RestDataSource_Def_Auth{
+				Method: &RestDataSource_Def_Auth_ApiKey{
+					ApiKey: &RestDataSource_Def_Auth_APIKey{
+						Header:     "X-API-Key",
+						QueryParam: "key",
+						Secret:     "token",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid provider auth",
+			auth: &RestDataSource_Def_Auth{
+				Method: &RestDataSource_Def_Auth_Provider_{
+					Provider: &RestDataSource_Def_Auth_Provider{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing auth method",
+			auth:    &RestDataSource_Def_Auth{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.auth.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRestDataSource_Limits_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		limits  *RestDataSource_Limits
+		wantErr bool
+	}{
+		{
+			name:    "nil limits are valid",
+			wantErr: false,
+		},
+		{
+			name: "valid limits",
+			limits: &RestDataSource_Limits{
+				RequestsPerSecond: 2.5,
+				Burst:             5,
+				MaxConcurrent:     10,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "negative rate",
+			limits:  &RestDataSource_Limits{RequestsPerSecond: -1},
+			wantErr: true,
+		},
+		{
+			name:    "too many concurrent requests",
+			limits:  &RestDataSource_Limits{MaxConcurrent: 5000},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.limits.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGraphQLDataSource_Def_Validate(t *testing.T) {
+	t.Parallel()
+
+	schema, err := structpb.NewStruct(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"org": map[string]any{"type": "string"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validDef := func() *GraphQLDataSource_Def {
+		return &GraphQLDataSource_Def{
+			Endpoint:    "https://api.github.com/graphql",
+			Query:       "query($login: String!) { organization(login: $login) { name } }",
+			Variables:   map[string]string{"login": "org"},
+			InputSchema: schema,
+			Result:      ".organization.name",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(*GraphQLDataSource_Def)
+		wantErr bool
+	}{
+		{
+			name:    "valid function",
+			mutate:  func(*GraphQLDataSource_Def) {},
+			wantErr: false,
+		},
+		{
+			name:    "empty query",
+			mutate:  func(def *GraphQLDataSource_Def) { def.Query = "" },
+			wantErr: true,
+		},
+		{
+			name:    "variable from unknown argument",
+			mutate:  func(def *GraphQLDataSource_Def) { def.Variables = map[string]string{"login": "owner"} },
+			wantErr: true,
+		},
+		{
+			name:    "invalid result expression",
+			mutate:  func(def *GraphQLDataSource_Def) { def.Result = "organization.name" },
+			wantErr: true,
+		},
+		{
+			name: "valid pagination",
+			mutate: func(def *GraphQLDataSource_Def) {
+				def.Pagination = &GraphQLDataSource_Def_Pagination{
+					Nodes:          ".organization.teams.nodes",
+					PageInfo:       ".organization.teams.pageInfo",
+					CursorVariable: "after",
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "pagination without cursor variable",
+			mutate: func(def *GraphQLDataSource_Def) {
+				def.Pagination = &GraphQLDataSource_Def_Pagination{
+					Nodes:    ".organization.teams.nodes",
+					PageInfo: ".organization.teams.pageInfo",
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many pages",
+			mutate: func(def *GraphQLDataSource_Def) {
+				def.Pagination = &GraphQLDataSource_Def_Pagination{
+					Nodes:          ".organization.teams.nodes",
+					PageInfo:       ".organization.teams.pageInfo",
+					CursorVariable: "after",
+					MaxPages:       1000,
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			def := validDef()
+			tt.mutate(def)
+			err := def.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestRuleType_Definition_Remediate_PullRequestRemediation_Validate(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -669,3 +983,70 @@ func TestRuleType_Definition_Remediate_GhBranchProtectionType_Validate(t *testin
 		})
 	}
 }
+
+func TestProfile_EvaluationSchedule_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		schedule *Profile_EvaluationSchedule
+		wantErr  bool
+	}{
+		{
+			name:     "nil schedule",
+			schedule: nil,
+			wantErr:  false,
+		},
+		{
+			name: "valid interval",
+			schedule: &Profile_EvaluationSchedule{
+				Schedule: &Profile_EvaluationSchedule_Interval{Interval: "168h"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid cron",
+			schedule: &Profile_EvaluationSchedule{
+				Schedule: &Profile_EvaluationSchedule_Cron{Cron: "0 6 * * 1"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unparsable interval",
+			schedule: &Profile_EvaluationSchedule{
+				Schedule: &Profile_EvaluationSchedule_Interval{Interval: "weekly"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "interval too short",
+			schedule: &Profile_EvaluationSchedule{
+				Schedule: &Profile_EvaluationSchedule_Interval{Interval: "30s"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid cron",
+			schedule: &Profile_EvaluationSchedule{
+				Schedule: &Profile_EvaluationSchedule_Cron{Cron: "0 6 * *"},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "empty schedule",
+			schedule: &Profile_EvaluationSchedule{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.schedule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
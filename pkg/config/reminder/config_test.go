@@ -75,6 +75,40 @@ func TestValidateConfig(t *testing.T) {
 			},
 			errMsg: "cannot be negative",
 		},
+		{
+			name: "UnknownEntityType",
+			config: reminder.Config{
+				RecurrenceConfig: reminder.RecurrenceConfig{
+					Interval:   parseTimeDuration(t, "1h"),
+					BatchSize:  100,
+					MinElapsed: parseTimeDuration(t, "1h"),
+					EntityTypes: map[string]reminder.EntityRecurrenceConfig{
+						"widget": {BatchSize: 10},
+					},
+				},
+				EventConfig: serverconfig.EventConfig{
+					Driver: constants.SQLDriver,
+				},
+			},
+			errMsg: `unknown entity type "widget"`,
+		},
+		{
+			name: "NegativeEntityTypeMinElapsed",
+			config: reminder.Config{
+				RecurrenceConfig: reminder.RecurrenceConfig{
+					Interval:   parseTimeDuration(t, "1h"),
+					BatchSize:  100,
+					MinElapsed: parseTimeDuration(t, "1h"),
+					EntityTypes: map[string]reminder.EntityRecurrenceConfig{
+						"artifact": {MinElapsed: parseTimeDuration(t, "-1h")},
+					},
+				},
+				EventConfig: serverconfig.EventConfig{
+					Driver: constants.SQLDriver,
+				},
+			},
+			errMsg: "cannot be negative",
+		},
 		{
 			name: "UnsupportedDriver",
 			config: reminder.Config{
@@ -134,6 +168,41 @@ recurrence:
 	require.Equal(t, "info", cfg.LoggingConfig.Level)
 }
 
+func TestReadConfigEntityTypes(t *testing.T) {
+	t.Parallel()
+
+	cfgstr := `---
+recurrence:
+  batch_size: 100
+  min_elapsed: "1h"
+  entity_types:
+    artifact:
+      batch_size: 20
+      min_elapsed: "6h"
+    pull_request:
+      min_elapsed: "30m"
+`
+
+	cfgbuf := bytes.NewBufferString(cfgstr)
+
+	v := viper.New()
+	reminder.SetViperDefaults(v)
+
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(cfgbuf), "Unexpected error")
+
+	cfg, err := config.ReadConfigFromViper[reminder.Config](v)
+	require.NoError(t, err, "Unexpected error")
+
+	rc := cfg.RecurrenceConfig
+	require.Equal(t, 20, rc.BatchSizeFor("artifact"))
+	require.Equal(t, parseTimeDuration(t, "6h"), rc.MinElapsedFor("artifact"))
+	require.Equal(t, 100, rc.BatchSizeFor("pull_request"))
+	require.Equal(t, parseTimeDuration(t, "30m"), rc.MinElapsedFor("pull_request"))
+	require.Equal(t, 100, rc.BatchSizeFor("repository"))
+	require.Equal(t, parseTimeDuration(t, "1h"), rc.MinElapsedFor("repository"))
+}
+
 func TestReadConfigWithCommandLineArgOverrides(t *testing.T) {
 	t.Parallel()
 
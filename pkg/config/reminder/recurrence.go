@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/config"
 )
 
@@ -22,6 +23,36 @@ type RecurrenceConfig struct {
 	BatchSize int `mapstructure:"batch_size" default:"100"`
 	// MinElapsed is the minimum time after last update before sending a reminder
 	MinElapsed time.Duration `mapstructure:"min_elapsed" default:"1h"`
+	// EntityTypes overrides BatchSize and MinElapsed for some entity types,
+	// keyed by entity type (e.g. "artifact" or "pull_request").
+	EntityTypes map[string]EntityRecurrenceConfig `mapstructure:"entity_types"`
+}
+
+// EntityRecurrenceConfig contains the reminder recurrence configuration
+// for a single entity type
+type EntityRecurrenceConfig struct {
+	// BatchSize is the number of entities of this type to process at once.
+	// Zero uses the default batch size.
+	BatchSize int `mapstructure:"batch_size"`
+	// MinElapsed is the minimum time after last update before sending a
+	// reminder for an entity of this type. Zero uses the default.
+	MinElapsed time.Duration `mapstructure:"min_elapsed"`
+}
+
+// BatchSizeFor returns the batch size for the given entity type
+func (r RecurrenceConfig) BatchSizeFor(entityType string) int {
+	if et, ok := r.EntityTypes[entityType]; ok && et.BatchSize > 0 {
+		return et.BatchSize
+	}
+	return r.BatchSize
+}
+
+// MinElapsedFor returns the minimum elapsed time for the given entity type
+func (r RecurrenceConfig) MinElapsedFor(entityType string) time.Duration {
+	if et, ok := r.EntityTypes[entityType]; ok && et.MinElapsed > 0 {
+		return et.MinElapsed
+	}
+	return r.MinElapsed
 }
 
 // Validate checks that the recurrence config is valid
@@ -34,6 +65,18 @@ func (r RecurrenceConfig) Validate() error {
 		return fmt.Errorf("interval %s cannot be negative", r.Interval)
 	}
 
+	for entityType, et := range r.EntityTypes {
+		if !minderv1.EntityFromString(entityType).IsValid() {
+			return fmt.Errorf("entity_types: unknown entity type %q", entityType)
+		}
+		if et.BatchSize < 0 {
+			return fmt.Errorf("entity_types.%s.batch_size %d cannot be negative", entityType, et.BatchSize)
+		}
+		if et.MinElapsed < 0 {
+			return fmt.Errorf("entity_types.%s.min_elapsed %s cannot be negative", entityType, et.MinElapsed)
+		}
+	}
+
 	return nil
 }
 
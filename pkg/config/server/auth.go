@@ -9,4 +9,9 @@ type AuthConfig struct {
 	NoncePeriod int64 `mapstructure:"nonce_period" default:"3600"`
 	// TokenKey is the key used to store the provider's token in the database
 	TokenKey string `mapstructure:"token_key" default:"./.ssh/token_key_passphrase"`
+	// ServerAdmins are the identities allowed to call the server
+	// administration RPCs, such as managing the dead letter queue.
+	// Identities are written as "<provider>/<user ID>", or as the user ID
+	// alone for the default identity provider.
+	ServerAdmins []string `mapstructure:"server_admins"`
 }
@@ -18,25 +18,28 @@ import (
 
 // Config is the top-level configuration structure.
 type Config struct {
-	HTTPServer      HTTPServerConfig      `mapstructure:"http_server"`
-	GRPCServer      GRPCServerConfig      `mapstructure:"grpc_server"`
-	MetricServer    MetricServerConfig    `mapstructure:"metric_server"`
-	LoggingConfig   LoggingConfig         `mapstructure:"logging"`
-	Tracing         TracingConfig         `mapstructure:"tracing"`
-	Metrics         MetricsConfig         `mapstructure:"metrics"`
-	Flags           FlagsConfig           `mapstructure:"flags"`
-	Database        config.DatabaseConfig `mapstructure:"database"`
-	Identity        IdentityConfigWrapper `mapstructure:"identity"`
-	Auth            AuthConfig            `mapstructure:"auth"`
-	WebhookConfig   WebhookConfig         `mapstructure:"webhook-config"`
-	Events          EventConfig           `mapstructure:"events"`
-	Features        FeaturesConfig        `mapstructure:"features"`
-	Authz           AuthzConfig           `mapstructure:"authz"`
-	Provider        ProviderConfig        `mapstructure:"provider"`
-	Marketplace     MarketplaceConfig     `mapstructure:"marketplace"`
-	DefaultProfiles DefaultProfilesConfig `mapstructure:"default_profiles"`
-	Crypto          CryptoConfig          `mapstructure:"crypto"`
-	Email           EmailConfig           `mapstructure:"email"`
+	HTTPServer       HTTPServerConfig       `mapstructure:"http_server"`
+	GRPCServer       GRPCServerConfig       `mapstructure:"grpc_server"`
+	MetricServer     MetricServerConfig     `mapstructure:"metric_server"`
+	LoggingConfig    LoggingConfig          `mapstructure:"logging"`
+	Tracing          TracingConfig          `mapstructure:"tracing"`
+	Metrics          MetricsConfig          `mapstructure:"metrics"`
+	Flags            FlagsConfig            `mapstructure:"flags"`
+	Database         config.DatabaseConfig  `mapstructure:"database"`
+	Identity         IdentityConfigWrapper  `mapstructure:"identity"`
+	Auth             AuthConfig             `mapstructure:"auth"`
+	WebhookConfig    WebhookConfig          `mapstructure:"webhook-config"`
+	Events           EventConfig            `mapstructure:"events"`
+	Features         FeaturesConfig         `mapstructure:"features"`
+	Authz            AuthzConfig            `mapstructure:"authz"`
+	Provider         ProviderConfig         `mapstructure:"provider"`
+	Marketplace      MarketplaceConfig      `mapstructure:"marketplace"`
+	DefaultProfiles  DefaultProfilesConfig  `mapstructure:"default_profiles"`
+	Crypto           CryptoConfig           `mapstructure:"crypto"`
+	Email            EmailConfig            `mapstructure:"email"`
+	IngestCache      IngestCacheConfig      `mapstructure:"ingest_cache"`
+	Export           ExportConfig           `mapstructure:"export"`
+	HistoryRetention HistoryRetentionConfig `mapstructure:"history_retention"`
 }
 
 // DefaultConfigForTest returns a configuration with all the struct defaults set,
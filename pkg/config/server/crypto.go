@@ -11,12 +11,13 @@ type CryptoConfig struct {
 }
 
 // KeyStoreConfig specifies the type of keystore to use and its configuration
-// If we support multiple types of keystore (e.g. local, aws secrets, vault, etc.)
-// it is intended that there will be one field for each type of keystore config,
-// and that the `Type` field specifies which one to use
+// There is one field for each type of keystore config, and the `Type` field
+// specifies which one to use
 type KeyStoreConfig struct {
 	Type  string              `mapstructure:"type" default:"local"`
 	Local LocalKeyStoreConfig `mapstructure:"local"`
+	Vault VaultKeyStoreConfig `mapstructure:"vault"`
+	KMS   KMSKeyStoreConfig   `mapstructure:"kms"`
 }
 
 // DefaultCrypto defines the default crypto to be used for new data
@@ -37,3 +38,28 @@ type LocalKeyStoreConfig struct {
 	// `./.ssh/` is the directory generated by `make bootstrap`
 	KeyDir string `mapstructure:"key_dir" default:"./.ssh/"`
 }
+
+// VaultKeyStoreConfig contains configuration for the HashiCorp Vault Transit
+// keystore. The key IDs are the names of Transit keys.
+type VaultKeyStoreConfig struct {
+	// Address is the address of the Vault server
+	Address string `mapstructure:"address"`
+	// Mount is the path the Transit secrets engine is mounted at
+	Mount string `mapstructure:"mount" default:"transit"`
+	// Namespace is the Vault Enterprise namespace, if any
+	Namespace string `mapstructure:"namespace"`
+	// TokenFile is the file holding the Vault token. The VAULT_TOKEN
+	// environment variable is used if it is not set. The file is read
+	// again when Vault rejects the token, so that it can be rotated by
+	// Vault Agent without restarting the server.
+	TokenFile string `mapstructure:"token_file"`
+}
+
+// KMSKeyStoreConfig contains configuration for the AWS KMS keystore. The key
+// IDs are KMS key IDs, ARNs or aliases.
+type KMSKeyStoreConfig struct {
+	// Region is the AWS region of the keys
+	Region string `mapstructure:"region"`
+	// Endpoint overrides the KMS endpoint, e.g. to use a local KMS
+	Endpoint string `mapstructure:"endpoint"`
+}
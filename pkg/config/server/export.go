@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// ExportConfig is the configuration for exporting evaluation, remediation
+// and alert status changes as CloudEvents to external systems.
+type ExportConfig struct {
+	// Sink is where events are exported to. It is one of "http", "nats"
+	// or "file". Events are not exported when it is empty.
+	Sink string `mapstructure:"sink" default:""`
+	// Source is the CloudEvents source attribute of exported events, which
+	// would usually be the URL of this Minder instance.
+	Source string `mapstructure:"source" default:"minder"`
+	// HTTP is the configuration for the "http" sink
+	HTTP HTTPExportConfig `mapstructure:"http"`
+	// Nats is the configuration for the "nats" sink
+	Nats NatsExportConfig `mapstructure:"nats"`
+	// File is the configuration for the "file" sink
+	File FileExportConfig `mapstructure:"file"`
+	// Retry is the configuration for retrying failed deliveries
+	Retry ExportRetryConfig `mapstructure:"retry"`
+}
+
+// HTTPExportConfig is the configuration for exporting events to an HTTP
+// endpoint
+type HTTPExportConfig struct {
+	// URL is the endpoint events are posted to, in the CloudEvents binary
+	// content mode
+	URL string `mapstructure:"url"`
+}
+
+// NatsExportConfig is the configuration for exporting events to a NATS
+// JetStream subject
+type NatsExportConfig struct {
+	// URL is the URL for the NATS server
+	URL string `mapstructure:"url" default:"nats://localhost:4222"`
+	// Prefix is the name of the stream, and the prefix of the subject
+	Prefix string `mapstructure:"prefix" default:"minder"`
+	// Subject is the subject events are published to, under the prefix
+	Subject string `mapstructure:"subject" default:"export"`
+}
+
+// FileExportConfig is the configuration for exporting events to a local
+// file
+type FileExportConfig struct {
+	// Path is the file which events are appended to, one JSON-encoded
+	// CloudEvent per line
+	Path string `mapstructure:"path" default:"/tmp/minder-events.jsonl"`
+}
+
+// ExportRetryConfig is the configuration for retrying failed deliveries.
+// Once the retries are exhausted, the event is handed back to the event
+// router, which retries it again and eventually moves it to the dead
+// letter queue.
+type ExportRetryConfig struct {
+	// MaxRetries is the maximum number of retries for a single delivery
+	MaxRetries uint64 `mapstructure:"max_retries" default:"5"`
+	// InitialInterval is the delay before the first retry, which doubles
+	// on every further retry
+	InitialInterval time.Duration `mapstructure:"initial_interval" default:"500ms"`
+	// MaxInterval is the maximum delay between retries
+	MaxInterval time.Duration `mapstructure:"max_interval" default:"30s"`
+}
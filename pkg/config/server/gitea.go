@@ -0,0 +1,12 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+// GiteaConfig is the configuration for the Gitea (and Forgejo) providers
+type GiteaConfig struct {
+	// WebhookSecrets is the configuration for the Gitea webhook secrets
+	// setup and verification. This is used to sign the webhooks created on
+	// Gitea repositories, and to verify the incoming webhook requests.
+	WebhookSecrets `mapstructure:",squash"`
+}
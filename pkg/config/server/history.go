@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// HistoryRetentionConfig is the configuration for the background job which
+// purges evaluation history according to the retention policy of each
+// project.
+type HistoryRetentionConfig struct {
+	// Enabled runs the purge job in the server
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// DryRun only reports how many records each project would lose,
+	// without deleting them
+	DryRun bool `mapstructure:"dry_run" default:"false"`
+	// Interval is the time between purge runs
+	Interval time.Duration `mapstructure:"interval" default:"24h"`
+	// DefaultKeepDays is the number of days evaluation history is kept for
+	// projects which don't set their own retention policy
+	DefaultKeepDays int `mapstructure:"default_keep_days" default:"30"`
+	// BatchSize is the number of records deleted in each transaction
+	BatchSize int `mapstructure:"batch_size" default:"1000"`
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// IngestCacheConfig is the configuration for the cache which is shared
+// between rule evaluations to avoid re-ingesting the same data.
+type IngestCacheConfig struct {
+	// Type is the type of shared cache to use. "none" disables the shared
+	// cache, so that ingested data is only cached for a single entity
+	// evaluation. "disk" stores ingested data on the local filesystem.
+	Type string `mapstructure:"type" default:"none"`
+	// Disk contains the configuration for the "disk" cache type
+	Disk DiskIngestCacheConfig `mapstructure:"disk"`
+}
+
+// DiskIngestCacheConfig is the configuration for the on-disk ingest cache
+type DiskIngestCacheConfig struct {
+	// Dir is the directory where cache entries are stored
+	Dir string `mapstructure:"dir" default:"/tmp/minder-ingest-cache"`
+	// TTL is the maximum age of a cache entry
+	TTL time.Duration `mapstructure:"ttl" default:"1h"`
+	// MaxBytes is the maximum total size of the cache on disk. When the
+	// limit is exceeded, the least recently used entries are evicted.
+	MaxBytes int64 `mapstructure:"max_bytes" default:"1_000_000_000"`
+	// Ingesters is the list of ingester types whose results are stored
+	// in the shared cache. If empty, the git and deps ingesters are
+	// cached. REST responses can change without a push to the
+	// repository, so caching the rest ingester may serve data up to TTL
+	// old.
+	Ingesters []string `mapstructure:"ingesters"`
+}
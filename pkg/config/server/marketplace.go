@@ -14,6 +14,8 @@ type ConfigBundleSource string
 const (
 	// TgzSource represents a bundle in a .tar.gz file
 	TgzSource ConfigBundleSource = "tgz"
+	// OCISource represents bundles stored in an OCI registry
+	OCISource ConfigBundleSource = "oci"
 	// Unknown is a default value
 	Unknown = "unknown"
 )
@@ -27,20 +29,58 @@ var (
 type MarketplaceConfig struct {
 	Enabled bool                 `mapstructure:"enabled" default:"false"`
 	Sources []BundleSourceConfig `mapstructure:"sources"`
+	// TrustPolicy restricts the bundles projects may subscribe to to the
+	// bundles signed by a trusted signer. When no signers are configured,
+	// unsigned bundles are accepted.
+	TrustPolicy BundleTrustPolicyConfig `mapstructure:"trust_policy"`
+}
+
+// BundleTrustPolicyConfig holds the signers trusted to sign bundles. A
+// bundle is trusted if its manifest is signed by any of them.
+type BundleTrustPolicyConfig struct {
+	// Keyless lists the identities trusted to sign bundles with a
+	// certificate issued by sigstore
+	Keyless []KeylessSignerConfig `mapstructure:"keyless"`
+	// PublicKeys lists the paths of the PEM encoded public keys trusted to
+	// sign bundles
+	PublicKeys []string `mapstructure:"public_keys"`
+}
+
+// KeylessSignerConfig identifies a signer by the identity in its sigstore
+// certificate
+type KeylessSignerConfig struct {
+	// Issuer is the OIDC issuer of the signer's identity, e.g.
+	// https://token.actions.githubusercontent.com
+	Issuer string `mapstructure:"issuer"`
+	// Subject is a regular expression matching the signer's identity, e.g.
+	// the workflow which built the bundle
+	Subject string `mapstructure:"subject"`
+	// TUFRoot is the TUF repository of the sigstore instance which issued
+	// the certificate, defaults to the public sigstore instance
+	TUFRoot string `mapstructure:"tuf_root"`
 }
 
 // BundleSourceConfig holds details about where the bundle gets loaded from
 type BundleSourceConfig struct {
-	Type     string `mapstructure:"type"`
+	Type string `mapstructure:"type"`
+	// Location is the path of the .tar.gz file for tgz sources, and the
+	// repository below which the bundles are stored for oci sources, e.g.
+	// registry.example.com/mindpaks
 	Location string `mapstructure:"location"`
+	// Insecure allows connecting to the registry of an oci source over
+	// plain HTTP
+	Insecure bool `mapstructure:"insecure" default:"false"`
 }
 
 // GetType returns the source as an enum type, or error if invalid
 // TODO: investigate whether mapstructure would allow us to validate during
 // deserialization.
 func (b *BundleSourceConfig) GetType() (ConfigBundleSource, error) {
-	if b.Type == string(TgzSource) {
+	switch ConfigBundleSource(b.Type) {
+	case TgzSource:
 		return TgzSource, nil
+	case OCISource:
+		return OCISource, nil
 	}
 	return Unknown, fmt.Errorf("%w: %s", ErrInvalidBundleSource, b.Type)
 }
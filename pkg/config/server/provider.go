@@ -9,10 +9,20 @@ type ProviderConfig struct {
 	GitHub    *GitHubConfig    `mapstructure:"github"`
 	Git       GitConfig        `mapstructure:"git"`
 	GitLab    *GitLabConfig    `mapstructure:"gitlab"`
+	Gitea     *GiteaConfig     `mapstructure:"gitea"`
 }
 
 // GitConfig provides server-side configuration for Git operations like "clone"
 type GitConfig struct {
 	MaxFiles int64 `mapstructure:"max_files" default:"10000"`
 	MaxBytes int64 `mapstructure:"max_bytes" default:"100_000_000"`
+	// Mirror configures a store of bare repository mirrors which are
+	// fetched incrementally instead of cloning repositories from scratch
+	Mirror GitMirrorConfig `mapstructure:"mirror"`
+}
+
+// GitMirrorConfig provides the configuration for the git mirror store
+type GitMirrorConfig struct {
+	Enabled bool   `mapstructure:"enabled" default:"false"`
+	Dir     string `mapstructure:"dir" default:"/tmp/minder-git-mirrors"`
 }
@@ -19,6 +19,9 @@ const (
 	RepoPropertyIsArchived = "is_archived"
 	// RepoPropertyIsFork represents whether the repository is a fork
 	RepoPropertyIsFork = "is_fork"
+	// RepoPropertyPushedAt represents the time of the last push to the
+	// repository, if the provider reports it
+	RepoPropertyPushedAt = "pushed_at"
 )
 
 // Pull Request property keys
@@ -37,6 +40,8 @@ const (
 	PullRequestTargetBranch = "target_branch"
 	// PullRequestUpstreamURL represents the URL of the pull request in the provider
 	PullRequestUpstreamURL = "upstream_url"
+	// PullRequestRepoUpstreamID represents the upstream ID of the repository the pull request is opened against
+	PullRequestRepoUpstreamID = "repo_upstream_id"
 )
 
 // Artifact property keys
@@ -43,6 +43,10 @@ const (
 	TopicQueueReconcileEntityDelete = "internal.entity.delete.event"
 	// TopicQueueReconcileEntityAdd is the topic for reconciling when an entity is added
 	TopicQueueReconcileEntityAdd = "internal.entity.add.event"
-	// TopicQueueRepoReminder is the topic for repo reminder events
+	// TopicQueueRepoReminder is the topic for repo reminder events.
+	// Deprecated: reminders are published to TopicQueueEntityReminder; this
+	// topic is only consumed to drain reminders sent by older versions.
 	TopicQueueRepoReminder = "repo.reminder.event"
+	// TopicQueueEntityReminder is the topic for entity reminder events
+	TopicQueueEntityReminder = "entity.reminder.event"
 )
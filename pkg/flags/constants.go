@@ -10,6 +10,8 @@ const (
 	DockerHubProvider Experiment = "dockerhub_provider"
 	// GitLabProvider enables the GitLab provider.
 	GitLabProvider Experiment = "gitlab_provider"
+	// GiteaProvider enables the Gitea (and Forgejo) provider.
+	GiteaProvider Experiment = "gitea_provider"
 	// MachineAccounts enables machine accounts (in particular, GitHub Actions) for authorization
 	MachineAccounts Experiment = "machine_accounts"
 	// AlternateMessageDriver enables an an alternate message driver.
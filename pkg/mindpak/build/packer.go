@@ -98,6 +98,12 @@ func (*Packer) InitBundle(opts *InitOptions) (*mindpak.Bundle, error) {
 		return nil, fmt.Errorf("writing manifest data: %w", err)
 	}
 	fmt.Printf("wrote to %s\n", f.Name())
+
+	// The signature of a previous manifest no longer applies
+	sigPath := filepath.Join(opts.Path, mindpak.SignatureFileName)
+	if err := os.Remove(sigPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("removing stale signature: %w", err)
+	}
 	return bundle, nil
 }
 
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	"github.com/sigstore/sigstore-go/pkg/sign"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+const (
+	// DefaultFulcioURL is the URL of the public sigstore certificate authority
+	DefaultFulcioURL = "https://fulcio.sigstore.dev"
+	// DefaultRekorURL is the URL of the public sigstore transparency log
+	DefaultRekorURL = "https://rekor.sigstore.dev"
+
+	signingTimeout = 30 * time.Second
+)
+
+// SignOptions control how the manifest of a bundle gets signed.
+type SignOptions struct {
+	// Path is the bundle directory holding the manifest
+	Path string
+	// Key is a PEM encoded ECDSA private key to sign the manifest with.
+	// Keys generated by cosign are supported, the Password is used to
+	// decrypt them.
+	Key      []byte
+	Password []byte
+	// IdentityToken is the OIDC token used to request a short-lived
+	// certificate from Fulcio when signing keyless, i.e. when Key is empty
+	IdentityToken string
+	FulcioURL     string
+	RekorURL      string
+}
+
+// Validate checks the signing options
+func (opts *SignOptions) Validate() error {
+	var errs = []error{}
+	if opts.Path == "" {
+		errs = append(errs, fmt.Errorf("path of the bundle is required to sign it"))
+	}
+	if len(opts.Key) == 0 && opts.IdentityToken == "" {
+		errs = append(errs, fmt.Errorf("either a key or an identity token is required to sign a bundle"))
+	}
+	if len(opts.Key) > 0 && opts.IdentityToken != "" {
+		errs = append(errs, fmt.Errorf("a bundle can be signed either with a key or keyless, not both"))
+	}
+	return errors.Join(errs...)
+}
+
+// SignBundle signs the manifest of a bundle initialized with InitBundle and
+// writes the signature, a sigstore bundle, next to it so it gets packed with
+// the rest of the bundle.
+func (*Packer) SignBundle(ctx context.Context, opts *SignOptions) error {
+	if opts == nil {
+		return fmt.Errorf("invalid sign options")
+	}
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("validating sign options: %w", err)
+	}
+
+	manifest, err := os.ReadFile(filepath.Join(filepath.Clean(opts.Path), mindpak.ManifestFileName))
+	if err != nil {
+		return fmt.Errorf("reading manifest: %w", err)
+	}
+
+	var keypair sign.Keypair
+	bundleOpts := sign.BundleOptions{Context: ctx}
+	if len(opts.Key) > 0 {
+		keypair, err = newKeypair(opts.Key, opts.Password)
+		if err != nil {
+			return fmt.Errorf("loading signing key: %w", err)
+		}
+	} else {
+		keypair, err = sign.NewEphemeralKeypair(nil)
+		if err != nil {
+			return fmt.Errorf("generating ephemeral key: %w", err)
+		}
+		bundleOpts.CertificateProvider = sign.NewFulcio(&sign.FulcioOptions{
+			BaseURL: valueOrDefault(opts.FulcioURL, DefaultFulcioURL),
+			Timeout: signingTimeout,
+		})
+		bundleOpts.CertificateProviderOptions = &sign.CertificateProviderOptions{
+			IDToken: opts.IdentityToken,
+		}
+		bundleOpts.TransparencyLogs = []sign.Transparency{
+			sign.NewRekor(&sign.RekorOptions{
+				BaseURL: valueOrDefault(opts.RekorURL, DefaultRekorURL),
+				Timeout: signingTimeout,
+			}),
+		}
+	}
+
+	pb, err := sign.Bundle(&sign.PlainData{Data: manifest}, keypair, bundleOpts)
+	if err != nil {
+		return fmt.Errorf("signing manifest: %w", err)
+	}
+
+	data, err := protojson.Marshal(pb)
+	if err != nil {
+		return fmt.Errorf("encoding signature: %w", err)
+	}
+
+	sigPath := filepath.Join(filepath.Clean(opts.Path), mindpak.SignatureFileName)
+	if err := os.WriteFile(sigPath, data, 0600); err != nil {
+		return fmt.Errorf("writing signature: %w", err)
+	}
+	return nil
+}
+
+// keypair implements sign.Keypair on top of an existing ECDSA private key
+type keypair struct {
+	key  *ecdsa.PrivateKey
+	hint []byte
+}
+
+var _ sign.Keypair = (*keypair)(nil)
+
+func newKeypair(keyPEM, password []byte) (*keypair, error) {
+	priv, err := cryptoutils.UnmarshalPEMToPrivateKey(keyPEM, cryptoutils.StaticPasswordFunc(password))
+	if err != nil {
+		return nil, err
+	}
+	key, ok := priv.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T, only ECDSA keys are supported", priv)
+	}
+
+	// Use the same hint as the sigstore ephemeral keys: the hash of the
+	// public key
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(der)
+
+	return &keypair{
+		key:  key,
+		hint: []byte(base64.StdEncoding.EncodeToString(digest[:])),
+	}, nil
+}
+
+func (*keypair) GetHashAlgorithm() protocommon.HashAlgorithm {
+	return protocommon.HashAlgorithm_SHA2_256
+}
+
+func (k *keypair) GetHint() []byte {
+	return k.hint
+}
+
+func (*keypair) GetKeyAlgorithm() string {
+	return "ECDSA"
+}
+
+func (k *keypair) GetPublicKeyPem() (string, error) {
+	pem, err := cryptoutils.MarshalPublicKeyToPEM(k.key.Public())
+	if err != nil {
+		return "", err
+	}
+	return string(pem), nil
+}
+
+func (k *keypair) SignData(data []byte) ([]byte, []byte, error) {
+	digest := sha256.Sum256(data)
+	sig, err := k.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, digest[:], nil
+}
+
+func valueOrDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+func TestSignOptionsValidate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		opts    *SignOptions
+		mustErr bool
+	}{
+		{name: "key", opts: &SignOptions{Path: "bundle", Key: []byte("key")}},
+		{name: "keyless", opts: &SignOptions{Path: "bundle", IdentityToken: "token"}},
+		{name: "no-path", opts: &SignOptions{Key: []byte("key")}, mustErr: true},
+		{name: "no-key", opts: &SignOptions{Path: "bundle"}, mustErr: true},
+		{name: "key-and-keyless", opts: &SignOptions{Path: "bundle", Key: []byte("key"), IdentityToken: "token"}, mustErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.opts.Validate()
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPackerSignBundle(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+
+	dir := t.TempDir()
+	opts := &InitOptions{
+		Metadata: &mindpak.Metadata{Name: "my-bundle"},
+		Path:     dir,
+	}
+	sigPath := filepath.Join(dir, mindpak.SignatureFileName)
+
+	p := NewPacker()
+	_, err = p.InitBundle(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.SignBundle(context.Background(), &SignOptions{Path: dir, Key: keyPEM}))
+	require.FileExists(t, sigPath)
+
+	// The signature is part of the bundle
+	bundle, err := mindpak.NewBundleFromDirectory(dir)
+	require.NoError(t, err)
+	require.NoError(t, bundle.VerifyFiles())
+
+	// Rebuilding the bundle drops the signature of the previous manifest
+	_, err = p.InitBundle(opts)
+	require.NoError(t, err)
+	require.NoFileExists(t, sigPath)
+
+	// Invalid keys are rejected without writing a signature
+	require.Error(t, p.SignBundle(context.Background(), &SignOptions{Path: dir, Key: []byte("not a key")}))
+	_, err = os.Stat(sigPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -9,6 +9,7 @@ import (
 	"archive/tar"
 	"compress/gzip"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -69,9 +70,20 @@ func NewBundleFromTarGZ(path string) (*Bundle, error) {
 	}
 	defer file.Close()
 
-	gz, err := gzip.NewReader(file)
+	bundle, err := NewBundleFromTarGZReader(file)
 	if err != nil {
-		return nil, fmt.Errorf("error while creating gzip reader for %s: %w", path, err)
+		return nil, fmt.Errorf("error while loading %s: %w", path, err)
+	}
+	return bundle, nil
+}
+
+// NewBundleFromTarGZReader loads a bundle from a reader returning the
+// contents of a .tar.gz file, e.g. a bundle fetched from an OCI registry.
+// As with NewBundleFromTarGZ, the contents are loaded into memory.
+func NewBundleFromTarGZReader(r io.Reader) (*Bundle, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating gzip reader: %w", err)
 	}
 	defer gz.Close()
 
@@ -85,7 +97,7 @@ func NewBundleFromTarGZ(path string) (*Bundle, error) {
 		Source: sourceFS,
 	}
 	if err := bundle.ReadSource(); err != nil {
-		return nil, fmt.Errorf("reading bundle data from %q: %w", path, err)
+		return nil, fmt.Errorf("reading bundle data: %w", err)
 	}
 
 	return bundle, nil
@@ -182,7 +194,8 @@ func pathInKnownDirectory(path string) bool {
 	return strings.HasPrefix(path, PathProfiles+"/") ||
 		strings.HasPrefix(path, PathRuleTypes+"/") ||
 		strings.HasPrefix(path, PathDataSources+"/") ||
-		strings.HasPrefix(path, ManifestFileName)
+		strings.HasPrefix(path, ManifestFileName) ||
+		path == SignatureFileName
 }
 
 // Verify checks the contents of the bundle against its manifest
@@ -191,6 +204,49 @@ func (*Bundle) Verify() error {
 	return nil
 }
 
+// VerifyFiles checks the files of the bundle against the hashes in its
+// manifest: every file in the bundle must be listed in the manifest with a
+// matching hash, and every file listed in the manifest must be present in
+// the bundle.
+func (b *Bundle) VerifyFiles() error {
+	if b.Manifest == nil || b.Manifest.Files == nil {
+		return fmt.Errorf("bundle has no manifest")
+	}
+	if b.Files == nil {
+		return fmt.Errorf("bundle contents have not been read")
+	}
+
+	return errors.Join(
+		verifyFiles(PathProfiles, b.Manifest.Files.Profiles, b.Files.Profiles),
+		verifyFiles(PathRuleTypes, b.Manifest.Files.RuleTypes, b.Files.RuleTypes),
+		verifyFiles(PathDataSources, b.Manifest.Files.DataSources, b.Files.DataSources),
+	)
+}
+
+func verifyFiles(dir string, expected, actual []*File) error {
+	hashes := make(map[string]string, len(expected))
+	for _, f := range expected {
+		hashes[f.Name] = f.Hashes[SHA256]
+	}
+
+	var errs []error
+	for _, f := range actual {
+		hash, ok := hashes[f.Name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s/%s is not listed in the manifest", dir, f.Name))
+			continue
+		}
+		if hash != f.Hashes[SHA256] {
+			errs = append(errs, fmt.Errorf("%s/%s does not match the hash in the manifest", dir, f.Name))
+		}
+		delete(hashes, f.Name)
+	}
+	for name := range hashes {
+		errs = append(errs, fmt.Errorf("%s/%s is listed in the manifest but missing from the bundle", dir, name))
+	}
+	return errors.Join(errs...)
+}
+
 func copyTarIntoMemory(tarReader *tar.Reader) (fs.StatFS, error) {
 	// create the memfs instance, and create the directories we need
 	sourceFS := afero.NewIOFS(afero.NewMemMapFs())
@@ -22,6 +22,10 @@ const (
 
 	// ManifestFileName is the defaul filename for the manifest
 	ManifestFileName = "manifest.json"
+
+	// SignatureFileName is the filename of the sigstore bundle holding the
+	// signature of the manifest, if the bundle is signed
+	SignatureFileName = "manifest.sigstore.json"
 )
 
 const (
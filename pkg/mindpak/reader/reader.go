@@ -5,6 +5,7 @@
 package reader
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"strings"
@@ -23,6 +24,10 @@ type BundleReader interface {
 	// read it from the bundle, parse it and return an instance of the profile
 	// struct
 	GetProfile(string) (*v1.Profile, error)
+	// ForEachProfile walks each profile in the bundle, attempts to read and
+	// parse the profile, and then applies the specified anonymous function
+	// to the profile
+	ForEachProfile(func(*v1.Profile) error) error
 	// ForEachRuleType walks each rule type in the bundle, attempts to read
 	// and parse the rule type, and then applies the specified anonymous
 	// function to the rule type
@@ -31,6 +36,11 @@ type BundleReader interface {
 	// and parse the data source, and then applies the specified anonymous
 	// function to the rule type
 	ForEachDataSource(func(source *v1.DataSource) error) error
+	// GetSignedManifest returns the raw manifest of the bundle and its
+	// signature, a sigstore bundle, or a nil signature if the bundle is not
+	// signed. The files of the bundle are checked against the hashes in the
+	// manifest, so a valid signature of the manifest covers the whole bundle.
+	GetSignedManifest() (manifest []byte, signature []byte, err error)
 }
 
 type profileSetType = map[string]struct{}
@@ -90,6 +100,22 @@ func (b *bundleReader) GetProfile(name string) (*v1.Profile, error) {
 	return profile, nil
 }
 
+func (b *bundleReader) ForEachProfile(fn func(*v1.Profile) error) error {
+	for _, profile := range b.original.Files.Profiles {
+		parsedProfile, err := b.GetProfile(profile.Name)
+		if err != nil {
+			return err
+		}
+
+		// apply operation from caller
+		if err := fn(parsedProfile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (b *bundleReader) ForEachRuleType(fn func(*v1.RuleType) error) error {
 	var err error
 	var file fs.File
@@ -166,6 +192,26 @@ func (b *bundleReader) ForEachDataSource(fn func(source *v1.DataSource) error) e
 	return nil
 }
 
+func (b *bundleReader) GetSignedManifest() ([]byte, []byte, error) {
+	if err := b.original.VerifyFiles(); err != nil {
+		return nil, nil, fmt.Errorf("bundle does not match its manifest: %w", err)
+	}
+
+	manifest, err := fs.ReadFile(b.original.Source, mindpak.ManifestFileName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading manifest from bundle: %w", err)
+	}
+
+	signature, err := fs.ReadFile(b.original.Source, mindpak.SignatureFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return manifest, nil, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("error reading signature from bundle: %w", err)
+	}
+
+	return manifest, signature, nil
+}
+
 func ensureYamlSuffix(name string) string {
 	if strings.HasSuffix(name, fileSuffix) {
 		return name
@@ -5,6 +5,8 @@ package reader_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -83,6 +85,18 @@ func TestBundle_GetProfile(t *testing.T) {
 	}
 }
 
+func TestBundle_ForEachProfile(t *testing.T) {
+	t.Parallel()
+	results := []string{}
+	bundle := loadBundle(t, testDataPath)
+	err := bundle.ForEachProfile(func(profile *minderv1.Profile) error {
+		results = append(results, profile.Name)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{expectedProfileName}, results)
+}
+
 func TestBundle_ForEachRuleType(t *testing.T) {
 	t.Parallel()
 	results := []string{}
@@ -139,6 +153,32 @@ func TestBundle_ForEachDataSourceError(t *testing.T) {
 	require.ErrorContains(t, err, errorMessage)
 }
 
+func TestBundle_GetSignedManifest(t *testing.T) {
+	t.Parallel()
+	bundle := loadBundle(t, testDataPath)
+	manifest, signature, err := bundle.GetSignedManifest()
+	require.NoError(t, err)
+	require.Nil(t, signature)
+
+	expected, err := os.ReadFile(filepath.Join(testDataPath, mindpak.ManifestFileName))
+	require.NoError(t, err)
+	require.Equal(t, expected, manifest)
+}
+
+func TestBundle_GetSignedManifestTampered(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS(testDataPath)))
+
+	ruleTypePath := filepath.Join(dir, mindpak.PathRuleTypes, "branch_protection_enabled.yaml")
+	require.NoError(t, os.WriteFile(ruleTypePath, []byte("tampered"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, mindpak.SignatureFileName), []byte("{}"), 0600))
+
+	bundle := loadBundle(t, dir)
+	_, _, err := bundle.GetSignedManifest()
+	require.ErrorContains(t, err, "bundle does not match its manifest")
+}
+
 func loadBundle(t *testing.T, path string) reader.BundleReader {
 	t.Helper()
 	bundle, err := mindpak.NewBundleFromDirectory(path)
@@ -0,0 +1,400 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sources
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"golang.org/x/mod/semver"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/build"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+)
+
+const (
+	// BundleConfigMediaType is the media type of the config of a mindpak
+	// bundle stored as an OCI artifact
+	BundleConfigMediaType types.MediaType = "application/vnd.minder.mindpak.config.v1+json"
+	// BundleLayerMediaType is the media type of the layer holding the
+	// .tar.gz archive of a mindpak bundle
+	BundleLayerMediaType types.MediaType = "application/vnd.minder.mindpak.bundle.v1.tar+gzip"
+
+	// AnnotationManifestDigest is the annotation holding the digest of the
+	// manifest of the bundle, i.e. its manifest.json file
+	AnnotationManifestDigest = "dev.minder.mindpak.manifest.digest"
+	// AnnotationNamespace is the annotation holding the namespace of the bundle
+	AnnotationNamespace = "dev.minder.mindpak.namespace"
+	// AnnotationName is the annotation holding the name of the bundle
+	AnnotationName = "dev.minder.mindpak.name"
+	// AnnotationVersion is the standard OCI annotation holding the version
+	// of the bundle
+	AnnotationVersion = "org.opencontainers.image.version"
+)
+
+var (
+	// ErrDigestMismatch is returned when the contents of a bundle pulled
+	// from an OCI registry do not match their digest
+	ErrDigestMismatch = errors.New("digest mismatch")
+	// ErrNotABundle is returned when an OCI artifact is not a mindpak bundle
+	ErrNotABundle = errors.New("artifact is not a mindpak bundle")
+)
+
+// OCISource is a BundleSource backed by an OCI registry. Each bundle is
+// stored in its own repository below the base repository of the source, with
+// a tag per version. For instance, version 1.0.0 of the bundle
+// stacklok/healthcheck in the source registry.example.com/mindpaks is stored
+// as registry.example.com/mindpaks/stacklok/healthcheck:1.0.0.
+type OCISource struct {
+	base       name.Repository
+	bundles    []mindpak.BundleID
+	nameOpts   []name.Option
+	remoteOpts []remote.Option
+}
+
+// OCIOption is a functional option for OCISource
+type OCIOption func(*OCISource)
+
+// WithInsecureRegistry allows connecting to the registry over plain HTTP
+func WithInsecureRegistry() OCIOption {
+	return func(s *OCISource) {
+		s.nameOpts = append(s.nameOpts, name.Insecure)
+	}
+}
+
+// WithRemoteOptions sets additional options for the requests to the
+// registry, e.g. the authentication or the transport
+func WithRemoteOptions(opts ...remote.Option) OCIOption {
+	return func(s *OCISource) {
+		s.remoteOpts = append(s.remoteOpts, opts...)
+	}
+}
+
+// WithBundles sets the bundles listed by the source. By default, the
+// bundles are listed from the catalog of the registry, which some
+// registries do not support.
+func WithBundles(ids ...mindpak.BundleID) OCIOption {
+	return func(s *OCISource) {
+		s.bundles = append(s.bundles, ids...)
+	}
+}
+
+// NewOCISource creates a BundleSource for the bundles stored below the
+// given base repository, e.g. registry.example.com/mindpaks. By default,
+// the registry credentials are read from the docker config.
+func NewOCISource(base string, opts ...OCIOption) (*OCISource, error) {
+	s := &OCISource{
+		remoteOpts: []remote.Option{remote.WithAuthFromKeychain(authn.DefaultKeychain)},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	repo, err := name.NewRepository(base, s.nameOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository %s: %w", base, err)
+	}
+	s.base = repo
+
+	return s, nil
+}
+
+// GetBundle fetches the latest version of a bundle from the registry
+func (s *OCISource) GetBundle(id mindpak.BundleID) (reader.BundleReader, error) {
+	ctx := context.Background()
+
+	versions, err := s.ListVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrBundleNotFound, id)
+	}
+
+	return s.GetBundleVersion(ctx, id, versions[len(versions)-1])
+}
+
+// ListBundles lists the bundles in the registry
+func (s *OCISource) ListBundles() ([]mindpak.BundleID, error) {
+	if len(s.bundles) > 0 {
+		return s.bundles, nil
+	}
+
+	ctx := context.Background()
+	repos, err := remote.Catalog(ctx, s.base.Registry, s.options(ctx)...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing repositories in %s: %w", s.base.RegistryStr(), err)
+	}
+
+	prefix := s.base.RepositoryStr() + "/"
+	var ids []mindpak.BundleID
+	for _, repo := range repos {
+		rest, ok := strings.CutPrefix(repo, prefix)
+		if !ok {
+			continue
+		}
+		namespace, bundleName, ok := strings.Cut(rest, "/")
+		if !ok || strings.Contains(bundleName, "/") {
+			continue
+		}
+		ids = append(ids, mindpak.ID(namespace, bundleName))
+	}
+
+	return ids, nil
+}
+
+// ListVersions lists the versions of a bundle, from oldest to newest.
+// Tags which are not semantic versions are ignored.
+func (s *OCISource) ListVersions(ctx context.Context, id mindpak.BundleID) ([]string, error) {
+	repo, err := s.repository(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := remote.List(repo, s.options(ctx)...)
+	if isNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", ErrBundleNotFound, id)
+	} else if err != nil {
+		return nil, fmt.Errorf("error listing versions of %s: %w", id, err)
+	}
+
+	versions := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if semver.IsValid(canonicalVersion(tag)) {
+			versions = append(versions, tag)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.Compare(canonicalVersion(versions[i]), canonicalVersion(versions[j])) < 0
+	})
+
+	return versions, nil
+}
+
+// GetBundleVersion fetches a version of a bundle from the registry. The
+// version is either a tag or a digest. The digests of the OCI manifest,
+// the bundle archive and the bundle manifest are verified.
+func (s *OCISource) GetBundleVersion(
+	ctx context.Context, id mindpak.BundleID, version string,
+) (reader.BundleReader, error) {
+	repo, err := s.repository(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var ref name.Reference
+	if strings.HasPrefix(version, "sha256:") {
+		ref = repo.Digest(version)
+	} else {
+		ref = repo.Tag(version)
+	}
+
+	opts := s.options(ctx)
+	desc, err := remote.Get(ref, opts...)
+	if isNotFound(err) {
+		return nil, fmt.Errorf("%w: %s@%s", ErrBundleNotFound, id, version)
+	} else if err != nil {
+		return nil, fmt.Errorf("error fetching %s: %w", ref, err)
+	}
+
+	if err := verifyDigest(desc.Digest, desc.Manifest); err != nil {
+		return nil, fmt.Errorf("manifest of %s: %w", ref, err)
+	}
+
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifest of %s: %w", ref, err)
+	}
+	if manifest.Config.MediaType != BundleConfigMediaType || len(manifest.Layers) != 1 ||
+		manifest.Layers[0].MediaType != BundleLayerMediaType {
+		return nil, fmt.Errorf("%w: %s", ErrNotABundle, ref)
+	}
+
+	archive, err := s.fetchBlob(repo, manifest.Layers[0], opts)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching bundle %s: %w", ref, err)
+	}
+
+	bundle, err := mindpak.NewBundleFromTarGZReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load bundle %s: %w", ref, err)
+	}
+
+	manifestDigest, err := bundleManifestDigest(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("bundle %s: %w", ref, err)
+	}
+	if manifestDigest != manifest.Annotations[AnnotationManifestDigest] {
+		return nil, fmt.Errorf("bundle manifest of %s: %w", ref, ErrDigestMismatch)
+	}
+
+	metadata := bundle.Manifest.Metadata
+	if metadata == nil || metadata.Namespace != id.Namespace || metadata.Name != id.Name {
+		return nil, fmt.Errorf("%s does not contain bundle %s", ref, id)
+	}
+
+	if err := bundle.Verify(); err != nil {
+		return nil, fmt.Errorf("bundle failed verification: %w", err)
+	}
+
+	return reader.NewBundleReader(bundle), nil
+}
+
+// PushBundle pushes a bundle to the registry, tagged with its version, and
+// returns the digest of the pushed artifact. The bundle must have a
+// manifest, as created by `mindev bundle build`.
+func (s *OCISource) PushBundle(ctx context.Context, bundle *mindpak.Bundle) (string, error) {
+	if bundle.Manifest == nil {
+		return "", errors.New("bundle has no manifest")
+	}
+	metadata := bundle.Manifest.Metadata
+	if metadata == nil || metadata.Namespace == "" || metadata.Name == "" {
+		return "", errors.New("bundle manifest is missing the bundle namespace or name")
+	}
+	if !semver.IsValid(canonicalVersion(metadata.Version)) {
+		return "", fmt.Errorf("bundle version %q is not a semantic version", metadata.Version)
+	}
+
+	manifestDigest, err := bundleManifestDigest(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	var archive bytes.Buffer
+	if err := build.NewPacker().Write(bundle, &archive); err != nil {
+		return "", fmt.Errorf("error packing bundle: %w", err)
+	}
+
+	img, err := mutate.AppendLayers(empty.Image, static.NewLayer(archive.Bytes(), BundleLayerMediaType))
+	if err != nil {
+		return "", fmt.Errorf("error creating artifact: %w", err)
+	}
+	img = mutate.MediaType(img, types.OCIManifestSchema1)
+	img = mutate.ConfigMediaType(img, BundleConfigMediaType)
+	img = mutate.Annotations(img, map[string]string{
+		AnnotationManifestDigest: manifestDigest,
+		AnnotationNamespace:      metadata.Namespace,
+		AnnotationName:           metadata.Name,
+		AnnotationVersion:        metadata.Version,
+	}).(v1.Image)
+
+	repo, err := s.repository(mindpak.ID(metadata.Namespace, metadata.Name))
+	if err != nil {
+		return "", err
+	}
+	tag := repo.Tag(metadata.Version)
+	if err := remote.Write(tag, img, s.options(ctx)...); err != nil {
+		return "", fmt.Errorf("error pushing %s: %w", tag, err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return "", fmt.Errorf("error computing digest of %s: %w", tag, err)
+	}
+	return digest.String(), nil
+}
+
+// options returns the options for the requests to the registry
+func (s *OCISource) options(ctx context.Context) []remote.Option {
+	opts := make([]remote.Option, 0, len(s.remoteOpts)+1)
+	opts = append(opts, s.remoteOpts...)
+	return append(opts, remote.WithContext(ctx))
+}
+
+func (s *OCISource) repository(id mindpak.BundleID) (name.Repository, error) {
+	repo, err := name.NewRepository(
+		fmt.Sprintf("%s/%s/%s", s.base.Name(), id.Namespace, id.Name), s.nameOpts...)
+	if err != nil {
+		return name.Repository{}, fmt.Errorf("invalid repository for bundle %s: %w", id, err)
+	}
+	return repo, nil
+}
+
+// fetchBlob fetches a blob and checks it against its digest and size
+func (*OCISource) fetchBlob(repo name.Repository, desc v1.Descriptor, opts []remote.Option) ([]byte, error) {
+	layer, err := remote.Layer(repo.Digest(desc.Digest.String()), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	// Don't read more than the expected size, the digest check below
+	// catches a truncated blob.
+	data, err := io.ReadAll(io.LimitReader(rc, desc.Size+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != desc.Size {
+		return nil, fmt.Errorf("blob %s: size mismatch", desc.Digest)
+	}
+	if err := verifyDigest(desc.Digest, data); err != nil {
+		return nil, fmt.Errorf("blob %s: %w", desc.Digest, err)
+	}
+
+	return data, nil
+}
+
+func verifyDigest(expected v1.Hash, data []byte) error {
+	actual, _, err := v1.SHA256(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return fmt.Errorf("%w: expected %s, got %s", ErrDigestMismatch, expected, actual)
+	}
+	return nil
+}
+
+// bundleManifestDigest returns the digest of the manifest file of a bundle
+func bundleManifestDigest(bundle *mindpak.Bundle) (string, error) {
+	if bundle.Source == nil {
+		return "", errors.New("bundle has no source")
+	}
+
+	f, err := bundle.Source.Open(mindpak.ManifestFileName)
+	if err != nil {
+		return "", fmt.Errorf("error opening bundle manifest: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("error reading bundle manifest: %w", err)
+	}
+	return fmt.Sprintf("sha256:%x", h.Sum(nil)), nil
+}
+
+func canonicalVersion(version string) string {
+	if strings.HasPrefix(version, "v") {
+		return version
+	}
+	return "v" + version
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
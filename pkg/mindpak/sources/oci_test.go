@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sources_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/sources"
+)
+
+// newTestRegistry starts an in-memory OCI registry and returns its host
+func newTestRegistry(t *testing.T) string {
+	t.Helper()
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+	return strings.TrimPrefix(srv.URL, "http://")
+}
+
+func TestOCISource_PushAndPull(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	host := newTestRegistry(t)
+	source, err := sources.NewOCISource(host+"/mindpaks", sources.WithInsecureRegistry())
+	require.NoError(t, err)
+
+	bundle, err := mindpak.NewBundleFromDirectory(bundlePath)
+	require.NoError(t, err)
+
+	digest, err := source.PushBundle(ctx, bundle)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(digest, "sha256:"))
+
+	// A second, newer version
+	bundle.Manifest.Metadata.Version = "v0.1.0"
+	_, err = source.PushBundle(ctx, bundle)
+	require.NoError(t, err)
+
+	ids, err := source.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []mindpak.BundleID{mindpak.ID("stacklok", "t2")}, ids)
+
+	versions, err := source.ListVersions(ctx, mindpak.ID("stacklok", "t2"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"v0.0.1", "v0.1.0"}, versions)
+
+	// The bundle contents still describe the version they were built as
+	latest, err := source.GetBundle(mindpak.ID("stacklok", "t2"))
+	require.NoError(t, err)
+	require.Equal(t, "t2", latest.GetMetadata().Name)
+	require.Equal(t, "stacklok", latest.GetMetadata().Namespace)
+
+	byDigest, err := source.GetBundleVersion(ctx, mindpak.ID("stacklok", "t2"), digest)
+	require.NoError(t, err)
+	require.Equal(t, "t2", byDigest.GetMetadata().Name)
+	var ruleTypes []string
+	require.NoError(t, byDigest.ForEachRuleType(func(rt *minderv1.RuleType) error {
+		ruleTypes = append(ruleTypes, rt.GetName())
+		return nil
+	}))
+	require.Equal(t, []string{"branch_protection_enabled"}, ruleTypes)
+
+	_, err = source.GetBundle(mindpak.ID("acmecorp", "foobar"))
+	require.ErrorIs(t, err, sources.ErrBundleNotFound)
+
+	_, err = source.GetBundleVersion(ctx, mindpak.ID("stacklok", "t2"), "v9.9.9")
+	require.ErrorIs(t, err, sources.ErrBundleNotFound)
+}
+
+func TestOCISource_RejectsInvalidArtifacts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	host := newTestRegistry(t)
+	source, err := sources.NewOCISource(host+"/mindpaks", sources.WithInsecureRegistry(),
+		sources.WithBundles(mindpak.ID("stacklok", "t2")))
+	require.NoError(t, err)
+
+	ids, err := source.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []mindpak.BundleID{mindpak.ID("stacklok", "t2")}, ids)
+
+	bundle, err := mindpak.NewBundleFromDirectory(bundlePath)
+	require.NoError(t, err)
+	_, err = source.PushBundle(ctx, bundle)
+	require.NoError(t, err)
+
+	repo, err := name.NewRepository(host+"/mindpaks/stacklok/t2", name.Insecure)
+	require.NoError(t, err)
+
+	// Copy the bundle layer into an artifact with the wrong manifest digest
+	pushed, err := remote.Image(repo.Tag("v0.0.1"))
+	require.NoError(t, err)
+	layers, err := pushed.Layers()
+	require.NoError(t, err)
+	tampered, err := mutate.AppendLayers(empty.Image, layers...)
+	require.NoError(t, err)
+	tampered = mutate.MediaType(tampered, types.OCIManifestSchema1)
+	tampered = mutate.ConfigMediaType(tampered, sources.BundleConfigMediaType)
+	tampered = mutate.Annotations(tampered, map[string]string{
+		sources.AnnotationManifestDigest: "sha256:0000",
+	}).(v1.Image)
+	require.NoError(t, remote.Write(repo.Tag("v0.0.2"), tampered))
+
+	_, err = source.GetBundleVersion(ctx, mindpak.ID("stacklok", "t2"), "v0.0.2")
+	require.ErrorIs(t, err, sources.ErrDigestMismatch)
+
+	// An image which is not a bundle
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(repo.Tag("v0.0.3"), img))
+
+	_, err = source.GetBundleVersion(ctx, mindpak.ID("stacklok", "t2"), "v0.0.3")
+	require.ErrorIs(t, err, sources.ErrNotABundle)
+
+	// A bundle stored in the repository of another bundle
+	other, err := mutate.AppendLayers(empty.Image, static.NewLayer([]byte("not a bundle"), sources.BundleLayerMediaType))
+	require.NoError(t, err)
+	other = mutate.ConfigMediaType(mutate.MediaType(other, types.OCIManifestSchema1), sources.BundleConfigMediaType)
+	require.NoError(t, remote.Write(repo.Tag("v0.0.4"), other))
+
+	_, err = source.GetBundleVersion(ctx, mindpak.ID("stacklok", "t2"), "v0.0.4")
+	require.ErrorContains(t, err, "unable to load bundle")
+}
+
+const bundlePath = "../testdata/t2"
@@ -146,3 +146,15 @@ func profileSelectorFromDb(dbSelector db.ProfileSelector) ProfileSelector {
 		Selector: dbSelector.Selector,
 	}
 }
+
+// SelectorSliceFromPB converts a slice of protobuf profile selectors to a slice of ProfileSelector
+func SelectorSliceFromPB(pbSelectors []*minderv1.Profile_Selector) []ProfileSelector {
+	selectors := make([]ProfileSelector, 0, len(pbSelectors))
+	for _, pbSelector := range pbSelectors {
+		selectors = append(selectors, ProfileSelector{
+			Entity:   minderv1.EntityFromString(pbSelector.GetEntity()),
+			Selector: pbSelector.GetSelector(),
+		})
+	}
+	return selectors
+}
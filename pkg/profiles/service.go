@@ -149,6 +149,8 @@ func (p *profileService) CreateProfile(
 		Remediate:      db.ValidateRemediateType(profile.GetRemediate()),
 		Alert:          db.ValidateAlertType(profile.GetAlert()),
 		SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: subscriptionID != uuid.Nil},
+
+		EvaluationSchedule: evaluationScheduleToDB(profile.GetEvaluationSchedule()),
 	}
 
 	// Create profile
@@ -246,6 +248,8 @@ func (p *profileService) UpdateProfile(
 		Labels:      profile.GetLabels(),
 		Remediate:   db.ValidateRemediateType(profile.GetRemediate()),
 		Alert:       db.ValidateAlertType(profile.GetAlert()),
+
+		EvaluationSchedule: evaluationScheduleToDB(profile.GetEvaluationSchedule()),
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error updating profile: %v", err)
@@ -348,9 +352,13 @@ func patchProfilePb(oldProfilePb, patchPb *minderv1.Profile, updateMask *fieldma
 	}
 }
 
-// this is NOT a generic function, it only works because our Profiles only contain repeated or scalars.
+// this is NOT a generic function, it only works because our Profiles only contain repeated fields,
+// scalars, or messages which are replaced as a whole.
 func copyFieldValue(dstReflect, srcReflect protoreflect.Message, fieldDesc protoreflect.FieldDescriptor) {
-	if fieldDesc.Cardinality() == protoreflect.Repeated {
+	if fieldDesc.Message() != nil && fieldDesc.Cardinality() != protoreflect.Repeated && !srcReflect.Has(fieldDesc) {
+		// unsetting a message field clears it
+		dstReflect.Clear(fieldDesc)
+	} else if fieldDesc.Cardinality() == protoreflect.Repeated {
 		srcList := srcReflect.Get(fieldDesc).List()
 
 		// truncate the destination list to zero
@@ -5,6 +5,7 @@ package profiles
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -213,6 +214,8 @@ func MergeDatabaseListIntoProfiles[T db.ProfileRow](ppl []T) map[string]*pb.Prof
 				profiles[p.GetProfile().Name].Alert = proto.String(string(db.ActionTypeOn))
 			}
 
+			profiles[p.GetProfile().Name].EvaluationSchedule = evaluationScheduleFromDB(p.GetProfile().EvaluationSchedule)
+
 			selectorsToProfile(profiles[p.GetProfile().Name], p.GetSelectors())
 		}
 		if pm := rowInfoToProfileMap(
@@ -376,9 +379,44 @@ func dbProfileToPB(p db.Profile) *pb.Profile {
 		outprof.Alert = proto.String(string(db.ActionTypeOn))
 	}
 
+	outprof.EvaluationSchedule = evaluationScheduleFromDB(p.EvaluationSchedule)
+
 	return outprof
 }
 
+// evaluationIntervalPrefix is the cron descriptor used to store interval
+// evaluation schedules, so that all schedules can be parsed as cron specs.
+const evaluationIntervalPrefix = "@every "
+
+// evaluationScheduleToDB converts a profile evaluation schedule to the cron
+// spec stored in the database
+func evaluationScheduleToDB(sched *pb.Profile_EvaluationSchedule) sql.NullString {
+	switch s := sched.GetSchedule().(type) {
+	case *pb.Profile_EvaluationSchedule_Interval:
+		return sql.NullString{String: evaluationIntervalPrefix + s.Interval, Valid: true}
+	case *pb.Profile_EvaluationSchedule_Cron:
+		return sql.NullString{String: s.Cron, Valid: true}
+	default:
+		return sql.NullString{}
+	}
+}
+
+// evaluationScheduleFromDB converts the cron spec stored in the database
+// back to a profile evaluation schedule
+func evaluationScheduleFromDB(spec sql.NullString) *pb.Profile_EvaluationSchedule {
+	if !spec.Valid || spec.String == "" {
+		return nil
+	}
+	if interval, ok := strings.CutPrefix(spec.String, evaluationIntervalPrefix); ok {
+		return &pb.Profile_EvaluationSchedule{
+			Schedule: &pb.Profile_EvaluationSchedule_Interval{Interval: interval},
+		}
+	}
+	return &pb.Profile_EvaluationSchedule{
+		Schedule: &pb.Profile_EvaluationSchedule_Cron{Cron: spec.String},
+	}
+}
+
 // rowInfoToProfileMap adds the database row information to the given map of
 // profiles. This assumes that the profiles belong to the same project.
 // Note that this function is thought to be called from scpecific Merge functions
@@ -4,12 +4,16 @@
 package profiles_test
 
 import (
+	"database/sql"
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/mindersec/minder/internal/db"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/profiles"
 )
@@ -774,3 +778,49 @@ func TestDeriveProfileNameFromDisplayName(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeDatabaseGetIntoProfilesEvaluationSchedule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spec     sql.NullString
+		expected *minderv1.Profile_EvaluationSchedule
+	}{
+		{
+			name: "no schedule",
+		},
+		{
+			name: "interval",
+			spec: sql.NullString{String: "@every 1h", Valid: true},
+			expected: &minderv1.Profile_EvaluationSchedule{
+				Schedule: &minderv1.Profile_EvaluationSchedule_Interval{Interval: "1h"},
+			},
+		},
+		{
+			name: "cron",
+			spec: sql.NullString{String: "0 6 * * 1", Valid: true},
+			expected: &minderv1.Profile_EvaluationSchedule{
+				Schedule: &minderv1.Profile_EvaluationSchedule_Cron{Cron: "0 6 * * 1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profs := profiles.MergeDatabaseGetIntoProfiles([]db.GetProfileByProjectAndIDRow{{
+				Profile: db.Profile{
+					ID:                 uuid.New(),
+					Name:               "test",
+					ProjectID:          uuid.New(),
+					EvaluationSchedule: tt.spec,
+				},
+			}})
+			require.Len(t, profs, 1)
+			require.True(t, proto.Equal(tt.expected, profs["test"].GetEvaluationSchedule()),
+				"expected %v, got %v", tt.expected, profs["test"].GetEvaluationSchedule())
+		})
+	}
+}
@@ -60,3 +60,9 @@ type GitLabCredential interface {
 	GitCredential
 	OAuth2TokenCredential
 }
+
+// GiteaCredential is the interface for credentials used when interacting with Gitea or Forgejo
+type GiteaCredential interface {
+	RestCredential
+	GitCredential
+}
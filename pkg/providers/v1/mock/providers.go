@@ -220,18 +220,23 @@ func (mr *MockGitMockRecorder) CanImplement(trait any) *gomock.Call {
 }
 
 // Clone mocks base method.
-func (m *MockGit) Clone(ctx context.Context, url, branch string) (*git.Repository, error) {
+func (m *MockGit) Clone(ctx context.Context, url, branch string, opts ...v11.CloneOption) (*git.Repository, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Clone", ctx, url, branch)
+	varargs := []any{ctx, url, branch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Clone", varargs...)
 	ret0, _ := ret[0].(*git.Repository)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Clone indicates an expected call of Clone.
-func (mr *MockGitMockRecorder) Clone(ctx, url, branch any) *gomock.Call {
+func (mr *MockGitMockRecorder) Clone(ctx, url, branch any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockGit)(nil).Clone), ctx, url, branch)
+	varargs := append([]any{ctx, url, branch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockGit)(nil).Clone), varargs...)
 }
 
 // DeregisterEntity mocks base method.
@@ -550,6 +555,645 @@ func (mr *MockRESTMockRecorder) SupportsEntity(entType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockREST)(nil).SupportsEntity), entType)
 }
 
+// MockPullRequestProvider is a mock of PullRequestProvider interface.
+type MockPullRequestProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockPullRequestProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockPullRequestProviderMockRecorder is the mock recorder for MockPullRequestProvider.
+type MockPullRequestProviderMockRecorder struct {
+	mock *MockPullRequestProvider
+}
+
+// NewMockPullRequestProvider creates a new mock instance.
+func NewMockPullRequestProvider(ctrl *gomock.Controller) *MockPullRequestProvider {
+	mock := &MockPullRequestProvider{ctrl: ctrl}
+	mock.recorder = &MockPullRequestProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPullRequestProvider) EXPECT() *MockPullRequestProviderMockRecorder {
+	return m.recorder
+}
+
+// AddAuthToPushOptions mocks base method.
+func (m *MockPullRequestProvider) AddAuthToPushOptions(ctx context.Context, options *git.PushOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuthToPushOptions", ctx, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuthToPushOptions indicates an expected call of AddAuthToPushOptions.
+func (mr *MockPullRequestProviderMockRecorder) AddAuthToPushOptions(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuthToPushOptions", reflect.TypeOf((*MockPullRequestProvider)(nil).AddAuthToPushOptions), ctx, options)
+}
+
+// CanImplement mocks base method.
+func (m *MockPullRequestProvider) CanImplement(trait v10.ProviderType) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanImplement", trait)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanImplement indicates an expected call of CanImplement.
+func (mr *MockPullRequestProviderMockRecorder) CanImplement(trait any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanImplement", reflect.TypeOf((*MockPullRequestProvider)(nil).CanImplement), trait)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockPullRequestProvider) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockPullRequestProviderMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockPullRequestProvider)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// EditPullRequest mocks base method.
+func (m *MockPullRequestProvider) EditPullRequest(ctx context.Context, repo *v10.Repository, number int, title, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditPullRequest", ctx, repo, number, title, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditPullRequest indicates an expected call of EditPullRequest.
+func (mr *MockPullRequestProviderMockRecorder) EditPullRequest(ctx, repo, number, title, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditPullRequest", reflect.TypeOf((*MockPullRequestProvider)(nil).EditPullRequest), ctx, repo, number, title, body)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockPullRequestProvider) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockPullRequestProviderMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockPullRequestProvider)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// FetchProperty mocks base method.
+func (m *MockPullRequestProvider) FetchProperty(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, key string) (*properties.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchProperty", ctx, getByProps, entType, key)
+	ret0, _ := ret[0].(*properties.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchProperty indicates an expected call of FetchProperty.
+func (mr *MockPullRequestProviderMockRecorder) FetchProperty(ctx, getByProps, entType, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchProperty", reflect.TypeOf((*MockPullRequestProvider)(nil).FetchProperty), ctx, getByProps, entType, key)
+}
+
+// FindOpenPullRequest mocks base method.
+func (m *MockPullRequestProvider) FindOpenPullRequest(ctx context.Context, repo *v10.Repository, head string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpenPullRequest", ctx, repo, head)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpenPullRequest indicates an expected call of FindOpenPullRequest.
+func (mr *MockPullRequestProviderMockRecorder) FindOpenPullRequest(ctx, repo, head any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenPullRequest", reflect.TypeOf((*MockPullRequestProvider)(nil).FindOpenPullRequest), ctx, repo, head)
+}
+
+// GetCommitAuthor mocks base method.
+func (m *MockPullRequestProvider) GetCommitAuthor(ctx context.Context) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitAuthor", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCommitAuthor indicates an expected call of GetCommitAuthor.
+func (mr *MockPullRequestProviderMockRecorder) GetCommitAuthor(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitAuthor", reflect.TypeOf((*MockPullRequestProvider)(nil).GetCommitAuthor), ctx)
+}
+
+// GetEntityName mocks base method.
+func (m *MockPullRequestProvider) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockPullRequestProviderMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockPullRequestProvider)(nil).GetEntityName), entType, props)
+}
+
+// OpenPullRequest mocks base method.
+func (m *MockPullRequestProvider) OpenPullRequest(ctx context.Context, repo *v10.Repository, title, body, head, base string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenPullRequest", ctx, repo, title, body, head, base)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenPullRequest indicates an expected call of OpenPullRequest.
+func (mr *MockPullRequestProviderMockRecorder) OpenPullRequest(ctx, repo, title, body, head, base any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenPullRequest", reflect.TypeOf((*MockPullRequestProvider)(nil).OpenPullRequest), ctx, repo, title, body, head, base)
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockPullRequestProvider) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockPullRequestProviderMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockPullRequestProvider)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// RegisterEntity mocks base method.
+func (m *MockPullRequestProvider) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockPullRequestProviderMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockPullRequestProvider)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// ReregisterEntity mocks base method.
+func (m *MockPullRequestProvider) ReregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReregisterEntity indicates an expected call of ReregisterEntity.
+func (mr *MockPullRequestProviderMockRecorder) ReregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReregisterEntity", reflect.TypeOf((*MockPullRequestProvider)(nil).ReregisterEntity), ctx, entType, props)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockPullRequestProvider) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockPullRequestProviderMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockPullRequestProvider)(nil).SupportsEntity), entType)
+}
+
+// WithdrawPullRequest mocks base method.
+func (m *MockPullRequestProvider) WithdrawPullRequest(ctx context.Context, repo *v10.Repository, number int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawPullRequest", ctx, repo, number)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawPullRequest indicates an expected call of WithdrawPullRequest.
+func (mr *MockPullRequestProviderMockRecorder) WithdrawPullRequest(ctx, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawPullRequest", reflect.TypeOf((*MockPullRequestProvider)(nil).WithdrawPullRequest), ctx, repo, number)
+}
+
+// MockPullRequestCommenter is a mock of PullRequestCommenter interface.
+type MockPullRequestCommenter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPullRequestCommenterMockRecorder
+	isgomock struct{}
+}
+
+// MockPullRequestCommenterMockRecorder is the mock recorder for MockPullRequestCommenter.
+type MockPullRequestCommenterMockRecorder struct {
+	mock *MockPullRequestCommenter
+}
+
+// NewMockPullRequestCommenter creates a new mock instance.
+func NewMockPullRequestCommenter(ctrl *gomock.Controller) *MockPullRequestCommenter {
+	mock := &MockPullRequestCommenter{ctrl: ctrl}
+	mock.recorder = &MockPullRequestCommenterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPullRequestCommenter) EXPECT() *MockPullRequestCommenterMockRecorder {
+	return m.recorder
+}
+
+// CanImplement mocks base method.
+func (m *MockPullRequestCommenter) CanImplement(trait v10.ProviderType) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanImplement", trait)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanImplement indicates an expected call of CanImplement.
+func (mr *MockPullRequestCommenterMockRecorder) CanImplement(trait any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanImplement", reflect.TypeOf((*MockPullRequestCommenter)(nil).CanImplement), trait)
+}
+
+// CreatePullRequestComment mocks base method.
+func (m *MockPullRequestCommenter) CreatePullRequestComment(ctx context.Context, pr *properties.Properties, body string) (*v11.PullRequestComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullRequestComment", ctx, pr, body)
+	ret0, _ := ret[0].(*v11.PullRequestComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePullRequestComment indicates an expected call of CreatePullRequestComment.
+func (mr *MockPullRequestCommenterMockRecorder) CreatePullRequestComment(ctx, pr, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequestComment", reflect.TypeOf((*MockPullRequestCommenter)(nil).CreatePullRequestComment), ctx, pr, body)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockPullRequestCommenter) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockPullRequestCommenterMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockPullRequestCommenter)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockPullRequestCommenter) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockPullRequestCommenterMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockPullRequestCommenter)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// FetchProperty mocks base method.
+func (m *MockPullRequestCommenter) FetchProperty(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, key string) (*properties.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchProperty", ctx, getByProps, entType, key)
+	ret0, _ := ret[0].(*properties.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchProperty indicates an expected call of FetchProperty.
+func (mr *MockPullRequestCommenterMockRecorder) FetchProperty(ctx, getByProps, entType, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchProperty", reflect.TypeOf((*MockPullRequestCommenter)(nil).FetchProperty), ctx, getByProps, entType, key)
+}
+
+// GetEntityName mocks base method.
+func (m *MockPullRequestCommenter) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockPullRequestCommenterMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockPullRequestCommenter)(nil).GetEntityName), entType, props)
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockPullRequestCommenter) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockPullRequestCommenterMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockPullRequestCommenter)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// RegisterEntity mocks base method.
+func (m *MockPullRequestCommenter) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockPullRequestCommenterMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockPullRequestCommenter)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// ReregisterEntity mocks base method.
+func (m *MockPullRequestCommenter) ReregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReregisterEntity indicates an expected call of ReregisterEntity.
+func (mr *MockPullRequestCommenterMockRecorder) ReregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReregisterEntity", reflect.TypeOf((*MockPullRequestCommenter)(nil).ReregisterEntity), ctx, entType, props)
+}
+
+// ResolvePullRequestComment mocks base method.
+func (m *MockPullRequestCommenter) ResolvePullRequestComment(ctx context.Context, pr *properties.Properties, comment *v11.PullRequestComment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePullRequestComment", ctx, pr, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolvePullRequestComment indicates an expected call of ResolvePullRequestComment.
+func (mr *MockPullRequestCommenterMockRecorder) ResolvePullRequestComment(ctx, pr, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePullRequestComment", reflect.TypeOf((*MockPullRequestCommenter)(nil).ResolvePullRequestComment), ctx, pr, comment)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockPullRequestCommenter) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockPullRequestCommenterMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockPullRequestCommenter)(nil).SupportsEntity), entType)
+}
+
+// UpdatePullRequestComment mocks base method.
+func (m *MockPullRequestCommenter) UpdatePullRequestComment(ctx context.Context, pr *properties.Properties, comment *v11.PullRequestComment, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullRequestComment", ctx, pr, comment, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePullRequestComment indicates an expected call of UpdatePullRequestComment.
+func (mr *MockPullRequestCommenterMockRecorder) UpdatePullRequestComment(ctx, pr, comment, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestComment", reflect.TypeOf((*MockPullRequestCommenter)(nil).UpdatePullRequestComment), ctx, pr, comment, body)
+}
+
+// MockIssueTracker is a mock of IssueTracker interface.
+type MockIssueTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssueTrackerMockRecorder
+	isgomock struct{}
+}
+
+// MockIssueTrackerMockRecorder is the mock recorder for MockIssueTracker.
+type MockIssueTrackerMockRecorder struct {
+	mock *MockIssueTracker
+}
+
+// NewMockIssueTracker creates a new mock instance.
+func NewMockIssueTracker(ctrl *gomock.Controller) *MockIssueTracker {
+	mock := &MockIssueTracker{ctrl: ctrl}
+	mock.recorder = &MockIssueTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssueTracker) EXPECT() *MockIssueTrackerMockRecorder {
+	return m.recorder
+}
+
+// CanImplement mocks base method.
+func (m *MockIssueTracker) CanImplement(trait v10.ProviderType) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanImplement", trait)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanImplement indicates an expected call of CanImplement.
+func (mr *MockIssueTrackerMockRecorder) CanImplement(trait any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanImplement", reflect.TypeOf((*MockIssueTracker)(nil).CanImplement), trait)
+}
+
+// CloseIssue mocks base method.
+func (m *MockIssueTracker) CloseIssue(ctx context.Context, repo *v10.Repository, number int, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseIssue", ctx, repo, number, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseIssue indicates an expected call of CloseIssue.
+func (mr *MockIssueTrackerMockRecorder) CloseIssue(ctx, repo, number, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseIssue", reflect.TypeOf((*MockIssueTracker)(nil).CloseIssue), ctx, repo, number, comment)
+}
+
+// CreateIssue mocks base method.
+func (m *MockIssueTracker) CreateIssue(ctx context.Context, repo *v10.Repository, opts *v11.IssueOptions) (*v11.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssue", ctx, repo, opts)
+	ret0, _ := ret[0].(*v11.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssue indicates an expected call of CreateIssue.
+func (mr *MockIssueTrackerMockRecorder) CreateIssue(ctx, repo, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockIssueTracker)(nil).CreateIssue), ctx, repo, opts)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockIssueTracker) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockIssueTrackerMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockIssueTracker)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockIssueTracker) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockIssueTrackerMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockIssueTracker)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// FetchProperty mocks base method.
+func (m *MockIssueTracker) FetchProperty(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, key string) (*properties.Property, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchProperty", ctx, getByProps, entType, key)
+	ret0, _ := ret[0].(*properties.Property)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchProperty indicates an expected call of FetchProperty.
+func (mr *MockIssueTrackerMockRecorder) FetchProperty(ctx, getByProps, entType, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchProperty", reflect.TypeOf((*MockIssueTracker)(nil).FetchProperty), ctx, getByProps, entType, key)
+}
+
+// GetEntityName mocks base method.
+func (m *MockIssueTracker) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockIssueTrackerMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockIssueTracker)(nil).GetEntityName), entType, props)
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockIssueTracker) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockIssueTrackerMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockIssueTracker)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// RegisterEntity mocks base method.
+func (m *MockIssueTracker) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockIssueTrackerMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockIssueTracker)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// ReregisterEntity mocks base method.
+func (m *MockIssueTracker) ReregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReregisterEntity indicates an expected call of ReregisterEntity.
+func (mr *MockIssueTrackerMockRecorder) ReregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReregisterEntity", reflect.TypeOf((*MockIssueTracker)(nil).ReregisterEntity), ctx, entType, props)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockIssueTracker) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockIssueTrackerMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockIssueTracker)(nil).SupportsEntity), entType)
+}
+
+// UpdateIssue mocks base method.
+func (m *MockIssueTracker) UpdateIssue(ctx context.Context, repo *v10.Repository, number int, opts *v11.IssueOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssue", ctx, repo, number, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssue indicates an expected call of UpdateIssue.
+func (mr *MockIssueTrackerMockRecorder) UpdateIssue(ctx, repo, number, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssue", reflect.TypeOf((*MockIssueTracker)(nil).UpdateIssue), ctx, repo, number, opts)
+}
+
 // MockRepoLister is a mock of RepoLister interface.
 type MockRepoLister struct {
 	ctrl     *gomock.Controller
@@ -850,18 +1494,23 @@ func (mr *MockGitHubMockRecorder) CanImplement(trait any) *gomock.Call {
 }
 
 // Clone mocks base method.
-func (m *MockGitHub) Clone(ctx context.Context, url, branch string) (*git.Repository, error) {
+func (m *MockGitHub) Clone(ctx context.Context, url, branch string, opts ...v11.CloneOption) (*git.Repository, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Clone", ctx, url, branch)
+	varargs := []any{ctx, url, branch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Clone", varargs...)
 	ret0, _ := ret[0].(*git.Repository)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Clone indicates an expected call of Clone.
-func (mr *MockGitHubMockRecorder) Clone(ctx, url, branch any) *gomock.Call {
+func (mr *MockGitHubMockRecorder) Clone(ctx, url, branch any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockGitHub)(nil).Clone), ctx, url, branch)
+	varargs := append([]any{ctx, url, branch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockGitHub)(nil).Clone), varargs...)
 }
 
 // ClosePullRequest mocks base method.
@@ -1041,6 +1690,20 @@ func (mr *MockGitHubMockRecorder) EditHook(ctx, owner, repo, id, hook any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditHook", reflect.TypeOf((*MockGitHub)(nil).EditHook), ctx, owner, repo, id, hook)
 }
 
+// EditPullRequest mocks base method.
+func (m *MockGitHub) EditPullRequest(ctx context.Context, repo *v10.Repository, number int, title, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditPullRequest", ctx, repo, number, title, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditPullRequest indicates an expected call of EditPullRequest.
+func (mr *MockGitHubMockRecorder) EditPullRequest(ctx, repo, number, title, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditPullRequest", reflect.TypeOf((*MockGitHub)(nil).EditPullRequest), ctx, repo, number, title, body)
+}
+
 // FetchAllProperties mocks base method.
 func (m *MockGitHub) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
 	m.ctrl.T.Helper()
@@ -1071,6 +1734,21 @@ func (mr *MockGitHubMockRecorder) FetchProperty(ctx, getByProps, entType, key an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchProperty", reflect.TypeOf((*MockGitHub)(nil).FetchProperty), ctx, getByProps, entType, key)
 }
 
+// FindOpenPullRequest mocks base method.
+func (m *MockGitHub) FindOpenPullRequest(ctx context.Context, repo *v10.Repository, head string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpenPullRequest", ctx, repo, head)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpenPullRequest indicates an expected call of FindOpenPullRequest.
+func (mr *MockGitHubMockRecorder) FindOpenPullRequest(ctx, repo, head any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenPullRequest", reflect.TypeOf((*MockGitHub)(nil).FindOpenPullRequest), ctx, repo, head)
+}
+
 // GetArtifactVersions mocks base method.
 func (m *MockGitHub) GetArtifactVersions(ctx context.Context, artifact *v10.Artifact, filter v11.GetArtifactVersionsFilter) ([]*v10.ArtifactVersion, error) {
 	m.ctrl.T.Helper()
@@ -1115,6 +1793,22 @@ func (mr *MockGitHubMockRecorder) GetBranchProtection(arg0, arg1, arg2, arg3 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranchProtection", reflect.TypeOf((*MockGitHub)(nil).GetBranchProtection), arg0, arg1, arg2, arg3)
 }
 
+// GetCommitAuthor mocks base method.
+func (m *MockGitHub) GetCommitAuthor(ctx context.Context) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitAuthor", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCommitAuthor indicates an expected call of GetCommitAuthor.
+func (mr *MockGitHubMockRecorder) GetCommitAuthor(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitAuthor", reflect.TypeOf((*MockGitHub)(nil).GetCommitAuthor), ctx)
+}
+
 // GetCredential mocks base method.
 func (m *MockGitHub) GetCredential() v11.GitHubCredential {
 	m.ctrl.T.Helper()
@@ -1428,6 +2122,21 @@ func (mr *MockGitHubMockRecorder) NewRequest(method, url, body any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRequest", reflect.TypeOf((*MockGitHub)(nil).NewRequest), method, url, body)
 }
 
+// OpenPullRequest mocks base method.
+func (m *MockGitHub) OpenPullRequest(ctx context.Context, repo *v10.Repository, title, body, head, base string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenPullRequest", ctx, repo, title, body, head, base)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenPullRequest indicates an expected call of OpenPullRequest.
+func (mr *MockGitHubMockRecorder) OpenPullRequest(ctx, repo, title, body, head, base any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenPullRequest", reflect.TypeOf((*MockGitHub)(nil).OpenPullRequest), ctx, repo, title, body, head, base)
+}
+
 // PropertiesToProtoMessage mocks base method.
 func (m *MockGitHub) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
 	m.ctrl.T.Helper()
@@ -1574,6 +2283,20 @@ func (mr *MockGitHubMockRecorder) UpdateReview(arg0, arg1, arg2, arg3, arg4, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockGitHub)(nil).UpdateReview), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// WithdrawPullRequest mocks base method.
+func (m *MockGitHub) WithdrawPullRequest(ctx context.Context, repo *v10.Repository, number int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawPullRequest", ctx, repo, number)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawPullRequest indicates an expected call of WithdrawPullRequest.
+func (mr *MockGitHubMockRecorder) WithdrawPullRequest(ctx, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawPullRequest", reflect.TypeOf((*MockGitHub)(nil).WithdrawPullRequest), ctx, repo, number)
+}
+
 // MockImageLister is a mock of ImageLister interface.
 type MockImageLister struct {
 	ctrl     *gomock.Controller
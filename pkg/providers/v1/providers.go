@@ -90,7 +90,34 @@ type Git interface {
 	Provider
 
 	// Clone clones a git repository
-	Clone(ctx context.Context, url string, branch string) (*git.Repository, error)
+	Clone(ctx context.Context, url string, branch string, opts ...CloneOption) (*git.Repository, error)
+}
+
+// CloneOptions holds the optional settings for Git.Clone
+type CloneOptions struct {
+	// SparsePaths restricts the checked out worktree to the given
+	// directories. If empty, the whole tree is checked out.
+	SparsePaths []string
+}
+
+// CloneOption is a functional option for Git.Clone
+type CloneOption func(*CloneOptions)
+
+// WithSparsePaths restricts the worktree of the cloned repository to
+// the given directories
+func WithSparsePaths(paths ...string) CloneOption {
+	return func(o *CloneOptions) {
+		o.SparsePaths = append(o.SparsePaths, paths...)
+	}
+}
+
+// NewCloneOptions applies the given options to a new CloneOptions
+func NewCloneOptions(opts ...CloneOption) *CloneOptions {
+	co := &CloneOptions{}
+	for _, opt := range opts {
+		opt(co)
+	}
+	return co
 }
 
 // REST is the trait interface for interacting with an REST API.
@@ -107,6 +134,95 @@ type REST interface {
 	Do(ctx context.Context, req *http.Request) (*http.Response, error)
 }
 
+// PullRequestProvider is the trait interface for providers which can
+// propose changes to a repository, e.g. GitHub pull requests or GitLab
+// merge requests.
+type PullRequestProvider interface {
+	Provider
+
+	// AddAuthToPushOptions adds the credentials needed to push a branch
+	// to the repository.
+	AddAuthToPushOptions(ctx context.Context, options *git.PushOptions) error
+
+	// GetCommitAuthor returns the name and email of the authenticated user,
+	// used as the author of the commits proposed by minder.
+	GetCommitAuthor(ctx context.Context) (name string, email string, err error)
+
+	// FindOpenPullRequest returns the number of the open pull request whose
+	// head is the given branch, or 0 if there is none.
+	FindOpenPullRequest(ctx context.Context, repo *minderv1.Repository, head string) (int, error)
+
+	// OpenPullRequest opens a pull request merging the head branch into the
+	// base branch and returns its number.
+	OpenPullRequest(ctx context.Context, repo *minderv1.Repository, title, body, head, base string) (int, error)
+
+	// EditPullRequest updates the title and body of an open pull request.
+	EditPullRequest(ctx context.Context, repo *minderv1.Repository, number int, title, body string) error
+
+	// WithdrawPullRequest closes a pull request without merging it.
+	WithdrawPullRequest(ctx context.Context, repo *minderv1.Repository, number int) error
+}
+
+// PullRequestComment identifies a comment left by minder on a pull request
+type PullRequestComment struct {
+	// ID is the upstream ID of the comment
+	ID string
+	// ThreadID is the upstream ID of the thread holding the comment, for
+	// providers which group comments in threads.
+	ThreadID string
+}
+
+// PullRequestCommenter is the trait interface for providers which can
+// comment on pull requests, e.g. GitLab merge request notes.
+type PullRequestCommenter interface {
+	Provider
+
+	// CreatePullRequestComment posts a new comment on the pull request
+	// with the given properties.
+	CreatePullRequestComment(ctx context.Context, pr *properties.Properties, body string) (*PullRequestComment, error)
+
+	// UpdatePullRequestComment replaces the body of a comment. It returns
+	// ErrEntityNotFound if the comment no longer exists.
+	UpdatePullRequestComment(ctx context.Context, pr *properties.Properties, comment *PullRequestComment, body string) error
+
+	// ResolvePullRequestComment marks a comment as resolved. It returns
+	// ErrEntityNotFound if the comment no longer exists.
+	ResolvePullRequestComment(ctx context.Context, pr *properties.Properties, comment *PullRequestComment) error
+}
+
+// Issue identifies an issue opened on a repository
+type Issue struct {
+	// Number is the number of the issue within its repository
+	Number int
+	// URL is the web URL of the issue
+	URL string
+}
+
+// IssueOptions are the contents of an issue
+type IssueOptions struct {
+	Title     string
+	Body      string
+	Labels    []string
+	Assignees []string
+}
+
+// IssueTracker is the trait interface for providers which can open issues
+// on repositories, e.g. GitHub and GitLab issues.
+type IssueTracker interface {
+	Provider
+
+	// CreateIssue opens a new issue on the repository.
+	CreateIssue(ctx context.Context, repo *minderv1.Repository, opts *IssueOptions) (*Issue, error)
+
+	// UpdateIssue replaces the contents of an issue and reopens it if it
+	// was closed. It returns ErrEntityNotFound if the issue no longer exists.
+	UpdateIssue(ctx context.Context, repo *minderv1.Repository, number int, opts *IssueOptions) error
+
+	// CloseIssue leaves a comment on an issue and closes it. It returns
+	// ErrEntityNotFound if the issue no longer exists.
+	CloseIssue(ctx context.Context, repo *minderv1.Repository, number int, comment string) error
+}
+
 // RepoLister is the interface for listing repositories
 type RepoLister interface {
 	Provider
@@ -142,6 +258,7 @@ type GitHub interface {
 	Git
 	ImageLister
 	ArtifactProvider
+	PullRequestProvider
 
 	GetCredential() GitHubCredential
 	GetRepository(context.Context, string, string) (*github.Repository, error)
@@ -179,7 +296,6 @@ type GitHub interface {
 		opts *github.IssueListCommentsOptions,
 	) ([]*github.IssueComment, error)
 	UpdateIssueComment(ctx context.Context, owner, repo string, number int64, comment string) error
-	AddAuthToPushOptions(ctx context.Context, options *git.PushOptions) error
 	StartCheckRun(context.Context, string, string, *github.CreateCheckRunOptions) (*github.CheckRun, error)
 	UpdateCheckRun(context.Context, string, string, int64, *github.UpdateCheckRunOptions) (*github.CheckRun, error)
 }
@@ -310,6 +310,26 @@ func validateRuleUpdate(existingRecord *db.RuleType, newRuleType *pb.RuleType) e
 		return fmt.Errorf("cannot convert rule type %s to pb: %w", newRuleType.GetName(), err)
 	}
 
+	return validateSchemaUpdates(oldRuleType, newRuleType)
+}
+
+// ValidateRuleTypeUpdate checks that a rule type can be updated from before
+// to after: the new rule type must be valid, keep the same name, and its rule
+// and parameter schemas must be compatible with the old ones so the update
+// does not break the profiles which use the rule type.
+func ValidateRuleTypeUpdate(before, after *pb.RuleType) error {
+	if err := after.Validate(); err != nil {
+		return errors.Join(ErrRuleTypeInvalid, err)
+	}
+
+	if before.GetName() != after.GetName() {
+		return fmt.Errorf("%w: rule type name cannot be changed", ErrRuleTypeInvalid)
+	}
+
+	return validateSchemaUpdates(before, after)
+}
+
+func validateSchemaUpdates(oldRuleType, newRuleType *pb.RuleType) error {
 	oldDef := oldRuleType.GetDef()
 	newDef := newRuleType.GetDef()
 
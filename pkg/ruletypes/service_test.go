@@ -365,6 +365,53 @@ func TestRuleTypeService(t *testing.T) {
 	}
 }
 
+func TestValidateRuleTypeUpdate(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		Name          string
+		After         *pb.RuleType
+		ExpectedError string
+	}{
+		{
+			Name:  "ValidateRuleTypeUpdate accepts compatible update",
+			After: newRuleType(withBasicStructure, withEvaluationFailureMessage(shortFailureMessage)),
+		},
+		{
+			Name:          "ValidateRuleTypeUpdate rejects invalid rule type",
+			After:         newRuleType(withBasicStructure, withRuleName("")),
+			ExpectedError: ruletypes.ErrRuleTypeInvalid.Error(),
+		},
+		{
+			Name:          "ValidateRuleTypeUpdate rejects name change",
+			After:         newRuleType(withBasicStructure, withRuleName(namespacedRuleName)),
+			ExpectedError: "rule type name cannot be changed",
+		},
+		{
+			Name:          "ValidateRuleTypeUpdate rejects incompatible rule schema",
+			After:         newRuleType(withBasicStructure, withIncompatibleDef),
+			ExpectedError: "rule schema update is invalid",
+		},
+		{
+			Name:          "ValidateRuleTypeUpdate rejects incompatible param schema",
+			After:         newRuleType(withBasicStructure, withIncompatibleParams),
+			ExpectedError: "parameter schema update is invalid",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+			err := ruletypes.ValidateRuleTypeUpdate(newRuleType(withBasicStructure), scenario.After)
+			if scenario.ExpectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, scenario.ExpectedError)
+			}
+		})
+	}
+}
+
 type method int
 
 const (
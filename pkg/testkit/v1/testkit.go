@@ -6,7 +6,9 @@
 package v1
 
 import (
-	"net/http/httptest"
+	"sync"
+
+	"github.com/go-git/go-billy/v5"
 
 	"github.com/mindersec/minder/internal/engine/ingester/git"
 )
@@ -17,12 +19,37 @@ type TestKit struct {
 	ingestType string
 	// gitDir is the directory where the git repository is cloned
 	gitDir string
+	// gitFS is the file tree of the git repository. It takes precedence
+	// over gitDir.
+	gitFS billy.Filesystem
 
 	// HTTP
-	httpRecorder *httptest.ResponseRecorder
-	httpStatus   int
-	httpBody     []byte
-	httpHeaders  map[string]string
+	httpDefault *HTTPResponse
+	httpRoutes  []HTTPResponse
+
+	mu       sync.Mutex
+	requests []HTTPRequest
+}
+
+// HTTPResponse is a canned HTTP response returned by the TestKit
+type HTTPResponse struct {
+	// Method is the HTTP method the response is returned for. Any method
+	// matches if it's empty.
+	Method string
+	// Path is the URL path the response is returned for. Any path matches
+	// if it's empty.
+	Path    string
+	Status  int
+	Body    []byte
+	Headers map[string]string
+}
+
+// HTTPRequest is an HTTP request received by the TestKit
+type HTTPRequest struct {
+	Method string
+	// URL is the URL of the request, as passed to NewRequest
+	URL  string
+	Body []byte
 }
 
 // Option is a functional option type for TestKit
@@ -38,13 +65,35 @@ func WithGitDir(dir string) Option {
 	}
 }
 
-// WithHTTP is a functional option to set the HTTP response
+// WithGitFS is a functional option to set the file tree of the git
+// repository, e.g. an in-memory file system.
+// As with WithGitDir, the ingester in the rule type engine needs to be
+// overwritten.
+func WithGitFS(fs billy.Filesystem) Option {
+	return func(tp *TestKit) {
+		tp.ingestType = git.GitRuleDataIngestType
+		tp.gitFS = fs
+	}
+}
+
+// WithHTTP is a functional option to set the HTTP response returned
+// for requests that don't match any response set with WithHTTPResponse.
 func WithHTTP(status int, body []byte, headers map[string]string) Option {
 	return func(tp *TestKit) {
-		tp.httpRecorder = httptest.NewRecorder()
-		tp.httpStatus = status
-		tp.httpBody = body
-		tp.httpHeaders = headers
+		tp.httpDefault = &HTTPResponse{
+			Status:  status,
+			Body:    body,
+			Headers: headers,
+		}
+	}
+}
+
+// WithHTTPResponse is a functional option to add an HTTP response for
+// the requests matching its method and path. Responses are matched in the
+// order they were added.
+func WithHTTPResponse(resp HTTPResponse) Option {
+	return func(tp *TestKit) {
+		tp.httpRoutes = append(tp.httpRoutes, resp)
 	}
 }
 
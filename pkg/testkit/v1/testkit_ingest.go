@@ -50,7 +50,10 @@ func (*TestKit) GetConfig() protoreflect.ProtoMessage {
 func (tk *TestKit) fakeGit(
 	_ context.Context, _ protoreflect.ProtoMessage, _ map[string]any,
 ) (*interfaces.Result, error) {
-	fs := osfs.New(tk.gitDir)
+	fs := tk.gitFS
+	if fs == nil {
+		fs = osfs.New(tk.gitDir)
+	}
 	return &interfaces.Result{
 		Fs: fs,
 	}, nil
@@ -74,7 +74,7 @@ func (*TestKit) PropertiesToProtoMessage(_ minderv1.Entity, _ *properties.Proper
 
 // Clone Implements the Git trait. This is a stub implementation that allows us to instantiate a Git ingester.
 // This will later be overridden by the actual implementation.
-func (*TestKit) Clone(_ context.Context, _ string, _ string) (*git.Repository, error) {
+func (*TestKit) Clone(_ context.Context, _ string, _ string, _ ...provv1.CloneOption) (*git.Repository, error) {
 	// Note that this should not be called. If it is, it means that the ingester has not been overridden.
 	return nil, ErrNotIngeserOverridden
 }
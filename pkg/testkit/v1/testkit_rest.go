@@ -6,6 +6,8 @@ package v1
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -18,11 +20,23 @@ func (*TestKit) GetBaseURL() string {
 	return ""
 }
 
-// NewRequest implements the REST interface.
+// NewRequest implements the REST interface. As with the REST clients of
+// the providers, a body which is not a byte slice or a reader is encoded
+// as JSON.
 func (*TestKit) NewRequest(method, url string, body any) (*http.Request, error) {
 	var r io.Reader
-	if body != nil {
-		r = bytes.NewReader(body.([]byte))
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		r = bytes.NewReader(b)
+	case io.Reader:
+		r = b
+	default:
+		buf, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode request body: %w", err)
+		}
+		r = bytes.NewReader(buf)
 	}
 	return httptest.NewRequest(method, url, r), nil
 }
@@ -35,16 +49,56 @@ func (tk *TestKit) Do(ctx context.Context, req *http.Request) (*http.Response, e
 		Str("url", req.URL.String()).
 		Msg("HTTP request")
 
-	h := func(w http.ResponseWriter, _ *http.Request) {
-		for k, v := range tk.httpHeaders {
-			w.Header().Set(k, v)
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read request body: %w", err)
 		}
+	}
+
+	tk.mu.Lock()
+	tk.requests = append(tk.requests, HTTPRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Body:   body,
+	})
+	tk.mu.Unlock()
 
-		w.WriteHeader(tk.httpStatus)
-		_, _ = w.Write(tk.httpBody)
+	rec := httptest.NewRecorder()
+	resp := tk.responseFor(req)
+	if resp == nil {
+		http.Error(rec, "no response configured in the testkit", http.StatusNotFound)
+		return rec.Result(), nil
 	}
 
-	h(tk.httpRecorder, req)
+	for k, v := range resp.Headers {
+		rec.Header().Set(k, v)
+	}
+	rec.WriteHeader(resp.Status)
+	_, _ = rec.Write(resp.Body)
+
+	return rec.Result(), nil
+}
 
-	return tk.httpRecorder.Result(), nil
+// Requests returns the HTTP requests received so far, in order
+func (tk *TestKit) Requests() []HTTPRequest {
+	tk.mu.Lock()
+	defer tk.mu.Unlock()
+	return append([]HTTPRequest(nil), tk.requests...)
+}
+
+func (tk *TestKit) responseFor(req *http.Request) *HTTPResponse {
+	for i := range tk.httpRoutes {
+		route := &tk.httpRoutes[i]
+		if route.Method != "" && route.Method != req.Method {
+			continue
+		}
+		if route.Path != "" && route.Path != req.URL.Path {
+			continue
+		}
+		return route
+	}
+	return tk.httpDefault
 }
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestKitDo(t *testing.T) {
+	t.Parallel()
+
+	tk := NewTestKit(
+		WithHTTP(http.StatusOK, []byte("default"), nil),
+		WithHTTPResponse(HTTPResponse{
+			Method:  http.MethodGet,
+			Path:    "/repos/foo/bar",
+			Status:  http.StatusNotFound,
+			Body:    []byte("routed"),
+			Headers: map[string]string{"X-Test": "yes"},
+		}),
+	)
+
+	tests := []struct {
+		name       string
+		method     string
+		url        string
+		body       any
+		wantStatus int
+		wantBody   string
+		wantSent   string
+	}{
+		{
+			name:       "routed",
+			method:     http.MethodGet,
+			url:        "/repos/foo/bar?page=2",
+			wantStatus: http.StatusNotFound,
+			wantBody:   "routed",
+		},
+		{
+			name:       "other method falls back to the default",
+			method:     http.MethodPatch,
+			url:        "/repos/foo/bar",
+			body:       map[string]any{"enabled": true},
+			wantStatus: http.StatusOK,
+			wantBody:   "default",
+			wantSent:   `{"enabled":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		req, err := tk.NewRequest(tt.method, tt.url, tt.body)
+		require.NoError(t, err, tt.name)
+
+		resp, err := tk.Do(context.Background(), req)
+		require.NoError(t, err, tt.name)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err, tt.name)
+		require.NoError(t, resp.Body.Close())
+
+		require.Equal(t, tt.wantStatus, resp.StatusCode, tt.name)
+		require.Equal(t, tt.wantBody, string(body), tt.name)
+	}
+
+	requests := tk.Requests()
+	require.Len(t, requests, len(tests))
+	for i, tt := range tests {
+		require.Equal(t, tt.method, requests[i].Method)
+		require.Equal(t, tt.url, requests[i].URL)
+		require.Equal(t, tt.wantSent, string(requests[i].Body))
+	}
+}
+
+func TestTestKitDoWithoutResponse(t *testing.T) {
+	t.Parallel()
+
+	tk := NewTestKit()
+	req, err := tk.NewRequest(http.MethodGet, "/anything", nil)
+	require.NoError(t, err)
+
+	resp, err := tk.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}